@@ -85,8 +85,10 @@ type ToolHandlerFunc func(params ToolHandlerParams) (*ToolCallResult, error)
 type EventSubscriptionManager interface {
 	// Create creates a new subscription and returns it.
 	// The filters parameter should be events.SubscriptionFilters.
+	// resumeFrom is the resource version an events-mode subscription resumes from,
+	// or empty to start from now.
 	// Returns *events.Subscription on success.
-	Create(sessionID, cluster, mode string, filters interface{}) (interface{}, error)
+	Create(sessionID, cluster, mode string, filters interface{}, resumeFrom string) (interface{}, error)
 
 	// CancelBySessionAndID cancels a subscription by ID for a specific session.
 	CancelBySessionAndID(sessionID, subscriptionID string) error
@@ -94,6 +96,10 @@ type EventSubscriptionManager interface {
 	// ListSubscriptionsForSession returns all subscriptions for a session.
 	// Returns []*events.Subscription.
 	ListSubscriptionsForSession(sessionID string) interface{}
+
+	// GetRecentFaults returns the most recent faults detected on a cluster, newest-first.
+	// Returns []*events.FaultSignal.
+	GetRecentFaults(cluster string, limit int) interface{}
 }
 
 type Tool struct {
@@ -3,6 +3,7 @@ package events
 import (
 	"context"
 	"iter"
+	"sync"
 
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 )
@@ -10,27 +11,102 @@ import (
 // MCPServerAdapter adapts a real mcp.Server to the MCPServer interface.
 type MCPServerAdapter struct {
 	server *mcp.Server
+	levels *sessionLogLevels
 }
 
 // NewMCPServerAdapter creates a new adapter for the given mcp.Server.
+// It adds a sending middleware to the server so sessions can report whether
+// log notifications were sent or dropped because of the client's log level, and a
+// receiving middleware so they can report the log level itself.
 func NewMCPServerAdapter(server *mcp.Server) *MCPServerAdapter {
-	return &MCPServerAdapter{server: server}
+	levels := &sessionLogLevels{levels: make(map[*mcp.ServerSession]mcp.LoggingLevel)}
+	server.AddSendingMiddleware(logDeliveryMiddleware)
+	server.AddReceivingMiddleware(levels.middleware(server))
+	return &MCPServerAdapter{server: server, levels: levels}
+}
+
+// logDeliveryKey is the context key under which Log records whether the
+// notification reached the sending handler.
+type logDeliveryKey struct{}
+
+// logDeliveryMiddleware marks log notifications as delivered when they are actually
+// sent; mcp.ServerSession.Log returns nil both when sending and when dropping them.
+func logDeliveryMiddleware(next mcp.MethodHandler) mcp.MethodHandler {
+	return func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+		if delivered, ok := ctx.Value(logDeliveryKey{}).(*bool); ok && method == "notifications/message" {
+			*delivered = true
+		}
+		return next(ctx, method, req)
+	}
+}
+
+// sessionLogLevels records the log level each client set with logging/setLevel, which
+// mcp.ServerSession doesn't expose.
+type sessionLogLevels struct {
+	mu     sync.Mutex
+	levels map[*mcp.ServerSession]mcp.LoggingLevel
+}
+
+// middleware records the level of successful logging/setLevel requests. Levels of
+// sessions that have since closed are dropped on each request.
+func (l *sessionLogLevels) middleware(server *mcp.Server) mcp.Middleware {
+	return func(next mcp.MethodHandler) mcp.MethodHandler {
+		return func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+			result, err := next(ctx, method, req)
+			if method != "logging/setLevel" || err != nil {
+				return result, err
+			}
+			session, ok := req.GetSession().(*mcp.ServerSession)
+			if !ok {
+				return result, err
+			}
+			if params, ok := req.GetParams().(*mcp.SetLoggingLevelParams); ok {
+				l.set(server, session, params.Level)
+			}
+			return result, err
+		}
+	}
+}
+
+// set records the log level of session and drops those of closed sessions.
+func (l *sessionLogLevels) set(server *mcp.Server, session *mcp.ServerSession, level mcp.LoggingLevel) {
+	open := make(map[*mcp.ServerSession]bool)
+	for s := range server.Sessions() {
+		open[s] = true
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.levels[session] = level
+	for s := range l.levels {
+		if !open[s] {
+			delete(l.levels, s)
+		}
+	}
+}
+
+// get returns the log level session set, or empty if it hasn't set one.
+func (l *sessionLogLevels) get(session *mcp.ServerSession) mcp.LoggingLevel {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.levels[session]
 }
 
 // Sessions returns an iterator over active server sessions.
 func (a *MCPServerAdapter) Sessions() SessionIterator {
-	return &sessionIteratorAdapter{seq: a.server.Sessions()}
+	return &sessionIteratorAdapter{seq: a.server.Sessions(), levels: a.levels}
 }
 
 // sessionIteratorAdapter adapts iter.Seq[*mcp.ServerSession] to SessionIterator.
 type sessionIteratorAdapter struct {
-	seq iter.Seq[*mcp.ServerSession]
+	seq    iter.Seq[*mcp.ServerSession]
+	levels *sessionLogLevels
 }
 
 // All calls the yield function for each session in the iterator.
 func (s *sessionIteratorAdapter) All(yield func(ServerSession) bool) {
 	for session := range s.seq {
-		if !yield(&serverSessionAdapter{session: session}) {
+		if !yield(&serverSessionAdapter{session: session, levels: s.levels}) {
 			return
 		}
 	}
@@ -39,6 +115,13 @@ func (s *sessionIteratorAdapter) All(yield func(ServerSession) bool) {
 // serverSessionAdapter adapts mcp.ServerSession to ServerSession interface.
 type serverSessionAdapter struct {
 	session *mcp.ServerSession
+	levels  *sessionLogLevels
+}
+
+// LogLevel returns the log level the client set with logging/setLevel, or empty if
+// it hasn't set one.
+func (s *serverSessionAdapter) LogLevel() mcp.LoggingLevel {
+	return s.levels.get(s.session)
 }
 
 // ID returns the session ID.
@@ -46,7 +129,9 @@ func (s *serverSessionAdapter) ID() string {
 	return s.session.ID()
 }
 
-// Log sends a log message to the session.
-func (s *serverSessionAdapter) Log(ctx context.Context, params *mcp.LoggingMessageParams) error {
-	return s.session.Log(ctx, params)
+// Log sends a log message to the session and reports whether it was sent.
+func (s *serverSessionAdapter) Log(ctx context.Context, params *mcp.LoggingMessageParams) (bool, error) {
+	delivered := false
+	err := s.session.Log(context.WithValue(ctx, logDeliveryKey{}, &delivered), params)
+	return delivered, err
 }
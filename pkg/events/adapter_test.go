@@ -0,0 +1,100 @@
+package events
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/suite"
+)
+
+type AdapterTestSuite struct {
+	suite.Suite
+}
+
+func TestAdapterSuite(t *testing.T) {
+	suite.Run(t, new(AdapterTestSuite))
+}
+
+// TestServerSessionLogDelivery tests that adapted sessions report whether log notifications were sent
+func (s *AdapterTestSuite) TestServerSessionLogDelivery() {
+	// connect returns the adapted server session and a channel receiving the client's log messages
+	connect := func(ctx context.Context) (ServerSession, *mcp.ClientSession, chan *mcp.LoggingMessageParams) {
+		server := mcp.NewServer(&mcp.Implementation{Name: "test-server"}, nil)
+		adapter := NewMCPServerAdapter(server)
+
+		received := make(chan *mcp.LoggingMessageParams, 1)
+		client := mcp.NewClient(&mcp.Implementation{Name: "test-client"}, &mcp.ClientOptions{
+			LoggingMessageHandler: func(_ context.Context, req *mcp.LoggingMessageRequest) {
+				received <- req.Params
+			},
+		})
+
+		serverTransport, clientTransport := mcp.NewInMemoryTransports()
+		_, err := server.Connect(ctx, serverTransport, nil)
+		s.Require().NoError(err)
+		clientSession, err := client.Connect(ctx, clientTransport, nil)
+		s.Require().NoError(err)
+		s.T().Cleanup(func() { _ = clientSession.Close() })
+
+		var session ServerSession
+		adapter.Sessions().All(func(ss ServerSession) bool {
+			session = ss
+			return false
+		})
+		s.Require().NotNil(session)
+		return session, clientSession, received
+	}
+
+	s.Run("reports delivery once the client set a log level", func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		session, clientSession, received := connect(ctx)
+		s.Require().NoError(clientSession.SetLoggingLevel(ctx, &mcp.SetLoggingLevelParams{Level: "info"}))
+
+		delivered, err := session.Log(ctx, &mcp.LoggingMessageParams{Level: "info", Logger: LoggerTestNotification, Data: "hello"})
+		s.Require().NoError(err)
+		s.True(delivered)
+
+		select {
+		case params := <-received:
+			s.Equal(LoggerTestNotification, params.Logger)
+		case <-ctx.Done():
+			s.Fail("client did not receive the notification")
+		}
+	})
+
+	s.Run("reports the log level the client set", func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		session, clientSession, _ := connect(ctx)
+		reporter, ok := session.(logLevelReporter)
+		s.Require().True(ok, "adapted sessions report their log level")
+		s.Empty(reporter.LogLevel())
+
+		s.Require().NoError(clientSession.SetLoggingLevel(ctx, &mcp.SetLoggingLevelParams{Level: "warning"}))
+		s.Equal(mcp.LoggingLevel("warning"), reporter.LogLevel())
+	})
+
+	s.Run("reports a drop when the client set no log level", func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		session, _, _ := connect(ctx)
+
+		delivered, err := session.Log(ctx, &mcp.LoggingMessageParams{Level: "info", Data: "hello"})
+		s.Require().NoError(err)
+		s.False(delivered)
+	})
+
+	s.Run("reports a drop below the client's log level", func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		session, clientSession, _ := connect(ctx)
+		s.Require().NoError(clientSession.SetLoggingLevel(ctx, &mcp.SetLoggingLevelParams{Level: "error"}))
+
+		delivered, err := session.Log(ctx, &mcp.LoggingMessageParams{Level: "info", Data: "hello"})
+		s.Require().NoError(err)
+		s.False(delivered)
+	})
+}
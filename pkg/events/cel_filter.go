@@ -0,0 +1,106 @@
+package events
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/google/cel-go/cel"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/lru"
+)
+
+// celProgramCacheMaxEntries bounds the number of cached CEL programs.
+const celProgramCacheMaxEntries = 1000
+
+// celEnv declares the event variable available to CEL filter expressions. Its fields
+// are namespace, type, reason, message, count, and involvedObject (with kind, name,
+// namespace, uid, apiVersion, and fieldPath). The fields are grouped under "event"
+// because "type" and "namespace" are reserved identifiers in CEL.
+var celEnv = sync.OnceValues(func() (*cel.Env, error) {
+	return cel.NewEnv(
+		cel.Variable("event", cel.MapType(cel.StringType, cel.DynType)),
+	)
+})
+
+// celPrograms caches compiled CEL programs by expression, evicting the least
+// recently used ones. Filters are copied by value, so programs are cached here
+// rather than on SubscriptionFilters.
+var celPrograms = lru.New(celProgramCacheMaxEntries) // expression -> cel.Program
+
+// compileCELExpression compiles a CEL filter expression. The expression must evaluate
+// to a bool; expressions of dynamic type are checked when evaluated.
+func compileCELExpression(expression string) (cel.Program, error) {
+	env, err := celEnv()
+	if err != nil {
+		return nil, err
+	}
+
+	ast, issues := env.Compile(expression)
+	if issues != nil && issues.Err() != nil {
+		return nil, issues.Err()
+	}
+
+	if outputType := ast.OutputType(); outputType != cel.BoolType && outputType != cel.DynType {
+		return nil, fmt.Errorf("expression must evaluate to bool, got %s", outputType)
+	}
+
+	return env.Program(ast)
+}
+
+// cachedCELProgram returns the compiled program for expression, compiling it on a
+// cache miss. Only matching caches programs, so validating expressions of rejected
+// subscriptions doesn't evict the programs of active ones.
+func cachedCELProgram(expression string) (cel.Program, error) {
+	if cached, ok := celPrograms.Get(expression); ok {
+		return cached.(cel.Program), nil
+	}
+
+	program, err := compileCELExpression(expression)
+	if err != nil {
+		return nil, err
+	}
+	celPrograms.Add(expression, program)
+	return program, nil
+}
+
+// matchesCELExpression checks if an event satisfies the given CEL expression.
+// Expressions that fail to compile or evaluate (e.g., a missing field) or that don't
+// return a bool don't match.
+func matchesCELExpression(event *corev1.Event, expression string) bool {
+	program, err := cachedCELProgram(expression)
+	if err != nil {
+		// This should not happen as we validated in Validate()
+		return false
+	}
+
+	result, _, err := program.Eval(celActivation(event))
+	if err != nil {
+		klog.V(4).Infof("CEL expression %q failed to evaluate for event %s/%s: %v", expression, event.Namespace, event.Name, err)
+		return false
+	}
+
+	matched, ok := result.Value().(bool)
+	return ok && matched
+}
+
+// celActivation binds the event fields to the event variable declared in celEnv.
+func celActivation(event *corev1.Event) map[string]any {
+	return map[string]any{
+		"event": map[string]any{
+			"namespace": event.Namespace,
+			"type":      event.Type,
+			"reason":    event.Reason,
+			"message":   event.Message,
+			"count":     int64(eventCount(event)),
+			"involvedObject": map[string]any{
+				"kind":       event.InvolvedObject.Kind,
+				"name":       event.InvolvedObject.Name,
+				"namespace":  event.InvolvedObject.Namespace,
+				"uid":        string(event.InvolvedObject.UID),
+				"apiVersion": event.InvolvedObject.APIVersion,
+				"fieldPath":  event.InvolvedObject.FieldPath,
+			},
+		},
+	}
+}
@@ -0,0 +1,197 @@
+package events
+
+import (
+	"sync"
+	"time"
+
+	"k8s.io/klog/v2"
+)
+
+// DefaultBreakerFailureThreshold is the default number of consecutive failed watch
+// attempts on a cluster after which its circuit breaker opens.
+const DefaultBreakerFailureThreshold = 5
+
+// DefaultBreakerCooldown is the default time a cluster's circuit breaker stays open
+// before a single trial watch attempt is allowed.
+const DefaultBreakerCooldown = 30 * time.Second
+
+// maxBreakerProbeWait caps how long a watch waits for another watch's trial attempt
+// to settle a half-open breaker before checking again.
+const maxBreakerProbeWait = time.Second
+
+// BreakerState represents the state of a cluster's circuit breaker.
+type BreakerState string
+
+const (
+	// BreakerClosed indicates watch attempts on the cluster are allowed
+	BreakerClosed BreakerState = "Closed"
+	// BreakerOpen indicates the cluster is failing persistently and watch attempts are paused
+	BreakerOpen BreakerState = "Open"
+	// BreakerHalfOpen indicates the cooldown has passed and a trial watch attempt is allowed
+	BreakerHalfOpen BreakerState = "HalfOpen"
+)
+
+// CircuitBreaker pauses watch attempts on a cluster that keeps failing, so every
+// subscription on an unavailable cluster doesn't keep retrying against its API server.
+// After threshold consecutive failures the breaker opens and rejects attempts for the
+// cooldown. It then half-opens and lets one trial attempt through: success closes it,
+// failure opens it for another cooldown.
+//
+// Thread-safe for concurrent use.
+type CircuitBreaker struct {
+	name      string // identifies the breaker in logs, e.g. the cluster name
+	mu        sync.Mutex
+	state     BreakerState
+	failures  int       // consecutive failures while closed
+	openedAt  time.Time // when the breaker last opened
+	probingAt time.Time // when the half-open trial attempt started; zero if none is running
+	threshold int
+	cooldown  time.Duration
+	now       func() time.Time
+}
+
+// NewCircuitBreaker creates a closed circuit breaker that opens after threshold
+// consecutive failures and stays open for cooldown.
+func NewCircuitBreaker(threshold int, cooldown time.Duration) *CircuitBreaker {
+	return &CircuitBreaker{
+		state:     BreakerClosed,
+		threshold: threshold,
+		cooldown:  cooldown,
+		now:       time.Now,
+	}
+}
+
+// Allow reports whether a watch attempt may be made now. When it may not, it also
+// returns how long to wait before asking again.
+func (b *CircuitBreaker) Allow() (bool, time.Duration) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	now := b.now()
+	switch b.state {
+	case BreakerOpen:
+		if remaining := b.openedAt.Add(b.cooldown).Sub(now); remaining > 0 {
+			return false, remaining
+		}
+		b.state = BreakerHalfOpen
+		b.probingAt = now
+		klog.V(1).Infof("Circuit breaker %q half-open, allowing a trial watch attempt", b.name)
+		return true, 0
+	case BreakerHalfOpen:
+		// A trial attempt that never reported back (e.g. its watch was stopped) must not
+		// hold the breaker half-open forever
+		if b.probingAt.IsZero() || now.Sub(b.probingAt) >= b.cooldown {
+			b.probingAt = now
+			return true, 0
+		}
+		return false, min(b.cooldown, maxBreakerProbeWait)
+	default:
+		return true, 0
+	}
+}
+
+// RecordSuccess records a successful watch attempt, closing the breaker.
+func (b *CircuitBreaker) RecordSuccess() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.state != BreakerClosed {
+		klog.V(1).Infof("Circuit breaker %q closed after a successful watch attempt", b.name)
+	}
+	b.state = BreakerClosed
+	b.failures = 0
+	b.probingAt = time.Time{}
+}
+
+// RecordFailure records a failed watch attempt, opening the breaker once the
+// threshold is reached or if the half-open trial attempt failed.
+func (b *CircuitBreaker) RecordFailure() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch b.state {
+	case BreakerHalfOpen:
+		b.open()
+	case BreakerClosed:
+		b.failures++
+		if b.failures >= b.threshold {
+			b.open()
+		}
+	}
+}
+
+// open opens the breaker for a cooldown. Must be called with the lock held.
+func (b *CircuitBreaker) open() {
+	b.state = BreakerOpen
+	b.openedAt = b.now()
+	b.failures = 0
+	b.probingAt = time.Time{}
+	klog.Warningf("Circuit breaker %q open, pausing watch attempts for %s", b.name, b.cooldown)
+}
+
+// State returns the current state of the breaker. An open breaker whose cooldown
+// has passed reports BreakerHalfOpen, as the next attempt will be allowed.
+func (b *CircuitBreaker) State() BreakerState {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.state == BreakerOpen && !b.now().Before(b.openedAt.Add(b.cooldown)) {
+		return BreakerHalfOpen
+	}
+	return b.state
+}
+
+// clusterBreakers holds one circuit breaker per cluster, shared by every watch on
+// that cluster.
+//
+// Thread-safe for concurrent use.
+type clusterBreakers struct {
+	mu        sync.Mutex
+	breakers  map[string]*CircuitBreaker // cluster -> breaker
+	threshold int
+	cooldown  time.Duration
+}
+
+// newClusterBreakers creates breakers opening after threshold consecutive failures
+// and staying open for cooldown.
+func newClusterBreakers(threshold int, cooldown time.Duration) *clusterBreakers {
+	return &clusterBreakers{
+		breakers:  make(map[string]*CircuitBreaker),
+		threshold: threshold,
+		cooldown:  cooldown,
+	}
+}
+
+// forCluster returns the breaker of a cluster, creating it if needed.
+func (c *clusterBreakers) forCluster(cluster string) *CircuitBreaker {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	breaker, exists := c.breakers[cluster]
+	if !exists {
+		breaker = NewCircuitBreaker(c.threshold, c.cooldown)
+		breaker.name = cluster
+		c.breakers[cluster] = breaker
+	}
+	return breaker
+}
+
+// remove drops the breaker of a cluster, e.g. once the cluster is no longer configured.
+func (c *clusterBreakers) remove(cluster string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.breakers, cluster)
+}
+
+// states returns the state of every cluster's breaker, keyed by cluster.
+func (c *clusterBreakers) states() map[string]BreakerState {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	states := make(map[string]BreakerState, len(c.breakers))
+	for cluster, breaker := range c.breakers {
+		states[cluster] = breaker.State()
+	}
+	return states
+}
@@ -0,0 +1,142 @@
+package events
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type CircuitBreakerTestSuite struct {
+	suite.Suite
+	now     time.Time
+	breaker *CircuitBreaker
+}
+
+func (s *CircuitBreakerTestSuite) SetupTest() {
+	s.now = time.Now()
+	s.breaker = NewCircuitBreaker(3, time.Minute)
+	s.breaker.now = func() time.Time { return s.now }
+}
+
+func (s *CircuitBreakerTestSuite) SetupSubTest() {
+	s.SetupTest()
+}
+
+func TestCircuitBreakerSuite(t *testing.T) {
+	suite.Run(t, new(CircuitBreakerTestSuite))
+}
+
+// fail records n failed attempts.
+func (s *CircuitBreakerTestSuite) fail(n int) {
+	for i := 0; i < n; i++ {
+		s.breaker.RecordFailure()
+	}
+}
+
+func (s *CircuitBreakerTestSuite) TestClosed() {
+	s.Run("allows attempts below the threshold", func() {
+		s.fail(2)
+
+		allowed, _ := s.breaker.Allow()
+		s.True(allowed)
+		s.Equal(BreakerClosed, s.breaker.State())
+	})
+
+	s.Run("success resets the failure count", func() {
+		s.fail(2)
+		s.breaker.RecordSuccess()
+		s.fail(2)
+
+		s.Equal(BreakerClosed, s.breaker.State(), "failures should be consecutive")
+	})
+}
+
+func (s *CircuitBreakerTestSuite) TestOpen() {
+	s.Run("opens at the threshold", func() {
+		s.fail(3)
+
+		s.Equal(BreakerOpen, s.breaker.State())
+	})
+
+	s.Run("rejects attempts for the rest of the cooldown", func() {
+		s.fail(3)
+		s.now = s.now.Add(20 * time.Second)
+
+		allowed, wait := s.breaker.Allow()
+		s.False(allowed)
+		s.Equal(40*time.Second, wait)
+	})
+}
+
+func (s *CircuitBreakerTestSuite) TestHalfOpen() {
+	s.Run("allows a single trial attempt after the cooldown", func() {
+		s.fail(3)
+		s.now = s.now.Add(time.Minute)
+		s.Equal(BreakerHalfOpen, s.breaker.State())
+
+		allowed, _ := s.breaker.Allow()
+		s.True(allowed, "the trial attempt should be allowed")
+		allowed, wait := s.breaker.Allow()
+		s.False(allowed, "other attempts should wait for the trial")
+		s.Equal(maxBreakerProbeWait, wait)
+	})
+
+	s.Run("successful trial closes the breaker", func() {
+		s.fail(3)
+		s.now = s.now.Add(time.Minute)
+		allowed, _ := s.breaker.Allow()
+		s.Require().True(allowed)
+
+		s.breaker.RecordSuccess()
+
+		s.Equal(BreakerClosed, s.breaker.State())
+		allowed, _ = s.breaker.Allow()
+		s.True(allowed)
+	})
+
+	s.Run("failed trial reopens the breaker for another cooldown", func() {
+		s.fail(3)
+		s.now = s.now.Add(time.Minute)
+		allowed, _ := s.breaker.Allow()
+		s.Require().True(allowed)
+
+		s.breaker.RecordFailure()
+
+		s.Equal(BreakerOpen, s.breaker.State())
+		allowed, wait := s.breaker.Allow()
+		s.False(allowed)
+		s.Equal(time.Minute, wait)
+	})
+
+	s.Run("abandoned trial is retried after a cooldown", func() {
+		s.fail(3)
+		s.now = s.now.Add(time.Minute)
+		allowed, _ := s.breaker.Allow()
+		s.Require().True(allowed)
+
+		s.now = s.now.Add(time.Minute)
+		allowed, _ = s.breaker.Allow()
+		s.True(allowed, "a trial that never reported back should not block the breaker")
+	})
+}
+
+func (s *CircuitBreakerTestSuite) TestClusterBreakers() {
+	s.Run("shares a breaker per cluster", func() {
+		breakers := newClusterBreakers(1, time.Minute)
+
+		s.Same(breakers.forCluster("cluster1"), breakers.forCluster("cluster1"))
+		s.NotSame(breakers.forCluster("cluster1"), breakers.forCluster("cluster2"))
+	})
+
+	s.Run("reports the state of each cluster", func() {
+		breakers := newClusterBreakers(1, time.Minute)
+		breakers.forCluster("cluster1").RecordFailure()
+		breakers.forCluster("cluster2")
+
+		s.Equal(map[string]BreakerState{"cluster1": BreakerOpen, "cluster2": BreakerClosed}, breakers.states())
+
+		breakers.remove("cluster1")
+		s.Equal(map[string]BreakerState{"cluster2": BreakerClosed}, breakers.states())
+	})
+}
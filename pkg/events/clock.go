@@ -0,0 +1,31 @@
+package events
+
+import (
+	"time"
+
+	"k8s.io/utils/clock"
+)
+
+// Clock abstracts the passing of time for the backoffs, deduplication TTLs, and session
+// monitoring, so time-based logic can be tested without real sleeps.
+//
+// It is satisfied by clock.RealClock, the default, and by the fake clocks of
+// k8s.io/utils/clock/testing, which only move forward when stepped.
+type Clock interface {
+	// Now returns the current time.
+	Now() time.Time
+	// After returns a channel receiving the current time once d has passed.
+	After(d time.Duration) <-chan time.Time
+	// NewTimer returns a timer firing once d has passed, which can be stopped early.
+	NewTimer(d time.Duration) clock.Timer
+	// NewTicker returns a ticker firing every d.
+	NewTicker(d time.Duration) clock.Ticker
+}
+
+// clockOrDefault returns c, or the real clock if c is nil.
+func clockOrDefault(c Clock) Clock {
+	if c == nil {
+		return clock.RealClock{}
+	}
+	return c
+}
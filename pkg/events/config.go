@@ -1,6 +1,30 @@
 package events
 
-import "time"
+import (
+	"time"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+// DefaultNotificationTimeout is the default time allowed for a single notification
+// send before the session is considered unreachable.
+const DefaultNotificationTimeout = 2 * time.Second
+
+// DefaultMaxNotificationFailures is the default number of consecutive failed notification
+// sends after which a subscription is cancelled.
+const DefaultMaxNotificationFailures = 3
+
+// DefaultMaxNotificationRetries is the default number of times a notification send failing
+// with a transient error is retried.
+const DefaultMaxNotificationRetries = 2
+
+// DefaultNotificationRetryBackoff is the default wait before the first retry of a
+// notification send, doubled for each further retry.
+const DefaultNotificationRetryBackoff = 100 * time.Millisecond
+
+// DefaultShutdownDrainTimeout is the default time shutdown waits for queued and
+// in-flight notifications to be sent.
+const DefaultShutdownDrainTimeout = 5 * time.Second
 
 // ManagerConfig holds configuration for the EventSubscriptionManager.
 // All fields have sensible defaults specified in the design document.
@@ -13,6 +37,29 @@ type ManagerConfig struct {
 	// Default: 100
 	MaxSubscriptionsGlobal int
 
+	// MaxSubscriptionsPerMinute limits how many subscriptions a session may create per minute,
+	// protecting watch connections from clients creating subscriptions in a loop.
+	// Attempts count against the limit even if they fail, and attempts beyond it are
+	// rejected with ErrCreationRateExceeded. Zero disables rate limiting.
+	// Default: 30
+	MaxSubscriptionsPerMinute int
+
+	// MaxWatchConnections limits the number of concurrent watches across all clusters and
+	// subscriptions, protecting API servers from watch exhaustion. A shared event watch counts
+	// once however many subscriptions use it, and each faults subscription counts once.
+	// Subscriptions needing a watch beyond the limit are rejected with
+	// ErrWatchConnectionLimitExceeded. Zero disables the limit.
+	// Default: 50
+	MaxWatchConnections int
+
+	// MaxResourceWatchers limits the number of ResourceWatchers running across all faults
+	// subscriptions and clusters. Each one runs informers caching every resource its
+	// detectors inspect, so this bounds memory in large multi-cluster setups. Faults
+	// subscriptions beyond the limit are rejected with ErrResourceWatcherLimitExceeded.
+	// Zero disables the limit.
+	// Default: 25
+	MaxResourceWatchers int
+
 	// MaxLogCapturesPerCluster limits concurrent log capture operations per cluster.
 	// Default: 5
 	MaxLogCapturesPerCluster int
@@ -29,21 +76,184 @@ type ManagerConfig struct {
 	// Default: 5
 	MaxContainersPerNotification int
 
+	// MaxConcurrentEnrichments limits how many fault enrichments (container log and
+	// related event fetches) may run at the same time across all faults subscriptions.
+	// Faults detected while the limit is reached are sent without logs or related events.
+	// Non-positive values fall back to the default.
+	// Default: 10
+	MaxConcurrentEnrichments int
+
 	// EventDeduplicationWindow specifies the time window for deduplicating event notifications.
 	// Default: 5s
 	EventDeduplicationWindow time.Duration
 
 	// FaultDeduplicationWindow specifies the time window for deduplicating fault notifications.
-	// Default: 60s
+	// A fault condition reported again within the window is suppressed, and reports of the
+	// same fault within the window are recorded once in the fault history. Non-positive
+	// values fall back to the default.
+	// Default: 15m (DeduplicationTTL)
 	FaultDeduplicationWindow time.Duration
 
+	// FaultCoalescingWindow specifies how long faults for the same resource are collected
+	// into a single notification, so correlated faults (e.g. a crash and the resulting
+	// CrashLoopBackOff) are reported together. Zero disables coalescing.
+	// Default: 2s
+	FaultCoalescingWindow time.Duration
+
 	// SessionMonitorInterval specifies how often to check for stale sessions.
 	// Default: 30s (must be long enough to tolerate brief network interruptions)
 	SessionMonitorInterval time.Duration
 
+	// MaxSubscriptionTTL is the maximum lifetime of a subscription. The session monitor
+	// cancels subscriptions older than this and sends their session a
+	// SubscriptionErrorNotification explaining the expiry, so clients can re-subscribe.
+	// Expiry is checked every SessionMonitorInterval. Zero disables expiry.
+	// Default: 0 (subscriptions live until cancelled or their session ends)
+	MaxSubscriptionTTL time.Duration
+
+	// MaxIdleTime is how long an events-mode subscription may go without receiving an
+	// event before the session monitor cancels it, e.g. because its filters never match
+	// anything, and sends its session a SubscriptionErrorNotification explaining why.
+	// Idleness is measured from Subscription.LastEventAt and checked every
+	// SessionMonitorInterval. Faults subscriptions are never idle-cancelled, since a
+	// healthy cluster produces no faults. Zero disables idle cancellation.
+	// Default: 0 (idle subscriptions live until cancelled or their session ends)
+	MaxIdleTime time.Duration
+
 	// WatchReconnectMaxRetries specifies the maximum number of watch reconnection attempts.
 	// Default: 5
 	WatchReconnectMaxRetries int
+
+	// WatchBreakerThreshold specifies how many consecutive watch attempts on a cluster may
+	// fail before its circuit breaker opens and pauses watch attempts of every subscription
+	// on that cluster. Non-positive values fall back to the default.
+	// Default: 5
+	WatchBreakerThreshold int
+
+	// WatchBreakerCooldown specifies how long an open circuit breaker pauses watch attempts
+	// before allowing a trial attempt, which closes the breaker if it succeeds.
+	// Non-positive values fall back to the default.
+	// Default: 30s
+	WatchBreakerCooldown time.Duration
+
+	// ShutdownDrainTimeout specifies how long shutdown waits for queued and in-flight
+	// notifications to be sent before cancelling all subscriptions. Notifications not
+	// sent by then are dropped. Non-positive values fall back to the default.
+	// Default: 5s
+	ShutdownDrainTimeout time.Duration
+
+	// FaultHistorySize specifies how many recent faults are retained in memory per cluster
+	// so that late subscribers can query them. Zero disables the history.
+	// Default: 100
+	FaultHistorySize int
+
+	// EventReplaySize specifies how many recently processed events are retained in memory
+	// per events-mode subscription, so a client that briefly disconnected can request
+	// them with ReplayRecent. Zero disables replay.
+	// Default: 50
+	EventReplaySize int
+
+	// NotificationTimeout specifies how long a single notification send may take before
+	// it fails and the session is considered unreachable. Must be positive; non-positive
+	// values fall back to the default. Raise it for slow clients on high-latency links.
+	// Default: 2s
+	NotificationTimeout time.Duration
+
+	// MaxNotificationFailures specifies how many consecutive notification sends may fail
+	// before the subscription is cancelled. A successful send resets the count.
+	// Non-positive values fall back to the default.
+	// Default: 3
+	MaxNotificationFailures int
+
+	// MaxNotificationRetries specifies how many times a subscription's notification send
+	// that failed with a transient error is retried before it counts as a failure.
+	// Errors meaning the session is gone or its connection is dead (closed connection,
+	// NotificationTimeout elapsed) are not retried. Zero disables retries; negative values
+	// fall back to the default.
+	// Default: 2
+	MaxNotificationRetries int
+
+	// NotificationRetryBackoff specifies how long to wait before the first retry of a
+	// notification send, doubled for each further retry. Non-positive values fall back
+	// to the default.
+	// Default: 100ms
+	NotificationRetryBackoff time.Duration
+
+	// NotificationQueueSize specifies how many notifications are buffered per subscription
+	// while waiting to be sent, so a slow client doesn't stall the watcher. When the queue
+	// is full the oldest notification is dropped and counted. Non-positive values fall
+	// back to the default.
+	// Default: 100
+	NotificationQueueSize int
+
+	// Sinks receive every fault notification in addition to subscribed sessions,
+	// once per fault regardless of how many subscriptions reported it.
+	// Default: nil (no sinks)
+	Sinks []NotificationSink
+
+	// MaxRelatedEventsPerFault attaches up to this many of the most recent Kubernetes events
+	// for the faulty resource to each fault notification, so clients get triage context
+	// without a follow-up query. Zero disables related events.
+	// Default: 0
+	MaxRelatedEventsPerFault int
+
+	// MaxFaultContextBytes limits the size of the context and of each detail of fault
+	// notifications. Longer termination messages and panic traces keep their leading
+	// bytes and end with "...[truncated]".
+	// Default: 4096 (DefaultMaxContextBytes)
+	MaxFaultContextBytes int
+
+	// FaultContextYAML adds a contextYAML field to fault notifications rendering their
+	// details as an indented YAML document, for clients that display YAML better than
+	// the flat context string. The context and details are sent as well.
+	// Default: false
+	FaultContextYAML bool
+
+	// ResolveEventOwners includes the top-level owner (e.g. the Deployment of a pod) of
+	// each event's involved object in event notifications. Owners are resolved by
+	// following owner references through the API server, with results cached per subscription.
+	// Default: false
+	ResolveEventOwners bool
+
+	// FaultLoggers routes fault notifications of a severity to a different logger name
+	// than LoggerFaults, e.g. {SeverityCritical: "kubernetes/faults_critical"}, so clients
+	// can handle critical faults and warnings on separate channels. Severities without an
+	// entry use LoggerFaults.
+	// Default: nil (every fault is sent on LoggerFaults)
+	FaultLoggers map[Severity]string
+
+	// FaultTypeLoggers routes fault notifications of a fault type to a different logger
+	// name, taking precedence over FaultLoggers. Fault types without an entry are routed
+	// by severity.
+	// Default: nil (faults are routed by severity)
+	FaultTypeLoggers map[FaultType]string
+
+	// TracerProvider supplies the tracer used to create spans for event and fault processing.
+	// Default: nil (uses the global OpenTelemetry tracer provider, a no-op unless one is registered)
+	TracerProvider trace.TracerProvider
+
+	// Clock is the source of time for subscription creation rate limiting and expiry,
+	// the session monitor, deduplication TTLs, fault coalescing windows, and watch
+	// backoffs, so tests can control time-based behavior without sleeping.
+	// Default: nil (the real clock)
+	Clock Clock
+
+	// Notifier delivers notifications to sessions, allowing transports other than MCP
+	// logging notifications to be plugged in.
+	// Default: nil (sends MCP log messages to the server's sessions, see NewMCPNotifier)
+	Notifier Notifier
+
+	// RequireViableDelivery rejects subscriptions with ErrDeliveryNotViable when the
+	// session's log level would drop their notifications (see Subscription.DeliveryViable),
+	// instead of creating them with a warning.
+	// Default: false
+	RequireViableDelivery bool
+
+	// ResourceNotifier delivers the notifications of subscriptions created with the
+	// resource delivery mode (SubscriptionFilters.Delivery), e.g. a ResourceNotifier
+	// publishing them to MCP resources.
+	// Default: nil (subscriptions with the resource delivery mode are rejected)
+	ResourceNotifier Notifier
 }
 
 // DefaultManagerConfig returns a ManagerConfig with sensible defaults
@@ -52,13 +262,29 @@ func DefaultManagerConfig() ManagerConfig {
 	return ManagerConfig{
 		MaxSubscriptionsPerSession:   10,
 		MaxSubscriptionsGlobal:       100,
+		MaxSubscriptionsPerMinute:    30,
+		MaxWatchConnections:          50,
+		MaxResourceWatchers:          25,
 		MaxLogCapturesPerCluster:     5,
 		MaxLogCapturesGlobal:         20,
 		MaxLogBytesPerContainer:      10240, // 10KB
 		MaxContainersPerNotification: 5,
+		MaxConcurrentEnrichments:     DefaultMaxConcurrentEnrichments,
+		MaxFaultContextBytes:         DefaultMaxContextBytes,
 		EventDeduplicationWindow:     5 * time.Second,
-		FaultDeduplicationWindow:     60 * time.Second,
+		FaultDeduplicationWindow:     DeduplicationTTL,
+		FaultCoalescingWindow:        2 * time.Second,
 		SessionMonitorInterval:       30 * time.Second,
 		WatchReconnectMaxRetries:     5,
+		WatchBreakerThreshold:        DefaultBreakerFailureThreshold,
+		WatchBreakerCooldown:         DefaultBreakerCooldown,
+		ShutdownDrainTimeout:         DefaultShutdownDrainTimeout,
+		FaultHistorySize:             100,
+		EventReplaySize:              50,
+		NotificationTimeout:          DefaultNotificationTimeout,
+		MaxNotificationFailures:      DefaultMaxNotificationFailures,
+		MaxNotificationRetries:       DefaultMaxNotificationRetries,
+		NotificationRetryBackoff:     DefaultNotificationRetryBackoff,
+		NotificationQueueSize:        DefaultNotificationQueueSize,
 	}
 }
@@ -1,15 +1,22 @@
 package events
 
 import (
+	"context"
 	"sync"
 	"time"
+
+	"k8s.io/utils/clock"
 )
 
-// DeduplicationCache implements a TTL-based cache for event deduplication
+// DeduplicationCache implements a TTL-based cache for event deduplication.
+// Expired entries are dropped when their key is seen again; Start runs a background
+// sweeper that also reclaims entries whose key is never seen again.
 type DeduplicationCache struct {
-	mu      sync.RWMutex
-	entries map[string]*dedupEntry
-	ttl     time.Duration
+	mu            sync.RWMutex
+	entries       map[string]*dedupEntry
+	ttl           time.Duration
+	sweepInterval time.Duration
+	clock         Clock
 }
 
 // dedupEntry tracks when an event key was last seen
@@ -18,24 +25,41 @@ type dedupEntry struct {
 	expiresAt time.Time
 }
 
-// NewDeduplicationCache creates a new deduplication cache with the given TTL
+// NewDeduplicationCache creates a new deduplication cache with the given TTL,
+// sweeping expired entries once per TTL after Start
 func NewDeduplicationCache(ttl time.Duration) *DeduplicationCache {
-	cache := &DeduplicationCache{
-		entries: make(map[string]*dedupEntry),
-		ttl:     ttl,
-	}
+	return NewDeduplicationCacheWithSweepInterval(ttl, ttl)
+}
 
-	// Start background cleanup goroutine
-	go cache.cleanupLoop()
+// NewDeduplicationCacheWithSweepInterval creates a new deduplication cache with the given
+// TTL, sweeping expired entries every sweepInterval after Start. Non-positive intervals
+// fall back to the TTL.
+func NewDeduplicationCacheWithSweepInterval(ttl, sweepInterval time.Duration) *DeduplicationCache {
+	if sweepInterval <= 0 {
+		sweepInterval = ttl
+	}
+	return &DeduplicationCache{
+		entries:       make(map[string]*dedupEntry),
+		ttl:           ttl,
+		sweepInterval: sweepInterval,
+		clock:         clock.RealClock{},
+	}
+}
 
-	return cache
+// Start runs the background sweeper purging expired entries every sweep interval,
+// until ctx is cancelled.
+func (c *DeduplicationCache) Start(ctx context.Context) {
+	if c.sweepInterval <= 0 {
+		return
+	}
+	go c.sweepLoop(ctx)
 }
 
 // IsDuplicate checks if a key has been seen within the TTL window
 // If not seen or expired, marks the key as seen and returns false
 // If seen within TTL, returns true
 func (c *DeduplicationCache) IsDuplicate(key string) bool {
-	now := time.Now()
+	now := c.clock.Now()
 
 	c.mu.Lock()
 	defer c.mu.Unlock()
@@ -59,25 +83,30 @@ func (c *DeduplicationCache) IsDuplicate(key string) bool {
 	return false
 }
 
-// cleanupLoop periodically removes expired entries
-func (c *DeduplicationCache) cleanupLoop() {
-	ticker := time.NewTicker(c.ttl)
+// sweepLoop periodically removes expired entries until ctx is cancelled
+func (c *DeduplicationCache) sweepLoop(ctx context.Context) {
+	ticker := c.clock.NewTicker(c.sweepInterval)
 	defer ticker.Stop()
 
-	for range ticker.C {
-		c.cleanup()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C():
+			c.sweep()
+		}
 	}
 }
 
-// cleanup removes all expired entries from the cache
-func (c *DeduplicationCache) cleanup() {
-	now := time.Now()
+// sweep removes all expired entries from the cache
+func (c *DeduplicationCache) sweep() {
+	now := c.clock.Now()
 
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
 	for key, entry := range c.entries {
-		if now.After(entry.expiresAt) {
+		if !now.Before(entry.expiresAt) {
 			delete(c.entries, key)
 		}
 	}
@@ -1,11 +1,13 @@
 package events
 
 import (
+	"context"
 	"sync"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/suite"
+	clocktesting "k8s.io/utils/clock/testing"
 )
 
 type DedupTestSuite struct {
@@ -53,14 +55,16 @@ func (s *DedupTestSuite) TestExpiredEntryPassesThrough() {
 	s.Run("same key after TTL expires returns false", func() {
 		ttl := 50 * time.Millisecond
 		cache := NewDeduplicationCache(ttl)
+		fakeClock := clocktesting.NewFakeClock(time.Now())
+		cache.clock = fakeClock
 		key := "cluster1/default/event1/uid-123/rv-1"
 
 		// First occurrence
 		isDup1 := cache.IsDuplicate(key)
 		s.False(isDup1, "first occurrence should not be duplicate")
 
-		// Wait for TTL to expire
-		time.Sleep(ttl + 10*time.Millisecond)
+		// Let the TTL expire
+		fakeClock.Step(ttl)
 
 		// Second occurrence after TTL
 		isDup2 := cache.IsDuplicate(key)
@@ -155,6 +159,11 @@ func (s *DedupTestSuite) TestCacheCleanup() {
 	s.Run("removes expired entries during cleanup", func() {
 		ttl := 50 * time.Millisecond
 		cache := NewDeduplicationCache(ttl)
+		fakeClock := clocktesting.NewFakeClock(time.Now())
+		cache.clock = fakeClock
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		cache.Start(ctx)
 
 		// Add multiple entries
 		keys := []string{
@@ -169,11 +178,86 @@ func (s *DedupTestSuite) TestCacheCleanup() {
 
 		s.Equal(len(keys), cache.Size(), "cache should have %d entries", len(keys))
 
-		// Wait for TTL + cleanup cycle
-		time.Sleep(ttl + 100*time.Millisecond)
+		// Move past the TTL once the sweeper is waiting for its next cycle
+		s.Eventually(fakeClock.HasWaiters, time.Second, time.Millisecond, "sweeper should be started")
+		fakeClock.Step(ttl)
 
 		// Entries should be cleaned up
-		s.Equal(0, cache.Size(), "cache should be empty after cleanup")
+		s.Eventually(func() bool { return cache.Size() == 0 }, time.Second, time.Millisecond,
+			"cache should be empty after cleanup")
+	})
+}
+
+// TestCacheSweep validates the background sweeper reclaiming expired entries
+func (s *DedupTestSuite) TestCacheSweep() {
+	newCache := func(sweepInterval time.Duration) (*DeduplicationCache, *clocktesting.FakeClock) {
+		cache := NewDeduplicationCacheWithSweepInterval(time.Minute, sweepInterval)
+		fakeClock := clocktesting.NewFakeClock(time.Now())
+		cache.clock = fakeClock
+		for i := 0; i < 5; i++ {
+			cache.IsDuplicate("cluster1/default/event" + string(rune('0'+i)) + "/uid/rv")
+		}
+		return cache, fakeClock
+	}
+
+	s.Run("sweep removes entries past their TTL", func() {
+		cache, fakeClock := newCache(time.Minute)
+		s.Equal(5, cache.Size())
+
+		fakeClock.Step(30 * time.Second)
+		cache.sweep()
+		s.Equal(5, cache.Size(), "entries within their TTL should be kept")
+
+		fakeClock.Step(30 * time.Second)
+		cache.sweep()
+		s.Equal(0, cache.Size(), "entries past their TTL should be removed")
+	})
+
+	s.Run("Start sweeps every sweep interval", func() {
+		cache, fakeClock := newCache(10 * time.Second)
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		cache.Start(ctx)
+		s.Eventually(fakeClock.HasWaiters, time.Second, time.Millisecond, "sweeper should be started")
+
+		// Sweeps within the TTL keep the entries
+		fakeClock.Step(50 * time.Second)
+		s.Never(func() bool { return cache.Size() != 5 }, 50*time.Millisecond, 5*time.Millisecond,
+			"entries within their TTL should be kept")
+
+		// The next sweep is past the TTL
+		fakeClock.Step(10 * time.Second)
+		s.Eventually(func() bool { return cache.Size() == 0 }, time.Second, time.Millisecond,
+			"expired entries should be swept before their keys are seen again")
+	})
+
+	s.Run("sweeper stops when the context is cancelled", func() {
+		cache, fakeClock := newCache(10 * time.Second)
+		ctx, cancel := context.WithCancel(context.Background())
+		done := make(chan struct{})
+
+		go func() {
+			cache.sweepLoop(ctx)
+			close(done)
+		}()
+		s.Eventually(fakeClock.HasWaiters, time.Second, time.Millisecond, "sweeper should be started")
+		cancel()
+
+		s.Eventually(func() bool {
+			select {
+			case <-done:
+				return true
+			default:
+				return false
+			}
+		}, time.Second, time.Millisecond, "sweeper should return once the context is cancelled")
+		s.Equal(5, cache.Size(), "entries within their TTL should be kept")
+	})
+
+	s.Run("non-positive sweep intervals fall back to the TTL", func() {
+		cache := NewDeduplicationCacheWithSweepInterval(time.Minute, 0)
+		s.Equal(time.Minute, cache.sweepInterval)
 	})
 }
 
@@ -0,0 +1,194 @@
+package events
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"sync/atomic"
+	"syscall"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"go.opentelemetry.io/otel/attribute"
+	"k8s.io/klog/v2"
+)
+
+// DefaultNotificationQueueSize is the default number of notifications buffered per
+// subscription while waiting to be sent to the session.
+const DefaultNotificationQueueSize = 100
+
+// queuedNotification is a notification waiting to be sent to a subscription's session.
+type queuedNotification struct {
+	ctx     context.Context // carries the span of the event or fault being processed
+	channel string
+	data    any
+	attrs   []attribute.KeyValue
+}
+
+// notificationQueue decouples event processing from notification delivery for a
+// subscription. Watchers push notifications without blocking and a dedicated goroutine
+// sends them, so a slow client can't stall the watch and make it fall behind. When the
+// buffer is full the oldest queued notification is dropped and counted.
+type notificationQueue struct {
+	pending chan queuedNotification
+	flushes chan chan struct{} // flush requests, closed by run once the queue is empty
+	stopped chan struct{}      // closed when run returns
+	dropped atomic.Int64
+}
+
+// newNotificationQueue creates a queue buffering up to size notifications.
+func newNotificationQueue(size int) *notificationQueue {
+	return &notificationQueue{
+		pending: make(chan queuedNotification, size),
+		flushes: make(chan chan struct{}),
+		stopped: make(chan struct{}),
+	}
+}
+
+// push queues a notification without blocking, dropping the oldest queued
+// notification if the buffer is full.
+func (q *notificationQueue) push(n queuedNotification) {
+	for {
+		select {
+		case q.pending <- n:
+			return
+		default:
+		}
+
+		// Buffer is full: make room by discarding the oldest notification. The delivery
+		// goroutine may have taken it first, in which case nothing is dropped.
+		select {
+		case <-q.pending:
+			q.dropped.Add(1)
+		default:
+		}
+	}
+}
+
+// run sends queued notifications with deliver until ctx is done. Notifications still
+// queued when ctx is done are discarded.
+func (q *notificationQueue) run(ctx context.Context, deliver func(queuedNotification)) {
+	defer close(q.stopped)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case n := <-q.pending:
+			deliver(n)
+		case flushed := <-q.flushes:
+			q.deliverPending(ctx, deliver)
+			close(flushed)
+		}
+	}
+}
+
+// deliverPending sends the notifications currently queued, stopping early once ctx is done.
+func (q *notificationQueue) deliverPending(ctx context.Context, deliver func(queuedNotification)) {
+	for ctx.Err() == nil {
+		select {
+		case n := <-q.pending:
+			deliver(n)
+		default:
+			return
+		}
+	}
+}
+
+// flush waits until the notifications queued so far were sent. Returns early, with the
+// context error, if ctx is done first; returns nil if run has stopped.
+func (q *notificationQueue) flush(ctx context.Context) error {
+	flushed := make(chan struct{})
+	select {
+	case q.flushes <- flushed:
+	case <-q.stopped:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	select {
+	case <-flushed:
+		return nil
+	case <-q.stopped:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// startDelivery creates the subscription's notification queue and starts the goroutine
+// sending its notifications, which stops when ctx is done.
+func (m *EventSubscriptionManager) startDelivery(ctx context.Context, sub *Subscription) {
+	queue := newNotificationQueue(m.config.NotificationQueueSize)
+	sub.deliveries = queue
+	go queue.run(ctx, func(n queuedNotification) {
+		err := m.sendWithRetry(ctx, sub, n)
+		m.recordNotificationResult(sub, err)
+	})
+}
+
+// queueNotification queues a notification for delivery to the subscription's session.
+// Subscriptions without a running watcher, and so without a delivery goroutine, send
+// the notification directly.
+func (m *EventSubscriptionManager) queueNotification(ctx context.Context, sub *Subscription, channel string, data any, attrs ...attribute.KeyValue) {
+	// Queues are being flushed for shutdown; notifications queued now would never be sent
+	if m.queuesClosed.Load() {
+		klog.V(2).Infof("Dropping notification for subscription %s: manager is shutting down", sub.ID)
+		return
+	}
+
+	n := queuedNotification{ctx: ctx, channel: channel, data: data, attrs: attrs}
+	if sub.deliveries == nil {
+		err := m.sendWithRetry(ctx, sub, n)
+		m.recordNotificationResult(sub, err)
+		return
+	}
+
+	sub.deliveries.push(n)
+}
+
+// sendWithRetry sends a notification to the subscription's session, retrying sends that
+// failed with a transient error up to MaxNotificationRetries times, with exponential
+// backoff starting at NotificationRetryBackoff. Sends failing because the session is gone
+// or its connection is dead are not retried, as retrying won't reach it. Waiting between
+// attempts stops when ctx is done. Returns the error of the last attempt.
+func (m *EventSubscriptionManager) sendWithRetry(ctx context.Context, sub *Subscription, n queuedNotification) error {
+	backoff := m.config.NotificationRetryBackoff
+	for attempt := 1; ; attempt++ {
+		err := m.sendTracedNotification(n.ctx, m.notifierFor(sub), sub.SessionID, n.channel, n.data, n.attrs...)
+		if err == nil || attempt > m.config.MaxNotificationRetries || !isRetriableNotificationError(err) {
+			return err
+		}
+
+		klog.V(2).Infof("Retrying notification for subscription %s in %s (retry %d/%d): %v", sub.ID, backoff, attempt, m.config.MaxNotificationRetries, err)
+		timer := m.clock.NewTimer(backoff)
+		select {
+		case <-timer.C():
+		case <-ctx.Done():
+			timer.Stop()
+			return err
+		}
+		backoff *= 2
+	}
+}
+
+// isRetriableNotificationError reports whether a failed notification send may succeed
+// when retried. Errors meaning the session is gone or its connection is dead aren't
+// retriable: the session doesn't exist, the connection is closed, or the send ran into
+// NotificationTimeout, which is how a dead connection shows up.
+func isRetriableNotificationError(err error) bool {
+	switch {
+	case errors.Is(err, ErrSessionNotFound),
+		errors.Is(err, mcp.ErrConnectionClosed),
+		errors.Is(err, context.DeadlineExceeded),
+		errors.Is(err, context.Canceled),
+		errors.Is(err, io.EOF),
+		errors.Is(err, io.ErrClosedPipe),
+		errors.Is(err, net.ErrClosed),
+		errors.Is(err, syscall.ECONNRESET),
+		errors.Is(err, syscall.EPIPE):
+		return false
+	default:
+		return true
+	}
+}
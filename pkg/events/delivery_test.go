@@ -0,0 +1,129 @@
+package events
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type NotificationQueueTestSuite struct {
+	suite.Suite
+}
+
+func TestNotificationQueueSuite(t *testing.T) {
+	suite.Run(t, new(NotificationQueueTestSuite))
+}
+
+func (s *NotificationQueueTestSuite) TestPush() {
+	s.Run("full queue drops the oldest notification", func() {
+		queue := newNotificationQueue(2)
+		for _, channel := range []string{"first", "second", "third"} {
+			queue.push(queuedNotification{channel: channel})
+		}
+
+		s.Equal(int64(1), queue.dropped.Load())
+		s.Equal("second", (<-queue.pending).channel)
+		s.Equal("third", (<-queue.pending).channel)
+	})
+
+	s.Run("push does not block while delivery is stalled", func() {
+		queue := newNotificationQueue(1)
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		release := make(chan struct{})
+		defer close(release)
+		go queue.run(ctx, func(queuedNotification) { <-release })
+
+		done := make(chan struct{})
+		go func() {
+			for i := 0; i < 100; i++ {
+				queue.push(queuedNotification{})
+			}
+			close(done)
+		}()
+
+		s.Eventually(func() bool {
+			select {
+			case <-done:
+				return true
+			default:
+				return false
+			}
+		}, time.Second, 10*time.Millisecond)
+		s.GreaterOrEqual(queue.dropped.Load(), int64(98))
+	})
+}
+
+func (s *NotificationQueueTestSuite) TestRun() {
+	s.Run("delivers notifications in order until cancelled", func() {
+		queue := newNotificationQueue(10)
+		ctx, cancel := context.WithCancel(context.Background())
+		delivered := make(chan string, 10)
+		stopped := make(chan struct{})
+		go func() {
+			queue.run(ctx, func(n queuedNotification) { delivered <- n.channel })
+			close(stopped)
+		}()
+
+		queue.push(queuedNotification{channel: "first"})
+		queue.push(queuedNotification{channel: "second"})
+		s.Equal("first", <-delivered)
+		s.Equal("second", <-delivered)
+
+		cancel()
+		s.Eventually(func() bool {
+			select {
+			case <-stopped:
+				return true
+			default:
+				return false
+			}
+		}, time.Second, 10*time.Millisecond, "run should return once the context is done")
+	})
+}
+
+func (s *NotificationQueueTestSuite) TestFlush() {
+	s.Run("returns once queued notifications were delivered", func() {
+		queue := newNotificationQueue(10)
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		release := make(chan struct{})
+		var delivered []string
+		go queue.run(ctx, func(n queuedNotification) {
+			<-release
+			delivered = append(delivered, n.channel)
+		})
+
+		queue.push(queuedNotification{channel: "first"})
+		queue.push(queuedNotification{channel: "second"})
+		close(release)
+
+		s.Require().NoError(queue.flush(context.Background()))
+		s.Equal([]string{"first", "second"}, delivered)
+	})
+
+	s.Run("returns the context error if delivery doesn't finish in time", func() {
+		queue := newNotificationQueue(10)
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		release := make(chan struct{})
+		defer close(release)
+		go queue.run(ctx, func(queuedNotification) { <-release })
+		queue.push(queuedNotification{})
+
+		flushCtx, cancelFlush := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancelFlush()
+		s.ErrorIs(queue.flush(flushCtx), context.DeadlineExceeded)
+	})
+
+	s.Run("returns once run has stopped", func() {
+		queue := newNotificationQueue(10)
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		queue.run(ctx, func(queuedNotification) {})
+
+		s.NoError(queue.flush(context.Background()))
+	})
+}
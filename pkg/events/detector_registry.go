@@ -0,0 +1,148 @@
+package events
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// DetectorFactory creates a new Detector instance.
+type DetectorFactory func() Detector
+
+// DetectorRegistry holds named detector factories. The subscription manager builds
+// a fresh set of detectors from the registry for every faults subscription, so
+// stateful detectors are never shared between subscriptions, and subscriptions
+// select detectors by their registered name. Opt-in detectors only run for
+// subscriptions selecting them by name.
+//
+// Thread-safe for concurrent use.
+type DetectorRegistry struct {
+	mu        sync.RWMutex
+	names     []string // registration order
+	factories map[string]DetectorFactory
+	optIn     map[string]bool // detectors left out when no names are selected
+}
+
+// RegisteredDetector describes a detector available in a DetectorRegistry.
+type RegisteredDetector struct {
+	// Name is the name subscriptions select the detector by
+	Name string `json:"name"`
+	// OptIn is true if the detector only runs for subscriptions selecting it by name
+	OptIn bool `json:"optIn"`
+	DetectorInfo
+}
+
+// NewDetectorRegistry creates an empty DetectorRegistry.
+func NewDetectorRegistry() *DetectorRegistry {
+	return &DetectorRegistry{
+		factories: make(map[string]DetectorFactory),
+		optIn:     make(map[string]bool),
+	}
+}
+
+// Register adds a detector factory under the given name.
+// Panics if the name is empty, the factory is nil, or a detector is already
+// registered under the name.
+func (r *DetectorRegistry) Register(name string, factory func() Detector) {
+	r.register(name, factory, false)
+}
+
+// RegisterOptIn adds a detector factory under the given name like Register, but the
+// detector is only built for subscriptions selecting it by name, e.g. for detectors
+// reporting intentional changes rather than failures.
+func (r *DetectorRegistry) RegisterOptIn(name string, factory func() Detector) {
+	r.register(name, factory, true)
+}
+
+// register implements Register and RegisterOptIn.
+func (r *DetectorRegistry) register(name string, factory func() Detector, optIn bool) {
+	if name == "" {
+		panic("detector name must not be empty")
+	}
+	if factory == nil {
+		panic(fmt.Sprintf("detector factory for '%s' must not be nil", name))
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.factories[name]; exists {
+		panic(fmt.Sprintf("detector already registered for name '%s'", name))
+	}
+	r.names = append(r.names, name)
+	r.factories[name] = factory
+	r.optIn[name] = optIn
+}
+
+// Names returns the registered detector names in registration order.
+func (r *DetectorRegistry) Names() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return append([]string(nil), r.names...)
+}
+
+// ListDetectors describes every registered detector in registration order. Each
+// detector is built once to call its Describe method.
+func (r *DetectorRegistry) ListDetectors() []RegisteredDetector {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	detectors := make([]RegisteredDetector, 0, len(r.names))
+	for _, name := range r.names {
+		detectors = append(detectors, RegisteredDetector{
+			Name:         name,
+			OptIn:        r.optIn[name],
+			DetectorInfo: r.factories[name]().Describe(),
+		})
+	}
+	return detectors
+}
+
+// Validate checks that a detector is registered under each of the given names.
+// Returns an error naming the available detectors otherwise.
+func (r *DetectorRegistry) Validate(names []string) error {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return r.validateLocked(names)
+}
+
+// validateLocked implements Validate. Must be called with the lock held.
+func (r *DetectorRegistry) validateLocked(names []string) error {
+	for _, name := range names {
+		if _, exists := r.factories[name]; !exists {
+			return fmt.Errorf("unknown detector type %q (available: %s)", name, strings.Join(r.names, ", "))
+		}
+	}
+	return nil
+}
+
+// Build creates a new instance of each named detector, or of every registered
+// detector except opt-in ones if names is empty. Detectors are returned in registration order.
+// Returns an error naming the available detectors if a name is not registered.
+func (r *DetectorRegistry) Build(names []string) ([]Detector, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if err := r.validateLocked(names); err != nil {
+		return nil, err
+	}
+
+	selected := make(map[string]bool, len(names))
+	for _, name := range names {
+		selected[name] = true
+	}
+
+	detectors := make([]Detector, 0, len(r.names))
+	for _, name := range r.names {
+		if len(selected) > 0 && !selected[name] {
+			continue
+		}
+		if len(selected) == 0 && r.optIn[name] {
+			continue
+		}
+		detectors = append(detectors, r.factories[name]())
+	}
+	return detectors, nil
+}
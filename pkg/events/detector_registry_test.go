@@ -0,0 +1,124 @@
+package events
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type DetectorRegistryTestSuite struct {
+	suite.Suite
+	registry *DetectorRegistry
+}
+
+func TestDetectorRegistrySuite(t *testing.T) {
+	suite.Run(t, new(DetectorRegistryTestSuite))
+}
+
+func (s *DetectorRegistryTestSuite) SetupTest() {
+	s.registry = NewDetectorRegistry()
+	s.registry.Register("PodCrash", func() Detector { return &MockTypedDetector{faultType: FaultTypePodCrash, kind: "Pod"} })
+	s.registry.Register("NodeUnhealthy", func() Detector { return &MockTypedDetector{faultType: FaultTypeNodeUnhealthy, kind: "Node"} })
+}
+
+func (s *DetectorRegistryTestSuite) TestRegister() {
+	s.Run("names are returned in registration order", func() {
+		s.Equal([]string{"PodCrash", "NodeUnhealthy"}, s.registry.Names())
+	})
+
+	s.Run("duplicate name panics", func() {
+		s.PanicsWithValue("detector already registered for name 'PodCrash'", func() {
+			s.registry.Register("PodCrash", func() Detector { return &MockTypedDetector{} })
+		})
+	})
+
+	s.Run("empty name panics", func() {
+		s.Panics(func() {
+			s.registry.Register("", func() Detector { return &MockTypedDetector{} })
+		})
+	})
+
+	s.Run("nil factory panics", func() {
+		s.Panics(func() {
+			s.registry.Register("Custom", nil)
+		})
+	})
+}
+
+func (s *DetectorRegistryTestSuite) TestBuild() {
+	s.Run("empty selection builds every detector", func() {
+		built, err := s.registry.Build(nil)
+		s.Require().NoError(err)
+		s.Require().Len(built, 2)
+		s.Equal(FaultTypePodCrash, built[0].(TypedDetector).FaultType())
+		s.Equal(FaultTypeNodeUnhealthy, built[1].(TypedDetector).FaultType())
+	})
+
+	s.Run("selection builds detectors in registration order", func() {
+		built, err := s.registry.Build([]string{"NodeUnhealthy", "PodCrash", "PodCrash"})
+		s.Require().NoError(err)
+		s.Require().Len(built, 2)
+		s.Equal(FaultTypePodCrash, built[0].(TypedDetector).FaultType())
+		s.Equal(FaultTypeNodeUnhealthy, built[1].(TypedDetector).FaultType())
+	})
+
+	s.Run("each build creates new instances", func() {
+		first, err := s.registry.Build([]string{"PodCrash"})
+		s.Require().NoError(err)
+		second, err := s.registry.Build([]string{"PodCrash"})
+		s.Require().NoError(err)
+		s.NotSame(first[0], second[0])
+	})
+
+	s.Run("unknown name lists available detectors", func() {
+		built, err := s.registry.Build([]string{"DiskFull"})
+		s.Require().Error(err)
+		s.Equal(`unknown detector type "DiskFull" (available: PodCrash, NodeUnhealthy)`, err.Error())
+		s.Nil(built)
+	})
+}
+
+func (s *DetectorRegistryTestSuite) TestBuildOptIn() {
+	s.registry.RegisterOptIn("ScaledToZero", func() Detector {
+		return &MockTypedDetector{faultType: FaultTypeScaledToZero, kind: "Deployment"}
+	})
+
+	s.Run("opt-in detectors are listed and validated", func() {
+		s.Equal([]string{"PodCrash", "NodeUnhealthy", "ScaledToZero"}, s.registry.Names())
+		s.NoError(s.registry.Validate([]string{"ScaledToZero"}))
+	})
+
+	s.Run("empty selection leaves out opt-in detectors", func() {
+		built, err := s.registry.Build(nil)
+		s.Require().NoError(err)
+		s.Require().Len(built, 2)
+		s.Equal(FaultTypePodCrash, built[0].(TypedDetector).FaultType())
+		s.Equal(FaultTypeNodeUnhealthy, built[1].(TypedDetector).FaultType())
+	})
+
+	s.Run("opt-in detectors are built when selected", func() {
+		built, err := s.registry.Build([]string{"PodCrash", "ScaledToZero"})
+		s.Require().NoError(err)
+		s.Require().Len(built, 2)
+		s.Equal(FaultTypePodCrash, built[0].(TypedDetector).FaultType())
+		s.Equal(FaultTypeScaledToZero, built[1].(TypedDetector).FaultType())
+	})
+}
+
+func (s *DetectorRegistryTestSuite) TestListDetectors() {
+	s.registry.RegisterOptIn("ScaledToZero", func() Detector {
+		return &MockTypedDetector{faultType: FaultTypeScaledToZero, kind: "Deployment"}
+	})
+
+	s.Equal([]RegisteredDetector{
+		{Name: "PodCrash", DetectorInfo: DetectorInfo{FaultTypes: []FaultType{FaultTypePodCrash}, ResourceKinds: []string{"Pod"}, Description: "mock detector"}},
+		{Name: "NodeUnhealthy", DetectorInfo: DetectorInfo{FaultTypes: []FaultType{FaultTypeNodeUnhealthy}, ResourceKinds: []string{"Node"}, Description: "mock detector"}},
+		{Name: "ScaledToZero", OptIn: true, DetectorInfo: DetectorInfo{FaultTypes: []FaultType{FaultTypeScaledToZero}, ResourceKinds: []string{"Deployment"}, Description: "mock detector"}},
+	}, s.registry.ListDetectors())
+}
+
+func (s *DetectorRegistryTestSuite) TestValidate() {
+	s.NoError(s.registry.Validate(nil))
+	s.NoError(s.registry.Validate([]string{"NodeUnhealthy"}))
+	s.Error(s.registry.Validate([]string{"PodCrash", "DiskFull"}))
+}
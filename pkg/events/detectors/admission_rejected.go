@@ -0,0 +1,147 @@
+package detectors
+
+import (
+	"regexp"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/events"
+)
+
+var (
+	// podSecurityViolation matches Pod Security Admission rejections, capturing the
+	// enforced level and version, e.g. `violates PodSecurity "restricted:latest"`.
+	podSecurityViolation = regexp.MustCompile(`violates PodSecurity "([^"]+)"`)
+	// webhookDenial matches validating admission webhook rejections, e.g. from OPA
+	// Gatekeeper or Kyverno, capturing the webhook name.
+	webhookDenial = regexp.MustCompile(`admission webhook "([^"]+)" denied the request`)
+)
+
+// AdmissionRejectedDetector detects pods rejected by admission control, such as
+// Pod Security Admission or policy webhooks (OPA Gatekeeper, Kyverno). A rejected pod
+// is never created, so there is no pod status to inspect: the rejection is only
+// surfaced as an event on the controller that tried to create it. This detector
+// therefore inspects Events rather than resource status.
+//
+// A rejection is detected when an Event is created, or recurs (its count increases), with:
+// 1. Reason "FailedCreate" and a message reporting the request as forbidden or denied, or
+// 2. Reason "FailedAdmission"
+//
+// Signals are reported for the event's involved object (e.g. the ReplicaSet or Job).
+type AdmissionRejectedDetector struct{}
+
+// NewAdmissionRejectedDetector creates a new AdmissionRejectedDetector instance.
+func NewAdmissionRejectedDetector() *AdmissionRejectedDetector {
+	return &AdmissionRejectedDetector{}
+}
+
+// FaultType returns the type of the fault signals emitted by this detector.
+func (d *AdmissionRejectedDetector) FaultType() events.FaultType {
+	return events.FaultTypeAdmissionRejected
+}
+
+// ResourceKind returns the kind of resource this detector inspects.
+func (d *AdmissionRejectedDetector) ResourceKind() string {
+	return "Event"
+}
+
+// Describe returns the fault type emitted and the resource kind inspected by this detector.
+func (d *AdmissionRejectedDetector) Describe() events.DetectorInfo {
+	return events.DetectorInfo{
+		FaultTypes:    []events.FaultType{d.FaultType()},
+		ResourceKinds: []string{d.ResourceKind()},
+		Description:   "Reports pods rejected by admission control (Pod Security Admission, policy webhooks), from FailedCreate and FailedAdmission events.",
+	}
+}
+
+// Detect analyzes new and recurring Events and returns a fault signal for events
+// reporting an admission rejection. oldObj is nil for newly created events.
+func (d *AdmissionRejectedDetector) Detect(oldObj, newObj interface{}) []events.FaultSignal {
+	// Handle nil newObj - nothing to detect
+	if newObj == nil {
+		return []events.FaultSignal{}
+	}
+
+	// Type assert to Event
+	newEvent, ok := newObj.(*corev1.Event)
+	if !ok {
+		return []events.FaultSignal{}
+	}
+
+	// Updates are only new occurrences when the count increases, not on resyncs
+	if oldObj != nil {
+		oldEvent, ok := oldObj.(*corev1.Event)
+		if !ok || occurrences(newEvent) <= occurrences(oldEvent) {
+			return []events.FaultSignal{}
+		}
+	}
+
+	if !isAdmissionRejection(newEvent) {
+		return []events.FaultSignal{}
+	}
+
+	violation := admissionViolation(newEvent.Message)
+	signal := events.FaultSignal{
+		FaultType:   events.FaultTypeAdmissionRejected,
+		ResourceUID: newEvent.InvolvedObject.UID,
+		Kind:        newEvent.InvolvedObject.Kind,
+		Name:        newEvent.InvolvedObject.Name,
+		Namespace:   newEvent.InvolvedObject.Namespace,
+		Severity:    events.SeverityWarning,
+		Context:     "Pod creation was rejected by admission control: " + violation,
+		Details:     buildAdmissionRejectedDetails(newEvent, violation),
+		Timestamp:   time.Now(),
+	}
+
+	return []events.FaultSignal{signal}
+}
+
+// isAdmissionRejection reports whether an event reports a pod rejected by admission
+// control. FailedCreate is also reported for other errors (e.g. the API server being
+// unavailable), so those only count when the request was forbidden or denied.
+func isAdmissionRejection(event *corev1.Event) bool {
+	switch event.Reason {
+	case "FailedAdmission":
+		return true
+	case "FailedCreate":
+		return strings.Contains(event.Message, "is forbidden") || strings.Contains(event.Message, "denied the request")
+	default:
+		return false
+	}
+}
+
+// admissionViolation returns the policy violation reported in an event message,
+// without the "Error creating: " prefix controllers add.
+func admissionViolation(message string) string {
+	return strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(message), "Error creating:"))
+}
+
+// occurrences returns how many times an event occurred, from its series for
+// events.k8s.io-style events and its count otherwise.
+func occurrences(event *corev1.Event) int32 {
+	if event.Series != nil {
+		return event.Series.Count
+	}
+	return event.Count
+}
+
+// buildAdmissionRejectedDetails returns the event reason and violation, plus the
+// Pod Security level or webhook that rejected the pod when the message names them.
+func buildAdmissionRejectedDetails(event *corev1.Event, violation string) map[string]string {
+	details := map[string]string{
+		"reason":  event.Reason,
+		"message": violation,
+	}
+
+	if match := podSecurityViolation.FindStringSubmatch(violation); match != nil {
+		details["podSecurity"] = match[1]
+	}
+
+	if match := webhookDenial.FindStringSubmatch(violation); match != nil {
+		details["webhook"] = match[1]
+	}
+
+	return details
+}
@@ -0,0 +1,174 @@
+package detectors
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/events"
+)
+
+// AdmissionRejectedDetectorSuite contains tests for AdmissionRejectedDetector
+type AdmissionRejectedDetectorSuite struct {
+	suite.Suite
+	detector *AdmissionRejectedDetector
+}
+
+func TestAdmissionRejectedDetectorSuite(t *testing.T) {
+	suite.Run(t, new(AdmissionRejectedDetectorSuite))
+}
+
+// SetupTest runs before each test
+func (s *AdmissionRejectedDetectorSuite) SetupTest() {
+	s.detector = NewAdmissionRejectedDetector()
+}
+
+const (
+	podSecurityMessage = `Error creating: pods "web-7d4b9c-x2k4p" is forbidden: violates PodSecurity "restricted:latest": ` +
+		`allowPrivilegeEscalation != false (container "web" must set securityContext.allowPrivilegeEscalation=false)`
+	webhookMessage = `Error creating: admission webhook "validation.gatekeeper.sh" denied the request: ` +
+		`[k8srequiredlabels] you must provide labels: {"owner"}`
+)
+
+// TestAdmissionRejectedDetector_Rejections tests classification of admission rejection events
+func (s *AdmissionRejectedDetectorSuite) TestAdmissionRejectedDetector_Rejections() {
+	s.Run("Pod Security Admission rejection emits signal", func() {
+		event := createEvent("FailedCreate", podSecurityMessage, 1)
+
+		signals := s.detector.Detect(nil, event)
+
+		s.Require().Len(signals, 1, "expected one fault signal for a rejected pod")
+		signal := signals[0]
+		s.Equal(events.FaultTypeAdmissionRejected, signal.FaultType)
+		s.Equal(types.UID("rs-uid-123"), signal.ResourceUID)
+		s.Equal("ReplicaSet", signal.Kind)
+		s.Equal("web-7d4b9c", signal.Name)
+		s.Equal("default", signal.Namespace)
+		s.Empty(signal.ContainerName)
+		s.Equal(events.SeverityWarning, signal.Severity)
+		s.Equal(`Pod creation was rejected by admission control: pods "web-7d4b9c-x2k4p" is forbidden: violates PodSecurity "restricted:latest": `+
+			`allowPrivilegeEscalation != false (container "web" must set securityContext.allowPrivilegeEscalation=false)`, signal.Context)
+		s.Equal(map[string]string{
+			"reason":      "FailedCreate",
+			"message":     podSecurityMessage[len("Error creating: "):],
+			"podSecurity": "restricted:latest",
+		}, signal.Details)
+		s.False(signal.Timestamp.IsZero())
+	})
+
+	s.Run("admission webhook denial emits signal naming the webhook", func() {
+		event := createEvent("FailedCreate", webhookMessage, 1)
+
+		signals := s.detector.Detect(nil, event)
+
+		s.Require().Len(signals, 1)
+		s.Contains(signals[0].Context, `[k8srequiredlabels] you must provide labels: {"owner"}`)
+		s.Equal("validation.gatekeeper.sh", signals[0].Details["webhook"])
+		s.NotContains(signals[0].Details, "podSecurity")
+	})
+
+	s.Run("FailedAdmission event emits signal", func() {
+		event := createEvent("FailedAdmission", "Pod was rejected: node didn't satisfy plugin(s) [NodeAffinity]", 1)
+
+		signals := s.detector.Detect(nil, event)
+
+		s.Require().Len(signals, 1)
+		s.Equal("FailedAdmission", signals[0].Details["reason"])
+		s.Equal("Pod creation was rejected by admission control: Pod was rejected: node didn't satisfy plugin(s) [NodeAffinity]", signals[0].Context)
+	})
+
+	s.Run("recurring rejection emits signal", func() {
+		oldEvent := createEvent("FailedCreate", podSecurityMessage, 3)
+		newEvent := createEvent("FailedCreate", podSecurityMessage, 4)
+
+		signals := s.detector.Detect(oldEvent, newEvent)
+
+		s.Len(signals, 1)
+	})
+
+	s.Run("recurring events.k8s.io-style rejection emits signal", func() {
+		oldEvent := createEvent("FailedCreate", podSecurityMessage, 0)
+		oldEvent.Series = &corev1.EventSeries{Count: 2}
+		newEvent := createEvent("FailedCreate", podSecurityMessage, 0)
+		newEvent.Series = &corev1.EventSeries{Count: 3}
+
+		signals := s.detector.Detect(oldEvent, newEvent)
+
+		s.Len(signals, 1)
+	})
+
+	s.Run("resynced rejection does not emit signal", func() {
+		oldEvent := createEvent("FailedCreate", podSecurityMessage, 3)
+		newEvent := createEvent("FailedCreate", podSecurityMessage, 3)
+
+		signals := s.detector.Detect(oldEvent, newEvent)
+
+		s.Empty(signals, "an unchanged event is not a new rejection")
+	})
+}
+
+// TestAdmissionRejectedDetector_OtherEvents tests that other events are not classified as rejections
+func (s *AdmissionRejectedDetectorSuite) TestAdmissionRejectedDetector_OtherEvents() {
+	s.Run("FailedCreate for other errors does not emit signal", func() {
+		event := createEvent("FailedCreate", `Error creating: Internal error occurred: etcdserver: request timed out`, 1)
+
+		signals := s.detector.Detect(nil, event)
+
+		s.Empty(signals)
+	})
+
+	s.Run("other reasons do not emit signal", func() {
+		event := createEvent("BackOff", "Back-off restarting failed container", 1)
+
+		signals := s.detector.Detect(nil, event)
+
+		s.Empty(signals)
+	})
+}
+
+// TestAdmissionRejectedDetector_EdgeCases tests edge cases and error handling
+func (s *AdmissionRejectedDetectorSuite) TestAdmissionRejectedDetector_EdgeCases() {
+	s.Run("returns empty slice for nil newObj", func() {
+		signals := s.detector.Detect(createEvent("FailedCreate", podSecurityMessage, 1), nil)
+		s.Empty(signals)
+	})
+
+	s.Run("returns empty slice when objects are not Events", func() {
+		pod := &corev1.Pod{}
+		s.Empty(s.detector.Detect(nil, pod))
+		s.Empty(s.detector.Detect(pod, createEvent("FailedCreate", podSecurityMessage, 1)))
+	})
+}
+
+// TestAdmissionRejectedDetector_DetectorInterface verifies interface compliance
+func (s *AdmissionRejectedDetectorSuite) TestAdmissionRejectedDetector_DetectorInterface() {
+	s.Run("AdmissionRejectedDetector implements TypedDetector interface", func() {
+		var _ events.TypedDetector = &AdmissionRejectedDetector{}
+		s.Equal(events.FaultTypeAdmissionRejected, s.detector.FaultType())
+		s.Equal("Event", s.detector.ResourceKind())
+	})
+}
+
+// Helper function to create a Warning event about a ReplicaSet
+func createEvent(reason, message string, count int32) *corev1.Event {
+	return &corev1.Event{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "web-7d4b9c.17a2b3c4d5e6f789",
+			Namespace: "default",
+		},
+		InvolvedObject: corev1.ObjectReference{
+			Kind:       "ReplicaSet",
+			APIVersion: "apps/v1",
+			Name:       "web-7d4b9c",
+			Namespace:  "default",
+			UID:        "rs-uid-123",
+		},
+		Type:    corev1.EventTypeWarning,
+		Reason:  reason,
+		Message: message,
+		Count:   count,
+	}
+}
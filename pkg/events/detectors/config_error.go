@@ -0,0 +1,152 @@
+package detectors
+
+import (
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/events"
+)
+
+// configErrorReasons are the container Waiting reasons reported when the kubelet
+// cannot create a container from its configuration, most commonly because a
+// referenced Secret or ConfigMap (or a key within it) does not exist.
+var configErrorReasons = map[string]bool{
+	"CreateContainerConfigError": true,
+	"CreateContainerError":       true,
+}
+
+// ConfigErrorDetector detects when a container cannot be created because of a
+// configuration error such as a missing Secret or ConfigMap.
+// This is an edge-triggered detector that emits signals when a container
+// transitions INTO a CreateContainerConfigError or CreateContainerError waiting state.
+// The kubelet's waiting message names the missing resource and is included in the context.
+type ConfigErrorDetector struct{}
+
+// NewConfigErrorDetector creates a new ConfigErrorDetector instance.
+func NewConfigErrorDetector() *ConfigErrorDetector {
+	return &ConfigErrorDetector{}
+}
+
+// FaultType returns the type of the fault signals emitted by this detector.
+func (d *ConfigErrorDetector) FaultType() events.FaultType {
+	return events.FaultTypeConfigError
+}
+
+// ResourceKind returns the kind of resource this detector inspects.
+func (d *ConfigErrorDetector) ResourceKind() string {
+	return "Pod"
+}
+
+// Describe returns the fault type emitted and the resource kind inspected by this detector.
+func (d *ConfigErrorDetector) Describe() events.DetectorInfo {
+	return events.DetectorInfo{
+		FaultTypes:    []events.FaultType{d.FaultType()},
+		ResourceKinds: []string{d.ResourceKind()},
+		Description:   "Reports containers that cannot be created because of a configuration error, such as a missing Secret or ConfigMap.",
+	}
+}
+
+// Detect analyzes pod state changes and returns fault signals for containers
+// entering a container creation error state. It compares container and init
+// container statuses between oldObj and newObj.
+func (d *ConfigErrorDetector) Detect(oldObj, newObj interface{}) []events.FaultSignal {
+	// Handle nil newObj - nothing to detect
+	if newObj == nil {
+		return []events.FaultSignal{}
+	}
+
+	// Type assert to Pod
+	newPod, ok := newObj.(*corev1.Pod)
+	if !ok {
+		return []events.FaultSignal{}
+	}
+
+	// If oldObj is nil (Add event), don't emit signal (edge-triggered)
+	if oldObj == nil {
+		return []events.FaultSignal{}
+	}
+
+	oldPod, ok := oldObj.(*corev1.Pod)
+	if !ok {
+		return []events.FaultSignal{}
+	}
+
+	signals := []events.FaultSignal{}
+	signals = append(signals, d.detectTransitions(newPod, oldPod.Status.InitContainerStatuses, newPod.Status.InitContainerStatuses)...)
+	signals = append(signals, d.detectTransitions(newPod, oldPod.Status.ContainerStatuses, newPod.Status.ContainerStatuses)...)
+	return signals
+}
+
+// detectTransitions returns a signal for each container in newStatuses that entered a
+// configuration error state. Containers without a previous status are treated as
+// transitioning, since a pod with a missing Secret typically reports the error in its
+// first container status.
+func (d *ConfigErrorDetector) detectTransitions(pod *corev1.Pod, oldStatuses, newStatuses []corev1.ContainerStatus) []events.FaultSignal {
+	var signals []events.FaultSignal
+
+	// Create a map of old container statuses by container name for easy lookup
+	oldStatusMap := make(map[string]corev1.ContainerStatus)
+	for _, status := range oldStatuses {
+		oldStatusMap[status.Name] = status
+	}
+
+	for _, newStatus := range newStatuses {
+		if !isInConfigError(newStatus) {
+			continue
+		}
+
+		// Already in a configuration error state, no transition
+		if oldStatus, exists := oldStatusMap[newStatus.Name]; exists && isInConfigError(oldStatus) {
+			continue
+		}
+
+		signal := events.FaultSignal{
+			FaultType:     events.FaultTypeConfigError,
+			ResourceUID:   types.UID(pod.UID),
+			Kind:          "Pod",
+			Name:          pod.Name,
+			Namespace:     pod.Namespace,
+			ContainerName: newStatus.Name,
+			Severity:      events.SeverityWarning,
+			Context:       buildConfigErrorContext(newStatus),
+			Details:       buildConfigErrorDetails(newStatus),
+			Timestamp:     time.Now(),
+		}
+
+		signals = append(signals, signal)
+	}
+
+	return signals
+}
+
+// isInConfigError checks if a container status indicates a container creation error.
+func isInConfigError(status corev1.ContainerStatus) bool {
+	return status.State.Waiting != nil && configErrorReasons[status.State.Waiting.Reason]
+}
+
+// buildConfigErrorContext creates a human-readable context string for a container creation error.
+func buildConfigErrorContext(status corev1.ContainerStatus) string {
+	context := fmt.Sprintf("Container could not be created: %s", status.State.Waiting.Reason)
+
+	if status.State.Waiting.Message != "" {
+		context += fmt.Sprintf(", waiting message: %s", status.State.Waiting.Message)
+	}
+
+	return context
+}
+
+// buildConfigErrorDetails returns the fields embedded in the container creation error context.
+func buildConfigErrorDetails(status corev1.ContainerStatus) map[string]string {
+	details := map[string]string{
+		"reason": status.State.Waiting.Reason,
+	}
+
+	if status.State.Waiting.Message != "" {
+		details["waitingMessage"] = status.State.Waiting.Message
+	}
+
+	return details
+}
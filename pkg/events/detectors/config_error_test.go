@@ -0,0 +1,247 @@
+package detectors
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/events"
+)
+
+// ConfigErrorDetectorSuite contains tests for ConfigErrorDetector
+type ConfigErrorDetectorSuite struct {
+	suite.Suite
+	detector *ConfigErrorDetector
+}
+
+func TestConfigErrorDetectorSuite(t *testing.T) {
+	suite.Run(t, new(ConfigErrorDetectorSuite))
+}
+
+// SetupTest runs before each test
+func (s *ConfigErrorDetectorSuite) SetupTest() {
+	s.detector = NewConfigErrorDetector()
+}
+
+// TestConfigErrorDetector_TransitionIntoConfigError tests detection of transition into a config error
+func (s *ConfigErrorDetectorSuite) TestConfigErrorDetector_TransitionIntoConfigError() {
+	s.Run("transition from ContainerCreating to CreateContainerConfigError emits signal", func() {
+		oldPod := createPodWithWaitingState("test-pod", "default", "app-container", 0, &corev1.ContainerStateWaiting{
+			Reason: "ContainerCreating",
+		})
+		newPod := createPodWithWaitingState("test-pod", "default", "app-container", 0, &corev1.ContainerStateWaiting{
+			Reason:  "CreateContainerConfigError",
+			Message: `secret "db-credentials" not found`,
+		})
+
+		signals := s.detector.Detect(oldPod, newPod)
+
+		s.Require().Len(signals, 1, "expected one fault signal for config error transition")
+		signal := signals[0]
+
+		s.Equal(events.FaultTypeConfigError, signal.FaultType)
+		s.Equal(types.UID(newPod.UID), signal.ResourceUID)
+		s.Equal("Pod", signal.Kind)
+		s.Equal("test-pod", signal.Name)
+		s.Equal("default", signal.Namespace)
+		s.Equal("app-container", signal.ContainerName)
+		s.Equal(events.SeverityWarning, signal.Severity)
+		s.Contains(signal.Context, "CreateContainerConfigError")
+		s.Contains(signal.Context, `secret "db-credentials" not found`)
+		s.False(signal.Timestamp.IsZero())
+	})
+
+	s.Run("transition to CreateContainerError emits signal", func() {
+		oldPod := createPodWithWaitingState("test-pod", "default", "app-container", 0, &corev1.ContainerStateWaiting{
+			Reason: "ContainerCreating",
+		})
+		newPod := createPodWithWaitingState("test-pod", "default", "app-container", 0, &corev1.ContainerStateWaiting{
+			Reason:  "CreateContainerError",
+			Message: `configmap "app-config" not found`,
+		})
+
+		signals := s.detector.Detect(oldPod, newPod)
+
+		s.Require().Len(signals, 1)
+		s.Contains(signals[0].Context, "CreateContainerError")
+		s.Contains(signals[0].Context, `configmap "app-config" not found`)
+	})
+
+	s.Run("first container status reporting config error emits signal", func() {
+		oldPod := createPodWithWaitingState("test-pod", "default", "app-container", 0, nil)
+		oldPod.Status.ContainerStatuses = nil
+		newPod := createPodWithWaitingState("test-pod", "default", "app-container", 0, &corev1.ContainerStateWaiting{
+			Reason:  "CreateContainerConfigError",
+			Message: `secret "db-credentials" not found`,
+		})
+
+		signals := s.detector.Detect(oldPod, newPod)
+
+		s.Require().Len(signals, 1, "pending pod gaining its first status in config error should emit signal")
+		s.Equal("app-container", signals[0].ContainerName)
+	})
+
+	s.Run("init container entering config error emits signal", func() {
+		oldPod := createPodWithWaitingState("test-pod", "default", "app-container", 0, nil)
+		oldPod.Status.InitContainerStatuses = []corev1.ContainerStatus{{Name: "init-container"}}
+		newPod := createPodWithWaitingState("test-pod", "default", "app-container", 0, nil)
+		newPod.Status.InitContainerStatuses = []corev1.ContainerStatus{{
+			Name: "init-container",
+			State: corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{
+				Reason:  "CreateContainerConfigError",
+				Message: `couldn't find key password in Secret default/db-credentials`,
+			}},
+		}}
+
+		signals := s.detector.Detect(oldPod, newPod)
+
+		s.Require().Len(signals, 1)
+		s.Equal("init-container", signals[0].ContainerName)
+	})
+}
+
+// TestConfigErrorDetector_NoTransitionNoSignal tests that steady states do not emit signals
+func (s *ConfigErrorDetectorSuite) TestConfigErrorDetector_NoTransitionNoSignal() {
+	s.Run("already in CreateContainerConfigError does not emit signal", func() {
+		waiting := &corev1.ContainerStateWaiting{Reason: "CreateContainerConfigError", Message: `secret "db-credentials" not found`}
+		oldPod := createPodWithWaitingState("test-pod", "default", "app-container", 0, waiting)
+		newPod := createPodWithWaitingState("test-pod", "default", "app-container", 0, waiting)
+
+		signals := s.detector.Detect(oldPod, newPod)
+
+		s.Empty(signals, "no state change should not emit signal")
+	})
+
+	s.Run("switching between config error reasons does not emit signal", func() {
+		oldPod := createPodWithWaitingState("test-pod", "default", "app-container", 0, &corev1.ContainerStateWaiting{Reason: "CreateContainerConfigError"})
+		newPod := createPodWithWaitingState("test-pod", "default", "app-container", 0, &corev1.ContainerStateWaiting{Reason: "CreateContainerError"})
+
+		signals := s.detector.Detect(oldPod, newPod)
+
+		s.Empty(signals, "container was already failing to be created")
+	})
+
+	s.Run("recovery from CreateContainerConfigError does not emit signal", func() {
+		oldPod := createPodWithWaitingState("test-pod", "default", "app-container", 0, &corev1.ContainerStateWaiting{Reason: "CreateContainerConfigError"})
+		newPod := createPodWithWaitingState("test-pod", "default", "app-container", 0, nil)
+		newPod.Status.ContainerStatuses[0].State.Running = &corev1.ContainerStateRunning{}
+
+		signals := s.detector.Detect(oldPod, newPod)
+
+		s.Empty(signals, "recovery should not emit signal")
+	})
+
+	s.Run("other waiting reasons do not emit signal", func() {
+		for _, reason := range []string{"ContainerCreating", "ImagePullBackOff", "ErrImagePull", "CrashLoopBackOff"} {
+			oldPod := createPodWithWaitingState("test-pod", "default", "app-container", 0, nil)
+			newPod := createPodWithWaitingState("test-pod", "default", "app-container", 0, &corev1.ContainerStateWaiting{Reason: reason})
+
+			signals := s.detector.Detect(oldPod, newPod)
+
+			s.Empty(signals, "%s should not emit ConfigError signal", reason)
+		}
+	})
+}
+
+// TestConfigErrorDetector_EdgeCases tests edge cases and error conditions
+func (s *ConfigErrorDetectorSuite) TestConfigErrorDetector_EdgeCases() {
+	s.Run("returns empty slice for nil newObj", func() {
+		oldPod := createPodWithWaitingState("test-pod", "default", "app-container", 0, nil)
+
+		signals := s.detector.Detect(oldPod, nil)
+
+		s.Empty(signals)
+		s.NotNil(signals)
+	})
+
+	s.Run("returns empty slice for nil oldObj", func() {
+		newPod := createPodWithWaitingState("test-pod", "default", "app-container", 0, &corev1.ContainerStateWaiting{
+			Reason: "CreateContainerConfigError",
+		})
+
+		signals := s.detector.Detect(nil, newPod)
+
+		s.Empty(signals, "nil oldObj means Add event, no transition to detect")
+	})
+
+	s.Run("returns empty slice when newObj is not a Pod", func() {
+		oldPod := createPodWithWaitingState("test-pod", "default", "app-container", 0, nil)
+
+		signals := s.detector.Detect(oldPod, "this is a string, not a Pod")
+
+		s.Empty(signals)
+	})
+
+	s.Run("returns empty slice when oldObj is not a Pod", func() {
+		newPod := createPodWithWaitingState("test-pod", "default", "app-container", 0, &corev1.ContainerStateWaiting{
+			Reason: "CreateContainerConfigError",
+		})
+
+		signals := s.detector.Detect("this is a string, not a Pod", newPod)
+
+		s.Empty(signals)
+	})
+
+	s.Run("returns empty non-nil slice when no container is in config error", func() {
+		oldPod := createPodWithWaitingState("test-pod", "default", "app-container", 0, nil)
+		newPod := createPodWithWaitingState("test-pod", "default", "app-container", 0, nil)
+
+		signals := s.detector.Detect(oldPod, newPod)
+
+		s.Empty(signals)
+		s.NotNil(signals)
+	})
+}
+
+// TestConfigErrorDetector_ContextBuilding tests context message construction
+func (s *ConfigErrorDetectorSuite) TestConfigErrorDetector_ContextBuilding() {
+	s.Run("context omits waiting message when empty", func() {
+		oldPod := createPodWithWaitingState("test-pod", "default", "app-container", 0, nil)
+		newPod := createPodWithWaitingState("test-pod", "default", "app-container", 0, &corev1.ContainerStateWaiting{
+			Reason: "CreateContainerConfigError",
+		})
+
+		signals := s.detector.Detect(oldPod, newPod)
+
+		s.Require().Len(signals, 1)
+		s.Equal("Container could not be created: CreateContainerConfigError", signals[0].Context)
+	})
+}
+
+// TestConfigErrorDetector_DetectorInterface verifies interface compliance
+func (s *ConfigErrorDetectorSuite) TestConfigErrorDetector_DetectorInterface() {
+	s.Run("ConfigErrorDetector implements Detector interface", func() {
+		var _ events.Detector = &ConfigErrorDetector{}
+		var _ events.Detector = s.detector
+	})
+}
+
+// TestConfigErrorDetector_Details tests the structured fields of config error signals
+func (s *ConfigErrorDetectorSuite) TestConfigErrorDetector_Details() {
+	s.Run("details match the fields in the context", func() {
+		oldPod := createPodWithWaitingState("test-pod", "default", "app-container", 0, &corev1.ContainerStateWaiting{Reason: "ContainerCreating"})
+		newPod := createPodWithWaitingState("test-pod", "default", "app-container", 0, &corev1.ContainerStateWaiting{
+			Reason:  "CreateContainerConfigError",
+			Message: `secret "db-credentials" not found`,
+		})
+
+		signals := s.detector.Detect(oldPod, newPod)
+
+		s.Require().Len(signals, 1)
+		details := signals[0].Details
+		s.Equal(map[string]string{"reason": "CreateContainerConfigError", "waitingMessage": `secret "db-credentials" not found`}, details)
+		s.Contains(signals[0].Context, "Container could not be created: "+details["reason"])
+		s.Contains(signals[0].Context, "waiting message: "+details["waitingMessage"])
+	})
+
+	s.Run("empty waiting message is omitted", func() {
+		newPod := createPodWithWaitingState("test-pod", "default", "app-container", 0, &corev1.ContainerStateWaiting{Reason: "CreateContainerError"})
+
+		signals := s.detector.Detect(createPodWithWaitingState("test-pod", "default", "app-container", 0, nil), newPod)
+
+		s.Require().Len(signals, 1)
+		s.Equal(map[string]string{"reason": "CreateContainerError"}, signals[0].Details)
+	})
+}
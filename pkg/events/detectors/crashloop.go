@@ -2,6 +2,7 @@ package detectors
 
 import (
 	"fmt"
+	"strconv"
 	"time"
 
 	corev1 "k8s.io/api/core/v1"
@@ -22,6 +23,25 @@ func NewCrashLoopDetector() *CrashLoopDetector {
 	return &CrashLoopDetector{}
 }
 
+// FaultType returns the type of the fault signals emitted by this detector.
+func (d *CrashLoopDetector) FaultType() events.FaultType {
+	return events.FaultTypeCrashLoop
+}
+
+// ResourceKind returns the kind of resource this detector inspects.
+func (d *CrashLoopDetector) ResourceKind() string {
+	return "Pod"
+}
+
+// Describe returns the fault type emitted and the resource kind inspected by this detector.
+func (d *CrashLoopDetector) Describe() events.DetectorInfo {
+	return events.DetectorInfo{
+		FaultTypes:    []events.FaultType{d.FaultType()},
+		ResourceKinds: []string{d.ResourceKind()},
+		Description:   "Reports containers entering CrashLoopBackOff.",
+	}
+}
+
 // Detect analyzes pod state changes and returns fault signals for containers
 // entering CrashLoopBackOff state. It compares container statuses between
 // oldObj and newObj, looking for transitions from non-CrashLoopBackOff to
@@ -78,26 +98,45 @@ func (d *CrashLoopDetector) Detect(oldObj, newObj interface{}) []events.FaultSig
 		}
 
 		// We have a transition into CrashLoopBackOff state
-		context := buildCrashLoopContext(newStatus, newPod)
-
-		signal := events.FaultSignal{
-			FaultType:     events.FaultTypeCrashLoop,
-			ResourceUID:   types.UID(newPod.UID),
-			Kind:          "Pod",
-			Name:          newPod.Name,
-			Namespace:     newPod.Namespace,
-			ContainerName: newStatus.Name,
-			Severity:      events.SeverityCritical,
-			Context:       context,
-			Timestamp:     time.Now(),
-		}
+		signals = append(signals, newCrashLoopSignal(newPod, newStatus))
+	}
 
-		signals = append(signals, signal)
+	return signals
+}
+
+// DetectState returns fault signals for the containers of a pod that are currently
+// in CrashLoopBackOff, regardless of how long they have been in it.
+func (d *CrashLoopDetector) DetectState(obj interface{}) []events.FaultSignal {
+	pod, ok := obj.(*corev1.Pod)
+	if !ok {
+		return []events.FaultSignal{}
 	}
 
+	signals := []events.FaultSignal{}
+	for _, status := range pod.Status.ContainerStatuses {
+		if isInCrashLoopBackOff(status) {
+			signals = append(signals, newCrashLoopSignal(pod, status))
+		}
+	}
 	return signals
 }
 
+// newCrashLoopSignal creates the fault signal for a container of pod in CrashLoopBackOff.
+func newCrashLoopSignal(pod *corev1.Pod, status corev1.ContainerStatus) events.FaultSignal {
+	return events.FaultSignal{
+		FaultType:     events.FaultTypeCrashLoop,
+		ResourceUID:   types.UID(pod.UID),
+		Kind:          "Pod",
+		Name:          pod.Name,
+		Namespace:     pod.Namespace,
+		ContainerName: status.Name,
+		Severity:      events.SeverityCritical,
+		Context:       buildCrashLoopContext(status, pod),
+		Details:       buildCrashLoopDetails(status),
+		Timestamp:     time.Now(),
+	}
+}
+
 // isInCrashLoopBackOff checks if a container status indicates CrashLoopBackOff state.
 func isInCrashLoopBackOff(status corev1.ContainerStatus) bool {
 	if status.State.Waiting != nil && status.State.Waiting.Reason == "CrashLoopBackOff" {
@@ -130,3 +169,29 @@ func buildCrashLoopContext(status corev1.ContainerStatus, pod *corev1.Pod) strin
 
 	return context
 }
+
+// buildCrashLoopDetails returns the fields embedded in the CrashLoopBackOff context.
+func buildCrashLoopDetails(status corev1.ContainerStatus) map[string]string {
+	details := map[string]string{
+		"restartCount": strconv.Itoa(int(status.RestartCount)),
+	}
+
+	if status.State.Waiting != nil && status.State.Waiting.Message != "" {
+		details["waitingMessage"] = status.State.Waiting.Message
+	}
+
+	if status.LastTerminationState.Terminated != nil {
+		terminated := status.LastTerminationState.Terminated
+		if terminated.ExitCode != 0 {
+			details["lastExitCode"] = strconv.Itoa(int(terminated.ExitCode))
+		}
+		if terminated.Reason != "" {
+			details["lastReason"] = terminated.Reason
+		}
+		if terminated.Message != "" {
+			details["terminationMessage"] = terminated.Message
+		}
+	}
+
+	return details
+}
@@ -588,6 +588,39 @@ func (s *CrashLoopDetectorSuite) TestCrashLoopDetector_DetectorInterface() {
 	})
 }
 
+// TestCrashLoopDetector_DetectState tests reporting containers already in CrashLoopBackOff
+func (s *CrashLoopDetectorSuite) TestCrashLoopDetector_DetectState() {
+	s.Run("CrashLoopDetector implements InitialStateDetector interface", func() {
+		var _ events.InitialStateDetector = s.detector
+	})
+
+	s.Run("container in CrashLoopBackOff emits signal", func() {
+		pod := createPodWithWaitingState("test-pod", "default", "app", 5, &corev1.ContainerStateWaiting{
+			Reason:  "CrashLoopBackOff",
+			Message: "back-off 5m0s restarting failed container",
+		})
+
+		signals := s.detector.DetectState(pod)
+
+		s.Require().Len(signals, 1, "expected one signal for a container in CrashLoopBackOff")
+		s.Equal(events.FaultTypeCrashLoop, signals[0].FaultType)
+		s.Equal("test-pod", signals[0].Name)
+		s.Equal("app", signals[0].ContainerName)
+		s.Equal("5", signals[0].Details["restartCount"])
+	})
+
+	s.Run("container in another Waiting state does not emit signal", func() {
+		pod := createPodWithWaitingState("test-pod", "default", "app", 0, &corev1.ContainerStateWaiting{Reason: "ImagePullBackOff"})
+
+		s.Empty(s.detector.DetectState(pod))
+	})
+
+	s.Run("returns empty slice for nil or non-Pod objects", func() {
+		s.Empty(s.detector.DetectState(nil))
+		s.Empty(s.detector.DetectState(&corev1.Node{}))
+	})
+}
+
 // TestCrashLoopDetector_NonCrashLoopWaitingStates tests that other Waiting states don't trigger
 func (s *CrashLoopDetectorSuite) TestCrashLoopDetector_NonCrashLoopWaitingStates() {
 	s.Run("ImagePullBackOff does not emit signal", func() {
@@ -631,6 +664,49 @@ func (s *CrashLoopDetectorSuite) TestCrashLoopDetector_NonCrashLoopWaitingStates
 	})
 }
 
+// TestCrashLoopDetector_Details tests the structured fields of CrashLoopBackOff signals
+func (s *CrashLoopDetectorSuite) TestCrashLoopDetector_Details() {
+	s.Run("details match the fields in the context", func() {
+		oldPod := createPodWithWaitingState("test-pod", "default", "app-container", 2, nil)
+		newPod := createPodWithWaitingState("test-pod", "default", "app-container", 3, &corev1.ContainerStateWaiting{
+			Reason:  "CrashLoopBackOff",
+			Message: "back-off 5m0s restarting failed container",
+		})
+		newPod.Status.ContainerStatuses[0].LastTerminationState.Terminated = &corev1.ContainerStateTerminated{
+			ExitCode: 1,
+			Reason:   "Error",
+			Message:  "connection refused",
+		}
+
+		signals := s.detector.Detect(oldPod, newPod)
+
+		s.Require().Len(signals, 1)
+		details := signals[0].Details
+		s.Equal(map[string]string{
+			"restartCount":       "3",
+			"waitingMessage":     "back-off 5m0s restarting failed container",
+			"lastExitCode":       "1",
+			"lastReason":         "Error",
+			"terminationMessage": "connection refused",
+		}, details)
+		s.Contains(signals[0].Context, "restart count: "+details["restartCount"])
+		s.Contains(signals[0].Context, "waiting message: "+details["waitingMessage"])
+		s.Contains(signals[0].Context, "last exit code: "+details["lastExitCode"])
+		s.Contains(signals[0].Context, "last reason: "+details["lastReason"])
+		s.Contains(signals[0].Context, "termination message: "+details["terminationMessage"])
+	})
+
+	s.Run("only the restart count is set without termination info", func() {
+		oldPod := createPodWithWaitingState("test-pod", "default", "app-container", 2, nil)
+		newPod := createPodWithWaitingState("test-pod", "default", "app-container", 3, &corev1.ContainerStateWaiting{Reason: "CrashLoopBackOff"})
+
+		signals := s.detector.Detect(oldPod, newPod)
+
+		s.Require().Len(signals, 1)
+		s.Equal(map[string]string{"restartCount": "3"}, signals[0].Details)
+	})
+}
+
 // Helper function to create a pod with a single container in Waiting state
 func createPodWithWaitingState(name, namespace, containerName string, restartCount int32, waiting *corev1.ContainerStateWaiting) *corev1.Pod {
 	pod := &corev1.Pod{
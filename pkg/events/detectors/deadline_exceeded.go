@@ -0,0 +1,126 @@
+package detectors
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/events"
+)
+
+// podReasonDeadlineExceeded is the pod status reason set by the kubelet when it
+// terminates a pod for running longer than its Spec.ActiveDeadlineSeconds.
+const podReasonDeadlineExceeded = "DeadlineExceeded"
+
+// DeadlineExceededDetector detects when a pod is terminated for exceeding its
+// activeDeadlineSeconds, as commonly set on the pods of Jobs.
+// This is an edge-triggered detector that emits signals when a pod transitions
+// INTO the Failed phase with reason DeadlineExceeded. Pods completing normally,
+// or failing for other reasons, are not reported.
+type DeadlineExceededDetector struct{}
+
+// NewDeadlineExceededDetector creates a new DeadlineExceededDetector instance.
+func NewDeadlineExceededDetector() *DeadlineExceededDetector {
+	return &DeadlineExceededDetector{}
+}
+
+// FaultType returns the type of the fault signals emitted by this detector.
+func (d *DeadlineExceededDetector) FaultType() events.FaultType {
+	return events.FaultTypeDeadlineExceeded
+}
+
+// ResourceKind returns the kind of resource this detector inspects.
+func (d *DeadlineExceededDetector) ResourceKind() string {
+	return "Pod"
+}
+
+// Describe returns the fault type emitted and the resource kind inspected by this detector.
+func (d *DeadlineExceededDetector) Describe() events.DetectorInfo {
+	return events.DetectorInfo{
+		FaultTypes:    []events.FaultType{d.FaultType()},
+		ResourceKinds: []string{d.ResourceKind()},
+		Description:   "Reports pods terminated for running longer than their activeDeadlineSeconds.",
+	}
+}
+
+// Detect analyzes Pod state changes and returns fault signals for pods that
+// transitioned to the Failed phase because their active deadline was exceeded.
+func (d *DeadlineExceededDetector) Detect(oldObj, newObj interface{}) []events.FaultSignal {
+	// Handle nil newObj - nothing to detect
+	if newObj == nil {
+		return []events.FaultSignal{}
+	}
+
+	// Type assert to Pod
+	newPod, ok := newObj.(*corev1.Pod)
+	if !ok {
+		return []events.FaultSignal{}
+	}
+
+	// If oldObj is nil (Add event), no transition to detect
+	if oldObj == nil {
+		return []events.FaultSignal{}
+	}
+
+	oldPod, ok := oldObj.(*corev1.Pod)
+	if !ok {
+		return []events.FaultSignal{}
+	}
+
+	// Detect transition into the deadline-exceeded state
+	if !isDeadlineExceeded(newPod) || isDeadlineExceeded(oldPod) {
+		return []events.FaultSignal{}
+	}
+
+	signal := events.FaultSignal{
+		FaultType:   events.FaultTypeDeadlineExceeded,
+		ResourceUID: types.UID(newPod.UID),
+		Kind:        "Pod",
+		Name:        newPod.Name,
+		Namespace:   newPod.Namespace,
+		Severity:    events.SeverityWarning,
+		Context:     buildDeadlineExceededContext(newPod),
+		Details:     buildDeadlineExceededDetails(newPod),
+		Timestamp:   time.Now(),
+	}
+
+	return []events.FaultSignal{signal}
+}
+
+// isDeadlineExceeded reports whether the pod failed because its active deadline was exceeded.
+func isDeadlineExceeded(pod *corev1.Pod) bool {
+	return pod.Status.Phase == corev1.PodFailed && pod.Status.Reason == podReasonDeadlineExceeded
+}
+
+// buildDeadlineExceededContext creates a human-readable context string for a pod
+// that exceeded its active deadline, including the deadline if the spec sets one.
+func buildDeadlineExceededContext(pod *corev1.Pod) string {
+	context := "Pod exceeded its active deadline"
+	if pod.Spec.ActiveDeadlineSeconds != nil {
+		context += fmt.Sprintf(" of %ds", *pod.Spec.ActiveDeadlineSeconds)
+	}
+	if pod.Status.Message != "" {
+		context += fmt.Sprintf(", message: %s", pod.Status.Message)
+	}
+	return context
+}
+
+// buildDeadlineExceededDetails returns the fields embedded in the deadline exceeded
+// context. The deadline and message are omitted if unset.
+func buildDeadlineExceededDetails(pod *corev1.Pod) map[string]string {
+	details := map[string]string{
+		"reason": pod.Status.Reason,
+	}
+
+	if pod.Spec.ActiveDeadlineSeconds != nil {
+		details["activeDeadlineSeconds"] = strconv.FormatInt(*pod.Spec.ActiveDeadlineSeconds, 10)
+	}
+	if pod.Status.Message != "" {
+		details["message"] = pod.Status.Message
+	}
+
+	return details
+}
@@ -0,0 +1,130 @@
+package detectors
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/events"
+)
+
+// DeadlineExceededDetectorSuite contains tests for DeadlineExceededDetector
+type DeadlineExceededDetectorSuite struct {
+	suite.Suite
+	detector *DeadlineExceededDetector
+}
+
+func TestDeadlineExceededDetectorSuite(t *testing.T) {
+	suite.Run(t, new(DeadlineExceededDetectorSuite))
+}
+
+// SetupTest runs before each test
+func (s *DeadlineExceededDetectorSuite) SetupTest() {
+	s.detector = NewDeadlineExceededDetector()
+}
+
+// TestDeadlineExceededDetector_Transitions tests detection of pods failing for exceeding their active deadline
+func (s *DeadlineExceededDetectorSuite) TestDeadlineExceededDetector_Transitions() {
+	s.Run("transition to deadline exceeded emits warning signal", func() {
+		oldPod := createPodWithPhase("job-pod", corev1.PodRunning, "", ptr.To[int64](300))
+		newPod := createPodWithPhase("job-pod", corev1.PodFailed, "DeadlineExceeded", ptr.To[int64](300))
+		newPod.Status.Message = "Pod was active on the node longer than the specified deadline"
+
+		signals := s.detector.Detect(oldPod, newPod)
+
+		s.Require().Len(signals, 1)
+		signal := signals[0]
+
+		s.Equal(events.FaultTypeDeadlineExceeded, signal.FaultType)
+		s.Equal(types.UID(newPod.UID), signal.ResourceUID)
+		s.Equal("Pod", signal.Kind)
+		s.Equal("job-pod", signal.Name)
+		s.Equal("default", signal.Namespace)
+		s.Equal(events.SeverityWarning, signal.Severity)
+		s.Equal("Pod exceeded its active deadline of 300s, message: Pod was active on the node longer than the specified deadline", signal.Context)
+		s.Equal(map[string]string{
+			"reason":                "DeadlineExceeded",
+			"activeDeadlineSeconds": "300",
+			"message":               "Pod was active on the node longer than the specified deadline",
+		}, signal.Details)
+		s.False(signal.Timestamp.IsZero())
+	})
+
+	s.Run("deadline is omitted when the spec doesn't set one", func() {
+		oldPod := createPodWithPhase("job-pod", corev1.PodRunning, "", nil)
+		newPod := createPodWithPhase("job-pod", corev1.PodFailed, "DeadlineExceeded", nil)
+
+		signals := s.detector.Detect(oldPod, newPod)
+
+		s.Require().Len(signals, 1)
+		s.Equal("Pod exceeded its active deadline", signals[0].Context)
+		s.Equal(map[string]string{"reason": "DeadlineExceeded"}, signals[0].Details)
+	})
+
+	s.Run("normal completion does not emit signal", func() {
+		oldPod := createPodWithPhase("job-pod", corev1.PodRunning, "", ptr.To[int64](300))
+		newPod := createPodWithPhase("job-pod", corev1.PodSucceeded, "", ptr.To[int64](300))
+
+		s.Empty(s.detector.Detect(oldPod, newPod))
+	})
+
+	s.Run("failure for another reason does not emit signal", func() {
+		oldPod := createPodWithPhase("job-pod", corev1.PodRunning, "", ptr.To[int64](300))
+		newPod := createPodWithPhase("job-pod", corev1.PodFailed, "Evicted", ptr.To[int64](300))
+
+		s.Empty(s.detector.Detect(oldPod, newPod))
+	})
+
+	s.Run("pod already past its deadline does not emit signal again", func() {
+		oldPod := createPodWithPhase("job-pod", corev1.PodFailed, "DeadlineExceeded", ptr.To[int64](300))
+		newPod := createPodWithPhase("job-pod", corev1.PodFailed, "DeadlineExceeded", ptr.To[int64](300))
+
+		s.Empty(s.detector.Detect(oldPod, newPod))
+	})
+}
+
+// TestDeadlineExceededDetector_EdgeCases tests nil and unexpected inputs
+func (s *DeadlineExceededDetectorSuite) TestDeadlineExceededDetector_EdgeCases() {
+	failed := createPodWithPhase("job-pod", corev1.PodFailed, "DeadlineExceeded", nil)
+
+	s.Run("nil newObj returns empty", func() {
+		signals := s.detector.Detect(nil, nil)
+		s.NotNil(signals)
+		s.Empty(signals)
+	})
+
+	s.Run("nil oldObj (Add event) returns empty", func() {
+		s.Empty(s.detector.Detect(nil, failed))
+	})
+
+	s.Run("non-Pod newObj returns empty", func() {
+		s.Empty(s.detector.Detect(nil, "not a pod"))
+	})
+
+	s.Run("non-Pod oldObj returns empty", func() {
+		s.Empty(s.detector.Detect("not a pod", failed))
+	})
+}
+
+// createPodWithPhase creates a pod in the given phase and status reason, with an
+// optional active deadline.
+func createPodWithPhase(name string, phase corev1.PodPhase, reason string, activeDeadlineSeconds *int64) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "default",
+			UID:       types.UID("pod-uid-" + name),
+		},
+		Spec: corev1.PodSpec{
+			ActiveDeadlineSeconds: activeDeadlineSeconds,
+		},
+		Status: corev1.PodStatus{
+			Phase:  phase,
+			Reason: reason,
+		},
+	}
+}
@@ -25,6 +25,25 @@ func NewDeploymentFailureDetector() *DeploymentFailureDetector {
 	return &DeploymentFailureDetector{}
 }
 
+// FaultType returns the type of the fault signals emitted by this detector.
+func (d *DeploymentFailureDetector) FaultType() events.FaultType {
+	return events.FaultTypeDeploymentFailure
+}
+
+// ResourceKind returns the kind of resource this detector inspects.
+func (d *DeploymentFailureDetector) ResourceKind() string {
+	return "Deployment"
+}
+
+// Describe returns the fault type emitted and the resource kind inspected by this detector.
+func (d *DeploymentFailureDetector) Describe() events.DetectorInfo {
+	return events.DetectorInfo{
+		FaultTypes:    []events.FaultType{d.FaultType()},
+		ResourceKinds: []string{d.ResourceKind()},
+		Description:   "Reports Deployments whose rollout exceeds its progress deadline.",
+	}
+}
+
 // Detect analyzes Deployment state changes and returns fault signals for detected
 // rollout failures. It detects transitions to the ProgressDeadlineExceeded state
 // by comparing the Progressing condition between oldObj and newObj.
@@ -71,8 +90,6 @@ func (d *DeploymentFailureDetector) Detect(oldObj, newObj interface{}) []events.
 			oldProgressing.Status != corev1.ConditionFalse ||
 			oldProgressing.Reason != "ProgressDeadlineExceeded" {
 
-			context := buildDeploymentFailureContext(newProgressing)
-
 			signal := events.FaultSignal{
 				FaultType:   events.FaultTypeDeploymentFailure,
 				ResourceUID: types.UID(newDeployment.UID),
@@ -80,7 +97,8 @@ func (d *DeploymentFailureDetector) Detect(oldObj, newObj interface{}) []events.
 				Name:        newDeployment.Name,
 				Namespace:   newDeployment.Namespace,
 				Severity:    events.SeverityCritical,
-				Context:     context,
+				Context:     buildDeploymentFailureContext(newProgressing),
+				Details:     buildDeploymentFailureDetails(newProgressing),
 				Timestamp:   time.Now(),
 			}
 
@@ -122,3 +140,16 @@ func buildDeploymentFailureContext(progressingCondition *appsv1.DeploymentCondit
 
 	return context
 }
+
+// buildDeploymentFailureDetails returns the fields embedded in the rollout failure context.
+func buildDeploymentFailureDetails(progressingCondition *appsv1.DeploymentCondition) map[string]string {
+	details := map[string]string{
+		"reason": progressingCondition.Reason,
+	}
+
+	if progressingCondition.Message != "" {
+		details["message"] = progressingCondition.Message
+	}
+
+	return details
+}
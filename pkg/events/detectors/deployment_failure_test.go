@@ -494,6 +494,22 @@ func (s *DeploymentFailureDetectorSuite) TestGetProgressingCondition() {
 	})
 }
 
+// TestDeploymentFailureDetector_Details tests the structured fields of rollout failure signals
+func (s *DeploymentFailureDetectorSuite) TestDeploymentFailureDetector_Details() {
+	s.Run("details match the fields in the context", func() {
+		oldDeployment := createDeploymentWithProgressingCondition("test-deployment", "default", corev1.ConditionTrue, "NewReplicaSetAvailable", "")
+		newDeployment := createDeploymentWithProgressingCondition("test-deployment", "default", corev1.ConditionFalse, "ProgressDeadlineExceeded", "ReplicaSet test-deployment-abc has timed out progressing")
+
+		signals := s.detector.Detect(oldDeployment, newDeployment)
+
+		s.Require().Len(signals, 1)
+		details := signals[0].Details
+		s.Equal(map[string]string{"reason": "ProgressDeadlineExceeded", "message": "ReplicaSet test-deployment-abc has timed out progressing"}, details)
+		s.Contains(signals[0].Context, details["reason"])
+		s.Contains(signals[0].Context, "message: "+details["message"])
+	})
+}
+
 // Helper function to create a Deployment with a Progressing condition
 func createDeploymentWithProgressingCondition(name, namespace string, status corev1.ConditionStatus, reason, message string) *appsv1.Deployment {
 	return &appsv1.Deployment{
@@ -0,0 +1,224 @@
+package detectors
+
+import (
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	discoveryv1 "k8s.io/api/discovery/v1"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/events"
+)
+
+const (
+	// DefaultEndpointFlappingThreshold is how many times a Service's ready endpoint count
+	// may change direction within DefaultEndpointFlappingWindow before it is reported
+	// as flapping. It leaves room for the churn of a rolling update of a few replicas.
+	DefaultEndpointFlappingThreshold = 8
+
+	// DefaultEndpointFlappingWindow is the period over which direction changes are counted.
+	DefaultEndpointFlappingWindow = 5 * time.Minute
+)
+
+// serviceEndpoints is the ready endpoint history of a Service.
+type serviceEndpoints struct {
+	serviceSlices
+	direction int         // +1 if the ready count last went up, -1 if down, 0 if unknown
+	reversals []time.Time // when the ready count changed direction, oldest first
+}
+
+// EndpointFlappingDetector detects Services whose ready endpoints keep coming and going,
+// e.g. because pods fail their readiness probes intermittently. Unlike NoEndpoints,
+// such a Service may never lose all of its endpoints, yet clients see errors as they
+// are routed to pods that stop being ready.
+//
+// The detector sums the ready endpoints of each Service's EndpointSlices and counts the
+// times that count changes direction (goes up after having gone down, or down after
+// having gone up), so steady scale-ups and scale-downs are not reported. It emits a
+// signal when the direction changed more than the threshold within the window, then
+// resets the count, so the endpoints must keep flapping to be reported again. Like
+// EndpointsDetector, it counts slices from the time they are added to the informer
+// cache and stops counting deleted ones.
+//
+// It is safe for concurrent use.
+type EndpointFlappingDetector struct {
+	mu        sync.Mutex
+	threshold int
+	window    time.Duration
+	tracked   map[serviceKey]*serviceEndpoints
+	now       func() time.Time // allows time injection for testing
+}
+
+// NewEndpointFlappingDetector creates a new EndpointFlappingDetector with the default threshold and window.
+func NewEndpointFlappingDetector() *EndpointFlappingDetector {
+	return NewEndpointFlappingDetectorWithThreshold(DefaultEndpointFlappingThreshold, DefaultEndpointFlappingWindow)
+}
+
+// NewEndpointFlappingDetectorWithThreshold creates a new EndpointFlappingDetector that
+// reports Services whose ready endpoint count changed direction more than threshold
+// times within window.
+func NewEndpointFlappingDetectorWithThreshold(threshold int, window time.Duration) *EndpointFlappingDetector {
+	return &EndpointFlappingDetector{
+		threshold: threshold,
+		window:    window,
+		tracked:   make(map[serviceKey]*serviceEndpoints),
+		now:       time.Now,
+	}
+}
+
+// FaultType returns the type of the fault signals emitted by this detector.
+func (d *EndpointFlappingDetector) FaultType() events.FaultType {
+	return events.FaultTypeEndpointFlapping
+}
+
+// ResourceKind returns the kind of resource this detector inspects.
+func (d *EndpointFlappingDetector) ResourceKind() string {
+	return "EndpointSlice"
+}
+
+// Describe returns the fault type emitted and the resource kind inspected by this detector.
+func (d *EndpointFlappingDetector) Describe() events.DetectorInfo {
+	return events.DetectorInfo{
+		FaultTypes:    []events.FaultType{d.FaultType()},
+		ResourceKinds: []string{d.ResourceKind()},
+		Description:   "Reports Services whose ready endpoint count keeps going up and down within a configured window.",
+	}
+}
+
+// Observe records an EndpointSlice added to the informer cache, so the ready endpoints
+// of its Service include the slice before it is first updated.
+func (d *EndpointFlappingDetector) Observe(obj interface{}) {
+	slice, ok := obj.(*discoveryv1.EndpointSlice)
+	if !ok {
+		return
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.serviceLocked(sliceServiceKey(slice)).add(slice)
+}
+
+// Forget drops a deleted EndpointSlice from the ready endpoints of its Service, and
+// the Service's history with its last slice.
+func (d *EndpointFlappingDetector) Forget(obj interface{}) {
+	slice, ok := obj.(*discoveryv1.EndpointSlice)
+	if !ok {
+		return
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	key := sliceServiceKey(slice)
+	state, exists := d.tracked[key]
+	if !exists {
+		return
+	}
+	state.remove(slice)
+	if len(state.slices) == 0 {
+		delete(d.tracked, key)
+	}
+}
+
+// serviceLocked returns the endpoint history of a Service, starting to track it if needed.
+// Must be called with the lock held.
+func (d *EndpointFlappingDetector) serviceLocked(key serviceKey) *serviceEndpoints {
+	state, exists := d.tracked[key]
+	if !exists {
+		state = &serviceEndpoints{serviceSlices: newServiceSlices()}
+		d.tracked[key] = state
+	}
+	return state
+}
+
+// Detect analyzes EndpointSlice updates and returns a fault signal once the ready
+// endpoint count of the slice's Service changed direction more than the threshold
+// within the window.
+func (d *EndpointFlappingDetector) Detect(oldObj, newObj interface{}) []events.FaultSignal {
+	// Handle nil newObj - nothing to detect
+	if newObj == nil {
+		return []events.FaultSignal{}
+	}
+
+	// Type assert to EndpointSlice
+	newSlice, ok := newObj.(*discoveryv1.EndpointSlice)
+	if !ok {
+		return []events.FaultSignal{}
+	}
+
+	// If oldObj is nil (Add event), no update to evaluate
+	if oldObj == nil {
+		return []events.FaultSignal{}
+	}
+
+	oldSlice, ok := oldObj.(*discoveryv1.EndpointSlice)
+	if !ok {
+		return []events.FaultSignal{}
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	now := d.now()
+	serviceName := getServiceName(newSlice)
+	key := sliceServiceKey(newSlice)
+	state := d.serviceLocked(key)
+	previous, ready := state.observe(oldSlice, newSlice)
+	if ready == previous {
+		return []events.FaultSignal{}
+	}
+
+	direction := 1
+	if ready < previous {
+		direction = -1
+	}
+	if state.direction != 0 && direction != state.direction {
+		state.reversals = append(state.reversals, now)
+	}
+	state.direction = direction
+
+	// Only count direction changes within the window
+	for len(state.reversals) > 0 && now.Sub(state.reversals[0]) > d.window {
+		state.reversals = state.reversals[1:]
+	}
+	if len(state.reversals) <= d.threshold {
+		return []events.FaultSignal{}
+	}
+
+	reversals := len(state.reversals)
+	// Require a fresh burst before reporting this Service again
+	state.reversals = nil
+
+	signal := events.FaultSignal{
+		FaultType:   events.FaultTypeEndpointFlapping,
+		ResourceUID: state.resourceUID(key),
+		Kind:        "Service",
+		Name:        serviceName,
+		Namespace:   newSlice.Namespace,
+		Severity:    events.SeverityWarning,
+		Context:     buildEndpointFlappingContext(serviceName, reversals, d.window, ready),
+		Details:     buildEndpointFlappingDetails(serviceName, reversals, d.window, ready),
+		Timestamp:   now,
+	}
+
+	return []events.FaultSignal{signal}
+}
+
+// buildEndpointFlappingContext creates a human-readable context string for a Service
+// whose ready endpoints are flapping.
+func buildEndpointFlappingContext(serviceName string, reversals int, window time.Duration, ready int) string {
+	return fmt.Sprintf("Service %s ready endpoints went up and down %d times within %s, ready endpoint count: %d",
+		serviceName, reversals, window, ready)
+}
+
+// buildEndpointFlappingDetails returns the fields embedded in the endpoint flapping context.
+func buildEndpointFlappingDetails(serviceName string, reversals int, window time.Duration, ready int) map[string]string {
+	return map[string]string{
+		"service":        serviceName,
+		"reversals":      strconv.Itoa(reversals),
+		"window":         window.String(),
+		"readyEndpoints": strconv.Itoa(ready),
+	}
+}
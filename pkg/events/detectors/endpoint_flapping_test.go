@@ -0,0 +1,264 @@
+package detectors
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/events"
+)
+
+// EndpointFlappingDetectorSuite contains tests for EndpointFlappingDetector
+type EndpointFlappingDetectorSuite struct {
+	suite.Suite
+	detector    *EndpointFlappingDetector
+	currentTime time.Time
+}
+
+func TestEndpointFlappingDetectorSuite(t *testing.T) {
+	suite.Run(t, new(EndpointFlappingDetectorSuite))
+}
+
+// SetupTest runs before each test
+func (s *EndpointFlappingDetectorSuite) SetupTest() {
+	s.currentTime = time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	s.detector = NewEndpointFlappingDetectorWithThreshold(3, 5*time.Minute)
+	s.detector.now = func() time.Time {
+		return s.currentTime
+	}
+}
+
+// SetupSubTest resets detector state between subtests
+func (s *EndpointFlappingDetectorSuite) SetupSubTest() {
+	s.SetupTest()
+}
+
+func (s *EndpointFlappingDetectorSuite) advanceTime(d time.Duration) {
+	s.currentTime = s.currentTime.Add(d)
+}
+
+// update feeds the detector updates of slice moving through the given ready endpoint
+// counts, a second apart, and returns the signals of every update.
+func (s *EndpointFlappingDetectorSuite) update(slice *discoveryv1.EndpointSlice, counts ...int) []events.FaultSignal {
+	var signals []events.FaultSignal
+	for _, count := range counts {
+		s.advanceTime(time.Second)
+		updated := withReadyEndpoints(slice, count)
+		signals = append(signals, s.detector.Detect(slice, updated)...)
+		slice = updated
+	}
+	return signals
+}
+
+// TestEndpointFlappingDetector_Flapping tests detection of ready endpoint counts going up and down
+func (s *EndpointFlappingDetectorSuite) TestEndpointFlappingDetector_Flapping() {
+	s.Run("oscillating ready count emits signal", func() {
+		slice := createFlappingSlice("web-abc", "web", 3)
+
+		// 3 -> 2 -> 3 -> 2 -> 3 -> 2 changes direction 4 times
+		signals := s.update(slice, 2, 3, 2, 3, 2)
+
+		s.Require().Len(signals, 1)
+		signal := signals[0]
+		s.Equal(events.FaultTypeEndpointFlapping, signal.FaultType)
+		s.Equal(types.UID("svc-uid-web"), signal.ResourceUID)
+		s.Equal("Service", signal.Kind)
+		s.Equal("web", signal.Name)
+		s.Equal("default", signal.Namespace)
+		s.Equal(events.SeverityWarning, signal.Severity)
+		s.Equal("Service web ready endpoints went up and down 4 times within 5m0s, ready endpoint count: 2", signal.Context)
+		s.Equal(s.currentTime, signal.Timestamp)
+	})
+
+	s.Run("oscillations up to the threshold do not emit signal", func() {
+		slice := createFlappingSlice("web-abc", "web", 3)
+
+		s.Empty(s.update(slice, 2, 3, 2, 3), "three direction changes are at the threshold")
+	})
+
+	s.Run("flapping reaching zero endpoints is counted", func() {
+		slice := createFlappingSlice("web-abc", "web", 1)
+
+		s.Len(s.update(slice, 0, 1, 0, 1, 0), 1)
+	})
+
+	s.Run("counting starts over after a signal", func() {
+		slice := createFlappingSlice("web-abc", "web", 3)
+		s.Require().Len(s.update(slice, 2, 3, 2, 3, 2), 1)
+
+		slice = withReadyEndpoints(slice, 2)
+		s.Empty(s.update(slice, 3, 2, 3), "three more direction changes are at the threshold again")
+		s.Len(s.update(withReadyEndpoints(slice, 3), 2), 1)
+	})
+
+	s.Run("ready counts are summed across the Service's slices", func() {
+		sliceA := createFlappingSlice("web-a", "web", 2)
+		sliceB := createFlappingSlice("web-b", "web", 2)
+		sliceB.UID = "slice-uid-web-b"
+
+		// Neither slice changes direction more than twice, but the Service total does four times
+		s.Empty(s.update(sliceA, 1))
+		s.Empty(s.update(sliceB, 3))
+		s.Empty(s.update(withReadyEndpoints(sliceA, 1), 0))
+		s.Empty(s.update(withReadyEndpoints(sliceB, 3), 4))
+		s.Len(s.update(withReadyEndpoints(sliceA, 0), 1, 0), 1)
+	})
+
+	s.Run("different Services are tracked separately", func() {
+		web := createFlappingSlice("web-abc", "web", 3)
+		api := createFlappingSlice("api-abc", "api", 3)
+		api.UID = "slice-uid-api"
+
+		s.Empty(s.update(web, 2, 3))
+		s.Empty(s.update(api, 2, 3))
+		s.Empty(s.update(withReadyEndpoints(web, 3), 2, 3))
+		s.Empty(s.update(withReadyEndpoints(api, 3), 2, 3))
+	})
+}
+
+// TestEndpointFlappingDetector_Stable tests that steady ready endpoint counts are not reported
+func (s *EndpointFlappingDetectorSuite) TestEndpointFlappingDetector_Stable() {
+	s.Run("steady scale-up does not emit signal", func() {
+		slice := createFlappingSlice("web-abc", "web", 0)
+
+		s.Empty(s.update(slice, 1, 2, 3, 4, 5, 6, 7, 8))
+	})
+
+	s.Run("steady scale-down does not emit signal", func() {
+		slice := createFlappingSlice("web-abc", "web", 8)
+
+		s.Empty(s.update(slice, 7, 6, 5, 4, 3, 2, 1, 0))
+	})
+
+	s.Run("unchanged ready count does not emit signal", func() {
+		slice := createFlappingSlice("web-abc", "web", 3)
+
+		s.Empty(s.update(slice, 3, 3, 3, 3, 3, 3))
+	})
+
+	s.Run("direction changes older than the window are not counted", func() {
+		slice := createFlappingSlice("web-abc", "web", 3)
+
+		s.Empty(s.update(slice, 2, 3, 2))
+		s.advanceTime(6 * time.Minute)
+		slice = withReadyEndpoints(slice, 2)
+		s.Empty(s.update(slice, 3, 2, 3), "the earlier direction changes fell out of the window")
+	})
+
+	s.Run("slices added but never updated are counted", func() {
+		sliceA := createFlappingSlice("web-a", "web", 3)
+		sliceA.UID = "slice-uid-web-a"
+		sliceB := createFlappingSlice("web-b", "web", 2)
+		sliceB.UID = "slice-uid-web-b"
+		s.detector.Observe(sliceA)
+		s.detector.Observe(sliceB)
+
+		signals := s.update(sliceA, 2, 3, 2, 3, 2)
+
+		s.Require().Len(signals, 1)
+		s.Equal("4", signals[0].Details["readyEndpoints"], "web-b's ready endpoints are included")
+	})
+
+	s.Run("Services are forgotten with their last slice", func() {
+		web := createFlappingSlice("web-abc", "web", 3)
+		s.update(web, 2)
+		api := createFlappingSlice("api-abc", "api", 3)
+		api.UID = "slice-uid-api"
+		s.detector.Observe(api)
+
+		s.detector.Forget(web)
+
+		s.Len(s.detector.tracked, 1)
+		s.Contains(s.detector.tracked, serviceKey{namespace: "default", service: "api"})
+	})
+}
+
+// TestEndpointFlappingDetector_EdgeCases tests edge cases and error handling
+func (s *EndpointFlappingDetectorSuite) TestEndpointFlappingDetector_EdgeCases() {
+	s.Run("returns empty slice for nil newObj", func() {
+		signals := s.detector.Detect(createFlappingSlice("web-abc", "web", 1), nil)
+		s.Empty(signals)
+		s.NotNil(signals)
+	})
+
+	s.Run("returns empty slice for nil oldObj", func() {
+		signals := s.detector.Detect(nil, createFlappingSlice("web-abc", "web", 1))
+		s.Empty(signals, "nil oldObj means Add event, no update")
+		s.Empty(s.detector.tracked)
+	})
+
+	s.Run("returns empty slice when objects are not EndpointSlices", func() {
+		slice := createFlappingSlice("web-abc", "web", 1)
+		s.Empty(s.detector.Detect(slice, "not a slice"))
+		s.Empty(s.detector.Detect("not a slice", slice))
+		s.Empty(s.detector.tracked)
+	})
+
+	s.Run("slices without a Service owner are identified by namespace and name", func() {
+		slice := createFlappingSlice("web-abc", "web", 3)
+		slice.OwnerReferences = nil
+
+		signals := s.update(slice, 2, 3, 2, 3, 2)
+
+		s.Require().Len(signals, 1)
+		s.Equal(types.UID("default/web"), signals[0].ResourceUID)
+		s.Equal("web", signals[0].Name)
+	})
+}
+
+// TestEndpointFlappingDetector_DetectorInterface verifies EndpointFlappingDetector implements Detector
+func (s *EndpointFlappingDetectorSuite) TestEndpointFlappingDetector_DetectorInterface() {
+	s.Run("EndpointFlappingDetector implements Detector interface", func() {
+		var _ events.Detector = &EndpointFlappingDetector{}
+		var _ events.Detector = s.detector
+		var _ events.TrackingDetector = s.detector
+		s.Equal(events.FaultTypeEndpointFlapping, s.detector.FaultType())
+		s.Equal("EndpointSlice", s.detector.ResourceKind())
+	})
+}
+
+// TestEndpointFlappingDetector_Details tests the structured fields of endpoint flapping signals
+func (s *EndpointFlappingDetectorSuite) TestEndpointFlappingDetector_Details() {
+	s.Run("details match the fields in the context", func() {
+		signals := s.update(createFlappingSlice("web-abc", "web", 3), 2, 3, 2, 3, 2)
+
+		s.Require().Len(signals, 1)
+		details := signals[0].Details
+		s.Equal(map[string]string{
+			"service":        "web",
+			"reversals":      "4",
+			"window":         "5m0s",
+			"readyEndpoints": "2",
+		}, details)
+		s.Contains(signals[0].Context, "Service "+details["service"])
+		s.Contains(signals[0].Context, "went up and down "+details["reversals"]+" times within "+details["window"])
+		s.Contains(signals[0].Context, "ready endpoint count: "+details["readyEndpoints"])
+	})
+}
+
+// createFlappingSlice creates an EndpointSlice of serviceName, owned by the Service, with
+// ready ready endpoints.
+func createFlappingSlice(name, serviceName string, ready int) *discoveryv1.EndpointSlice {
+	slice := withReadyEndpoints(createEndpointSlice(name, "default", serviceName), ready)
+	slice.OwnerReferences = []metav1.OwnerReference{{
+		APIVersion: "v1",
+		Kind:       "Service",
+		Name:       serviceName,
+		UID:        types.UID("svc-uid-" + serviceName),
+	}}
+	return slice
+}
+
+// withReadyEndpoints returns a copy of slice with ready ready endpoints.
+func withReadyEndpoints(slice *discoveryv1.EndpointSlice, ready int) *discoveryv1.EndpointSlice {
+	updated := slice.DeepCopy()
+	updated.Endpoints = nil
+	for range ready {
+		updated.Endpoints = append(updated.Endpoints, discoveryv1.Endpoint{Addresses: []string{"10.0.0.1"}})
+	}
+	return updated
+}
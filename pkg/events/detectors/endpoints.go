@@ -0,0 +1,286 @@
+package detectors
+
+import (
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	discoveryv1 "k8s.io/api/discovery/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/events"
+)
+
+// serviceKey identifies a Service by namespace and name.
+type serviceKey struct {
+	namespace string
+	service   string
+}
+
+// serviceSlices is the ready endpoint count of each EndpointSlice of a Service.
+type serviceSlices struct {
+	uid    types.UID         // Service UID, from the slices' owner references
+	slices map[types.UID]int // ready endpoints of each of the Service's slices
+}
+
+// newServiceSlices creates an empty serviceSlices.
+func newServiceSlices() serviceSlices {
+	return serviceSlices{slices: make(map[types.UID]int)}
+}
+
+// readyEndpoints returns the ready endpoints of the Service across its slices.
+func (e *serviceSlices) readyEndpoints() int {
+	total := 0
+	for _, ready := range e.slices {
+		total += ready
+	}
+	return total
+}
+
+// observe records the update of one of the Service's slices, and returns the ready
+// endpoints of the Service across its slices before and after the update.
+func (e *serviceSlices) observe(oldSlice, newSlice *discoveryv1.EndpointSlice) (previous, ready int) {
+	if uid := serviceUID(newSlice); uid != "" {
+		e.uid = uid
+	}
+
+	// Slices not seen being added yet start from their old count
+	if _, known := e.slices[newSlice.UID]; !known {
+		e.slices[newSlice.UID] = countReadyEndpoints(oldSlice)
+	}
+	previous = e.readyEndpoints()
+	e.slices[newSlice.UID] = countReadyEndpoints(newSlice)
+	return previous, e.readyEndpoints()
+}
+
+// add records a slice of the Service added to the informer cache, unless the slice
+// was already seen in an update.
+func (e *serviceSlices) add(slice *discoveryv1.EndpointSlice) {
+	if uid := serviceUID(slice); uid != "" {
+		e.uid = uid
+	}
+	if _, known := e.slices[slice.UID]; !known {
+		e.slices[slice.UID] = countReadyEndpoints(slice)
+	}
+}
+
+// resourceUID returns the UID identifying the Service in fault signals: its UID from
+// the slices' owner references, or its namespace and name if no slice has one, so
+// signals of Services without owner references are still deduplicated and coalesced
+// per Service.
+func (e *serviceSlices) resourceUID(key serviceKey) types.UID {
+	if e.uid != "" {
+		return e.uid
+	}
+	return types.UID(key.namespace + "/" + key.service)
+}
+
+// remove drops a deleted slice of the Service.
+func (e *serviceSlices) remove(slice *discoveryv1.EndpointSlice) {
+	delete(e.slices, slice.UID)
+}
+
+// sliceServiceKey returns the key of the Service an EndpointSlice belongs to.
+func sliceServiceKey(slice *discoveryv1.EndpointSlice) serviceKey {
+	return serviceKey{namespace: slice.Namespace, service: getServiceName(slice)}
+}
+
+// EndpointsDetector detects when a Service loses all of its ready endpoints.
+// It watches EndpointSlices and emits a signal when the number of ready
+// endpoints across all slices of a Service (e.g. the IPv4 and IPv6 slices of a
+// dual-stack Service, or the slices of a large one) transitions from a positive
+// count to zero. Slices are grouped by their kubernetes.io/service-name label.
+// As a TrackingDetector, it counts every slice of a Service from the time it is added
+// to the informer cache, and stops counting deleted ones, so a Service is only
+// reported once none of its existing slices has a ready endpoint.
+//
+// This detector only triggers on transitions - a Service that was already
+// without ready endpoints does not produce repeated signals.
+//
+// It is safe for concurrent use.
+type EndpointsDetector struct {
+	mu      sync.Mutex
+	tracked map[serviceKey]*serviceSlices
+	now     func() time.Time // allows time injection for testing
+}
+
+// NewEndpointsDetector creates a new EndpointsDetector instance.
+func NewEndpointsDetector() *EndpointsDetector {
+	return &EndpointsDetector{
+		tracked: make(map[serviceKey]*serviceSlices),
+		now:     time.Now,
+	}
+}
+
+// FaultType returns the type of the fault signals emitted by this detector.
+func (d *EndpointsDetector) FaultType() events.FaultType {
+	return events.FaultTypeNoEndpoints
+}
+
+// ResourceKind returns the kind of resource this detector inspects.
+func (d *EndpointsDetector) ResourceKind() string {
+	return "EndpointSlice"
+}
+
+// Describe returns the fault type emitted and the resource kind inspected by this detector.
+func (d *EndpointsDetector) Describe() events.DetectorInfo {
+	return events.DetectorInfo{
+		FaultTypes:    []events.FaultType{d.FaultType()},
+		ResourceKinds: []string{d.ResourceKind()},
+		Description:   "Reports Services losing all of their ready endpoints.",
+	}
+}
+
+// Observe records an EndpointSlice added to the informer cache, so the ready endpoints
+// of its Service include the slice before it is first updated.
+func (d *EndpointsDetector) Observe(obj interface{}) {
+	slice, ok := obj.(*discoveryv1.EndpointSlice)
+	if !ok {
+		return
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.serviceLocked(sliceServiceKey(slice)).add(slice)
+}
+
+// Forget drops a deleted EndpointSlice from the ready endpoints of its Service.
+// Deleting slices doesn't emit signals, as Services are deleted with their slices.
+func (d *EndpointsDetector) Forget(obj interface{}) {
+	slice, ok := obj.(*discoveryv1.EndpointSlice)
+	if !ok {
+		return
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	key := sliceServiceKey(slice)
+	state, exists := d.tracked[key]
+	if !exists {
+		return
+	}
+	state.remove(slice)
+	if len(state.slices) == 0 {
+		delete(d.tracked, key)
+	}
+}
+
+// serviceLocked returns the tracked slices of a Service, starting to track it if needed.
+// Must be called with the lock held.
+func (d *EndpointsDetector) serviceLocked(key serviceKey) *serviceSlices {
+	state, exists := d.tracked[key]
+	if !exists {
+		slices := newServiceSlices()
+		state = &slices
+		d.tracked[key] = state
+	}
+	return state
+}
+
+// Detect analyzes EndpointSlice state changes and returns fault signals when
+// the ready endpoint count of the slice's Service, across all of its slices,
+// drops to zero.
+func (d *EndpointsDetector) Detect(oldObj, newObj interface{}) []events.FaultSignal {
+	// Handle nil newObj - nothing to detect
+	if newObj == nil {
+		return []events.FaultSignal{}
+	}
+
+	// Type assert to EndpointSlice
+	newSlice, ok := newObj.(*discoveryv1.EndpointSlice)
+	if !ok {
+		return []events.FaultSignal{}
+	}
+
+	// If oldObj is nil (Add event), no transition to detect
+	if oldObj == nil {
+		return []events.FaultSignal{}
+	}
+
+	oldSlice, ok := oldObj.(*discoveryv1.EndpointSlice)
+	if !ok {
+		return []events.FaultSignal{}
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	now := d.now()
+	serviceName := getServiceName(newSlice)
+	key := sliceServiceKey(newSlice)
+	state := d.serviceLocked(key)
+	oldReady, newReady := state.observe(oldSlice, newSlice)
+
+	// Detect transition from having ready endpoints to having none
+	if oldReady > 0 && newReady == 0 {
+		signal := events.FaultSignal{
+			FaultType:   events.FaultTypeNoEndpoints,
+			ResourceUID: state.resourceUID(key),
+			Kind:        "Service",
+			Name:        serviceName,
+			Namespace:   newSlice.Namespace,
+			Severity:    events.SeverityWarning,
+			Context:     buildNoEndpointsContext(serviceName, oldReady),
+			Details:     buildNoEndpointsDetails(serviceName, oldReady),
+			Timestamp:   now,
+		}
+
+		return []events.FaultSignal{signal}
+	}
+
+	return []events.FaultSignal{}
+}
+
+// countReadyEndpoints counts the endpoints in an EndpointSlice that are ready.
+// Per the EndpointSlice API, a nil Ready condition should be interpreted as ready.
+func countReadyEndpoints(slice *discoveryv1.EndpointSlice) int {
+	if slice == nil {
+		return 0
+	}
+
+	count := 0
+	for _, endpoint := range slice.Endpoints {
+		if endpoint.Conditions.Ready == nil || *endpoint.Conditions.Ready {
+			count++
+		}
+	}
+
+	return count
+}
+
+// getServiceName returns the name of the Service owning an EndpointSlice,
+// falling back to the slice name when the service-name label is missing.
+func getServiceName(slice *discoveryv1.EndpointSlice) string {
+	if name, ok := slice.Labels[discoveryv1.LabelServiceName]; ok && name != "" {
+		return name
+	}
+	return slice.Name
+}
+
+// serviceUID returns the UID of the Service owning an EndpointSlice, or an empty UID
+// if the slice has no Service owner reference.
+func serviceUID(slice *discoveryv1.EndpointSlice) types.UID {
+	for _, owner := range slice.OwnerReferences {
+		if owner.Kind == "Service" && owner.APIVersion == "v1" {
+			return owner.UID
+		}
+	}
+	return ""
+}
+
+// buildNoEndpointsContext creates a human-readable context string for a
+// Service that lost all of its ready endpoints.
+func buildNoEndpointsContext(serviceName string, previousCount int) string {
+	return fmt.Sprintf("Service %s has no ready endpoints, previous ready endpoint count: %d", serviceName, previousCount)
+}
+
+// buildNoEndpointsDetails returns the fields embedded in the no endpoints context.
+func buildNoEndpointsDetails(serviceName string, previousCount int) map[string]string {
+	return map[string]string{
+		"service":                serviceName,
+		"previousReadyEndpoints": strconv.Itoa(previousCount),
+	}
+}
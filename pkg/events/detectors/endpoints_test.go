@@ -0,0 +1,311 @@
+package detectors
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/events"
+)
+
+// EndpointsDetectorSuite contains tests for EndpointsDetector
+type EndpointsDetectorSuite struct {
+	suite.Suite
+	detector *EndpointsDetector
+}
+
+func TestEndpointsDetectorSuite(t *testing.T) {
+	suite.Run(t, new(EndpointsDetectorSuite))
+}
+
+// SetupTest runs before each test
+func (s *EndpointsDetectorSuite) SetupTest() {
+	s.detector = NewEndpointsDetector()
+}
+
+// SetupSubTest resets detector state between subtests
+func (s *EndpointsDetectorSuite) SetupSubTest() {
+	s.SetupTest()
+}
+
+// TestEndpointsDetector_ReadyTransitions tests detection of ready endpoint count transitions
+func (s *EndpointsDetectorSuite) TestEndpointsDetector_ReadyTransitions() {
+	s.Run("transition from ready endpoints to zero emits signal", func() {
+		oldSlice := createFlappingSlice("web-abc12", "web", 2)
+		newSlice := withReadyEndpoints(oldSlice, 0)
+
+		signals := s.detector.Detect(oldSlice, newSlice)
+
+		s.Require().Len(signals, 1)
+		signal := signals[0]
+
+		s.Equal(events.FaultTypeNoEndpoints, signal.FaultType)
+		s.Equal(types.UID("svc-uid-web"), signal.ResourceUID)
+		s.Equal("Service", signal.Kind)
+		s.Equal("web", signal.Name)
+		s.Equal("default", signal.Namespace)
+		s.Equal(events.SeverityWarning, signal.Severity)
+		s.Contains(signal.Context, "Service web has no ready endpoints")
+		s.Contains(signal.Context, "previous ready endpoint count: 2")
+		s.False(signal.Timestamp.IsZero())
+	})
+
+	s.Run("transition to empty endpoint list emits signal", func() {
+		oldSlice := createEndpointSlice("web-abc12", "default", "web", true)
+		newSlice := createEndpointSlice("web-abc12", "default", "web")
+
+		signals := s.detector.Detect(oldSlice, newSlice)
+
+		s.Require().Len(signals, 1)
+		s.Contains(signals[0].Context, "previous ready endpoint count: 1")
+	})
+
+	s.Run("losing some but not all ready endpoints does not emit signal", func() {
+		oldSlice := createEndpointSlice("web-abc12", "default", "web", true, true)
+		newSlice := createEndpointSlice("web-abc12", "default", "web", true, false)
+
+		signals := s.detector.Detect(oldSlice, newSlice)
+
+		s.Empty(signals, "service still has a ready endpoint")
+	})
+
+	s.Run("already empty slice does not emit repeated signal", func() {
+		oldSlice := createEndpointSlice("web-abc12", "default", "web", false)
+		newSlice := createEndpointSlice("web-abc12", "default", "web", false, false)
+
+		signals := s.detector.Detect(oldSlice, newSlice)
+
+		s.Empty(signals, "no transition should not emit signal")
+	})
+
+	s.Run("recovery to ready endpoints does not emit signal", func() {
+		oldSlice := createEndpointSlice("web-abc12", "default", "web", false)
+		newSlice := createEndpointSlice("web-abc12", "default", "web", true)
+
+		signals := s.detector.Detect(oldSlice, newSlice)
+
+		s.Empty(signals, "recovery should not emit signal")
+	})
+
+	s.Run("nil Ready condition is treated as ready", func() {
+		oldSlice := createEndpointSlice("web-abc12", "default", "web")
+		oldSlice.Endpoints = []discoveryv1.Endpoint{{Addresses: []string{"10.0.0.1"}}}
+		newSlice := createEndpointSlice("web-abc12", "default", "web", false)
+
+		signals := s.detector.Detect(oldSlice, newSlice)
+
+		s.Require().Len(signals, 1)
+		s.Contains(signals[0].Context, "previous ready endpoint count: 1")
+	})
+}
+
+// TestEndpointsDetector_MultipleSlices tests that ready endpoints are counted across the slices of a Service
+func (s *EndpointsDetectorSuite) TestEndpointsDetector_MultipleSlices() {
+	s.Run("one of two slices emptying does not emit signal", func() {
+		sliceA := createFlappingSlice("web-a", "web", 2)
+		sliceA.UID = "slice-uid-web-a"
+		sliceB := createFlappingSlice("web-b", "web", 3)
+		sliceB.UID = "slice-uid-web-b"
+		s.Empty(s.detector.Detect(sliceB, sliceB), "resync of the other slice")
+
+		signals := s.detector.Detect(sliceA, withReadyEndpoints(sliceA, 0))
+
+		s.Empty(signals, "service still has ready endpoints in its other slice")
+	})
+
+	s.Run("last slice with ready endpoints emptying emits signal", func() {
+		sliceA := createFlappingSlice("web-a", "web", 2)
+		sliceA.UID = "slice-uid-web-a"
+		sliceB := createFlappingSlice("web-b", "web", 3)
+		sliceB.UID = "slice-uid-web-b"
+		s.Empty(s.detector.Detect(sliceB, sliceB), "resync of the other slice")
+		s.Empty(s.detector.Detect(sliceA, withReadyEndpoints(sliceA, 0)))
+
+		signals := s.detector.Detect(sliceB, withReadyEndpoints(sliceB, 0))
+
+		s.Require().Len(signals, 1)
+		s.Equal("Service", signals[0].Kind)
+		s.Equal("web", signals[0].Name)
+		s.Contains(signals[0].Context, "previous ready endpoint count: 3")
+	})
+
+	s.Run("dual-stack Service is reported once", func() {
+		ipv4 := createFlappingSlice("web-ipv4", "web", 2)
+		ipv4.UID = "slice-uid-web-ipv4"
+		ipv6 := createFlappingSlice("web-ipv6", "web", 2)
+		ipv6.UID = "slice-uid-web-ipv6"
+		ipv6.AddressType = discoveryv1.AddressTypeIPv6
+		s.Empty(s.detector.Detect(ipv6, ipv6), "resync of the IPv6 slice")
+
+		s.Empty(s.detector.Detect(ipv4, withReadyEndpoints(ipv4, 0)), "IPv6 endpoints are still ready")
+		signals := s.detector.Detect(ipv6, withReadyEndpoints(ipv6, 0))
+
+		s.Require().Len(signals, 1)
+		s.Equal(types.UID("svc-uid-web"), signals[0].ResourceUID)
+		s.Contains(signals[0].Context, "previous ready endpoint count: 2")
+	})
+
+	s.Run("slices of different Services are counted separately", func() {
+		web := createFlappingSlice("web-a", "web", 2)
+		web.UID = "slice-uid-web"
+		api := createFlappingSlice("api-a", "api", 2)
+		api.UID = "slice-uid-api"
+		s.Empty(s.detector.Detect(api, api))
+
+		s.Len(s.detector.Detect(web, withReadyEndpoints(web, 0)), 1)
+	})
+
+	s.Run("slice added but never updated keeps the Service ready", func() {
+		sliceA := createFlappingSlice("web-a", "web", 2)
+		sliceA.UID = "slice-uid-web-a"
+		sliceB := createFlappingSlice("web-b", "web", 3)
+		sliceB.UID = "slice-uid-web-b"
+		s.detector.Observe(sliceA)
+		s.detector.Observe(sliceB)
+
+		signals := s.detector.Detect(sliceA, withReadyEndpoints(sliceA, 0))
+
+		s.Empty(signals, "the never updated slice still has ready endpoints")
+	})
+
+	s.Run("deleted slices no longer count", func() {
+		sliceA := createFlappingSlice("web-a", "web", 2)
+		sliceA.UID = "slice-uid-web-a"
+		sliceB := createFlappingSlice("web-b", "web", 3)
+		sliceB.UID = "slice-uid-web-b"
+		s.detector.Observe(sliceA)
+		s.detector.Observe(sliceB)
+		s.detector.Forget(sliceB)
+
+		signals := s.detector.Detect(sliceA, withReadyEndpoints(sliceA, 0))
+
+		s.Require().Len(signals, 1)
+		s.Contains(signals[0].Context, "previous ready endpoint count: 2")
+	})
+
+	s.Run("Services are forgotten with their last slice", func() {
+		sliceA := createFlappingSlice("web-a", "web", 2)
+		s.detector.Observe(sliceA)
+		s.Len(s.detector.tracked, 1)
+
+		s.detector.Forget(sliceA)
+
+		s.Empty(s.detector.tracked)
+	})
+
+	s.Run("ignores objects that are not EndpointSlices", func() {
+		s.detector.Observe("not an EndpointSlice")
+		s.detector.Forget("not an EndpointSlice")
+
+		s.Empty(s.detector.tracked)
+	})
+}
+
+// TestEndpointsDetector_EdgeCases tests edge cases and error handling
+func (s *EndpointsDetectorSuite) TestEndpointsDetector_EdgeCases() {
+	s.Run("returns empty slice for nil newObj", func() {
+		oldSlice := createEndpointSlice("web-abc12", "default", "web", true)
+
+		signals := s.detector.Detect(oldSlice, nil)
+
+		s.Empty(signals)
+		s.NotNil(signals)
+	})
+
+	s.Run("returns empty slice for nil oldObj", func() {
+		newSlice := createEndpointSlice("web-abc12", "default", "web", false)
+
+		signals := s.detector.Detect(nil, newSlice)
+
+		s.Empty(signals, "nil oldObj means Add event, no transition to detect")
+	})
+
+	s.Run("returns empty slice when newObj is not an EndpointSlice", func() {
+		oldSlice := createEndpointSlice("web-abc12", "default", "web", true)
+
+		signals := s.detector.Detect(oldSlice, "not an EndpointSlice")
+
+		s.Empty(signals)
+	})
+
+	s.Run("returns empty slice when oldObj is not an EndpointSlice", func() {
+		newSlice := createEndpointSlice("web-abc12", "default", "web", false)
+
+		signals := s.detector.Detect("not an EndpointSlice", newSlice)
+
+		s.Empty(signals)
+	})
+
+	s.Run("falls back to slice name when service-name label is missing", func() {
+		oldSlice := createEndpointSlice("orphan-slice", "default", "", true)
+		newSlice := createEndpointSlice("orphan-slice", "default", "", false)
+
+		signals := s.detector.Detect(oldSlice, newSlice)
+
+		s.Require().Len(signals, 1)
+		s.Contains(signals[0].Context, "Service orphan-slice has no ready endpoints")
+		s.Equal(types.UID("default/orphan-slice"), signals[0].ResourceUID)
+	})
+
+	s.Run("Services without owner references are identified by namespace and name", func() {
+		oldSlice := createEndpointSlice("web-abc12", "default", "web", true)
+		newSlice := createEndpointSlice("web-abc12", "default", "web", false)
+
+		signals := s.detector.Detect(oldSlice, newSlice)
+
+		s.Require().Len(signals, 1)
+		s.Equal(types.UID("default/web"), signals[0].ResourceUID)
+	})
+}
+
+// TestEndpointsDetector_DetectorInterface verifies EndpointsDetector implements Detector
+func (s *EndpointsDetectorSuite) TestEndpointsDetector_DetectorInterface() {
+	s.Run("EndpointsDetector implements Detector interface", func() {
+		var _ events.Detector = &EndpointsDetector{}
+		var _ events.Detector = s.detector
+		var _ events.TrackingDetector = s.detector
+	})
+}
+
+// TestEndpointsDetector_Details tests the structured fields of no endpoints signals
+func (s *EndpointsDetectorSuite) TestEndpointsDetector_Details() {
+	s.Run("details match the fields in the context", func() {
+		oldSlice := createEndpointSlice("web-abc12", "default", "web", true, true)
+		newSlice := createEndpointSlice("web-abc12", "default", "web", false, false)
+
+		signals := s.detector.Detect(oldSlice, newSlice)
+
+		s.Require().Len(signals, 1)
+		details := signals[0].Details
+		s.Equal(map[string]string{"service": "web", "previousReadyEndpoints": "2"}, details)
+		s.Contains(signals[0].Context, "Service "+details["service"]+" has no ready endpoints")
+		s.Contains(signals[0].Context, "previous ready endpoint count: "+details["previousReadyEndpoints"])
+	})
+}
+
+// createEndpointSlice creates an EndpointSlice with one endpoint per ready value.
+func createEndpointSlice(name, namespace, serviceName string, ready ...bool) *discoveryv1.EndpointSlice {
+	slice := &discoveryv1.EndpointSlice{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+			UID:       "slice-uid-123",
+			Labels:    map[string]string{},
+		},
+		AddressType: discoveryv1.AddressTypeIPv4,
+	}
+	if serviceName != "" {
+		slice.Labels[discoveryv1.LabelServiceName] = serviceName
+	}
+	for _, r := range ready {
+		slice.Endpoints = append(slice.Endpoints, discoveryv1.Endpoint{
+			Addresses:  []string{"10.0.0.1"},
+			Conditions: discoveryv1.EndpointConditions{Ready: ptr.To(r)},
+		})
+	}
+	return slice
+}
@@ -0,0 +1,165 @@
+package detectors
+
+import (
+	"fmt"
+	"time"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/events"
+)
+
+// GenericConditionDetector detects when a status condition of an arbitrary resource,
+// typically a custom resource, changes to a configured "bad" status.
+// This is an edge-triggered detector that emits signals when the condition transitions
+// INTO the bad status (e.g., Ready becoming "False"). A condition that was missing
+// before counts as a transition.
+//
+// It implements events.DynamicDetector: ResourceWatcher watches the configured GVR
+// through a dynamic informer and passes *unstructured.Unstructured objects to Detect.
+type GenericConditionDetector struct {
+	gvr           schema.GroupVersionResource
+	conditionType string
+	badStatus     string
+}
+
+// NewGenericConditionDetector creates a GenericConditionDetector watching gvr that
+// emits a signal when the condition of type conditionType changes to badStatus.
+func NewGenericConditionDetector(gvr schema.GroupVersionResource, conditionType, badStatus string) *GenericConditionDetector {
+	return &GenericConditionDetector{
+		gvr:           gvr,
+		conditionType: conditionType,
+		badStatus:     badStatus,
+	}
+}
+
+// GVR returns the resource watched by this detector.
+func (d *GenericConditionDetector) GVR() schema.GroupVersionResource {
+	return d.gvr
+}
+
+// Describe returns the fault type emitted and the resource watched by this detector.
+func (d *GenericConditionDetector) Describe() events.DetectorInfo {
+	return events.DetectorInfo{
+		FaultTypes:    []events.FaultType{events.FaultTypeCustom},
+		ResourceKinds: []string{d.gvr.GroupResource().String()},
+		Description:   fmt.Sprintf("Reports %s whose %s condition changes to %s.", d.gvr.GroupResource(), d.conditionType, d.badStatus),
+	}
+}
+
+// Detect analyzes resource state changes and returns fault signals when the
+// configured condition transitions to the bad status. It compares the condition
+// in the status.conditions of oldObj and newObj.
+func (d *GenericConditionDetector) Detect(oldObj, newObj interface{}) []events.FaultSignal {
+	// Handle nil newObj - nothing to detect
+	if newObj == nil {
+		return []events.FaultSignal{}
+	}
+
+	// Type assert to Unstructured
+	newResource, ok := newObj.(*unstructured.Unstructured)
+	if !ok {
+		return []events.FaultSignal{}
+	}
+
+	// If oldObj is nil (Add event), don't emit signal (edge-triggered)
+	if oldObj == nil {
+		return []events.FaultSignal{}
+	}
+
+	oldResource, ok := oldObj.(*unstructured.Unstructured)
+	if !ok {
+		return []events.FaultSignal{}
+	}
+
+	newCondition, found := findUnstructuredCondition(newResource, d.conditionType)
+	if !found || newCondition.status != d.badStatus {
+		return []events.FaultSignal{}
+	}
+
+	// Already in the bad status, no transition
+	if oldCondition, found := findUnstructuredCondition(oldResource, d.conditionType); found && oldCondition.status == d.badStatus {
+		return []events.FaultSignal{}
+	}
+
+	signal := events.FaultSignal{
+		FaultType:   events.FaultTypeCustom,
+		ResourceUID: newResource.GetUID(),
+		APIVersion:  newResource.GetAPIVersion(),
+		Kind:        newResource.GetKind(),
+		Name:        newResource.GetName(),
+		Namespace:   newResource.GetNamespace(),
+		Severity:    events.SeverityWarning,
+		Context:     buildGenericConditionContext(newResource.GroupVersionKind(), d.conditionType, newCondition),
+		Details:     buildGenericConditionDetails(d.conditionType, newCondition),
+		Timestamp:   time.Now(),
+	}
+
+	return []events.FaultSignal{signal}
+}
+
+// unstructuredCondition holds the fields of a status condition read from an unstructured object.
+type unstructuredCondition struct {
+	status  string
+	reason  string
+	message string
+}
+
+// findUnstructuredCondition finds a condition by type in the object's status.conditions.
+// Returns false if the object has no conditions or none of the given type.
+func findUnstructuredCondition(obj *unstructured.Unstructured, conditionType string) (unstructuredCondition, bool) {
+	conditions, found, err := unstructured.NestedSlice(obj.Object, "status", "conditions")
+	if err != nil || !found {
+		return unstructuredCondition{}, false
+	}
+
+	for _, c := range conditions {
+		condition, ok := c.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if t, _, _ := unstructured.NestedString(condition, "type"); t != conditionType {
+			continue
+		}
+		status, _, _ := unstructured.NestedString(condition, "status")
+		reason, _, _ := unstructured.NestedString(condition, "reason")
+		message, _, _ := unstructured.NestedString(condition, "message")
+		return unstructuredCondition{status: status, reason: reason, message: message}, true
+	}
+
+	return unstructuredCondition{}, false
+}
+
+// buildGenericConditionContext creates a human-readable context string for a condition transition.
+func buildGenericConditionContext(gvk schema.GroupVersionKind, conditionType string, condition unstructuredCondition) string {
+	context := fmt.Sprintf("%s (%s) condition %s changed to %s", gvk.Kind, gvk.GroupVersion().String(), conditionType, condition.status)
+
+	if condition.reason != "" {
+		context += fmt.Sprintf(", reason: %s", condition.reason)
+	}
+
+	if condition.message != "" {
+		context += fmt.Sprintf(", message: %s", condition.message)
+	}
+
+	return context
+}
+
+// buildGenericConditionDetails returns the fields embedded in the condition transition context.
+func buildGenericConditionDetails(conditionType string, condition unstructuredCondition) map[string]string {
+	details := map[string]string{
+		"conditionType": conditionType,
+		"status":        condition.status,
+	}
+
+	if condition.reason != "" {
+		details["reason"] = condition.reason
+	}
+
+	if condition.message != "" {
+		details["message"] = condition.message
+	}
+
+	return details
+}
@@ -0,0 +1,227 @@
+package detectors
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/events"
+)
+
+var widgetGVR = schema.GroupVersionResource{Group: "example.com", Version: "v1", Resource: "widgets"}
+
+// GenericConditionDetectorSuite contains tests for GenericConditionDetector
+type GenericConditionDetectorSuite struct {
+	suite.Suite
+	detector *GenericConditionDetector
+}
+
+func TestGenericConditionDetectorSuite(t *testing.T) {
+	suite.Run(t, new(GenericConditionDetectorSuite))
+}
+
+// SetupTest runs before each test
+func (s *GenericConditionDetectorSuite) SetupTest() {
+	s.detector = NewGenericConditionDetector(widgetGVR, "Ready", "False")
+}
+
+// TestGenericConditionDetector_GVR tests that the detector reports its configured resource
+func (s *GenericConditionDetectorSuite) TestGenericConditionDetector_GVR() {
+	s.Equal(widgetGVR, s.detector.GVR())
+
+	var _ events.DynamicDetector = s.detector
+}
+
+// TestGenericConditionDetector_Describe tests that the detector describes its configured resource and condition
+func (s *GenericConditionDetectorSuite) TestGenericConditionDetector_Describe() {
+	info := s.detector.Describe()
+	s.Equal([]events.FaultType{events.FaultTypeCustom}, info.FaultTypes)
+	s.Equal([]string{widgetGVR.GroupResource().String()}, info.ResourceKinds)
+	s.Equal("Reports widgets.example.com whose Ready condition changes to False.", info.Description)
+}
+
+// TestGenericConditionDetector_Transitions tests detection of condition transitions to the bad status
+func (s *GenericConditionDetectorSuite) TestGenericConditionDetector_Transitions() {
+	s.Run("transition to bad status emits signal", func() {
+		oldWidget := createWidget(map[string]interface{}{"type": "Ready", "status": "True"})
+		newWidget := createWidget(map[string]interface{}{
+			"type":    "Ready",
+			"status":  "False",
+			"reason":  "BackendUnavailable",
+			"message": "backend pool has no healthy members",
+		})
+
+		signals := s.detector.Detect(oldWidget, newWidget)
+
+		s.Require().Len(signals, 1, "expected one fault signal for condition transition")
+		signal := signals[0]
+
+		s.Equal(events.FaultTypeCustom, signal.FaultType)
+		s.Equal(types.UID("widget-uid"), signal.ResourceUID)
+		s.Equal("example.com/v1", signal.APIVersion)
+		s.Equal("Widget", signal.Kind)
+		s.Equal("widget-1", signal.Name)
+		s.Equal("default", signal.Namespace)
+		s.Equal(events.SeverityWarning, signal.Severity)
+		s.Contains(signal.Context, "Widget (example.com/v1) condition Ready changed to False")
+		s.Contains(signal.Context, "reason: BackendUnavailable")
+		s.Contains(signal.Context, "message: backend pool has no healthy members")
+		s.False(signal.Timestamp.IsZero())
+	})
+
+	s.Run("condition appearing with bad status emits signal", func() {
+		oldWidget := createWidget()
+		newWidget := createWidget(map[string]interface{}{"type": "Ready", "status": "False"})
+
+		signals := s.detector.Detect(oldWidget, newWidget)
+
+		s.Require().Len(signals, 1)
+		s.Equal("Widget (example.com/v1) condition Ready changed to False", signals[0].Context)
+	})
+
+	s.Run("condition already in bad status does not emit signal", func() {
+		oldWidget := createWidget(map[string]interface{}{"type": "Ready", "status": "False"})
+		newWidget := createWidget(map[string]interface{}{"type": "Ready", "status": "False", "reason": "StillBroken"})
+
+		signals := s.detector.Detect(oldWidget, newWidget)
+
+		s.Empty(signals, "expected no signal when condition was already in the bad status")
+	})
+
+	s.Run("recovery from bad status does not emit signal", func() {
+		oldWidget := createWidget(map[string]interface{}{"type": "Ready", "status": "False"})
+		newWidget := createWidget(map[string]interface{}{"type": "Ready", "status": "True"})
+
+		signals := s.detector.Detect(oldWidget, newWidget)
+
+		s.Empty(signals)
+	})
+
+	s.Run("other condition types are ignored", func() {
+		oldWidget := createWidget(map[string]interface{}{"type": "Synced", "status": "True"})
+		newWidget := createWidget(map[string]interface{}{"type": "Synced", "status": "False"})
+
+		signals := s.detector.Detect(oldWidget, newWidget)
+
+		s.Empty(signals)
+	})
+
+	s.Run("configured bad status is respected", func() {
+		detector := NewGenericConditionDetector(widgetGVR, "Degraded", "True")
+		oldWidget := createWidget(map[string]interface{}{"type": "Degraded", "status": "False"})
+		newWidget := createWidget(map[string]interface{}{"type": "Degraded", "status": "True"})
+
+		s.Len(detector.Detect(oldWidget, newWidget), 1)
+		s.Empty(detector.Detect(newWidget, oldWidget))
+	})
+}
+
+// TestGenericConditionDetector_EdgeCases tests edge cases and error handling
+func (s *GenericConditionDetectorSuite) TestGenericConditionDetector_EdgeCases() {
+	s.Run("nil old object does not emit signal", func() {
+		newWidget := createWidget(map[string]interface{}{"type": "Ready", "status": "False"})
+
+		signals := s.detector.Detect(nil, newWidget)
+
+		s.NotNil(signals)
+		s.Empty(signals, "expected no signals for Add event")
+	})
+
+	s.Run("nil new object does not emit signal", func() {
+		oldWidget := createWidget(map[string]interface{}{"type": "Ready", "status": "True"})
+
+		signals := s.detector.Detect(oldWidget, nil)
+
+		s.NotNil(signals)
+		s.Empty(signals)
+	})
+
+	s.Run("non-unstructured objects do not emit signal", func() {
+		widget := createWidget(map[string]interface{}{"type": "Ready", "status": "False"})
+
+		s.Empty(s.detector.Detect(&corev1.Pod{}, widget))
+		s.Empty(s.detector.Detect(widget, &corev1.Pod{}))
+	})
+
+	s.Run("resource without status does not emit signal", func() {
+		oldWidget := createWidget(map[string]interface{}{"type": "Ready", "status": "True"})
+		newWidget := createWidget()
+		delete(newWidget.Object, "status")
+
+		signals := s.detector.Detect(oldWidget, newWidget)
+
+		s.Empty(signals)
+	})
+
+	s.Run("malformed conditions do not emit signal", func() {
+		oldWidget := createWidget(map[string]interface{}{"type": "Ready", "status": "True"})
+		newWidget := createWidget()
+		newWidget.Object["status"] = map[string]interface{}{"conditions": "not-a-list"}
+
+		signals := s.detector.Detect(oldWidget, newWidget)
+
+		s.Empty(signals)
+	})
+}
+
+// TestGenericConditionDetector_Details tests the structured fields of condition transition signals
+func (s *GenericConditionDetectorSuite) TestGenericConditionDetector_Details() {
+	s.Run("details match the fields in the context", func() {
+		oldWidget := createWidget(map[string]interface{}{"type": "Ready", "status": "True"})
+		newWidget := createWidget(map[string]interface{}{
+			"type":    "Ready",
+			"status":  "False",
+			"reason":  "BackendUnavailable",
+			"message": "backend pool has no healthy members",
+		})
+
+		signals := s.detector.Detect(oldWidget, newWidget)
+
+		s.Require().Len(signals, 1)
+		details := signals[0].Details
+		s.Equal(map[string]string{
+			"conditionType": "Ready",
+			"status":        "False",
+			"reason":        "BackendUnavailable",
+			"message":       "backend pool has no healthy members",
+		}, details)
+		s.Contains(signals[0].Context, "condition "+details["conditionType"]+" changed to "+details["status"])
+		s.Contains(signals[0].Context, "reason: "+details["reason"])
+		s.Contains(signals[0].Context, "message: "+details["message"])
+	})
+
+	s.Run("empty reason and message are omitted", func() {
+		oldWidget := createWidget(map[string]interface{}{"type": "Ready", "status": "True"})
+		newWidget := createWidget(map[string]interface{}{"type": "Ready", "status": "False"})
+
+		signals := s.detector.Detect(oldWidget, newWidget)
+
+		s.Require().Len(signals, 1)
+		s.Equal(map[string]string{"conditionType": "Ready", "status": "False"}, signals[0].Details)
+	})
+}
+
+// createWidget creates a sample custom resource with the given status conditions
+func createWidget(conditions ...map[string]interface{}) *unstructured.Unstructured {
+	statusConditions := make([]interface{}, 0, len(conditions))
+	for _, condition := range conditions {
+		statusConditions = append(statusConditions, condition)
+	}
+
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "example.com/v1",
+		"kind":       "Widget",
+		"metadata": map[string]interface{}{
+			"name":      "widget-1",
+			"namespace": "default",
+			"uid":       "widget-uid",
+		},
+		"status": map[string]interface{}{
+			"conditions": statusConditions,
+		},
+	}}
+}
@@ -0,0 +1,165 @@
+package detectors
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/events"
+)
+
+// InitContainerFailureDetector detects init containers exiting with a non-zero exit code.
+// A failing init container keeps the pod from starting its app containers, which
+// PodCrashDetector doesn't see as it only inspects Status.ContainerStatuses.
+// A failure is detected when an init container in Status.InitContainerStatuses:
+// 1. Transitions into Terminated state with a non-zero exit code, or
+// 2. Restarts (RestartCount increases) after terminating with a non-zero exit code
+type InitContainerFailureDetector struct{}
+
+// NewInitContainerFailureDetector creates a new InitContainerFailureDetector instance.
+func NewInitContainerFailureDetector() *InitContainerFailureDetector {
+	return &InitContainerFailureDetector{}
+}
+
+// FaultType returns the type of the fault signals emitted by this detector.
+func (d *InitContainerFailureDetector) FaultType() events.FaultType {
+	return events.FaultTypeInitContainerFailure
+}
+
+// ResourceKind returns the kind of resource this detector inspects.
+func (d *InitContainerFailureDetector) ResourceKind() string {
+	return "Pod"
+}
+
+// Describe returns the fault type emitted and the resource kind inspected by this detector.
+func (d *InitContainerFailureDetector) Describe() events.DetectorInfo {
+	return events.DetectorInfo{
+		FaultTypes:    []events.FaultType{d.FaultType()},
+		ResourceKinds: []string{d.ResourceKind()},
+		Description:   "Reports init containers exiting with a non-zero exit code, blocking pod startup.",
+	}
+}
+
+// Detect analyzes pod state changes and returns fault signals for init containers
+// that failed since oldObj.
+func (d *InitContainerFailureDetector) Detect(oldObj, newObj interface{}) []events.FaultSignal {
+	// Handle nil newObj - nothing to detect
+	if newObj == nil {
+		return []events.FaultSignal{}
+	}
+
+	// Type assert to Pod
+	newPod, ok := newObj.(*corev1.Pod)
+	if !ok {
+		return []events.FaultSignal{}
+	}
+
+	// If oldObj is nil (Add event), no transition to detect
+	if oldObj == nil {
+		return []events.FaultSignal{}
+	}
+
+	oldPod, ok := oldObj.(*corev1.Pod)
+	if !ok {
+		return []events.FaultSignal{}
+	}
+
+	signals := []events.FaultSignal{}
+
+	// Create a map of old init container statuses by container name for easy lookup
+	oldStatusMap := make(map[string]corev1.ContainerStatus)
+	for _, status := range oldPod.Status.InitContainerStatuses {
+		oldStatusMap[status.Name] = status
+	}
+
+	for _, newStatus := range newPod.Status.InitContainerStatuses {
+		oldStatus, exists := oldStatusMap[newStatus.Name]
+
+		// Skip if container didn't exist before
+		if !exists {
+			continue
+		}
+
+		terminated := initContainerFailure(oldStatus, newStatus)
+		if terminated == nil {
+			continue
+		}
+
+		signal := events.FaultSignal{
+			FaultType:     events.FaultTypeInitContainerFailure,
+			ResourceUID:   types.UID(newPod.UID),
+			Kind:          "Pod",
+			Name:          newPod.Name,
+			Namespace:     newPod.Namespace,
+			ContainerName: newStatus.Name,
+			Severity:      events.SeverityWarning,
+			Context:       buildInitContainerFailureContext(terminated),
+			Details:       buildInitContainerFailureDetails(terminated, newStatus.RestartCount),
+			Timestamp:     time.Now(),
+		}
+
+		signals = append(signals, signal)
+	}
+
+	return signals
+}
+
+// initContainerFailure returns the termination state of an init container that failed
+// between oldStatus and newStatus. Init containers stay Terminated once the pod gives
+// up on them, so the transition into the state is the failure; when they are retried,
+// the failure is in the last termination state of the restarted container.
+// Returns nil if the init container didn't fail.
+func initContainerFailure(oldStatus, newStatus corev1.ContainerStatus) *corev1.ContainerStateTerminated {
+	restarted := newStatus.RestartCount > oldStatus.RestartCount
+
+	if terminated := newStatus.State.Terminated; terminated != nil && terminated.ExitCode != 0 {
+		if restarted || oldStatus.State.Terminated == nil {
+			return terminated
+		}
+		return nil
+	}
+
+	if terminated := newStatus.LastTerminationState.Terminated; restarted && terminated != nil && terminated.ExitCode != 0 {
+		return terminated
+	}
+
+	return nil
+}
+
+// buildInitContainerFailureContext creates a human-readable context string from the
+// init container's termination info.
+func buildInitContainerFailureContext(terminated *corev1.ContainerStateTerminated) string {
+	context := fmt.Sprintf("Init container failed with exit code %d, pod startup is blocked", terminated.ExitCode)
+
+	if terminated.Reason != "" {
+		context += fmt.Sprintf(", reason: %s", terminated.Reason)
+	}
+
+	if terminated.Message != "" {
+		context += fmt.Sprintf(", message: %s", terminated.Message)
+	}
+
+	return context
+}
+
+// buildInitContainerFailureDetails returns the fields embedded in the init container
+// failure context, plus the init container's restart count.
+func buildInitContainerFailureDetails(terminated *corev1.ContainerStateTerminated, restartCount int32) map[string]string {
+	details := map[string]string{
+		"exitCode":     strconv.Itoa(int(terminated.ExitCode)),
+		"restartCount": strconv.Itoa(int(restartCount)),
+	}
+
+	if terminated.Reason != "" {
+		details["reason"] = terminated.Reason
+	}
+
+	if terminated.Message != "" {
+		details["message"] = terminated.Message
+	}
+
+	return details
+}
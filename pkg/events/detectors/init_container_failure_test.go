@@ -0,0 +1,201 @@
+package detectors
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/events"
+)
+
+// InitContainerFailureDetectorSuite contains tests for InitContainerFailureDetector
+type InitContainerFailureDetectorSuite struct {
+	suite.Suite
+	detector *InitContainerFailureDetector
+}
+
+func TestInitContainerFailureDetectorSuite(t *testing.T) {
+	suite.Run(t, new(InitContainerFailureDetectorSuite))
+}
+
+// SetupTest runs before each test
+func (s *InitContainerFailureDetectorSuite) SetupTest() {
+	s.detector = NewInitContainerFailureDetector()
+}
+
+// TestInitContainerFailureDetector_Failures tests detection of failing init containers
+func (s *InitContainerFailureDetectorSuite) TestInitContainerFailureDetector_Failures() {
+	s.Run("init container terminating with non-zero exit code emits signal", func() {
+		oldPod := createPodWithInitContainerStatus("test-pod", "init-db", 0, corev1.ContainerState{
+			Running: &corev1.ContainerStateRunning{},
+		})
+		newPod := createPodWithInitContainerStatus("test-pod", "init-db", 0, corev1.ContainerState{
+			Terminated: &corev1.ContainerStateTerminated{ExitCode: 1, Reason: "Error", Message: "database unreachable"},
+		})
+
+		signals := s.detector.Detect(oldPod, newPod)
+
+		s.Require().Len(signals, 1, "expected one fault signal for a failed init container")
+		signal := signals[0]
+		s.Equal(events.FaultTypeInitContainerFailure, signal.FaultType)
+		s.Equal(types.UID(newPod.UID), signal.ResourceUID)
+		s.Equal("Pod", signal.Kind)
+		s.Equal("test-pod", signal.Name)
+		s.Equal("default", signal.Namespace)
+		s.Equal("init-db", signal.ContainerName)
+		s.Equal(events.SeverityWarning, signal.Severity)
+		s.Equal("Init container failed with exit code 1, pod startup is blocked, reason: Error, message: database unreachable", signal.Context)
+		s.Equal(map[string]string{
+			"exitCode":     "1",
+			"restartCount": "0",
+			"reason":       "Error",
+			"message":      "database unreachable",
+		}, signal.Details)
+		s.False(signal.Timestamp.IsZero())
+	})
+
+	s.Run("restarted init container that failed emits signal", func() {
+		oldPod := createPodWithInitContainerStatus("test-pod", "init-db", 1, corev1.ContainerState{
+			Running: &corev1.ContainerStateRunning{},
+		})
+		newPod := createPodWithInitContainerStatus("test-pod", "init-db", 2, corev1.ContainerState{
+			Waiting: &corev1.ContainerStateWaiting{Reason: "CrashLoopBackOff"},
+		})
+		newPod.Status.InitContainerStatuses[0].LastTerminationState = corev1.ContainerState{
+			Terminated: &corev1.ContainerStateTerminated{ExitCode: 2},
+		}
+
+		signals := s.detector.Detect(oldPod, newPod)
+
+		s.Require().Len(signals, 1, "expected one fault signal for a restarted init container")
+		s.Equal("init-db", signals[0].ContainerName)
+		s.Equal(map[string]string{"exitCode": "2", "restartCount": "2"}, signals[0].Details)
+	})
+
+	s.Run("succeeding init container does not emit signal", func() {
+		oldPod := createPodWithInitContainerStatus("test-pod", "init-db", 0, corev1.ContainerState{
+			Running: &corev1.ContainerStateRunning{},
+		})
+		newPod := createPodWithInitContainerStatus("test-pod", "init-db", 0, corev1.ContainerState{
+			Terminated: &corev1.ContainerStateTerminated{ExitCode: 0, Reason: "Completed"},
+		})
+
+		s.Empty(s.detector.Detect(oldPod, newPod))
+	})
+
+	s.Run("init container staying failed does not emit signal", func() {
+		failed := corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{ExitCode: 1, Reason: "Error"}}
+		oldPod := createPodWithInitContainerStatus("test-pod", "init-db", 0, failed)
+		newPod := createPodWithInitContainerStatus("test-pod", "init-db", 0, failed)
+
+		s.Empty(s.detector.Detect(oldPod, newPod))
+	})
+
+	s.Run("only the failing one of several init containers is reported", func() {
+		oldPod := createPodWithInitContainerStatus("test-pod", "init-config", 0, corev1.ContainerState{
+			Running: &corev1.ContainerStateRunning{},
+		})
+		oldPod.Status.InitContainerStatuses = append(oldPod.Status.InitContainerStatuses, corev1.ContainerStatus{
+			Name:  "init-db",
+			State: corev1.ContainerState{Running: &corev1.ContainerStateRunning{}},
+		})
+		newPod := oldPod.DeepCopy()
+		newPod.Status.InitContainerStatuses[0].State = corev1.ContainerState{
+			Terminated: &corev1.ContainerStateTerminated{ExitCode: 0, Reason: "Completed"},
+		}
+		newPod.Status.InitContainerStatuses[1].State = corev1.ContainerState{
+			Terminated: &corev1.ContainerStateTerminated{ExitCode: 137, Reason: "OOMKilled"},
+		}
+
+		signals := s.detector.Detect(oldPod, newPod)
+
+		s.Require().Len(signals, 1)
+		s.Equal("init-db", signals[0].ContainerName)
+		s.Equal("OOMKilled", signals[0].Details["reason"])
+	})
+}
+
+// TestInitContainerFailureDetector_AppContainers tests that init and app container failures are reported separately
+func (s *InitContainerFailureDetectorSuite) TestInitContainerFailureDetector_AppContainers() {
+	s.Run("app container crashes are left to PodCrashDetector", func() {
+		oldPod := createPodWithContainerStatus("test-pod", "default", "app", 0, nil)
+		newPod := createPodWithContainerStatus("test-pod", "default", "app", 1, &corev1.ContainerStateTerminated{ExitCode: 1, Reason: "Error"})
+
+		s.Empty(s.detector.Detect(oldPod, newPod), "app container crashes should not be reported as init container failures")
+		s.Len(NewPodCrashDetector().Detect(oldPod, newPod), 1, "PodCrashDetector should still report the app container crash")
+	})
+
+	s.Run("init container failures are not reported by PodCrashDetector", func() {
+		oldPod := createPodWithInitContainerStatus("test-pod", "init-db", 0, corev1.ContainerState{
+			Running: &corev1.ContainerStateRunning{},
+		})
+		newPod := createPodWithInitContainerStatus("test-pod", "init-db", 1, corev1.ContainerState{
+			Terminated: &corev1.ContainerStateTerminated{ExitCode: 1, Reason: "Error"},
+		})
+
+		s.Len(s.detector.Detect(oldPod, newPod), 1)
+		s.Empty(NewPodCrashDetector().Detect(oldPod, newPod), "PodCrashDetector should only inspect app containers")
+	})
+}
+
+// TestInitContainerFailureDetector_EdgeCases tests edge cases and error handling
+func (s *InitContainerFailureDetectorSuite) TestInitContainerFailureDetector_EdgeCases() {
+	failedPod := createPodWithInitContainerStatus("test-pod", "init-db", 0, corev1.ContainerState{
+		Terminated: &corev1.ContainerStateTerminated{ExitCode: 1},
+	})
+
+	s.Run("returns empty slice for nil newObj", func() {
+		signals := s.detector.Detect(failedPod, nil)
+		s.NotNil(signals)
+		s.Empty(signals)
+	})
+
+	s.Run("returns empty slice for nil oldObj", func() {
+		s.Empty(s.detector.Detect(nil, failedPod))
+	})
+
+	s.Run("returns empty slice when objects are not Pods", func() {
+		s.Empty(s.detector.Detect(failedPod, &corev1.Node{}))
+		s.Empty(s.detector.Detect(&corev1.Node{}, failedPod))
+	})
+
+	s.Run("skips init containers that did not exist in oldPod", func() {
+		oldPod := failedPod.DeepCopy()
+		oldPod.Status.InitContainerStatuses = nil
+
+		s.Empty(s.detector.Detect(oldPod, failedPod))
+	})
+}
+
+// TestInitContainerFailureDetector_DetectorInterface verifies interface compliance
+func (s *InitContainerFailureDetectorSuite) TestInitContainerFailureDetector_DetectorInterface() {
+	s.Run("InitContainerFailureDetector implements TypedDetector interface", func() {
+		var _ events.TypedDetector = &InitContainerFailureDetector{}
+		s.Equal(events.FaultTypeInitContainerFailure, s.detector.FaultType())
+		s.Equal("Pod", s.detector.ResourceKind())
+	})
+}
+
+// Helper function to create a pod with a single init container status
+func createPodWithInitContainerStatus(name, containerName string, restartCount int32, state corev1.ContainerState) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "default",
+			UID:       "test-uid-123",
+		},
+		Status: corev1.PodStatus{
+			Phase: corev1.PodPending,
+			InitContainerStatuses: []corev1.ContainerStatus{
+				{
+					Name:         containerName,
+					RestartCount: restartCount,
+					State:        state,
+				},
+			},
+		},
+	}
+}
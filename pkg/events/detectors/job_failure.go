@@ -23,6 +23,25 @@ func NewJobFailureDetector() *JobFailureDetector {
 	return &JobFailureDetector{}
 }
 
+// FaultType returns the type of the fault signals emitted by this detector.
+func (d *JobFailureDetector) FaultType() events.FaultType {
+	return events.FaultTypeJobFailure
+}
+
+// ResourceKind returns the kind of resource this detector inspects.
+func (d *JobFailureDetector) ResourceKind() string {
+	return "Job"
+}
+
+// Describe returns the fault type emitted and the resource kind inspected by this detector.
+func (d *JobFailureDetector) Describe() events.DetectorInfo {
+	return events.DetectorInfo{
+		FaultTypes:    []events.FaultType{d.FaultType()},
+		ResourceKinds: []string{d.ResourceKind()},
+		Description:   "Reports failed Jobs.",
+	}
+}
+
 // Detect analyzes Job state changes and returns fault signals for detected
 // failures. It detects transitions to the Failed state by comparing the
 // Failed condition between oldObj and newObj.
@@ -64,27 +83,43 @@ func (d *JobFailureDetector) Detect(oldObj, newObj interface{}) []events.FaultSi
 	if newFailed.Status == corev1.ConditionTrue {
 		// Check if this is a transition (not already in failure state)
 		if oldFailed == nil || oldFailed.Status != corev1.ConditionTrue {
-			context := buildJobFailureContext(newFailed)
-			severity := determineJobFailureSeverity(newJob)
-
-			signal := events.FaultSignal{
-				FaultType:   events.FaultTypeJobFailure,
-				ResourceUID: types.UID(newJob.UID),
-				Kind:        "Job",
-				Name:        newJob.Name,
-				Namespace:   newJob.Namespace,
-				Severity:    severity,
-				Context:     context,
-				Timestamp:   time.Now(),
-			}
-
-			return []events.FaultSignal{signal}
+			return []events.FaultSignal{newJobFailureSignal(newJob, newFailed)}
 		}
 	}
 
 	return []events.FaultSignal{}
 }
 
+// DetectState returns a fault signal if the Job currently has a Failed condition
+// with Status="True".
+func (d *JobFailureDetector) DetectState(obj interface{}) []events.FaultSignal {
+	job, ok := obj.(*batchv1.Job)
+	if !ok {
+		return []events.FaultSignal{}
+	}
+
+	failed := getFailedCondition(job)
+	if failed == nil || failed.Status != corev1.ConditionTrue {
+		return []events.FaultSignal{}
+	}
+	return []events.FaultSignal{newJobFailureSignal(job, failed)}
+}
+
+// newJobFailureSignal creates the fault signal for a Job with the given Failed condition.
+func newJobFailureSignal(job *batchv1.Job, failed *batchv1.JobCondition) events.FaultSignal {
+	return events.FaultSignal{
+		FaultType:   events.FaultTypeJobFailure,
+		ResourceUID: types.UID(job.UID),
+		Kind:        "Job",
+		Name:        job.Name,
+		Namespace:   job.Namespace,
+		Severity:    determineJobFailureSeverity(job),
+		Context:     buildJobFailureContext(failed),
+		Details:     buildJobFailureDetails(failed),
+		Timestamp:   time.Now(),
+	}
+}
+
 // getFailedCondition finds the Failed condition in a Job's status.
 // Returns nil if the Failed condition is not found.
 func getFailedCondition(job *batchv1.Job) *batchv1.JobCondition {
@@ -117,6 +152,21 @@ func buildJobFailureContext(failedCondition *batchv1.JobCondition) string {
 	return context
 }
 
+// buildJobFailureDetails returns the fields embedded in the job failure context.
+func buildJobFailureDetails(failedCondition *batchv1.JobCondition) map[string]string {
+	details := map[string]string{}
+
+	if failedCondition.Reason != "" {
+		details["reason"] = failedCondition.Reason
+	}
+
+	if failedCondition.Message != "" {
+		details["message"] = failedCondition.Message
+	}
+
+	return details
+}
+
 // determineJobFailureSeverity determines the severity level based on the Job's
 // failure reason. BackoffLimitExceeded is critical (job exhausted retries),
 // other failure reasons are warnings.
@@ -399,6 +399,39 @@ func (s *JobFailureDetectorSuite) TestJobFailureDetector_DetectorInterface() {
 	})
 }
 
+// TestJobFailureDetector_DetectState tests reporting jobs that have already failed
+func (s *JobFailureDetectorSuite) TestJobFailureDetector_DetectState() {
+	s.Run("JobFailureDetector implements InitialStateDetector interface", func() {
+		var _ events.InitialStateDetector = s.detector
+	})
+
+	s.Run("Failed True emits signal", func() {
+		job := createJobWithFailedCondition("job-1", "default", corev1.ConditionTrue, "BackoffLimitExceeded", "Job has reached the specified backoff limit")
+
+		signals := s.detector.DetectState(job)
+
+		s.Require().Len(signals, 1, "expected one signal for a failed job")
+		s.Equal(events.FaultTypeJobFailure, signals[0].FaultType)
+		s.Equal("job-1", signals[0].Name)
+		s.Equal("default", signals[0].Namespace)
+	})
+
+	s.Run("Failed False does not emit signal", func() {
+		job := createJobWithFailedCondition("job-1", "default", corev1.ConditionFalse, "", "")
+
+		s.Empty(s.detector.DetectState(job))
+	})
+
+	s.Run("job without Failed condition does not emit signal", func() {
+		s.Empty(s.detector.DetectState(createJobWithoutFailedCondition("job-1", "default")))
+	})
+
+	s.Run("returns empty slice for nil or non-Job objects", func() {
+		s.Empty(s.detector.DetectState(nil))
+		s.Empty(s.detector.DetectState(&corev1.Pod{}))
+	})
+}
+
 // TestGetFailedCondition tests the getFailedCondition helper function
 func (s *JobFailureDetectorSuite) TestGetFailedCondition() {
 	s.Run("finds Failed condition", func() {
@@ -510,6 +543,22 @@ func (s *JobFailureDetectorSuite) TestGetFailedCondition() {
 	})
 }
 
+// TestJobFailureDetector_Details tests the structured fields of job failure signals
+func (s *JobFailureDetectorSuite) TestJobFailureDetector_Details() {
+	s.Run("details match the fields in the context", func() {
+		oldJob := createJobWithFailedCondition("test-job", "default", corev1.ConditionFalse, "", "")
+		newJob := createJobWithFailedCondition("test-job", "default", corev1.ConditionTrue, "BackoffLimitExceeded", "Job has reached the specified backoff limit")
+
+		signals := s.detector.Detect(oldJob, newJob)
+
+		s.Require().Len(signals, 1)
+		details := signals[0].Details
+		s.Equal(map[string]string{"reason": "BackoffLimitExceeded", "message": "Job has reached the specified backoff limit"}, details)
+		s.Contains(signals[0].Context, "reason: "+details["reason"])
+		s.Contains(signals[0].Context, "message: "+details["message"])
+	})
+}
+
 // Helper function to create a Job with a Failed condition
 func createJobWithFailedCondition(name, namespace string, status corev1.ConditionStatus, reason, message string) *batchv1.Job {
 	return &batchv1.Job{
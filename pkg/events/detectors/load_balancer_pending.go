@@ -0,0 +1,192 @@
+package detectors
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/events"
+)
+
+// DefaultLoadBalancerPendingWindow is how long a LoadBalancer Service may wait for
+// an ingress IP or hostname before it is reported, giving the cloud provider time
+// to provision the load balancer.
+const DefaultLoadBalancerPendingWindow = 5 * time.Minute
+
+// loadBalancerPendingStaleAfter is how long a tracked Service may go unobserved before
+// its entry is dropped, so Services deleted while pending don't accumulate.
+const loadBalancerPendingStaleAfter = 1 * time.Hour
+
+// loadBalancerPendingRecord tracks when a pending LoadBalancer Service was first and last observed.
+type loadBalancerPendingRecord struct {
+	firstObserved time.Time
+	lastObserved  time.Time
+}
+
+// LoadBalancerPendingDetector detects Services of type LoadBalancer that never get an
+// external IP or hostname, typically because the cloud provider failed to provision
+// the load balancer. The Service itself reports no error, so the failure is otherwise
+// silent.
+//
+// A Service is considered pending while its type is LoadBalancer and its
+// Status.LoadBalancer.Ingress is empty. The detector records when it first observed
+// each pending Service, keyed by UID, and emits a signal on every update once the
+// Service has been pending longer than the window. Repeated signals for the same
+// Service are suppressed by the ResourceWatcher's FaultDeduplicator. Because pending
+// Services rarely change, the signal usually fires on an informer resync.
+//
+// It is safe for concurrent use.
+type LoadBalancerPendingDetector struct {
+	mu      sync.Mutex
+	window  time.Duration
+	tracked map[types.UID]*loadBalancerPendingRecord
+	now     func() time.Time // allows time injection for testing
+}
+
+// NewLoadBalancerPendingDetector creates a new LoadBalancerPendingDetector with the default window.
+func NewLoadBalancerPendingDetector() *LoadBalancerPendingDetector {
+	return NewLoadBalancerPendingDetectorWithWindow(DefaultLoadBalancerPendingWindow)
+}
+
+// NewLoadBalancerPendingDetectorWithWindow creates a new LoadBalancerPendingDetector that
+// reports LoadBalancer Services pending for longer than window.
+func NewLoadBalancerPendingDetectorWithWindow(window time.Duration) *LoadBalancerPendingDetector {
+	return &LoadBalancerPendingDetector{
+		window:  window,
+		tracked: make(map[types.UID]*loadBalancerPendingRecord),
+		now:     time.Now,
+	}
+}
+
+// FaultType returns the type of the fault signals emitted by this detector.
+func (d *LoadBalancerPendingDetector) FaultType() events.FaultType {
+	return events.FaultTypeLoadBalancerPending
+}
+
+// ResourceKind returns the kind of resource this detector inspects.
+func (d *LoadBalancerPendingDetector) ResourceKind() string {
+	return "Service"
+}
+
+// Describe returns the fault type emitted and the resource kind inspected by this detector.
+func (d *LoadBalancerPendingDetector) Describe() events.DetectorInfo {
+	return events.DetectorInfo{
+		FaultTypes:    []events.FaultType{d.FaultType()},
+		ResourceKinds: []string{d.ResourceKind()},
+		Description:   "Reports LoadBalancer Services still without an ingress IP or hostname after a grace window.",
+	}
+}
+
+// Detect analyzes Service updates and returns a fault signal when a LoadBalancer
+// Service has been waiting for its ingress for longer than the configured window.
+func (d *LoadBalancerPendingDetector) Detect(oldObj, newObj interface{}) []events.FaultSignal {
+	// Handle nil newObj - nothing to detect
+	if newObj == nil {
+		return []events.FaultSignal{}
+	}
+
+	// Type assert to Service
+	newService, ok := newObj.(*corev1.Service)
+	if !ok {
+		return []events.FaultSignal{}
+	}
+
+	// If oldObj is nil (Add event), no update to evaluate
+	if oldObj == nil {
+		return []events.FaultSignal{}
+	}
+
+	if _, ok := oldObj.(*corev1.Service); !ok {
+		return []events.FaultSignal{}
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	now := d.now()
+	d.pruneLocked(now)
+
+	// Load balancer was provisioned, or the Service is no longer a LoadBalancer - stop tracking it
+	if !loadBalancerPending(newService) {
+		delete(d.tracked, newService.UID)
+		return []events.FaultSignal{}
+	}
+
+	record, exists := d.tracked[newService.UID]
+	if !exists {
+		record = &loadBalancerPendingRecord{firstObserved: now}
+		d.tracked[newService.UID] = record
+	}
+	record.lastObserved = now
+
+	pendingFor := now.Sub(record.firstObserved)
+	if pendingFor <= d.window {
+		return []events.FaultSignal{}
+	}
+
+	signal := events.FaultSignal{
+		FaultType:   events.FaultTypeLoadBalancerPending,
+		ResourceUID: types.UID(newService.UID),
+		Kind:        "Service",
+		Name:        newService.Name,
+		Namespace:   newService.Namespace,
+		Severity:    events.SeverityWarning,
+		Context:     buildLoadBalancerPendingContext(newService, pendingFor),
+		Details:     buildLoadBalancerPendingDetails(newService, pendingFor),
+		Timestamp:   now,
+	}
+
+	return []events.FaultSignal{signal}
+}
+
+// pruneLocked drops tracked Services that have not been observed recently.
+// Must be called with the lock held.
+func (d *LoadBalancerPendingDetector) pruneLocked(now time.Time) {
+	for uid, record := range d.tracked {
+		if now.Sub(record.lastObserved) > loadBalancerPendingStaleAfter {
+			delete(d.tracked, uid)
+		}
+	}
+}
+
+// loadBalancerPending reports whether a Service is of type LoadBalancer and has no ingress yet.
+func loadBalancerPending(service *corev1.Service) bool {
+	return service.Spec.Type == corev1.ServiceTypeLoadBalancer && len(service.Status.LoadBalancer.Ingress) == 0
+}
+
+// formatServicePorts formats the ports of a Service as a comma-separated list,
+// e.g. "http 80/TCP, 443/TCP", or "none" if it has no ports.
+func formatServicePorts(service *corev1.Service) string {
+	if len(service.Spec.Ports) == 0 {
+		return "none"
+	}
+	ports := make([]string, 0, len(service.Spec.Ports))
+	for _, port := range service.Spec.Ports {
+		formatted := fmt.Sprintf("%d/%s", port.Port, port.Protocol)
+		if port.Name != "" {
+			formatted = port.Name + " " + formatted
+		}
+		ports = append(ports, formatted)
+	}
+	return strings.Join(ports, ", ")
+}
+
+// buildLoadBalancerPendingContext creates a human-readable context string for a
+// LoadBalancer Service without ingress, including its name and ports.
+func buildLoadBalancerPendingContext(service *corev1.Service, pendingFor time.Duration) string {
+	return fmt.Sprintf("LoadBalancer Service %s has had no ingress IP or hostname for %s, ports: %s",
+		service.Name, pendingFor.Truncate(time.Second), formatServicePorts(service))
+}
+
+// buildLoadBalancerPendingDetails returns the fields embedded in the load balancer pending context.
+func buildLoadBalancerPendingDetails(service *corev1.Service, pendingFor time.Duration) map[string]string {
+	return map[string]string{
+		"service":    service.Name,
+		"pendingFor": pendingFor.Truncate(time.Second).String(),
+		"ports":      formatServicePorts(service),
+	}
+}
@@ -0,0 +1,210 @@
+package detectors
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/events"
+)
+
+// LoadBalancerPendingDetectorSuite contains tests for LoadBalancerPendingDetector
+type LoadBalancerPendingDetectorSuite struct {
+	suite.Suite
+	detector    *LoadBalancerPendingDetector
+	currentTime time.Time
+}
+
+func TestLoadBalancerPendingDetectorSuite(t *testing.T) {
+	suite.Run(t, new(LoadBalancerPendingDetectorSuite))
+}
+
+// SetupTest runs before each test
+func (s *LoadBalancerPendingDetectorSuite) SetupTest() {
+	s.currentTime = time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	s.detector = NewLoadBalancerPendingDetectorWithWindow(5 * time.Minute)
+	s.detector.now = func() time.Time {
+		return s.currentTime
+	}
+}
+
+// SetupSubTest resets detector state between subtests
+func (s *LoadBalancerPendingDetectorSuite) SetupSubTest() {
+	s.SetupTest()
+}
+
+func (s *LoadBalancerPendingDetectorSuite) advanceTime(d time.Duration) {
+	s.currentTime = s.currentTime.Add(d)
+}
+
+// TestLoadBalancerPendingDetector_Window tests detection relative to the window
+func (s *LoadBalancerPendingDetectorSuite) TestLoadBalancerPendingDetector_Window() {
+	s.Run("service provisioned within the window does not emit signal", func() {
+		pending := createPendingLoadBalancerService("web")
+		provisioned := createProvisionedLoadBalancerService("web")
+
+		s.Empty(s.detector.Detect(pending, pending), "load balancer was just requested")
+
+		s.advanceTime(2 * time.Minute)
+		s.Empty(s.detector.Detect(pending, provisioned), "load balancer was provisioned within the window")
+
+		s.advanceTime(10 * time.Minute)
+		s.Empty(s.detector.Detect(provisioned, provisioned))
+		s.Empty(s.detector.tracked)
+	})
+
+	s.Run("service stuck pending past the window emits signal", func() {
+		pending := createPendingLoadBalancerService("web")
+
+		s.Empty(s.detector.Detect(pending, pending))
+
+		s.advanceTime(6 * time.Minute)
+		signals := s.detector.Detect(pending, pending)
+
+		s.Require().Len(signals, 1)
+		signal := signals[0]
+		s.Equal(events.FaultTypeLoadBalancerPending, signal.FaultType)
+		s.Equal(types.UID("svc-uid-web"), signal.ResourceUID)
+		s.Equal("Service", signal.Kind)
+		s.Equal("web", signal.Name)
+		s.Equal("default", signal.Namespace)
+		s.Equal(events.SeverityWarning, signal.Severity)
+		s.Contains(signal.Context, "LoadBalancer Service web has had no ingress IP or hostname for 6m0s")
+		s.Contains(signal.Context, "ports: http 80/TCP, 443/TCP")
+		s.Equal(s.currentTime, signal.Timestamp)
+	})
+
+	s.Run("window is measured from first observation", func() {
+		pending := createPendingLoadBalancerService("web")
+
+		s.detector.Detect(pending, pending)
+		s.advanceTime(3 * time.Minute)
+		s.Empty(s.detector.Detect(pending, pending))
+		s.advanceTime(3 * time.Minute)
+		s.Len(s.detector.Detect(pending, pending), 1)
+	})
+
+	s.Run("services of other types do not emit signal", func() {
+		clusterIP := createPendingLoadBalancerService("web")
+		clusterIP.Spec.Type = corev1.ServiceTypeClusterIP
+
+		s.detector.Detect(clusterIP, clusterIP)
+		s.advanceTime(time.Hour)
+		s.Empty(s.detector.Detect(clusterIP, clusterIP), "only LoadBalancer Services get an ingress")
+		s.Empty(s.detector.tracked)
+	})
+
+	s.Run("service changed away from LoadBalancer stops being tracked", func() {
+		pending := createPendingLoadBalancerService("web")
+		nodePort := createPendingLoadBalancerService("web")
+		nodePort.Spec.Type = corev1.ServiceTypeNodePort
+
+		s.detector.Detect(pending, pending)
+		s.advanceTime(10 * time.Minute)
+		s.Empty(s.detector.Detect(pending, nodePort))
+		s.Empty(s.detector.tracked)
+	})
+
+	s.Run("stale entries for deleted services are pruned", func() {
+		gone := createPendingLoadBalancerService("gone")
+		other := createPendingLoadBalancerService("other")
+
+		s.detector.Detect(gone, gone)
+		s.advanceTime(2 * time.Hour)
+		s.detector.Detect(other, other)
+
+		s.Len(s.detector.tracked, 1)
+		s.Contains(s.detector.tracked, other.UID)
+	})
+}
+
+// TestLoadBalancerPendingDetector_EdgeCases tests edge cases and error handling
+func (s *LoadBalancerPendingDetectorSuite) TestLoadBalancerPendingDetector_EdgeCases() {
+	s.Run("returns empty slice for nil newObj", func() {
+		signals := s.detector.Detect(createPendingLoadBalancerService("web"), nil)
+		s.Empty(signals)
+		s.NotNil(signals)
+	})
+
+	s.Run("returns empty slice for nil oldObj", func() {
+		signals := s.detector.Detect(nil, createPendingLoadBalancerService("web"))
+		s.Empty(signals, "nil oldObj means Add event, nothing to evaluate")
+	})
+
+	s.Run("returns empty slice when objects are not Services", func() {
+		service := createPendingLoadBalancerService("web")
+		s.Empty(s.detector.Detect(service, "not a service"))
+		s.Empty(s.detector.Detect("not a service", service))
+	})
+
+	s.Run("reports services without ports", func() {
+		pending := createPendingLoadBalancerService("web")
+		pending.Spec.Ports = nil
+
+		s.detector.Detect(pending, pending)
+		s.advanceTime(6 * time.Minute)
+		signals := s.detector.Detect(pending, pending)
+
+		s.Require().Len(signals, 1)
+		s.Contains(signals[0].Context, "ports: none")
+	})
+}
+
+// TestLoadBalancerPendingDetector_DetectorInterface verifies LoadBalancerPendingDetector implements Detector
+func (s *LoadBalancerPendingDetectorSuite) TestLoadBalancerPendingDetector_DetectorInterface() {
+	s.Run("LoadBalancerPendingDetector implements Detector interface", func() {
+		var _ events.Detector = &LoadBalancerPendingDetector{}
+		var _ events.Detector = s.detector
+	})
+}
+
+// TestLoadBalancerPendingDetector_Details tests the structured fields of load balancer pending signals
+func (s *LoadBalancerPendingDetectorSuite) TestLoadBalancerPendingDetector_Details() {
+	s.Run("details match the fields in the context", func() {
+		pending := createPendingLoadBalancerService("web")
+
+		s.detector.Detect(pending, pending)
+		s.advanceTime(6 * time.Minute)
+		signals := s.detector.Detect(pending, pending)
+
+		s.Require().Len(signals, 1)
+		details := signals[0].Details
+		s.Equal(map[string]string{
+			"service":    "web",
+			"pendingFor": "6m0s",
+			"ports":      "http 80/TCP, 443/TCP",
+		}, details)
+		s.Contains(signals[0].Context, "Service "+details["service"])
+		s.Contains(signals[0].Context, "for "+details["pendingFor"])
+		s.Contains(signals[0].Context, "ports: "+details["ports"])
+	})
+}
+
+// createPendingLoadBalancerService creates a LoadBalancer Service without ingress.
+func createPendingLoadBalancerService(name string) *corev1.Service {
+	return &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "default",
+			UID:       types.UID("svc-uid-" + name),
+		},
+		Spec: corev1.ServiceSpec{
+			Type: corev1.ServiceTypeLoadBalancer,
+			Ports: []corev1.ServicePort{
+				{Name: "http", Port: 80, Protocol: corev1.ProtocolTCP},
+				{Port: 443, Protocol: corev1.ProtocolTCP},
+			},
+		},
+	}
+}
+
+// createProvisionedLoadBalancerService creates a LoadBalancer Service whose load balancer has an ingress IP.
+func createProvisionedLoadBalancerService(name string) *corev1.Service {
+	service := createPendingLoadBalancerService(name)
+	service.Status.LoadBalancer.Ingress = []corev1.LoadBalancerIngress{{IP: "203.0.113.10"}}
+	return service
+}
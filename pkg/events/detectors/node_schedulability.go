@@ -0,0 +1,129 @@
+package detectors
+
+import (
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/events"
+)
+
+// drainTaintKeys are taint keys set by tools that evict pods from a node, so their
+// presence on a cordoned node suggests it is being drained.
+var drainTaintKeys = map[string]bool{
+	"ToBeDeletedByClusterAutoscaler":    true, // cluster-autoscaler scale down
+	"karpenter.sh/disrupted":            true, // Karpenter disruption
+	"node.kubernetes.io/out-of-service": true, // non-graceful node shutdown
+}
+
+// NodeSchedulabilityDetector detects when a node is cordoned.
+// A node is considered cordoned when Spec.Unschedulable transitions from false to true,
+// which often precedes a drain and reduces cluster capacity.
+type NodeSchedulabilityDetector struct{}
+
+// NewNodeSchedulabilityDetector creates a new NodeSchedulabilityDetector instance.
+func NewNodeSchedulabilityDetector() *NodeSchedulabilityDetector {
+	return &NodeSchedulabilityDetector{}
+}
+
+// FaultType returns the type of the fault signals emitted by this detector.
+func (d *NodeSchedulabilityDetector) FaultType() events.FaultType {
+	return events.FaultTypeNodeCordoned
+}
+
+// ResourceKind returns the kind of resource this detector inspects.
+func (d *NodeSchedulabilityDetector) ResourceKind() string {
+	return "Node"
+}
+
+// Describe returns the fault type emitted and the resource kind inspected by this detector.
+func (d *NodeSchedulabilityDetector) Describe() events.DetectorInfo {
+	return events.DetectorInfo{
+		FaultTypes:    []events.FaultType{d.FaultType()},
+		ResourceKinds: []string{d.ResourceKind()},
+		Description:   "Reports nodes being cordoned.",
+	}
+}
+
+// Detect analyzes node state changes and returns fault signals for nodes
+// transitioning from schedulable to unschedulable.
+func (d *NodeSchedulabilityDetector) Detect(oldObj, newObj interface{}) []events.FaultSignal {
+	// Handle nil newObj - nothing to detect
+	if newObj == nil {
+		return []events.FaultSignal{}
+	}
+
+	// Type assert to Node
+	newNode, ok := newObj.(*corev1.Node)
+	if !ok {
+		return []events.FaultSignal{}
+	}
+
+	// If oldObj is nil (Add event), no transition to detect
+	if oldObj == nil {
+		return []events.FaultSignal{}
+	}
+
+	oldNode, ok := oldObj.(*corev1.Node)
+	if !ok {
+		return []events.FaultSignal{}
+	}
+
+	// Detect transition from schedulable to unschedulable
+	if oldNode.Spec.Unschedulable || !newNode.Spec.Unschedulable {
+		return []events.FaultSignal{}
+	}
+
+	signal := events.FaultSignal{
+		FaultType:   events.FaultTypeNodeCordoned,
+		ResourceUID: types.UID(newNode.UID),
+		Kind:        "Node",
+		Name:        newNode.Name,
+		Namespace:   "", // Nodes are cluster-scoped
+		Severity:    events.SeverityWarning,
+		Context:     buildNodeCordonedContext(newNode),
+		Details:     buildNodeCordonedDetails(newNode),
+		Timestamp:   time.Now(),
+	}
+
+	return []events.FaultSignal{signal}
+}
+
+// findDrainTaint returns the first taint on the node suggesting it is being drained:
+// a known drain taint or any NoExecute taint, which evicts running pods.
+// Returns nil if there is none.
+func findDrainTaint(node *corev1.Node) *corev1.Taint {
+	for i := range node.Spec.Taints {
+		taint := &node.Spec.Taints[i]
+		if drainTaintKeys[taint.Key] || taint.Effect == corev1.TaintEffectNoExecute {
+			return taint
+		}
+	}
+
+	return nil
+}
+
+// buildNodeCordonedContext creates a human-readable context string for a cordoned node,
+// noting whether a taint suggests the node is being drained.
+func buildNodeCordonedContext(node *corev1.Node) string {
+	context := "Node was cordoned (unschedulable)"
+
+	if taint := findDrainTaint(node); taint != nil {
+		context += fmt.Sprintf(", taint %s suggests the node is being drained", taint.ToString())
+	}
+
+	return context
+}
+
+// buildNodeCordonedDetails returns the fields embedded in the cordoned node context.
+func buildNodeCordonedDetails(node *corev1.Node) map[string]string {
+	details := map[string]string{}
+
+	if taint := findDrainTaint(node); taint != nil {
+		details["drainTaint"] = taint.ToString()
+	}
+
+	return details
+}
@@ -0,0 +1,178 @@
+package detectors
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/events"
+)
+
+// NodeSchedulabilityDetectorSuite contains tests for NodeSchedulabilityDetector
+type NodeSchedulabilityDetectorSuite struct {
+	suite.Suite
+	detector *NodeSchedulabilityDetector
+}
+
+func TestNodeSchedulabilityDetectorSuite(t *testing.T) {
+	suite.Run(t, new(NodeSchedulabilityDetectorSuite))
+}
+
+// SetupTest runs before each test
+func (s *NodeSchedulabilityDetectorSuite) SetupTest() {
+	s.detector = NewNodeSchedulabilityDetector()
+}
+
+// TestNodeSchedulabilityDetector_Transitions tests detection of schedulability transitions
+func (s *NodeSchedulabilityDetectorSuite) TestNodeSchedulabilityDetector_Transitions() {
+	s.Run("cordon emits warning signal", func() {
+		oldNode := createNodeWithSchedulability("node-1", false)
+		newNode := createNodeWithSchedulability("node-1", true, corev1.Taint{
+			Key:    corev1.TaintNodeUnschedulable,
+			Effect: corev1.TaintEffectNoSchedule,
+		})
+
+		signals := s.detector.Detect(oldNode, newNode)
+
+		s.Require().Len(signals, 1)
+		signal := signals[0]
+
+		s.Equal(events.FaultTypeNodeCordoned, signal.FaultType)
+		s.Equal(types.UID(newNode.UID), signal.ResourceUID)
+		s.Equal("Node", signal.Kind)
+		s.Equal("node-1", signal.Name)
+		s.Equal("", signal.Namespace)
+		s.Equal(events.SeverityWarning, signal.Severity)
+		s.Equal("Node was cordoned (unschedulable)", signal.Context, "the cordon taint alone does not suggest a drain")
+		s.False(signal.Timestamp.IsZero())
+	})
+
+	s.Run("cordon with drain taint notes the drain", func() {
+		oldNode := createNodeWithSchedulability("node-1", false)
+		newNode := createNodeWithSchedulability("node-1", true, corev1.Taint{
+			Key:    "ToBeDeletedByClusterAutoscaler",
+			Value:  "1700000000",
+			Effect: corev1.TaintEffectNoSchedule,
+		})
+
+		signals := s.detector.Detect(oldNode, newNode)
+
+		s.Require().Len(signals, 1)
+		s.Contains(signals[0].Context, "taint ToBeDeletedByClusterAutoscaler=1700000000:NoSchedule suggests the node is being drained")
+	})
+
+	s.Run("cordon with NoExecute taint notes the drain", func() {
+		oldNode := createNodeWithSchedulability("node-1", false)
+		newNode := createNodeWithSchedulability("node-1", true, corev1.Taint{
+			Key:    "maintenance",
+			Effect: corev1.TaintEffectNoExecute,
+		})
+
+		signals := s.detector.Detect(oldNode, newNode)
+
+		s.Require().Len(signals, 1)
+		s.Contains(signals[0].Context, "taint maintenance:NoExecute suggests the node is being drained")
+	})
+
+	s.Run("uncordon does not emit signal", func() {
+		oldNode := createNodeWithSchedulability("node-1", true)
+		newNode := createNodeWithSchedulability("node-1", false)
+
+		signals := s.detector.Detect(oldNode, newNode)
+
+		s.Empty(signals, "expected no signal when node becomes schedulable")
+	})
+
+	s.Run("already cordoned node does not emit repeat signal", func() {
+		oldNode := createNodeWithSchedulability("node-1", true)
+		newNode := createNodeWithSchedulability("node-1", true, corev1.Taint{
+			Key:    "node.kubernetes.io/out-of-service",
+			Effect: corev1.TaintEffectNoExecute,
+		})
+
+		signals := s.detector.Detect(oldNode, newNode)
+
+		s.Empty(signals, "expected no signal when node was already cordoned")
+	})
+
+	s.Run("schedulable node update does not emit signal", func() {
+		oldNode := createNodeWithSchedulability("node-1", false)
+		newNode := createNodeWithSchedulability("node-1", false)
+		newNode.Labels = map[string]string{"zone": "a"}
+
+		signals := s.detector.Detect(oldNode, newNode)
+
+		s.Empty(signals)
+	})
+}
+
+// TestNodeSchedulabilityDetector_EdgeCases tests edge cases and error handling
+func (s *NodeSchedulabilityDetectorSuite) TestNodeSchedulabilityDetector_EdgeCases() {
+	s.Run("nil old node does not emit signal", func() {
+		newNode := createNodeWithSchedulability("node-1", true)
+
+		signals := s.detector.Detect(nil, newNode)
+
+		s.NotNil(signals)
+		s.Empty(signals, "expected no signals for Add event")
+	})
+
+	s.Run("nil new node does not emit signal", func() {
+		oldNode := createNodeWithSchedulability("node-1", false)
+
+		signals := s.detector.Detect(oldNode, nil)
+
+		s.NotNil(signals)
+		s.Empty(signals)
+	})
+
+	s.Run("non-node objects do not emit signal", func() {
+		node := createNodeWithSchedulability("node-1", true)
+
+		s.Empty(s.detector.Detect(&corev1.Pod{}, node))
+		s.Empty(s.detector.Detect(node, &corev1.Pod{}))
+	})
+}
+
+// TestNodeSchedulabilityDetector_Details tests the structured fields of cordoned node signals
+func (s *NodeSchedulabilityDetectorSuite) TestNodeSchedulabilityDetector_Details() {
+	s.Run("details include the drain taint from the context", func() {
+		oldNode := createNodeWithSchedulability("node-1", false)
+		newNode := createNodeWithSchedulability("node-1", true, corev1.Taint{
+			Key:    "ToBeDeletedByClusterAutoscaler",
+			Value:  "1700000000",
+			Effect: corev1.TaintEffectNoSchedule,
+		})
+
+		signals := s.detector.Detect(oldNode, newNode)
+
+		s.Require().Len(signals, 1)
+		details := signals[0].Details
+		s.Equal(map[string]string{"drainTaint": "ToBeDeletedByClusterAutoscaler=1700000000:NoSchedule"}, details)
+		s.Contains(signals[0].Context, "taint "+details["drainTaint"]+" suggests the node is being drained")
+	})
+
+	s.Run("details are empty without a drain taint", func() {
+		signals := s.detector.Detect(createNodeWithSchedulability("node-1", false), createNodeWithSchedulability("node-1", true))
+
+		s.Require().Len(signals, 1)
+		s.Empty(signals[0].Details)
+	})
+}
+
+// createNodeWithSchedulability creates a test node with the given schedulability and taints
+func createNodeWithSchedulability(name string, unschedulable bool, taints ...corev1.Taint) *corev1.Node {
+	return &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+			UID:  types.UID(name + "-uid"),
+		},
+		Spec: corev1.NodeSpec{
+			Unschedulable: unschedulable,
+			Taints:        taints,
+		},
+	}
+}
@@ -20,6 +20,25 @@ func NewNodeUnhealthyDetector() *NodeUnhealthyDetector {
 	return &NodeUnhealthyDetector{}
 }
 
+// FaultType returns the type of the fault signals emitted by this detector.
+func (d *NodeUnhealthyDetector) FaultType() events.FaultType {
+	return events.FaultTypeNodeUnhealthy
+}
+
+// ResourceKind returns the kind of resource this detector inspects.
+func (d *NodeUnhealthyDetector) ResourceKind() string {
+	return "Node"
+}
+
+// Describe returns the fault type emitted and the resource kind inspected by this detector.
+func (d *NodeUnhealthyDetector) Describe() events.DetectorInfo {
+	return events.DetectorInfo{
+		FaultTypes:    []events.FaultType{d.FaultType()},
+		ResourceKinds: []string{d.ResourceKind()},
+		Description:   "Reports nodes whose Ready condition changes to False or Unknown.",
+	}
+}
+
 // Detect analyzes node state changes and returns fault signals for nodes
 // transitioning to unhealthy states. It detects transitions in the Ready
 // condition from True to False or Unknown.
@@ -57,25 +76,40 @@ func (d *NodeUnhealthyDetector) Detect(oldObj, newObj interface{}) []events.Faul
 	// Detect transition from Ready=True to Ready=False or Ready=Unknown
 	if oldReady.Status == corev1.ConditionTrue &&
 		(newReady.Status == corev1.ConditionFalse || newReady.Status == corev1.ConditionUnknown) {
+		return []events.FaultSignal{newNodeUnhealthySignal(newNode, newReady)}
+	}
 
-		context := buildNodeUnhealthyContext(newReady)
-		severity := determineNodeSeverity(newReady.Status)
-
-		signal := events.FaultSignal{
-			FaultType:   events.FaultTypeNodeUnhealthy,
-			ResourceUID: types.UID(newNode.UID),
-			Kind:        "Node",
-			Name:        newNode.Name,
-			Namespace:   "", // Nodes are cluster-scoped
-			Severity:    severity,
-			Context:     context,
-			Timestamp:   time.Now(),
-		}
+	return []events.FaultSignal{}
+}
 
-		return []events.FaultSignal{signal}
+// DetectState returns a fault signal if the node's Ready condition is currently
+// False or Unknown.
+func (d *NodeUnhealthyDetector) DetectState(obj interface{}) []events.FaultSignal {
+	node, ok := obj.(*corev1.Node)
+	if !ok {
+		return []events.FaultSignal{}
 	}
 
-	return []events.FaultSignal{}
+	ready := getReadyCondition(node)
+	if ready == nil || (ready.Status != corev1.ConditionFalse && ready.Status != corev1.ConditionUnknown) {
+		return []events.FaultSignal{}
+	}
+	return []events.FaultSignal{newNodeUnhealthySignal(node, ready)}
+}
+
+// newNodeUnhealthySignal creates the fault signal for a node with the given unhealthy Ready condition.
+func newNodeUnhealthySignal(node *corev1.Node, ready *corev1.NodeCondition) events.FaultSignal {
+	return events.FaultSignal{
+		FaultType:   events.FaultTypeNodeUnhealthy,
+		ResourceUID: types.UID(node.UID),
+		Kind:        "Node",
+		Name:        node.Name,
+		Namespace:   "", // Nodes are cluster-scoped
+		Severity:    determineNodeSeverity(ready.Status),
+		Context:     buildNodeUnhealthyContext(ready),
+		Details:     buildNodeUnhealthyDetails(ready),
+		Timestamp:   time.Now(),
+	}
 }
 
 // getReadyCondition finds the Ready condition in a node's status.
@@ -110,6 +144,23 @@ func buildNodeUnhealthyContext(readyCondition *corev1.NodeCondition) string {
 	return context
 }
 
+// buildNodeUnhealthyDetails returns the fields embedded in the node unhealthy context.
+func buildNodeUnhealthyDetails(readyCondition *corev1.NodeCondition) map[string]string {
+	details := map[string]string{
+		"readyStatus": string(readyCondition.Status),
+	}
+
+	if readyCondition.Reason != "" {
+		details["reason"] = readyCondition.Reason
+	}
+
+	if readyCondition.Message != "" {
+		details["message"] = readyCondition.Message
+	}
+
+	return details
+}
+
 // determineNodeSeverity determines the severity level based on the Ready
 // condition status. False is critical (node definitively unhealthy),
 // Unknown is warning (node status uncertain).
@@ -457,6 +457,44 @@ func (s *NodeUnhealthyDetectorSuite) TestNodeUnhealthyDetector_DetectorInterface
 	})
 }
 
+// TestNodeUnhealthyDetector_DetectState tests reporting nodes that are already unhealthy
+func (s *NodeUnhealthyDetectorSuite) TestNodeUnhealthyDetector_DetectState() {
+	s.Run("NodeUnhealthyDetector implements InitialStateDetector interface", func() {
+		var _ events.InitialStateDetector = s.detector
+	})
+
+	s.Run("Ready False emits critical signal", func() {
+		node := createNodeWithReadyCondition("node-1", corev1.ConditionFalse, "KubeletNotReady", "container runtime is down")
+
+		signals := s.detector.DetectState(node)
+
+		s.Require().Len(signals, 1, "expected one signal for a NotReady node")
+		s.Equal(events.FaultTypeNodeUnhealthy, signals[0].FaultType)
+		s.Equal("node-1", signals[0].Name)
+		s.Equal(events.SeverityCritical, signals[0].Severity)
+	})
+
+	s.Run("Ready Unknown emits warning signal", func() {
+		node := createNodeWithReadyCondition("node-1", corev1.ConditionUnknown, "NodeStatusUnknown", "")
+
+		signals := s.detector.DetectState(node)
+
+		s.Require().Len(signals, 1, "expected one signal for a node with unknown status")
+		s.Equal(events.SeverityWarning, signals[0].Severity)
+	})
+
+	s.Run("Ready True does not emit signal", func() {
+		node := createNodeWithReadyCondition("node-1", corev1.ConditionTrue, "KubeletReady", "")
+
+		s.Empty(s.detector.DetectState(node))
+	})
+
+	s.Run("returns empty slice for nil or non-Node objects", func() {
+		s.Empty(s.detector.DetectState(nil))
+		s.Empty(s.detector.DetectState(&corev1.Pod{}))
+	})
+}
+
 // TestGetReadyCondition tests the getReadyCondition helper function
 func (s *NodeUnhealthyDetectorSuite) TestGetReadyCondition() {
 	s.Run("finds Ready condition", func() {
@@ -568,6 +606,33 @@ func (s *NodeUnhealthyDetectorSuite) TestGetReadyCondition() {
 	})
 }
 
+// TestNodeUnhealthyDetector_Details tests the structured fields of node unhealthy signals
+func (s *NodeUnhealthyDetectorSuite) TestNodeUnhealthyDetector_Details() {
+	s.Run("details match the fields in the context", func() {
+		oldNode := createNodeWithReadyCondition("node-1", corev1.ConditionTrue, "KubeletReady", "kubelet is posting ready status")
+		newNode := createNodeWithReadyCondition("node-1", corev1.ConditionFalse, "KubeletNotReady", "kubelet stopped posting ready status")
+
+		signals := s.detector.Detect(oldNode, newNode)
+
+		s.Require().Len(signals, 1)
+		details := signals[0].Details
+		s.Equal(map[string]string{"readyStatus": "False", "reason": "KubeletNotReady", "message": "kubelet stopped posting ready status"}, details)
+		s.Contains(signals[0].Context, "Ready="+details["readyStatus"])
+		s.Contains(signals[0].Context, "reason: "+details["reason"])
+		s.Contains(signals[0].Context, "message: "+details["message"])
+	})
+
+	s.Run("empty reason and message are omitted", func() {
+		oldNode := createNodeWithReadyCondition("node-1", corev1.ConditionTrue, "", "")
+		newNode := createNodeWithReadyCondition("node-1", corev1.ConditionUnknown, "", "")
+
+		signals := s.detector.Detect(oldNode, newNode)
+
+		s.Require().Len(signals, 1)
+		s.Equal(map[string]string{"readyStatus": "Unknown"}, signals[0].Details)
+	})
+}
+
 // Helper function to create a node with a Ready condition
 func createNodeWithReadyCondition(name string, status corev1.ConditionStatus, reason, message string) *corev1.Node {
 	return &corev1.Node{
@@ -0,0 +1,182 @@
+package detectors
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/events"
+)
+
+// oomKilledReason is the termination reason reported by the kubelet when a
+// container is killed for exceeding its memory limit.
+const oomKilledReason = "OOMKilled"
+
+// OOMKillDetector detects containers killed for running out of memory.
+// A signal is emitted when:
+// 1. RestartCount increases and the container's last (or current) termination reason is OOMKilled
+// 2. A container that does not restart (restartPolicy Never) enters a Terminated state with reason OOMKilled
+// The container's memory limit from the pod spec is included in the context.
+type OOMKillDetector struct{}
+
+// NewOOMKillDetector creates a new OOMKillDetector instance.
+func NewOOMKillDetector() *OOMKillDetector {
+	return &OOMKillDetector{}
+}
+
+// FaultType returns the type of the fault signals emitted by this detector.
+func (d *OOMKillDetector) FaultType() events.FaultType {
+	return events.FaultTypeOOMKilled
+}
+
+// ResourceKind returns the kind of resource this detector inspects.
+func (d *OOMKillDetector) ResourceKind() string {
+	return "Pod"
+}
+
+// Describe returns the fault type emitted and the resource kind inspected by this detector.
+func (d *OOMKillDetector) Describe() events.DetectorInfo {
+	return events.DetectorInfo{
+		FaultTypes:    []events.FaultType{d.FaultType()},
+		ResourceKinds: []string{d.ResourceKind()},
+		Description:   "Reports containers killed for running out of memory.",
+	}
+}
+
+// Detect analyzes pod state changes and returns fault signals for OOMKilled containers.
+// It compares container statuses between oldObj and newObj.
+func (d *OOMKillDetector) Detect(oldObj, newObj interface{}) []events.FaultSignal {
+	// Handle nil newObj - nothing to detect
+	if newObj == nil {
+		return []events.FaultSignal{}
+	}
+
+	// Type assert to Pod
+	newPod, ok := newObj.(*corev1.Pod)
+	if !ok {
+		return []events.FaultSignal{}
+	}
+
+	// If oldObj is nil (Add event), don't emit signal (edge-triggered)
+	if oldObj == nil {
+		return []events.FaultSignal{}
+	}
+
+	oldPod, ok := oldObj.(*corev1.Pod)
+	if !ok {
+		return []events.FaultSignal{}
+	}
+
+	signals := []events.FaultSignal{}
+
+	// Create a map of old container statuses by container name for easy lookup
+	oldStatusMap := make(map[string]corev1.ContainerStatus)
+	for _, status := range oldPod.Status.ContainerStatuses {
+		oldStatusMap[status.Name] = status
+	}
+
+	for _, newStatus := range newPod.Status.ContainerStatuses {
+		oldStatus, exists := oldStatusMap[newStatus.Name]
+
+		// Skip if container didn't exist before
+		if !exists {
+			continue
+		}
+
+		terminated := oomKilledTermination(newStatus)
+		if terminated == nil {
+			continue
+		}
+
+		restarted := newStatus.RestartCount > oldStatus.RestartCount
+		// Containers that are not restarted stay Terminated, so the transition is the state change itself
+		newlyTerminated := newStatus.State.Terminated != nil && oldStatus.State.Terminated == nil
+		if !restarted && !newlyTerminated {
+			continue
+		}
+
+		memoryLimit := containerMemoryLimit(newPod, newStatus.Name)
+		signal := events.FaultSignal{
+			FaultType:     events.FaultTypeOOMKilled,
+			ResourceUID:   types.UID(newPod.UID),
+			Kind:          "Pod",
+			Name:          newPod.Name,
+			Namespace:     newPod.Namespace,
+			ContainerName: newStatus.Name,
+			Severity:      events.SeverityWarning,
+			Context:       buildOOMKillContext(terminated, memoryLimit),
+			Details:       buildOOMKillDetails(terminated, memoryLimit),
+			Timestamp:     time.Now(),
+		}
+
+		signals = append(signals, signal)
+	}
+
+	return signals
+}
+
+// oomKilledTermination returns the container's OOMKilled termination state, checking the
+// current state first and then the last termination state of a restarted container.
+// Returns nil if the container was not OOMKilled.
+func oomKilledTermination(status corev1.ContainerStatus) *corev1.ContainerStateTerminated {
+	if status.State.Terminated != nil && status.State.Terminated.Reason == oomKilledReason {
+		return status.State.Terminated
+	}
+	if status.LastTerminationState.Terminated != nil && status.LastTerminationState.Terminated.Reason == oomKilledReason {
+		return status.LastTerminationState.Terminated
+	}
+	return nil
+}
+
+// containerMemoryLimit returns the memory limit declared for a container in the pod spec.
+// Returns an empty string if the container is not found or has no memory limit.
+func containerMemoryLimit(pod *corev1.Pod, containerName string) string {
+	for _, container := range pod.Spec.Containers {
+		if container.Name != containerName {
+			continue
+		}
+		if limit, ok := container.Resources.Limits[corev1.ResourceMemory]; ok {
+			return limit.String()
+		}
+		return ""
+	}
+	return ""
+}
+
+// buildOOMKillContext creates a human-readable context string for an OOMKilled container.
+func buildOOMKillContext(terminated *corev1.ContainerStateTerminated, memoryLimit string) string {
+	context := fmt.Sprintf("Container was OOMKilled with exit code %d", terminated.ExitCode)
+
+	if memoryLimit != "" {
+		context += fmt.Sprintf(", memory limit: %s", memoryLimit)
+	} else {
+		context += ", memory limit: none"
+	}
+
+	if terminated.Message != "" {
+		context += fmt.Sprintf(", message: %s", terminated.Message)
+	}
+
+	return context
+}
+
+// buildOOMKillDetails returns the fields embedded in the OOMKill context.
+func buildOOMKillDetails(terminated *corev1.ContainerStateTerminated, memoryLimit string) map[string]string {
+	details := map[string]string{
+		"exitCode":    strconv.Itoa(int(terminated.ExitCode)),
+		"memoryLimit": "none",
+	}
+
+	if memoryLimit != "" {
+		details["memoryLimit"] = memoryLimit
+	}
+
+	if terminated.Message != "" {
+		details["message"] = terminated.Message
+	}
+
+	return details
+}
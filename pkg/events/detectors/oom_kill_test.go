@@ -0,0 +1,314 @@
+package detectors
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/events"
+)
+
+// OOMKillDetectorSuite contains tests for OOMKillDetector
+type OOMKillDetectorSuite struct {
+	suite.Suite
+	detector *OOMKillDetector
+}
+
+func TestOOMKillDetectorSuite(t *testing.T) {
+	suite.Run(t, new(OOMKillDetectorSuite))
+}
+
+// SetupTest runs before each test
+func (s *OOMKillDetectorSuite) SetupTest() {
+	s.detector = NewOOMKillDetector()
+}
+
+// TestOOMKillDetector_RestartedContainer tests detection of OOMKilled containers that were restarted
+func (s *OOMKillDetectorSuite) TestOOMKillDetector_RestartedContainer() {
+	s.Run("restart with OOMKilled last termination emits signal", func() {
+		oldPod := createPodWithMemoryLimit("test-pod", "default", "app-container", "256Mi", 0)
+		newPod := createPodWithMemoryLimit("test-pod", "default", "app-container", "256Mi", 1)
+		newPod.Status.ContainerStatuses[0].State.Running = &corev1.ContainerStateRunning{}
+		newPod.Status.ContainerStatuses[0].LastTerminationState.Terminated = &corev1.ContainerStateTerminated{
+			ExitCode: 137,
+			Reason:   "OOMKilled",
+		}
+
+		signals := s.detector.Detect(oldPod, newPod)
+
+		s.Require().Len(signals, 1, "expected one fault signal for OOMKill")
+		signal := signals[0]
+
+		s.Equal(events.FaultTypeOOMKilled, signal.FaultType)
+		s.Equal(types.UID(newPod.UID), signal.ResourceUID)
+		s.Equal("Pod", signal.Kind)
+		s.Equal("test-pod", signal.Name)
+		s.Equal("default", signal.Namespace)
+		s.Equal("app-container", signal.ContainerName)
+		s.Equal(events.SeverityWarning, signal.Severity)
+		s.Contains(signal.Context, "OOMKilled")
+		s.Contains(signal.Context, "exit code 137")
+		s.Contains(signal.Context, "memory limit: 256Mi")
+		s.False(signal.Timestamp.IsZero())
+	})
+
+	s.Run("restart with OOMKilled current termination emits signal", func() {
+		oldPod := createPodWithMemoryLimit("test-pod", "default", "app-container", "1Gi", 2)
+		newPod := createPodWithMemoryLimit("test-pod", "default", "app-container", "1Gi", 3)
+		newPod.Status.ContainerStatuses[0].State.Terminated = &corev1.ContainerStateTerminated{
+			ExitCode: 137,
+			Reason:   "OOMKilled",
+			Message:  "Memory cgroup out of memory",
+		}
+
+		signals := s.detector.Detect(oldPod, newPod)
+
+		s.Require().Len(signals, 1)
+		s.Contains(signals[0].Context, "memory limit: 1Gi")
+		s.Contains(signals[0].Context, "message: Memory cgroup out of memory")
+	})
+
+	s.Run("OOMKilled last termination without restart does not emit signal", func() {
+		oldPod := createPodWithMemoryLimit("test-pod", "default", "app-container", "256Mi", 1)
+		oldPod.Status.ContainerStatuses[0].LastTerminationState.Terminated = &corev1.ContainerStateTerminated{
+			ExitCode: 137,
+			Reason:   "OOMKilled",
+		}
+		newPod := oldPod.DeepCopy()
+
+		signals := s.detector.Detect(oldPod, newPod)
+
+		s.Empty(signals, "previously reported OOMKill should not emit signal again")
+	})
+}
+
+// TestOOMKillDetector_NonRestartedContainer tests detection for containers with restartPolicy Never
+func (s *OOMKillDetectorSuite) TestOOMKillDetector_NonRestartedContainer() {
+	s.Run("transition into OOMKilled terminated state emits signal", func() {
+		oldPod := createPodWithMemoryLimit("test-pod", "default", "app-container", "128Mi", 0)
+		oldPod.Status.ContainerStatuses[0].State.Running = &corev1.ContainerStateRunning{}
+		newPod := createPodWithMemoryLimit("test-pod", "default", "app-container", "128Mi", 0)
+		newPod.Status.ContainerStatuses[0].State.Terminated = &corev1.ContainerStateTerminated{
+			ExitCode: 137,
+			Reason:   "OOMKilled",
+		}
+
+		signals := s.detector.Detect(oldPod, newPod)
+
+		s.Require().Len(signals, 1)
+		s.Equal(events.FaultTypeOOMKilled, signals[0].FaultType)
+		s.Contains(signals[0].Context, "memory limit: 128Mi")
+	})
+
+	s.Run("already OOMKilled terminated state does not emit signal", func() {
+		oldPod := createPodWithMemoryLimit("test-pod", "default", "app-container", "128Mi", 0)
+		oldPod.Status.ContainerStatuses[0].State.Terminated = &corev1.ContainerStateTerminated{
+			ExitCode: 137,
+			Reason:   "OOMKilled",
+		}
+		newPod := oldPod.DeepCopy()
+
+		signals := s.detector.Detect(oldPod, newPod)
+
+		s.Empty(signals)
+	})
+}
+
+// TestOOMKillDetector_NonOOMCrashes tests that crashes for other reasons are ignored
+func (s *OOMKillDetectorSuite) TestOOMKillDetector_NonOOMCrashes() {
+	s.Run("restart with Error termination does not emit signal", func() {
+		oldPod := createPodWithMemoryLimit("test-pod", "default", "app-container", "256Mi", 0)
+		newPod := createPodWithMemoryLimit("test-pod", "default", "app-container", "256Mi", 1)
+		newPod.Status.ContainerStatuses[0].LastTerminationState.Terminated = &corev1.ContainerStateTerminated{
+			ExitCode: 1,
+			Reason:   "Error",
+		}
+
+		signals := s.detector.Detect(oldPod, newPod)
+
+		s.Empty(signals, "non-OOM crashes are reported by PodCrashDetector")
+	})
+
+	s.Run("exit code 137 without OOMKilled reason does not emit signal", func() {
+		oldPod := createPodWithMemoryLimit("test-pod", "default", "app-container", "256Mi", 0)
+		newPod := createPodWithMemoryLimit("test-pod", "default", "app-container", "256Mi", 1)
+		newPod.Status.ContainerStatuses[0].State.Terminated = &corev1.ContainerStateTerminated{
+			ExitCode: 137,
+			Reason:   "Error",
+		}
+
+		signals := s.detector.Detect(oldPod, newPod)
+
+		s.Empty(signals, "SIGKILL for other reasons is not an OOMKill")
+	})
+
+	s.Run("OOMKilled container is not reported by PodCrashDetector", func() {
+		oldPod := createPodWithMemoryLimit("test-pod", "default", "app-container", "256Mi", 0)
+		newPod := createPodWithMemoryLimit("test-pod", "default", "app-container", "256Mi", 1)
+		newPod.Status.ContainerStatuses[0].State.Terminated = &corev1.ContainerStateTerminated{
+			ExitCode: 137,
+			Reason:   "OOMKilled",
+		}
+
+		s.Len(s.detector.Detect(oldPod, newPod), 1)
+		s.Empty(NewPodCrashDetector().Detect(oldPod, newPod), "OOMKill should only be reported once")
+	})
+}
+
+// TestOOMKillDetector_MemoryLimit tests the memory limit in the signal context
+func (s *OOMKillDetectorSuite) TestOOMKillDetector_MemoryLimit() {
+	s.Run("container without memory limit reports none", func() {
+		oldPod := createPodWithMemoryLimit("test-pod", "default", "app-container", "", 0)
+		newPod := createPodWithMemoryLimit("test-pod", "default", "app-container", "", 1)
+		newPod.Status.ContainerStatuses[0].LastTerminationState.Terminated = &corev1.ContainerStateTerminated{
+			ExitCode: 137,
+			Reason:   "OOMKilled",
+		}
+
+		signals := s.detector.Detect(oldPod, newPod)
+
+		s.Require().Len(signals, 1)
+		s.Contains(signals[0].Context, "memory limit: none")
+	})
+
+	s.Run("memory limit is taken from the OOMKilled container", func() {
+		oldPod := createPodWithMemoryLimit("test-pod", "default", "app-container", "256Mi", 0)
+		sidecar := corev1.Container{
+			Name: "sidecar",
+			Resources: corev1.ResourceRequirements{
+				Limits: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("64Mi")},
+			},
+		}
+		oldPod.Spec.Containers = append(oldPod.Spec.Containers, sidecar)
+		oldPod.Status.ContainerStatuses = append(oldPod.Status.ContainerStatuses, corev1.ContainerStatus{Name: "sidecar"})
+
+		newPod := oldPod.DeepCopy()
+		newPod.Status.ContainerStatuses[1].RestartCount = 1
+		newPod.Status.ContainerStatuses[1].LastTerminationState.Terminated = &corev1.ContainerStateTerminated{
+			ExitCode: 137,
+			Reason:   "OOMKilled",
+		}
+
+		signals := s.detector.Detect(oldPod, newPod)
+
+		s.Require().Len(signals, 1)
+		s.Equal("sidecar", signals[0].ContainerName)
+		s.Contains(signals[0].Context, "memory limit: 64Mi")
+		s.NotContains(signals[0].Context, "256Mi")
+	})
+}
+
+// TestOOMKillDetector_EdgeCases tests edge cases
+func (s *OOMKillDetectorSuite) TestOOMKillDetector_EdgeCases() {
+	s.Run("nil newObj returns empty slice", func() {
+		signals := s.detector.Detect(nil, nil)
+		s.NotNil(signals)
+		s.Empty(signals)
+	})
+
+	s.Run("nil oldObj (Add event) returns empty slice", func() {
+		newPod := createPodWithMemoryLimit("test-pod", "default", "app-container", "256Mi", 1)
+		newPod.Status.ContainerStatuses[0].LastTerminationState.Terminated = &corev1.ContainerStateTerminated{
+			ExitCode: 137,
+			Reason:   "OOMKilled",
+		}
+
+		signals := s.detector.Detect(nil, newPod)
+		s.NotNil(signals)
+		s.Empty(signals)
+	})
+
+	s.Run("non-Pod object returns empty slice", func() {
+		signals := s.detector.Detect(&corev1.Node{}, &corev1.Node{})
+		s.NotNil(signals)
+		s.Empty(signals)
+	})
+
+	s.Run("new container not in old pod does not emit signal", func() {
+		oldPod := createPodWithMemoryLimit("test-pod", "default", "app-container", "256Mi", 0)
+		newPod := createPodWithMemoryLimit("test-pod", "default", "other-container", "256Mi", 1)
+		newPod.Status.ContainerStatuses[0].LastTerminationState.Terminated = &corev1.ContainerStateTerminated{
+			ExitCode: 137,
+			Reason:   "OOMKilled",
+		}
+
+		signals := s.detector.Detect(oldPod, newPod)
+		s.Empty(signals)
+	})
+}
+
+// TestOOMKillDetector_DetectorInterface verifies interface compliance
+func (s *OOMKillDetectorSuite) TestOOMKillDetector_DetectorInterface() {
+	s.Run("OOMKillDetector implements Detector interface", func() {
+		var _ events.Detector = &OOMKillDetector{}
+		var _ events.Detector = s.detector
+	})
+}
+
+// TestOOMKillDetector_Details tests the structured fields of OOMKill signals
+func (s *OOMKillDetectorSuite) TestOOMKillDetector_Details() {
+	s.Run("details match the fields in the context", func() {
+		oldPod := createPodWithMemoryLimit("test-pod", "default", "app-container", "256Mi", 0)
+		newPod := createPodWithMemoryLimit("test-pod", "default", "app-container", "256Mi", 1)
+		newPod.Status.ContainerStatuses[0].LastTerminationState.Terminated = &corev1.ContainerStateTerminated{
+			ExitCode: 137,
+			Reason:   "OOMKilled",
+			Message:  "memory cgroup out of memory",
+		}
+
+		signals := s.detector.Detect(oldPod, newPod)
+
+		s.Require().Len(signals, 1)
+		details := signals[0].Details
+		s.Equal(map[string]string{"exitCode": "137", "memoryLimit": "256Mi", "message": "memory cgroup out of memory"}, details)
+		s.Contains(signals[0].Context, "exit code "+details["exitCode"])
+		s.Contains(signals[0].Context, "memory limit: "+details["memoryLimit"])
+		s.Contains(signals[0].Context, "message: "+details["message"])
+	})
+
+	s.Run("container without memory limit reports none", func() {
+		oldPod := createPodWithMemoryLimit("test-pod", "default", "app-container", "", 0)
+		newPod := createPodWithMemoryLimit("test-pod", "default", "app-container", "", 1)
+		newPod.Status.ContainerStatuses[0].LastTerminationState.Terminated = &corev1.ContainerStateTerminated{
+			ExitCode: 137,
+			Reason:   "OOMKilled",
+		}
+
+		signals := s.detector.Detect(oldPod, newPod)
+
+		s.Require().Len(signals, 1)
+		s.Equal(map[string]string{"exitCode": "137", "memoryLimit": "none"}, signals[0].Details)
+		s.Contains(signals[0].Context, "memory limit: none")
+	})
+}
+
+// Helper function to create a pod with a single container, an optional memory limit and a container status
+func createPodWithMemoryLimit(name, namespace, containerName, memoryLimit string, restartCount int32) *corev1.Pod {
+	container := corev1.Container{Name: containerName}
+	if memoryLimit != "" {
+		container.Resources.Limits = corev1.ResourceList{corev1.ResourceMemory: resource.MustParse(memoryLimit)}
+	}
+
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+			UID:       "test-uid-123",
+		},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{container},
+		},
+		Status: corev1.PodStatus{
+			ContainerStatuses: []corev1.ContainerStatus{
+				{
+					Name:         containerName,
+					RestartCount: restartCount,
+				},
+			},
+		},
+	}
+}
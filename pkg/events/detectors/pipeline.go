@@ -0,0 +1,63 @@
+package detectors
+
+import (
+	"fmt"
+	"time"
+
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/events"
+)
+
+// FaultPipelineOptions customize the fault pipeline built by NewDefaultFaultPipeline.
+// The zero value runs every built-in detector that isn't opt-in on all namespaces,
+// with the default deduplication window.
+type FaultPipelineOptions struct {
+	// DetectorTypes selects the detectors to run by the fault type they are registered
+	// under in DefaultRegistry, including opt-in ones.
+	// If empty, every detector that isn't opt-in runs.
+	DetectorTypes []string
+	// DeduplicationWindow is how long a fault condition is suppressed after it was
+	// reported. Zero uses events.DeduplicationTTL and negative values are rejected.
+	DeduplicationWindow time.Duration
+	// NamespaceScope limits the namespaced resources watched to a single namespace
+	// (see events.ResourceWatcherConfig.NamespaceScope). If empty, all namespaces are watched.
+	NamespaceScope string
+	// LabelSelector limits the watched resources to those matching the selector
+	// (see events.ResourceWatcherConfig.LabelSelector). If empty, all resources are watched.
+	LabelSelector string
+	// SendInitialState also reports the faults resources are already in when the
+	// pipeline starts (see events.ResourceWatcherConfig.SendInitialState).
+	SendInitialState bool
+}
+
+// NewDefaultFaultPipeline creates a ResourceWatcher running the built-in detectors of
+// DefaultRegistry on cluster through clientset, with a deduplicator and the default
+// context enricher, and calling signalCallback with each detected fault. The watcher
+// is returned unstarted: call Start to begin watching and Stop once done.
+// Returns an error if a detector type isn't registered or the options are invalid.
+func NewDefaultFaultPipeline(clientset kubernetes.Interface, cluster string, signalCallback events.FaultSignalCallback, opts FaultPipelineOptions) (*events.ResourceWatcher, error) {
+	switch {
+	case opts.DeduplicationWindow < 0:
+		return nil, fmt.Errorf("deduplication window must not be negative, got %s", opts.DeduplicationWindow)
+	case opts.DeduplicationWindow == 0:
+		opts.DeduplicationWindow = events.DeduplicationTTL
+	}
+
+	detectors, err := DefaultRegistry.Build(opts.DetectorTypes)
+	if err != nil {
+		return nil, err
+	}
+
+	return events.NewResourceWatcher(events.ResourceWatcherConfig{
+		Clientset:        clientset,
+		Cluster:          cluster,
+		Detectors:        detectors,
+		Deduplicator:     events.NewFaultDeduplicatorWithTTL(opts.DeduplicationWindow),
+		Enricher:         events.NewFaultContextEnricher(),
+		SignalCallback:   signalCallback,
+		NamespaceScope:   opts.NamespaceScope,
+		LabelSelector:    opts.LabelSelector,
+		SendInitialState: opts.SendInitialState,
+	})
+}
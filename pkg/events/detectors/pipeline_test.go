@@ -0,0 +1,128 @@
+package detectors
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/events"
+)
+
+// FaultPipelineSuite contains tests for NewDefaultFaultPipeline
+type FaultPipelineSuite struct {
+	suite.Suite
+}
+
+func TestFaultPipelineSuite(t *testing.T) {
+	suite.Run(t, new(FaultPipelineSuite))
+}
+
+// TestNewDefaultFaultPipeline tests building and running the default fault pipeline
+func (s *FaultPipelineSuite) TestNewDefaultFaultPipeline() {
+	s.Run("reports faults detected by the built-in detectors", func() {
+		pod := createPodWithContainerStatus("web", "default", "app", 0, nil)
+		clientset := fake.NewClientset(pod)
+		signals := make(chan events.FaultSignal, 10)
+		watcher, err := NewDefaultFaultPipeline(clientset, "test-cluster", func(_ context.Context, signal events.FaultSignal) {
+			signals <- signal
+		}, FaultPipelineOptions{})
+		s.Require().NoError(err)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		s.Require().NoError(watcher.Start(ctx))
+		defer watcher.Stop()
+
+		crashed := createPodWithContainerStatus("web", "default", "app", 1, &corev1.ContainerStateTerminated{ExitCode: 1, Reason: "Error"})
+		crashed.ResourceVersion = "2"
+		_, err = clientset.CoreV1().Pods("default").UpdateStatus(ctx, crashed, metav1.UpdateOptions{})
+		s.Require().NoError(err)
+
+		select {
+		case signal := <-signals:
+			s.Equal(events.FaultTypePodCrash, signal.FaultType)
+			s.Equal("web", signal.Name)
+		case <-time.After(5 * time.Second):
+			s.Fail("timed out waiting for pod crash fault signal")
+		}
+	})
+
+	s.Run("counts slices that were never updated", func() {
+		sliceA := createFlappingSlice("web-a", "web", 2)
+		sliceA.UID = "slice-uid-web-a"
+		sliceB := createFlappingSlice("web-b", "web", 3)
+		sliceB.UID = "slice-uid-web-b"
+		clientset := fake.NewClientset(sliceA, sliceB)
+		signals := make(chan events.FaultSignal, 10)
+		watcher, err := NewDefaultFaultPipeline(clientset, "test-cluster", func(_ context.Context, signal events.FaultSignal) {
+			signals <- signal
+		}, FaultPipelineOptions{DetectorTypes: []string{string(events.FaultTypeNoEndpoints)}})
+		s.Require().NoError(err)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		s.Require().NoError(watcher.Start(ctx))
+		defer watcher.Stop()
+
+		emptied := withReadyEndpoints(sliceA, 0)
+		emptied.ResourceVersion = "2"
+		_, err = clientset.DiscoveryV1().EndpointSlices("default").Update(ctx, emptied, metav1.UpdateOptions{})
+		s.Require().NoError(err)
+		s.Never(func() bool { return len(signals) > 0 }, 200*time.Millisecond, 10*time.Millisecond,
+			"web-b still has ready endpoints")
+
+		s.Require().NoError(clientset.DiscoveryV1().EndpointSlices("default").Delete(ctx, "web-b", metav1.DeleteOptions{}))
+		restored := withReadyEndpoints(emptied, 1)
+		restored.ResourceVersion = "3"
+		_, err = clientset.DiscoveryV1().EndpointSlices("default").Update(ctx, restored, metav1.UpdateOptions{})
+		s.Require().NoError(err)
+		emptied = withReadyEndpoints(restored, 0)
+		emptied.ResourceVersion = "4"
+		_, err = clientset.DiscoveryV1().EndpointSlices("default").Update(ctx, emptied, metav1.UpdateOptions{})
+		s.Require().NoError(err)
+
+		select {
+		case signal := <-signals:
+			s.Equal(events.FaultTypeNoEndpoints, signal.FaultType)
+			s.Equal("web", signal.Name)
+		case <-time.After(5 * time.Second):
+			s.Fail("timed out waiting for no endpoints fault signal once web-b was deleted")
+		}
+	})
+
+	s.Run("runs only the selected detectors", func() {
+		watcher, err := NewDefaultFaultPipeline(fake.NewClientset(), "test-cluster", nil, FaultPipelineOptions{
+			DetectorTypes: []string{string(events.FaultTypeNodeUnhealthy)},
+		})
+		s.Require().NoError(err)
+		s.NotNil(watcher)
+	})
+
+	s.Run("rejects unknown detector types", func() {
+		_, err := NewDefaultFaultPipeline(fake.NewClientset(), "test-cluster", nil, FaultPipelineOptions{
+			DetectorTypes: []string{"NoSuchFault"},
+		})
+		s.Require().Error(err)
+		s.Contains(err.Error(), "NoSuchFault")
+	})
+
+	s.Run("rejects a negative deduplication window", func() {
+		_, err := NewDefaultFaultPipeline(fake.NewClientset(), "test-cluster", nil, FaultPipelineOptions{
+			DeduplicationWindow: -time.Minute,
+		})
+		s.Require().Error(err)
+		s.Contains(err.Error(), "deduplication window must not be negative")
+	})
+
+	s.Run("rejects an invalid label selector", func() {
+		_, err := NewDefaultFaultPipeline(fake.NewClientset(), "test-cluster", nil, FaultPipelineOptions{
+			LabelSelector: "app in (",
+		})
+		s.Error(err)
+	})
+}
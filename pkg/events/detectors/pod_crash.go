@@ -2,6 +2,7 @@ package detectors
 
 import (
 	"fmt"
+	"strconv"
 	"time"
 
 	corev1 "k8s.io/api/core/v1"
@@ -14,13 +15,43 @@ import (
 // between old and new pod states. A crash is detected when:
 // 1. RestartCount increases for a container
 // 2. The container is in Terminated state with a non-zero exit code
-type PodCrashDetector struct{}
+// Containers terminated with reason OOMKilled are left to OOMKillDetector.
+type PodCrashDetector struct {
+	// requireTerminationMessage drops crashes of containers that left no termination message
+	requireTerminationMessage bool
+}
 
 // NewPodCrashDetector creates a new PodCrashDetector instance.
 func NewPodCrashDetector() *PodCrashDetector {
 	return &PodCrashDetector{}
 }
 
+// NewPodCrashDetectorWithTerminationMessageRequired creates a PodCrashDetector that only
+// reports crashes of containers that wrote a termination message, for example containers
+// with terminationMessagePolicy FallbackToLogsOnError, so alerts always carry diagnostics.
+func NewPodCrashDetectorWithTerminationMessageRequired() *PodCrashDetector {
+	return &PodCrashDetector{requireTerminationMessage: true}
+}
+
+// FaultType returns the type of the fault signals emitted by this detector.
+func (d *PodCrashDetector) FaultType() events.FaultType {
+	return events.FaultTypePodCrash
+}
+
+// ResourceKind returns the kind of resource this detector inspects.
+func (d *PodCrashDetector) ResourceKind() string {
+	return "Pod"
+}
+
+// Describe returns the fault type emitted and the resource kind inspected by this detector.
+func (d *PodCrashDetector) Describe() events.DetectorInfo {
+	return events.DetectorInfo{
+		FaultTypes:    []events.FaultType{d.FaultType()},
+		ResourceKinds: []string{d.ResourceKind()},
+		Description:   "Reports containers restarting after exiting with a non-zero exit code.",
+	}
+}
+
 // Detect analyzes pod state changes and returns fault signals for detected crashes.
 // It compares container statuses between oldObj and newObj, looking for RestartCount
 // increases combined with Terminated state and non-zero exit codes.
@@ -79,8 +110,17 @@ func (d *PodCrashDetector) Detect(oldObj, newObj interface{}) []events.FaultSign
 			continue
 		}
 
+		if terminated.Reason == oomKilledReason {
+			// OOMKills are reported with their own fault type by OOMKillDetector
+			continue
+		}
+
 		// We have a crash: RestartCount increased and container terminated with error
-		context := buildCrashContext(terminated)
+
+		if d.requireTerminationMessage && extractTerminationMessage(newPod, newStatus.Name) == "" {
+			// Only crashes carrying diagnostics were asked for
+			continue
+		}
 
 		signal := events.FaultSignal{
 			FaultType:     events.FaultTypePodCrash,
@@ -90,7 +130,8 @@ func (d *PodCrashDetector) Detect(oldObj, newObj interface{}) []events.FaultSign
 			Namespace:     newPod.Namespace,
 			ContainerName: newStatus.Name,
 			Severity:      events.SeverityWarning,
-			Context:       context,
+			Context:       buildCrashContext(terminated),
+			Details:       buildCrashDetails(terminated),
 			Timestamp:     time.Now(),
 		}
 
@@ -133,3 +174,20 @@ func buildCrashContext(terminated *corev1.ContainerStateTerminated) string {
 
 	return context
 }
+
+// buildCrashDetails returns the fields embedded in the crash context.
+func buildCrashDetails(terminated *corev1.ContainerStateTerminated) map[string]string {
+	details := map[string]string{
+		"exitCode": strconv.Itoa(int(terminated.ExitCode)),
+	}
+
+	if terminated.Reason != "" {
+		details["reason"] = terminated.Reason
+	}
+
+	if terminated.Message != "" {
+		details["message"] = terminated.Message
+	}
+
+	return details
+}
@@ -70,9 +70,9 @@ func (s *PodCrashDetectorSuite) TestPodCrashDetector_RestartCountIncrease() {
 	s.Run("RestartCount 2->3 with non-zero exit code emits signal", func() {
 		oldPod := createPodWithContainerStatus("test-pod", "default", "app-container", 2, nil)
 		newPod := createPodWithContainerStatus("test-pod", "default", "app-container", 3, &corev1.ContainerStateTerminated{
-			ExitCode: 137,
-			Reason:   "OOMKilled",
-			Message:  "Out of memory",
+			ExitCode: 139,
+			Reason:   "Error",
+			Message:  "Segmentation fault",
 		})
 
 		signals := s.detector.Detect(oldPod, newPod)
@@ -81,8 +81,21 @@ func (s *PodCrashDetectorSuite) TestPodCrashDetector_RestartCountIncrease() {
 		signal := signals[0]
 
 		s.Equal(events.FaultTypePodCrash, signal.FaultType)
-		s.Contains(signal.Context, "exit code 137")
-		s.Contains(signal.Context, "reason: OOMKilled")
+		s.Contains(signal.Context, "exit code 139")
+		s.Contains(signal.Context, "reason: Error")
+	})
+
+	s.Run("RestartCount 2->3 with OOMKilled termination does not emit signal", func() {
+		oldPod := createPodWithContainerStatus("test-pod", "default", "app-container", 2, nil)
+		newPod := createPodWithContainerStatus("test-pod", "default", "app-container", 3, &corev1.ContainerStateTerminated{
+			ExitCode: 137,
+			Reason:   "OOMKilled",
+			Message:  "Out of memory",
+		})
+
+		signals := s.detector.Detect(oldPod, newPod)
+
+		s.Empty(signals, "OOMKilled containers should be reported by OOMKillDetector")
 	})
 }
 
@@ -426,9 +439,9 @@ func (s *PodCrashDetectorSuite) TestPodCrashDetector_ContextBuilding() {
 	s.Run("context includes exit code, reason, and message", func() {
 		oldPod := createPodWithContainerStatus("test-pod", "default", "app-container", 0, nil)
 		newPod := createPodWithContainerStatus("test-pod", "default", "app-container", 1, &corev1.ContainerStateTerminated{
-			ExitCode: 137,
-			Reason:   "OOMKilled",
-			Message:  "Container exceeded memory limits",
+			ExitCode: 143,
+			Reason:   "Error",
+			Message:  "Container received SIGTERM",
 		})
 
 		signals := s.detector.Detect(oldPod, newPod)
@@ -436,9 +449,9 @@ func (s *PodCrashDetectorSuite) TestPodCrashDetector_ContextBuilding() {
 		s.Require().Len(signals, 1)
 		context := signals[0].Context
 
-		s.Contains(context, "exit code 137")
-		s.Contains(context, "reason: OOMKilled")
-		s.Contains(context, "message: Container exceeded memory limits")
+		s.Contains(context, "exit code 143")
+		s.Contains(context, "reason: Error")
+		s.Contains(context, "message: Container received SIGTERM")
 	})
 
 	s.Run("context includes only exit code when reason and message are empty", func() {
@@ -616,22 +629,87 @@ func (s *PodCrashDetectorSuite) TestTerminationMessage() {
 		s.Contains(signals[0].Context, "exit code 1")
 		s.NotContains(signals[0].Context, "message:", "empty message should not add 'message:' to context")
 	})
+}
 
-	s.Run("multiline termination message with OOMKilled", func() {
-		oomMessage := "Container was OOMKilled\nMemory limit: 512Mi\nPeak usage: 723Mi\nLast allocation: 180Mi"
-		oldPod := createPodWithContainerStatus("test-pod", "default", "memory-hog", 0, nil)
-		newPod := createPodWithContainerStatus("test-pod", "default", "memory-hog", 1, &corev1.ContainerStateTerminated{
-			ExitCode: 137,
-			Reason:   "OOMKilled",
-			Message:  oomMessage,
+// TestPodCrashDetector_TerminationMessageRequired tests the option to only report crashes with a termination message
+func (s *PodCrashDetectorSuite) TestPodCrashDetector_TerminationMessageRequired() {
+	s.Run("crash with a termination message emits signal", func() {
+		detector := NewPodCrashDetectorWithTerminationMessageRequired()
+		oldPod := createPodWithContainerStatus("test-pod", "default", "app-container", 0, nil)
+		newPod := createPodWithContainerStatus("test-pod", "default", "app-container", 1, &corev1.ContainerStateTerminated{
+			ExitCode: 1,
+			Reason:   "Error",
+			Message:  "failed to load config: open /etc/app/config.yaml: no such file or directory",
+		})
+
+		signals := detector.Detect(oldPod, newPod)
+
+		s.Require().Len(signals, 1)
+		s.Equal(events.FaultTypePodCrash, signals[0].FaultType)
+		s.Equal("failed to load config: open /etc/app/config.yaml: no such file or directory", signals[0].Details["message"])
+	})
+
+	s.Run("crash with an empty termination message does not emit signal", func() {
+		detector := NewPodCrashDetectorWithTerminationMessageRequired()
+		oldPod := createPodWithContainerStatus("test-pod", "default", "app-container", 0, nil)
+		newPod := createPodWithContainerStatus("test-pod", "default", "app-container", 1, &corev1.ContainerStateTerminated{
+			ExitCode: 1,
+			Reason:   "Error",
 		})
 
+		s.Empty(detector.Detect(oldPod, newPod))
+		s.Len(s.detector.Detect(oldPod, newPod), 1, "the default detector still reports the crash")
+	})
+
+	s.Run("only containers with a termination message are reported", func() {
+		detector := NewPodCrashDetectorWithTerminationMessageRequired()
+		oldPod := createPodWithContainerStatus("test-pod", "default", "app-container", 0, nil)
+		oldPod.Status.ContainerStatuses = append(oldPod.Status.ContainerStatuses, corev1.ContainerStatus{Name: "sidecar"})
+		newPod := createPodWithContainerStatus("test-pod", "default", "app-container", 1, &corev1.ContainerStateTerminated{
+			ExitCode: 1,
+			Message:  "panic: boom",
+		})
+		newPod.Status.ContainerStatuses = append(newPod.Status.ContainerStatuses, corev1.ContainerStatus{
+			Name:         "sidecar",
+			RestartCount: 1,
+			State:        corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{ExitCode: 137}},
+		})
+
+		signals := detector.Detect(oldPod, newPod)
+
+		s.Require().Len(signals, 1)
+		s.Equal("app-container", signals[0].ContainerName)
+	})
+}
+
+// TestPodCrashDetector_Details tests the structured fields of crash signals
+func (s *PodCrashDetectorSuite) TestPodCrashDetector_Details() {
+	s.Run("details match the fields in the context", func() {
+		oldPod := createPodWithContainerStatus("test-pod", "default", "app-container", 0, nil)
+		newPod := createPodWithContainerStatus("test-pod", "default", "app-container", 1, &corev1.ContainerStateTerminated{
+			ExitCode: 1,
+			Reason:   "Error",
+			Message:  "Container failed",
+		})
+
+		signals := s.detector.Detect(oldPod, newPod)
+
+		s.Require().Len(signals, 1)
+		details := signals[0].Details
+		s.Equal(map[string]string{"exitCode": "1", "reason": "Error", "message": "Container failed"}, details)
+		s.Contains(signals[0].Context, "exit code "+details["exitCode"])
+		s.Contains(signals[0].Context, "reason: "+details["reason"])
+		s.Contains(signals[0].Context, "message: "+details["message"])
+	})
+
+	s.Run("empty reason and message are omitted", func() {
+		oldPod := createPodWithContainerStatus("test-pod", "default", "app-container", 0, nil)
+		newPod := createPodWithContainerStatus("test-pod", "default", "app-container", 1, &corev1.ContainerStateTerminated{ExitCode: 2})
+
 		signals := s.detector.Detect(oldPod, newPod)
 
 		s.Require().Len(signals, 1)
-		s.Contains(signals[0].Context, "exit code 137")
-		s.Contains(signals[0].Context, "reason: OOMKilled")
-		s.Contains(signals[0].Context, oomMessage, "multiline OOM message should be preserved in context")
+		s.Equal(map[string]string{"exitCode": "2"}, signals[0].Details)
 	})
 }
 
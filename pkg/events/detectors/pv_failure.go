@@ -0,0 +1,183 @@
+package detectors
+
+import (
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/events"
+)
+
+// DefaultPVReleasedWindow is how long a PersistentVolume with a Delete or Recycle reclaim
+// policy may stay Released before it is reported, giving the reclaim time to complete.
+const DefaultPVReleasedWindow = 5 * time.Minute
+
+// PVDetector detects PersistentVolumes whose reclaim failed. A fault is detected when:
+// 1. The PV transitions to the Failed phase, which the PV controller sets when
+// recycling or deleting the volume fails
+// 2. The PV has stayed Released for longer than the window although its reclaim policy
+// is Delete or Recycle, so the reclaim never succeeded
+//
+// Released PVs with the Retain reclaim policy are expected to stay Released until an
+// administrator reclaims them and are never reported, nor are PVs being deleted. How long
+// a PV has been Released is taken from its Status.LastPhaseTransitionTime, so PVs without
+// it are not reported as stuck. Repeated signals for the same PV are suppressed by the
+// ResourceWatcher's FaultDeduplicator.
+type PVDetector struct {
+	window time.Duration
+	now    func() time.Time // allows time injection for testing
+}
+
+// NewPVDetector creates a new PVDetector with the default window.
+func NewPVDetector() *PVDetector {
+	return NewPVDetectorWithWindow(DefaultPVReleasedWindow)
+}
+
+// NewPVDetectorWithWindow creates a new PVDetector that reports PersistentVolumes
+// Released for longer than window without being reclaimed.
+func NewPVDetectorWithWindow(window time.Duration) *PVDetector {
+	return &PVDetector{
+		window: window,
+		now:    time.Now,
+	}
+}
+
+// FaultType returns the type of the fault signals emitted by this detector.
+func (d *PVDetector) FaultType() events.FaultType {
+	return events.FaultTypePVFailed
+}
+
+// ResourceKind returns the kind of resource this detector inspects.
+func (d *PVDetector) ResourceKind() string {
+	return "PersistentVolume"
+}
+
+// Describe returns the fault type emitted and the resource kind inspected by this detector.
+func (d *PVDetector) Describe() events.DetectorInfo {
+	return events.DetectorInfo{
+		FaultTypes:    []events.FaultType{d.FaultType()},
+		ResourceKinds: []string{d.ResourceKind()},
+		Description:   "Reports PersistentVolumes whose recycle or delete failed, or that stay Released without being reclaimed.",
+	}
+}
+
+// Detect analyzes PersistentVolume state changes and returns fault signals for PVs
+// transitioning to the Failed phase or stuck Released past the window.
+func (d *PVDetector) Detect(oldObj, newObj interface{}) []events.FaultSignal {
+	// Handle nil newObj - nothing to detect
+	if newObj == nil {
+		return []events.FaultSignal{}
+	}
+
+	// Type assert to PersistentVolume
+	newPV, ok := newObj.(*corev1.PersistentVolume)
+	if !ok {
+		return []events.FaultSignal{}
+	}
+
+	// If oldObj is nil (Add event), no transition to detect
+	if oldObj == nil {
+		return []events.FaultSignal{}
+	}
+
+	oldPV, ok := oldObj.(*corev1.PersistentVolume)
+	if !ok {
+		return []events.FaultSignal{}
+	}
+
+	// PVs being deleted are on their way out, whatever their phase
+	if newPV.DeletionTimestamp != nil {
+		return []events.FaultSignal{}
+	}
+
+	now := d.now()
+	switch {
+	case newPV.Status.Phase == corev1.VolumeFailed && oldPV.Status.Phase != corev1.VolumeFailed:
+		return []events.FaultSignal{newPVFailedSignal(newPV, buildPVFailedContext(newPV), now)}
+	case d.releasedTooLong(newPV, now):
+		releasedFor := now.Sub(newPV.Status.LastPhaseTransitionTime.Time).Truncate(time.Second)
+		context := fmt.Sprintf("PersistentVolume %s has been Released for %s without being reclaimed (reclaim policy %s)",
+			newPV.Name, releasedFor, newPV.Spec.PersistentVolumeReclaimPolicy)
+		signal := newPVFailedSignal(newPV, appendPVMessage(context, newPV), now)
+		signal.Details["releasedFor"] = releasedFor.String()
+		return []events.FaultSignal{signal}
+	default:
+		return []events.FaultSignal{}
+	}
+}
+
+// releasedTooLong reports whether a PV expected to be reclaimed has been Released for
+// longer than the window.
+func (d *PVDetector) releasedTooLong(pv *corev1.PersistentVolume, now time.Time) bool {
+	if pv.Status.Phase != corev1.VolumeReleased || pv.Spec.PersistentVolumeReclaimPolicy == corev1.PersistentVolumeReclaimRetain {
+		return false
+	}
+	if pv.Status.LastPhaseTransitionTime == nil {
+		return false
+	}
+	return now.Sub(pv.Status.LastPhaseTransitionTime.Time) > d.window
+}
+
+// newPVFailedSignal creates the fault signal for a PV whose reclaim failed.
+func newPVFailedSignal(pv *corev1.PersistentVolume, context string, now time.Time) events.FaultSignal {
+	return events.FaultSignal{
+		FaultType:   events.FaultTypePVFailed,
+		ResourceUID: types.UID(pv.UID),
+		Kind:        "PersistentVolume",
+		Name:        pv.Name,
+		Namespace:   "", // PersistentVolumes are cluster-scoped
+		Severity:    events.SeverityWarning,
+		Context:     context,
+		Details:     buildPVFailedDetails(pv),
+		Timestamp:   now,
+	}
+}
+
+// buildPVFailedContext creates a human-readable context string for a failed PV,
+// including its reclaim policy and the failure message.
+func buildPVFailedContext(pv *corev1.PersistentVolume) string {
+	context := fmt.Sprintf("PersistentVolume %s failed (reclaim policy %s)", pv.Name, pv.Spec.PersistentVolumeReclaimPolicy)
+	return appendPVMessage(context, pv)
+}
+
+// appendPVMessage appends the PV's released claim and status message to context, if set.
+func appendPVMessage(context string, pv *corev1.PersistentVolume) string {
+	if claim := pvClaim(pv); claim != "" {
+		context += fmt.Sprintf(", claim: %s", claim)
+	}
+
+	if pv.Status.Message != "" {
+		context += fmt.Sprintf(", message: %s", pv.Status.Message)
+	}
+
+	return context
+}
+
+// buildPVFailedDetails returns the fields embedded in the PV failure context.
+func buildPVFailedDetails(pv *corev1.PersistentVolume) map[string]string {
+	details := map[string]string{
+		"phase":         string(pv.Status.Phase),
+		"reclaimPolicy": string(pv.Spec.PersistentVolumeReclaimPolicy),
+	}
+
+	if claim := pvClaim(pv); claim != "" {
+		details["claim"] = claim
+	}
+
+	if pv.Status.Message != "" {
+		details["message"] = pv.Status.Message
+	}
+
+	return details
+}
+
+// pvClaim returns the namespace/name of the claim a PV is or was bound to, or an
+// empty string if it has none.
+func pvClaim(pv *corev1.PersistentVolume) string {
+	if pv.Spec.ClaimRef == nil {
+		return ""
+	}
+	return pv.Spec.ClaimRef.Namespace + "/" + pv.Spec.ClaimRef.Name
+}
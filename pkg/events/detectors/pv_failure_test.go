@@ -0,0 +1,236 @@
+package detectors
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/events"
+)
+
+// PVDetectorSuite contains tests for PVDetector
+type PVDetectorSuite struct {
+	suite.Suite
+	detector    *PVDetector
+	currentTime time.Time
+}
+
+func TestPVDetectorSuite(t *testing.T) {
+	suite.Run(t, new(PVDetectorSuite))
+}
+
+// SetupTest runs before each test
+func (s *PVDetectorSuite) SetupTest() {
+	s.currentTime = time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	s.detector = NewPVDetectorWithWindow(5 * time.Minute)
+	s.detector.now = func() time.Time {
+		return s.currentTime
+	}
+}
+
+// TestPVDetector_Failed tests detection of PVs transitioning to the Failed phase
+func (s *PVDetectorSuite) TestPVDetector_Failed() {
+	s.Run("transition to Failed emits signal", func() {
+		oldPV := createPVWithPhase("pv-data", corev1.VolumeReleased, corev1.PersistentVolumeReclaimDelete, "")
+		newPV := createPVWithPhase("pv-data", corev1.VolumeFailed, corev1.PersistentVolumeReclaimDelete, "error deleting EBS volume: VolumeInUse")
+
+		signals := s.detector.Detect(oldPV, newPV)
+
+		s.Require().Len(signals, 1)
+		signal := signals[0]
+		s.Equal(events.FaultTypePVFailed, signal.FaultType)
+		s.Equal(types.UID("pv-uid-pv-data"), signal.ResourceUID)
+		s.Equal("PersistentVolume", signal.Kind)
+		s.Equal("pv-data", signal.Name)
+		s.Empty(signal.Namespace, "PersistentVolumes are cluster-scoped")
+		s.Equal(events.SeverityWarning, signal.Severity)
+		s.Contains(signal.Context, "PersistentVolume pv-data failed (reclaim policy Delete)")
+		s.Contains(signal.Context, "claim: default/data")
+		s.Contains(signal.Context, "message: error deleting EBS volume: VolumeInUse")
+		s.Equal(s.currentTime, signal.Timestamp)
+	})
+
+	s.Run("transition from Bound to Failed emits signal", func() {
+		oldPV := createPVWithPhase("pv-data", corev1.VolumeBound, corev1.PersistentVolumeReclaimRecycle, "")
+		newPV := createPVWithPhase("pv-data", corev1.VolumeFailed, corev1.PersistentVolumeReclaimRecycle, "recycler pod failed")
+
+		signals := s.detector.Detect(oldPV, newPV)
+
+		s.Require().Len(signals, 1)
+		s.Contains(signals[0].Context, "reclaim policy Recycle")
+	})
+
+	s.Run("no transition when already Failed", func() {
+		failed := createPVWithPhase("pv-data", corev1.VolumeFailed, corev1.PersistentVolumeReclaimDelete, "error deleting volume")
+
+		s.Empty(s.detector.Detect(failed, failed), "no state change should not emit signal")
+	})
+
+	s.Run("transitions to other phases do not emit signal", func() {
+		available := createPVWithPhase("pv-data", corev1.VolumeAvailable, corev1.PersistentVolumeReclaimDelete, "")
+		bound := createPVWithPhase("pv-data", corev1.VolumeBound, corev1.PersistentVolumeReclaimDelete, "")
+
+		s.Empty(s.detector.Detect(available, bound))
+		s.Empty(s.detector.Detect(bound, available))
+	})
+
+	s.Run("failed PV being deleted does not emit signal", func() {
+		oldPV := createPVWithPhase("pv-data", corev1.VolumeReleased, corev1.PersistentVolumeReclaimDelete, "")
+		newPV := createPVWithPhase("pv-data", corev1.VolumeFailed, corev1.PersistentVolumeReclaimDelete, "error deleting volume")
+		newPV.DeletionTimestamp = &metav1.Time{Time: s.currentTime}
+
+		s.Empty(s.detector.Detect(oldPV, newPV))
+	})
+}
+
+// TestPVDetector_Released tests detection of PVs stuck Released without being reclaimed
+func (s *PVDetectorSuite) TestPVDetector_Released() {
+	s.Run("PV released within the window does not emit signal", func() {
+		bound := createPVWithPhase("pv-data", corev1.VolumeBound, corev1.PersistentVolumeReclaimDelete, "")
+		released := createReleasedPV("pv-data", corev1.PersistentVolumeReclaimDelete, s.currentTime.Add(-2*time.Minute))
+
+		s.Empty(s.detector.Detect(bound, released), "reclaim may still be in progress")
+		s.Empty(s.detector.Detect(released, released))
+	})
+
+	s.Run("PV released past the window emits signal", func() {
+		released := createReleasedPV("pv-data", corev1.PersistentVolumeReclaimDelete, s.currentTime.Add(-6*time.Minute))
+		released.Status.Message = "waiting for external provisioner"
+
+		signals := s.detector.Detect(released, released)
+
+		s.Require().Len(signals, 1)
+		signal := signals[0]
+		s.Equal(events.FaultTypePVFailed, signal.FaultType)
+		s.Equal("PersistentVolume", signal.Kind)
+		s.Equal(events.SeverityWarning, signal.Severity)
+		s.Contains(signal.Context, "PersistentVolume pv-data has been Released for 6m0s without being reclaimed (reclaim policy Delete)")
+		s.Contains(signal.Context, "message: waiting for external provisioner")
+	})
+
+	s.Run("released PV with the Retain policy does not emit signal", func() {
+		released := createReleasedPV("pv-data", corev1.PersistentVolumeReclaimRetain, s.currentTime.Add(-24*time.Hour))
+
+		s.Empty(s.detector.Detect(released, released), "retained PVs wait for an administrator")
+	})
+
+	s.Run("released PV being deleted does not emit signal", func() {
+		released := createReleasedPV("pv-data", corev1.PersistentVolumeReclaimDelete, s.currentTime.Add(-time.Hour))
+		released.DeletionTimestamp = &metav1.Time{Time: s.currentTime}
+
+		s.Empty(s.detector.Detect(released, released))
+	})
+
+	s.Run("released PV without a phase transition time does not emit signal", func() {
+		released := createPVWithPhase("pv-data", corev1.VolumeReleased, corev1.PersistentVolumeReclaimDelete, "")
+
+		s.Empty(s.detector.Detect(released, released), "release time is unknown")
+	})
+}
+
+// TestPVDetector_EdgeCases tests edge cases and error handling
+func (s *PVDetectorSuite) TestPVDetector_EdgeCases() {
+	s.Run("returns empty slice for nil newObj", func() {
+		signals := s.detector.Detect(createPVWithPhase("pv-data", corev1.VolumeBound, corev1.PersistentVolumeReclaimDelete, ""), nil)
+		s.Empty(signals)
+		s.NotNil(signals)
+	})
+
+	s.Run("returns empty slice for nil oldObj", func() {
+		signals := s.detector.Detect(nil, createPVWithPhase("pv-data", corev1.VolumeFailed, corev1.PersistentVolumeReclaimDelete, "error"))
+		s.Empty(signals, "nil oldObj means Add event, no transition")
+	})
+
+	s.Run("returns empty slice when objects are not PersistentVolumes", func() {
+		pv := createPVWithPhase("pv-data", corev1.VolumeFailed, corev1.PersistentVolumeReclaimDelete, "error")
+		s.Empty(s.detector.Detect(pv, "not a pv"))
+		s.Empty(s.detector.Detect("not a pv", pv))
+		s.Empty(s.detector.Detect(&corev1.PersistentVolumeClaim{}, pv))
+	})
+
+	s.Run("reports failed PVs without claim or message", func() {
+		oldPV := createPVWithPhase("pv-data", corev1.VolumeReleased, corev1.PersistentVolumeReclaimDelete, "")
+		oldPV.Spec.ClaimRef = nil
+		newPV := createPVWithPhase("pv-data", corev1.VolumeFailed, corev1.PersistentVolumeReclaimDelete, "")
+		newPV.Spec.ClaimRef = nil
+
+		signals := s.detector.Detect(oldPV, newPV)
+
+		s.Require().Len(signals, 1)
+		s.Equal("PersistentVolume pv-data failed (reclaim policy Delete)", signals[0].Context)
+	})
+}
+
+// TestPVDetector_DetectorInterface verifies PVDetector implements Detector
+func (s *PVDetectorSuite) TestPVDetector_DetectorInterface() {
+	s.Run("PVDetector implements Detector interface", func() {
+		var _ events.Detector = &PVDetector{}
+		var _ events.Detector = s.detector
+		s.Equal("PersistentVolume", s.detector.ResourceKind())
+		s.Equal(events.FaultTypePVFailed, s.detector.FaultType())
+	})
+}
+
+// TestPVDetector_Details tests the structured fields of PV failure signals
+func (s *PVDetectorSuite) TestPVDetector_Details() {
+	s.Run("details match the fields in the context", func() {
+		oldPV := createPVWithPhase("pv-data", corev1.VolumeReleased, corev1.PersistentVolumeReclaimDelete, "")
+		newPV := createPVWithPhase("pv-data", corev1.VolumeFailed, corev1.PersistentVolumeReclaimDelete, "error deleting volume")
+
+		signals := s.detector.Detect(oldPV, newPV)
+
+		s.Require().Len(signals, 1)
+		details := signals[0].Details
+		s.Equal(map[string]string{
+			"phase":         "Failed",
+			"reclaimPolicy": "Delete",
+			"claim":         "default/data",
+			"message":       "error deleting volume",
+		}, details)
+		s.Contains(signals[0].Context, "reclaim policy "+details["reclaimPolicy"])
+		s.Contains(signals[0].Context, "claim: "+details["claim"])
+		s.Contains(signals[0].Context, "message: "+details["message"])
+	})
+
+	s.Run("stuck released PVs include how long they have been released", func() {
+		released := createReleasedPV("pv-data", corev1.PersistentVolumeReclaimRecycle, s.currentTime.Add(-10*time.Minute))
+
+		signals := s.detector.Detect(released, released)
+
+		s.Require().Len(signals, 1)
+		s.Equal("Released", signals[0].Details["phase"])
+		s.Equal("Recycle", signals[0].Details["reclaimPolicy"])
+		s.Equal("10m0s", signals[0].Details["releasedFor"])
+		s.Contains(signals[0].Context, "Released for "+signals[0].Details["releasedFor"])
+	})
+}
+
+// createPVWithPhase creates a PersistentVolume bound to default/data with the given phase,
+// reclaim policy and status message.
+func createPVWithPhase(name string, phase corev1.PersistentVolumePhase, policy corev1.PersistentVolumeReclaimPolicy, message string) *corev1.PersistentVolume {
+	return &corev1.PersistentVolume{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+			UID:  types.UID("pv-uid-" + name),
+		},
+		Spec: corev1.PersistentVolumeSpec{
+			PersistentVolumeReclaimPolicy: policy,
+			ClaimRef:                      &corev1.ObjectReference{Kind: "PersistentVolumeClaim", Namespace: "default", Name: "data"},
+		},
+		Status: corev1.PersistentVolumeStatus{
+			Phase:   phase,
+			Message: message,
+		},
+	}
+}
+
+// createReleasedPV creates a Released PersistentVolume that entered the phase at releasedAt.
+func createReleasedPV(name string, policy corev1.PersistentVolumeReclaimPolicy, releasedAt time.Time) *corev1.PersistentVolume {
+	pv := createPVWithPhase(name, corev1.VolumeReleased, policy, "")
+	pv.Status.LastPhaseTransitionTime = &metav1.Time{Time: releasedAt}
+	return pv
+}
@@ -0,0 +1,203 @@
+package detectors
+
+import (
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/events"
+)
+
+const (
+	// DefaultRecurringWarningThreshold is how many times a Warning event with the same
+	// reason may occur for an object within DefaultRecurringWarningWindow before it is
+	// escalated to a fault.
+	DefaultRecurringWarningThreshold = 5
+
+	// DefaultRecurringWarningWindow is the period over which Warning event occurrences are counted.
+	DefaultRecurringWarningWindow = 10 * time.Minute
+)
+
+// recurringWarningKey identifies the Warning events of one reason for one involved object.
+type recurringWarningKey struct {
+	object string // involved object UID, or kind/namespace/name for events without one
+	reason string
+}
+
+// warningOccurrences is how many times a Warning event occurred when it was observed.
+type warningOccurrences struct {
+	count      int32
+	observedAt time.Time
+}
+
+// RecurringWarningDetector escalates Warning events that keep recurring into faults.
+// A single Warning event is often noise, but the same warning reported over and over
+// for an object (e.g. repeated FailedMount or BackOff events) points to a problem that
+// needs attention, even when no resource status reflects it.
+//
+// The detector counts the new occurrences of each (involved object, reason) pair, from
+// newly created events and from the count increases of recurring ones, and emits a
+// signal when they exceed the threshold within the window. After a signal the count is
+// reset, so the warning must keep recurring at that rate to be escalated again.
+//
+// It is safe for concurrent use.
+type RecurringWarningDetector struct {
+	mu        sync.Mutex
+	threshold int32
+	window    time.Duration
+	tracked   map[recurringWarningKey][]warningOccurrences
+	now       func() time.Time // allows time injection for testing
+}
+
+// NewRecurringWarningDetector creates a new RecurringWarningDetector with the default threshold and window.
+func NewRecurringWarningDetector() *RecurringWarningDetector {
+	return NewRecurringWarningDetectorWithThreshold(DefaultRecurringWarningThreshold, DefaultRecurringWarningWindow)
+}
+
+// NewRecurringWarningDetectorWithThreshold creates a new RecurringWarningDetector that
+// escalates Warning events recurring more than threshold times within window.
+func NewRecurringWarningDetectorWithThreshold(threshold int32, window time.Duration) *RecurringWarningDetector {
+	return &RecurringWarningDetector{
+		threshold: threshold,
+		window:    window,
+		tracked:   make(map[recurringWarningKey][]warningOccurrences),
+		now:       time.Now,
+	}
+}
+
+// FaultType returns the type of the fault signals emitted by this detector.
+func (d *RecurringWarningDetector) FaultType() events.FaultType {
+	return events.FaultTypeRecurringWarning
+}
+
+// ResourceKind returns the kind of resource this detector inspects.
+func (d *RecurringWarningDetector) ResourceKind() string {
+	return "Event"
+}
+
+// Describe returns the fault type emitted and the resource kind inspected by this detector.
+func (d *RecurringWarningDetector) Describe() events.DetectorInfo {
+	return events.DetectorInfo{
+		FaultTypes:    []events.FaultType{d.FaultType()},
+		ResourceKinds: []string{d.ResourceKind()},
+		Description:   "Escalates Warning events recurring for the same object and reason more than a configured number of times.",
+	}
+}
+
+// Detect counts the new occurrences of a created or updated Warning event and returns a
+// fault signal once its object and reason recurred more than the threshold within the
+// window. oldObj is nil for newly created events.
+func (d *RecurringWarningDetector) Detect(oldObj, newObj interface{}) []events.FaultSignal {
+	// Handle nil newObj - nothing to detect
+	if newObj == nil {
+		return []events.FaultSignal{}
+	}
+
+	// Type assert to Event
+	newEvent, ok := newObj.(*corev1.Event)
+	if !ok || newEvent.Type != corev1.EventTypeWarning {
+		return []events.FaultSignal{}
+	}
+
+	// New events occurred once, updates as many times as their count increased
+	added := max(occurrences(newEvent), 1)
+	if oldObj != nil {
+		oldEvent, ok := oldObj.(*corev1.Event)
+		if !ok {
+			return []events.FaultSignal{}
+		}
+		added = occurrences(newEvent) - occurrences(oldEvent)
+	}
+	if added <= 0 {
+		return []events.FaultSignal{}
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	now := d.now()
+	d.pruneLocked(now)
+
+	key := recurringWarningKey{object: involvedObjectKey(newEvent), reason: newEvent.Reason}
+	samples := append(d.tracked[key], warningOccurrences{count: added, observedAt: now})
+	d.tracked[key] = samples
+
+	var total int32
+	for _, sample := range samples {
+		total += sample.count
+	}
+	if total <= d.threshold {
+		return []events.FaultSignal{}
+	}
+
+	// Start counting over, so the warning must keep recurring to be escalated again
+	delete(d.tracked, key)
+
+	signal := events.FaultSignal{
+		FaultType:   events.FaultTypeRecurringWarning,
+		ResourceUID: newEvent.InvolvedObject.UID,
+		Kind:        newEvent.InvolvedObject.Kind,
+		Name:        newEvent.InvolvedObject.Name,
+		Namespace:   newEvent.InvolvedObject.Namespace,
+		Severity:    events.SeverityWarning,
+		Context:     buildRecurringWarningContext(newEvent, total, d.window),
+		Details:     buildRecurringWarningDetails(newEvent, total, d.window),
+		Timestamp:   now,
+	}
+
+	return []events.FaultSignal{signal}
+}
+
+// pruneLocked drops occurrences older than the window, and the pairs left without any.
+// Must be called with the lock held.
+func (d *RecurringWarningDetector) pruneLocked(now time.Time) {
+	for key, samples := range d.tracked {
+		for len(samples) > 0 && now.Sub(samples[0].observedAt) > d.window {
+			samples = samples[1:]
+		}
+		if len(samples) == 0 {
+			delete(d.tracked, key)
+			continue
+		}
+		d.tracked[key] = samples
+	}
+}
+
+// involvedObjectKey identifies an event's involved object by UID, or by kind, namespace
+// and name for events that don't carry the UID.
+func involvedObjectKey(event *corev1.Event) string {
+	if event.InvolvedObject.UID != "" {
+		return string(event.InvolvedObject.UID)
+	}
+	return event.InvolvedObject.Kind + "/" + event.InvolvedObject.Namespace + "/" + event.InvolvedObject.Name
+}
+
+// buildRecurringWarningContext creates a human-readable context string for a recurring
+// Warning event, including its reason, how often it occurred, and its latest message.
+func buildRecurringWarningContext(event *corev1.Event, total int32, window time.Duration) string {
+	context := fmt.Sprintf("Warning %s occurred %d times within %s", event.Reason, total, window)
+
+	if event.Message != "" {
+		context += fmt.Sprintf(", message: %s", event.Message)
+	}
+
+	return context
+}
+
+// buildRecurringWarningDetails returns the fields embedded in the recurring warning context.
+func buildRecurringWarningDetails(event *corev1.Event, total int32, window time.Duration) map[string]string {
+	details := map[string]string{
+		"reason":      event.Reason,
+		"occurrences": strconv.Itoa(int(total)),
+		"window":      window.String(),
+	}
+
+	if event.Message != "" {
+		details["message"] = event.Message
+	}
+
+	return details
+}
@@ -0,0 +1,235 @@
+package detectors
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/events"
+)
+
+// RecurringWarningDetectorSuite contains tests for RecurringWarningDetector
+type RecurringWarningDetectorSuite struct {
+	suite.Suite
+	detector    *RecurringWarningDetector
+	currentTime time.Time
+}
+
+func TestRecurringWarningDetectorSuite(t *testing.T) {
+	suite.Run(t, new(RecurringWarningDetectorSuite))
+}
+
+// SetupTest runs before each test
+func (s *RecurringWarningDetectorSuite) SetupTest() {
+	s.currentTime = time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	s.detector = NewRecurringWarningDetectorWithThreshold(3, 10*time.Minute)
+	s.detector.now = func() time.Time {
+		return s.currentTime
+	}
+}
+
+// SetupSubTest resets detector state between subtests
+func (s *RecurringWarningDetectorSuite) SetupSubTest() {
+	s.SetupTest()
+}
+
+func (s *RecurringWarningDetectorSuite) advanceTime(d time.Duration) {
+	s.currentTime = s.currentTime.Add(d)
+}
+
+// recur feeds the detector updates of event bumping its count once each time, and
+// returns the signals of the last update.
+func (s *RecurringWarningDetectorSuite) recur(event *corev1.Event, times int) []events.FaultSignal {
+	var signals []events.FaultSignal
+	for range times {
+		updated := event.DeepCopy()
+		updated.Count++
+		signals = s.detector.Detect(event, updated)
+		event = updated
+	}
+	return signals
+}
+
+// TestRecurringWarningDetector_Threshold tests escalation relative to the threshold
+func (s *RecurringWarningDetectorSuite) TestRecurringWarningDetector_Threshold() {
+	s.Run("warnings recurring up to the threshold do not emit signal", func() {
+		event := createEvent("FailedMount", "MountVolume.SetUp failed for volume \"config\"", 1)
+
+		s.Empty(s.detector.Detect(nil, event), "first occurrence")
+		s.Empty(s.recur(event, 2), "third occurrence is at the threshold")
+	})
+
+	s.Run("warnings recurring above the threshold emit signal", func() {
+		event := createEvent("FailedMount", "MountVolume.SetUp failed for volume \"config\"", 1)
+
+		s.Empty(s.detector.Detect(nil, event))
+		signals := s.recur(event, 3)
+
+		s.Require().Len(signals, 1)
+		signal := signals[0]
+		s.Equal(events.FaultTypeRecurringWarning, signal.FaultType)
+		s.Equal(types.UID("rs-uid-123"), signal.ResourceUID)
+		s.Equal("ReplicaSet", signal.Kind)
+		s.Equal("web-7d4b9c", signal.Name)
+		s.Equal("default", signal.Namespace)
+		s.Equal(events.SeverityWarning, signal.Severity)
+		s.Contains(signal.Context, "Warning FailedMount occurred 4 times within 10m0s")
+		s.Contains(signal.Context, "message: MountVolume.SetUp failed for volume \"config\"")
+		s.Equal(s.currentTime, signal.Timestamp)
+	})
+
+	s.Run("count jumps are counted as several occurrences", func() {
+		oldEvent := createEvent("BackOff", "Back-off pulling image", 2)
+		newEvent := createEvent("BackOff", "Back-off pulling image", 6)
+
+		s.Len(s.detector.Detect(oldEvent, newEvent), 1)
+	})
+
+	s.Run("separate events with the same object and reason are counted together", func() {
+		for i := range 3 {
+			event := createEvent("FailedScheduling", "0/3 nodes are available", 1)
+			event.Name = event.Name + string(rune('a'+i))
+			s.Empty(s.detector.Detect(nil, event))
+		}
+
+		s.Len(s.detector.Detect(nil, createEvent("FailedScheduling", "0/3 nodes are available", 1)), 1)
+	})
+
+	s.Run("counting starts over after escalation", func() {
+		event := createEvent("FailedMount", "timed out waiting for the condition", 1)
+		s.detector.Detect(nil, event)
+		s.Require().Len(s.recur(event, 3), 1)
+
+		event.Count = 4
+		s.Empty(s.recur(event, 3), "three more occurrences are at the threshold again")
+		s.Len(s.recur(event.DeepCopy(), 1), 1)
+	})
+}
+
+// TestRecurringWarningDetector_Window tests that only occurrences within the window are counted
+func (s *RecurringWarningDetectorSuite) TestRecurringWarningDetector_Window() {
+	s.Run("occurrences older than the window are not counted", func() {
+		event := createEvent("FailedMount", "timed out waiting for the condition", 1)
+
+		s.detector.Detect(nil, event)
+		event.Count = 1
+		s.Empty(s.recur(event, 2))
+
+		s.advanceTime(11 * time.Minute)
+		event.Count = 3
+		s.Empty(s.recur(event, 3), "the earlier occurrences fell out of the window")
+		s.Len(s.recur(event.DeepCopy(), 1), 1)
+	})
+
+	s.Run("stale pairs are pruned", func() {
+		s.detector.Detect(nil, createEvent("FailedMount", "timed out", 1))
+		s.advanceTime(time.Hour)
+		s.detector.Detect(nil, createEvent("BackOff", "Back-off restarting failed container", 1))
+
+		s.Len(s.detector.tracked, 1)
+		s.Contains(s.detector.tracked, recurringWarningKey{object: "rs-uid-123", reason: "BackOff"})
+	})
+}
+
+// TestRecurringWarningDetector_Keys tests that occurrences are counted per object and reason
+func (s *RecurringWarningDetectorSuite) TestRecurringWarningDetector_Keys() {
+	s.Run("different reasons are counted separately", func() {
+		for _, reason := range []string{"FailedMount", "BackOff", "Unhealthy", "FailedScheduling"} {
+			s.Empty(s.detector.Detect(nil, createEvent(reason, "", 1)))
+		}
+	})
+
+	s.Run("different objects are counted separately", func() {
+		for _, uid := range []string{"uid-a", "uid-b", "uid-c", "uid-d"} {
+			event := createEvent("FailedMount", "", 1)
+			event.InvolvedObject.UID = types.UID(uid)
+			s.Empty(s.detector.Detect(nil, event))
+		}
+	})
+
+	s.Run("objects without UID are identified by kind, namespace and name", func() {
+		event := createEvent("FailedMount", "", 1)
+		event.InvolvedObject.UID = ""
+
+		s.detector.Detect(nil, event)
+		s.Len(s.recur(event, 3), 1)
+	})
+}
+
+// TestRecurringWarningDetector_EdgeCases tests edge cases and error handling
+func (s *RecurringWarningDetectorSuite) TestRecurringWarningDetector_EdgeCases() {
+	s.Run("returns empty slice for nil newObj", func() {
+		signals := s.detector.Detect(createEvent("FailedMount", "", 1), nil)
+		s.Empty(signals)
+		s.NotNil(signals)
+	})
+
+	s.Run("returns empty slice when objects are not Events", func() {
+		event := createEvent("FailedMount", "", 1)
+		s.Empty(s.detector.Detect(nil, "not an event"))
+		s.Empty(s.detector.Detect("not an event", event))
+		s.Empty(s.detector.tracked)
+	})
+
+	s.Run("Normal events are not counted", func() {
+		event := createEvent("Pulled", "Successfully pulled image", 1)
+		event.Type = corev1.EventTypeNormal
+
+		s.detector.Detect(nil, event)
+		s.Empty(s.recur(event, 5))
+		s.Empty(s.detector.tracked)
+	})
+
+	s.Run("updates without a count increase are not counted", func() {
+		event := createEvent("FailedMount", "", 4)
+
+		for range 5 {
+			s.Empty(s.detector.Detect(event, event), "resyncs are not new occurrences")
+		}
+		s.Empty(s.detector.tracked)
+	})
+
+	s.Run("events.k8s.io series counts are used", func() {
+		oldEvent := createEvent("BackOff", "", 0)
+		oldEvent.Series = &corev1.EventSeries{Count: 2}
+		newEvent := createEvent("BackOff", "", 0)
+		newEvent.Series = &corev1.EventSeries{Count: 6}
+
+		s.Len(s.detector.Detect(oldEvent, newEvent), 1)
+	})
+}
+
+// TestRecurringWarningDetector_DetectorInterface verifies RecurringWarningDetector implements Detector
+func (s *RecurringWarningDetectorSuite) TestRecurringWarningDetector_DetectorInterface() {
+	s.Run("RecurringWarningDetector implements Detector interface", func() {
+		var _ events.Detector = &RecurringWarningDetector{}
+		var _ events.Detector = s.detector
+		s.Equal(events.FaultTypeRecurringWarning, s.detector.FaultType())
+		s.Equal("Event", s.detector.ResourceKind())
+	})
+}
+
+// TestRecurringWarningDetector_Details tests the structured fields of recurring warning signals
+func (s *RecurringWarningDetectorSuite) TestRecurringWarningDetector_Details() {
+	s.Run("details match the fields in the context", func() {
+		oldEvent := createEvent("FailedMount", "timed out waiting for the condition", 1)
+		newEvent := createEvent("FailedMount", "timed out waiting for the condition", 5)
+
+		signals := s.detector.Detect(oldEvent, newEvent)
+
+		s.Require().Len(signals, 1)
+		details := signals[0].Details
+		s.Equal(map[string]string{
+			"reason":      "FailedMount",
+			"occurrences": "4",
+			"window":      "10m0s",
+			"message":     "timed out waiting for the condition",
+		}, details)
+		s.Contains(signals[0].Context, "Warning "+details["reason"])
+		s.Contains(signals[0].Context, "occurred "+details["occurrences"]+" times within "+details["window"])
+		s.Contains(signals[0].Context, "message: "+details["message"])
+	})
+}
@@ -0,0 +1,39 @@
+package detectors
+
+import (
+	"github.com/containers/kubernetes-mcp-server/pkg/events"
+)
+
+// DefaultRegistry holds the built-in fault detectors, registered under the
+// fault type each one emits. Additional detectors may be registered at startup
+// to make them available to faults subscriptions.
+var DefaultRegistry = events.NewDetectorRegistry()
+
+func init() {
+	DefaultRegistry.Register(string(events.FaultTypePodCrash), func() events.Detector { return NewPodCrashDetector() })
+	DefaultRegistry.Register(string(events.FaultTypeOOMKilled), func() events.Detector { return NewOOMKillDetector() })
+	DefaultRegistry.Register(string(events.FaultTypeCrashLoop), func() events.Detector { return NewCrashLoopDetector() })
+	DefaultRegistry.Register(string(events.FaultTypeRestartStorm), func() events.Detector { return NewRestartStormDetector() })
+	DefaultRegistry.Register(string(events.FaultTypeConfigError), func() events.Detector { return NewConfigErrorDetector() })
+	DefaultRegistry.Register(string(events.FaultTypeNodeUnhealthy), func() events.Detector { return NewNodeUnhealthyDetector() })
+	DefaultRegistry.Register(string(events.FaultTypeNodeCordoned), func() events.Detector { return NewNodeSchedulabilityDetector() })
+	DefaultRegistry.Register(string(events.FaultTypeDeploymentFailure), func() events.Detector { return NewDeploymentFailureDetector() })
+	DefaultRegistry.Register(string(events.FaultTypeReplicaFailure), func() events.Detector { return NewReplicaFailureDetector() })
+	DefaultRegistry.Register(string(events.FaultTypeJobFailure), func() events.Detector { return NewJobFailureDetector() })
+	DefaultRegistry.Register(string(events.FaultTypeNoEndpoints), func() events.Detector { return NewEndpointsDetector() })
+	DefaultRegistry.Register(string(events.FaultTypeEndpointFlapping), func() events.Detector { return NewEndpointFlappingDetector() })
+	DefaultRegistry.Register(string(events.FaultTypeStuckTerminating), func() events.Detector { return NewStuckTerminatingDetector() })
+	DefaultRegistry.Register(string(events.FaultTypeUnschedulable), func() events.Detector { return NewUnschedulableDetector() })
+	DefaultRegistry.Register(string(events.FaultTypeDeadlineExceeded), func() events.Detector { return NewDeadlineExceededDetector() })
+	DefaultRegistry.Register(string(events.FaultTypeInitContainerFailure), func() events.Detector { return NewInitContainerFailureDetector() })
+	DefaultRegistry.Register(string(events.FaultTypeAdmissionRejected), func() events.Detector { return NewAdmissionRejectedDetector() })
+	DefaultRegistry.Register(string(events.FaultTypeLoadBalancerPending), func() events.Detector { return NewLoadBalancerPendingDetector() })
+	DefaultRegistry.Register(string(events.FaultTypePVFailed), func() events.Detector { return NewPVDetector() })
+	DefaultRegistry.RegisterOptIn(string(events.FaultTypeScaledToZero), func() events.Detector { return NewScaledToZeroDetector() })
+	DefaultRegistry.RegisterOptIn(string(events.FaultTypeRecurringWarning), func() events.Detector { return NewRecurringWarningDetector() })
+}
+
+// ListDetectors describes the detectors registered in DefaultRegistry.
+func ListDetectors() []events.RegisteredDetector {
+	return DefaultRegistry.ListDetectors()
+}
@@ -0,0 +1,105 @@
+package detectors
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/events"
+)
+
+// DefaultRegistrySuite contains tests for the built-in detector registry
+type DefaultRegistrySuite struct {
+	suite.Suite
+}
+
+func TestDefaultRegistrySuite(t *testing.T) {
+	suite.Run(t, new(DefaultRegistrySuite))
+}
+
+// TestDefaultRegistry_BuiltInDetectors tests that built-in detectors are registered under their fault types
+func (s *DefaultRegistrySuite) TestDefaultRegistry_BuiltInDetectors() {
+	s.Run("every built-in detector is registered", func() {
+		s.Equal([]string{
+			"PodCrash", "OOMKilled", "CrashLoop", "RestartStorm", "ConfigError", "NodeUnhealthy", "NodeCordoned",
+			"DeploymentFailure", "ReplicaFailure", "JobFailure", "NoEndpoints", "EndpointFlapping", "StuckTerminating", "Unschedulable", "DeadlineExceeded",
+			"InitContainerFailure", "AdmissionRejected", "LoadBalancerPending", "PVFailed", "ScaledToZero", "RecurringWarning",
+		}, DefaultRegistry.Names())
+	})
+
+	s.Run("opt-in detectors only run when selected", func() {
+		built, err := DefaultRegistry.Build(nil)
+		s.Require().NoError(err)
+		for _, detector := range built {
+			s.NotEqual(events.FaultTypeScaledToZero, detector.(events.TypedDetector).FaultType())
+			s.NotEqual(events.FaultTypeRecurringWarning, detector.(events.TypedDetector).FaultType())
+		}
+
+		built, err = DefaultRegistry.Build([]string{string(events.FaultTypeScaledToZero)})
+		s.Require().NoError(err)
+		s.Require().Len(built, 1)
+		s.IsType(&ScaledToZeroDetector{}, built[0])
+	})
+
+	s.Run("each detector is registered under the fault type it emits", func() {
+		built, err := DefaultRegistry.Build(DefaultRegistry.Names())
+		s.Require().NoError(err)
+		s.Require().Len(built, len(DefaultRegistry.Names()))
+		for i, name := range DefaultRegistry.Names() {
+			typed, ok := built[i].(events.TypedDetector)
+			s.Require().True(ok, "detector %s should be typed", name)
+			s.Equal(name, string(typed.FaultType()))
+		}
+	})
+}
+
+// TestListDetectors tests that built-in detectors describe the fault type they emit and the kind they watch
+func (s *DefaultRegistrySuite) TestListDetectors() {
+	kinds := map[events.FaultType]string{
+		events.FaultTypePodCrash:             "Pod",
+		events.FaultTypeOOMKilled:            "Pod",
+		events.FaultTypeCrashLoop:            "Pod",
+		events.FaultTypeRestartStorm:         "Pod",
+		events.FaultTypeConfigError:          "Pod",
+		events.FaultTypeNodeUnhealthy:        "Node",
+		events.FaultTypeNodeCordoned:         "Node",
+		events.FaultTypeDeploymentFailure:    "Deployment",
+		events.FaultTypeReplicaFailure:       "Deployment",
+		events.FaultTypeJobFailure:           "Job",
+		events.FaultTypeNoEndpoints:          "EndpointSlice",
+		events.FaultTypeEndpointFlapping:     "EndpointSlice",
+		events.FaultTypeStuckTerminating:     "Pod",
+		events.FaultTypeUnschedulable:        "Pod",
+		events.FaultTypeDeadlineExceeded:     "Pod",
+		events.FaultTypeInitContainerFailure: "Pod",
+		events.FaultTypeAdmissionRejected:    "Event",
+		events.FaultTypeLoadBalancerPending:  "Service",
+		events.FaultTypePVFailed:             "PersistentVolume",
+		events.FaultTypeScaledToZero:         "Deployment",
+		events.FaultTypeRecurringWarning:     "Event",
+	}
+
+	listed := ListDetectors()
+	s.Require().Len(listed, len(kinds))
+	for i, detector := range listed {
+		s.Run(detector.Name, func() {
+			s.Equal(DefaultRegistry.Names()[i], detector.Name, "detectors should be listed in registration order")
+			s.Equal([]events.FaultType{events.FaultType(detector.Name)}, detector.FaultTypes)
+			s.Equal([]string{kinds[events.FaultType(detector.Name)]}, detector.ResourceKinds)
+			s.NotEmpty(detector.Description)
+			optIn := detector.Name == string(events.FaultTypeScaledToZero) || detector.Name == string(events.FaultTypeRecurringWarning)
+			s.Equal(optIn, detector.OptIn)
+		})
+	}
+
+	s.Run("descriptions match the typed detector methods", func() {
+		built, err := DefaultRegistry.Build(DefaultRegistry.Names())
+		s.Require().NoError(err)
+		for _, detector := range built {
+			typed := detector.(events.TypedDetector)
+			info := detector.Describe()
+			s.Equal([]events.FaultType{typed.FaultType()}, info.FaultTypes)
+			s.Equal([]string{typed.ResourceKind()}, info.ResourceKinds)
+		}
+	})
+}
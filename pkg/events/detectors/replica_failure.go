@@ -0,0 +1,145 @@
+package detectors
+
+import (
+	"fmt"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/events"
+)
+
+// ReplicaFailureDetector detects when a Deployment's ReplicaSet fails to create pods,
+// for example due to exhausted resource quota or admission webhook rejections.
+// A failure is detected when the Deployment's ReplicaFailure condition has Status="True".
+//
+// This detector only triggers on transitions - when the ReplicaFailure condition
+// changes to True from False or from being absent. It complements
+// DeploymentFailureDetector, which handles ProgressDeadlineExceeded.
+type ReplicaFailureDetector struct{}
+
+// NewReplicaFailureDetector creates a new ReplicaFailureDetector instance.
+func NewReplicaFailureDetector() *ReplicaFailureDetector {
+	return &ReplicaFailureDetector{}
+}
+
+// FaultType returns the type of the fault signals emitted by this detector.
+func (d *ReplicaFailureDetector) FaultType() events.FaultType {
+	return events.FaultTypeReplicaFailure
+}
+
+// ResourceKind returns the kind of resource this detector inspects.
+func (d *ReplicaFailureDetector) ResourceKind() string {
+	return "Deployment"
+}
+
+// Describe returns the fault type emitted and the resource kind inspected by this detector.
+func (d *ReplicaFailureDetector) Describe() events.DetectorInfo {
+	return events.DetectorInfo{
+		FaultTypes:    []events.FaultType{d.FaultType()},
+		ResourceKinds: []string{d.ResourceKind()},
+		Description:   "Reports Deployments whose ReplicaSet fails to create pods, e.g. because of exhausted resource quota.",
+	}
+}
+
+// Detect analyzes Deployment state changes and returns fault signals for detected
+// replica creation failures. It detects transitions of the ReplicaFailure condition
+// to True by comparing the condition between oldObj and newObj.
+func (d *ReplicaFailureDetector) Detect(oldObj, newObj interface{}) []events.FaultSignal {
+	// Handle nil newObj - nothing to detect
+	if newObj == nil {
+		return []events.FaultSignal{}
+	}
+
+	// Type assert to Deployment
+	newDeployment, ok := newObj.(*appsv1.Deployment)
+	if !ok {
+		return []events.FaultSignal{}
+	}
+
+	// If oldObj is nil (Add event), no transition to detect
+	if oldObj == nil {
+		return []events.FaultSignal{}
+	}
+
+	oldDeployment, ok := oldObj.(*appsv1.Deployment)
+	if !ok {
+		return []events.FaultSignal{}
+	}
+
+	oldFailure := getReplicaFailureCondition(oldDeployment)
+	newFailure := getReplicaFailureCondition(newDeployment)
+
+	// Only a ReplicaFailure condition that is True indicates a failure
+	if newFailure == nil || newFailure.Status != corev1.ConditionTrue {
+		return []events.FaultSignal{}
+	}
+
+	// Check if this is a transition (not already in failure state)
+	if oldFailure != nil && oldFailure.Status == corev1.ConditionTrue {
+		return []events.FaultSignal{}
+	}
+
+	signal := events.FaultSignal{
+		FaultType:   events.FaultTypeReplicaFailure,
+		ResourceUID: types.UID(newDeployment.UID),
+		Kind:        "Deployment",
+		Name:        newDeployment.Name,
+		Namespace:   newDeployment.Namespace,
+		Severity:    events.SeverityWarning,
+		Context:     buildReplicaFailureContext(newFailure),
+		Details:     buildReplicaFailureDetails(newFailure),
+		Timestamp:   time.Now(),
+	}
+
+	return []events.FaultSignal{signal}
+}
+
+// getReplicaFailureCondition finds the ReplicaFailure condition in a Deployment's status.
+// Returns nil if the ReplicaFailure condition is not found.
+func getReplicaFailureCondition(deployment *appsv1.Deployment) *appsv1.DeploymentCondition {
+	if deployment == nil {
+		return nil
+	}
+
+	for i := range deployment.Status.Conditions {
+		if deployment.Status.Conditions[i].Type == appsv1.DeploymentReplicaFailure {
+			return &deployment.Status.Conditions[i]
+		}
+	}
+
+	return nil
+}
+
+// buildReplicaFailureContext creates a human-readable context string from
+// the Deployment's ReplicaFailure condition.
+func buildReplicaFailureContext(failureCondition *appsv1.DeploymentCondition) string {
+	context := "Deployment failed to create replicas"
+
+	if failureCondition.Reason != "" {
+		context += fmt.Sprintf(", reason: %s", failureCondition.Reason)
+	}
+
+	if failureCondition.Message != "" {
+		context += fmt.Sprintf(", message: %s", failureCondition.Message)
+	}
+
+	return context
+}
+
+// buildReplicaFailureDetails returns the fields embedded in the replica failure context.
+func buildReplicaFailureDetails(failureCondition *appsv1.DeploymentCondition) map[string]string {
+	details := map[string]string{}
+
+	if failureCondition.Reason != "" {
+		details["reason"] = failureCondition.Reason
+	}
+
+	if failureCondition.Message != "" {
+		details["message"] = failureCondition.Message
+	}
+
+	return details
+}
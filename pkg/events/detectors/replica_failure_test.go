@@ -0,0 +1,206 @@
+package detectors
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/events"
+)
+
+// ReplicaFailureDetectorSuite contains tests for ReplicaFailureDetector
+type ReplicaFailureDetectorSuite struct {
+	suite.Suite
+	detector *ReplicaFailureDetector
+}
+
+func TestReplicaFailureDetectorSuite(t *testing.T) {
+	suite.Run(t, new(ReplicaFailureDetectorSuite))
+}
+
+// SetupTest runs before each test
+func (s *ReplicaFailureDetectorSuite) SetupTest() {
+	s.detector = NewReplicaFailureDetector()
+}
+
+// TestReplicaFailureDetector_Transitions tests detection of ReplicaFailure transitions
+func (s *ReplicaFailureDetectorSuite) TestReplicaFailureDetector_Transitions() {
+	s.Run("transition from no ReplicaFailure condition to True emits warning signal", func() {
+		oldDeployment := createDeploymentWithoutProgressingCondition("test-deployment", "default")
+		newDeployment := createDeploymentWithReplicaFailureCondition("test-deployment", "default", corev1.ConditionTrue, "FailedCreate", `pods "test-deployment-abc-" is forbidden: exceeded quota: compute-resources`)
+
+		signals := s.detector.Detect(oldDeployment, newDeployment)
+
+		s.Require().Len(signals, 1)
+		signal := signals[0]
+
+		s.Equal(events.FaultTypeReplicaFailure, signal.FaultType)
+		s.Equal(types.UID(newDeployment.UID), signal.ResourceUID)
+		s.Equal("Deployment", signal.Kind)
+		s.Equal("test-deployment", signal.Name)
+		s.Equal("default", signal.Namespace)
+		s.Equal(events.SeverityWarning, signal.Severity)
+		s.Contains(signal.Context, "reason: FailedCreate")
+		s.Contains(signal.Context, "exceeded quota")
+		s.False(signal.Timestamp.IsZero())
+	})
+
+	s.Run("transition from False to True emits signal", func() {
+		oldDeployment := createDeploymentWithReplicaFailureCondition("test-deployment", "default", corev1.ConditionFalse, "", "")
+		newDeployment := createDeploymentWithReplicaFailureCondition("test-deployment", "default", corev1.ConditionTrue, "FailedCreate", "admission webhook denied the request")
+
+		signals := s.detector.Detect(oldDeployment, newDeployment)
+
+		s.Require().Len(signals, 1)
+		s.Equal(events.FaultTypeReplicaFailure, signals[0].FaultType)
+		s.Contains(signals[0].Context, "admission webhook denied the request")
+	})
+
+	s.Run("no transition when ReplicaFailure is already True", func() {
+		oldDeployment := createDeploymentWithReplicaFailureCondition("test-deployment", "default", corev1.ConditionTrue, "FailedCreate", "exceeded quota")
+		newDeployment := createDeploymentWithReplicaFailureCondition("test-deployment", "default", corev1.ConditionTrue, "FailedCreate", "exceeded quota")
+
+		signals := s.detector.Detect(oldDeployment, newDeployment)
+
+		s.Empty(signals, "no state change should not emit signal")
+	})
+
+	s.Run("no signal when ReplicaFailure is False", func() {
+		oldDeployment := createDeploymentWithoutProgressingCondition("test-deployment", "default")
+		newDeployment := createDeploymentWithReplicaFailureCondition("test-deployment", "default", corev1.ConditionFalse, "", "")
+
+		signals := s.detector.Detect(oldDeployment, newDeployment)
+
+		s.Empty(signals, "ReplicaFailure=False should not emit signal")
+	})
+
+	s.Run("recovery from ReplicaFailure does not emit signal", func() {
+		oldDeployment := createDeploymentWithReplicaFailureCondition("test-deployment", "default", corev1.ConditionTrue, "FailedCreate", "exceeded quota")
+		newDeployment := createDeploymentWithoutProgressingCondition("test-deployment", "default")
+
+		signals := s.detector.Detect(oldDeployment, newDeployment)
+
+		s.Empty(signals, "recovery from failure should not emit signal")
+	})
+
+	s.Run("ignores ProgressDeadlineExceeded handled by DeploymentFailureDetector", func() {
+		oldDeployment := createDeploymentWithProgressingCondition("test-deployment", "default", corev1.ConditionTrue, "NewReplicaSetAvailable", "ReplicaSet is available")
+		newDeployment := createDeploymentWithProgressingCondition("test-deployment", "default", corev1.ConditionFalse, "ProgressDeadlineExceeded", "Deployment has timed out")
+
+		signals := s.detector.Detect(oldDeployment, newDeployment)
+
+		s.Empty(signals, "Progressing condition changes should not emit ReplicaFailure signal")
+	})
+}
+
+// TestReplicaFailureDetector_EdgeCases tests edge cases and error conditions
+func (s *ReplicaFailureDetectorSuite) TestReplicaFailureDetector_EdgeCases() {
+	s.Run("returns empty slice for nil newObj", func() {
+		oldDeployment := createDeploymentWithoutProgressingCondition("test-deployment", "default")
+
+		signals := s.detector.Detect(oldDeployment, nil)
+
+		s.Empty(signals)
+		s.NotNil(signals)
+	})
+
+	s.Run("returns empty slice for nil oldObj", func() {
+		newDeployment := createDeploymentWithReplicaFailureCondition("test-deployment", "default", corev1.ConditionTrue, "FailedCreate", "exceeded quota")
+
+		signals := s.detector.Detect(nil, newDeployment)
+
+		s.Empty(signals, "nil oldObj means Add event, no transition to detect")
+	})
+
+	s.Run("returns empty slice when newObj is not a Deployment", func() {
+		oldDeployment := createDeploymentWithoutProgressingCondition("test-deployment", "default")
+
+		signals := s.detector.Detect(oldDeployment, &appsv1.ReplicaSet{})
+
+		s.Empty(signals)
+	})
+
+	s.Run("returns empty slice when oldObj is not a Deployment", func() {
+		newDeployment := createDeploymentWithReplicaFailureCondition("test-deployment", "default", corev1.ConditionTrue, "FailedCreate", "exceeded quota")
+
+		signals := s.detector.Detect("not a Deployment", newDeployment)
+
+		s.Empty(signals)
+	})
+
+	s.Run("handles Deployment with nil conditions", func() {
+		oldDeployment := &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "test-deployment", Namespace: "default"}}
+		newDeployment := &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "test-deployment", Namespace: "default"}}
+
+		signals := s.detector.Detect(oldDeployment, newDeployment)
+
+		s.Empty(signals, "nil conditions should be handled gracefully")
+	})
+}
+
+// TestReplicaFailureDetector_ContextBuilding tests context message construction
+func (s *ReplicaFailureDetectorSuite) TestReplicaFailureDetector_ContextBuilding() {
+	s.Run("context is minimal when reason and message are empty", func() {
+		oldDeployment := createDeploymentWithoutProgressingCondition("test-deployment", "default")
+		newDeployment := createDeploymentWithReplicaFailureCondition("test-deployment", "default", corev1.ConditionTrue, "", "")
+
+		signals := s.detector.Detect(oldDeployment, newDeployment)
+
+		s.Require().Len(signals, 1)
+		s.Equal("Deployment failed to create replicas", signals[0].Context)
+	})
+}
+
+// TestReplicaFailureDetector_DetectorInterface verifies interface compliance
+func (s *ReplicaFailureDetectorSuite) TestReplicaFailureDetector_DetectorInterface() {
+	s.Run("ReplicaFailureDetector implements Detector interface", func() {
+		var _ events.Detector = &ReplicaFailureDetector{}
+		var _ events.Detector = s.detector
+	})
+}
+
+// TestReplicaFailureDetector_Details tests the structured fields of replica failure signals
+func (s *ReplicaFailureDetectorSuite) TestReplicaFailureDetector_Details() {
+	s.Run("details match the fields in the context", func() {
+		oldDeployment := createDeploymentWithoutProgressingCondition("test-deployment", "default")
+		newDeployment := createDeploymentWithReplicaFailureCondition("test-deployment", "default", corev1.ConditionTrue, "FailedCreate", "exceeded quota: compute-resources")
+
+		signals := s.detector.Detect(oldDeployment, newDeployment)
+
+		s.Require().Len(signals, 1)
+		details := signals[0].Details
+		s.Equal(map[string]string{"reason": "FailedCreate", "message": "exceeded quota: compute-resources"}, details)
+		s.Contains(signals[0].Context, "reason: "+details["reason"])
+		s.Contains(signals[0].Context, "message: "+details["message"])
+	})
+}
+
+// Helper function to create a Deployment with a ReplicaFailure condition
+func createDeploymentWithReplicaFailureCondition(name, namespace string, status corev1.ConditionStatus, reason, message string) *appsv1.Deployment {
+	return &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+			UID:       "deployment-uid-123",
+		},
+		Status: appsv1.DeploymentStatus{
+			Conditions: []appsv1.DeploymentCondition{
+				{
+					Type:   appsv1.DeploymentProgressing,
+					Status: corev1.ConditionTrue,
+					Reason: "ReplicaSetUpdated",
+				},
+				{
+					Type:    appsv1.DeploymentReplicaFailure,
+					Status:  status,
+					Reason:  reason,
+					Message: message,
+				},
+			},
+		},
+	}
+}
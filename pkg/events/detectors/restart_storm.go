@@ -0,0 +1,229 @@
+package detectors
+
+import (
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/events"
+)
+
+const (
+	// DefaultRestartStormThreshold is how many restarts a container may have within
+	// DefaultRestartStormWindow before it is reported as a restart storm.
+	DefaultRestartStormThreshold = 3
+
+	// DefaultRestartStormWindow is the period over which restarts are counted.
+	DefaultRestartStormWindow = 5 * time.Minute
+)
+
+// restartStormStaleAfter is how long a tracked container may go unobserved before
+// its entry is dropped, so containers of deleted pods don't accumulate.
+const restartStormStaleAfter = 1 * time.Hour
+
+// restartStormKey identifies a container within a pod.
+type restartStormKey struct {
+	uid       types.UID
+	container string
+}
+
+// restartSample is a container's restart count at the time it was observed.
+type restartSample struct {
+	restartCount int32
+	observedAt   time.Time
+}
+
+// RestartStormDetector detects containers restarting faster than a configured
+// rate, whether or not the kubelet has put them into CrashLoopBackOff. Fast
+// crash-restart cycles can keep a container out of CrashLoopBackOff entirely.
+//
+// The detector records the restart count and time of each observed update, keyed
+// by pod UID and container name, and emits a signal when the count grew by more
+// than the threshold over the window. When the oldest observation is older than
+// the window, the restarts since then are scaled to the window, so a slow, steady
+// increase is not reported. After a signal the observations are reset, so the
+// container must keep restarting at that rate to be reported again.
+//
+// It is safe for concurrent use.
+type RestartStormDetector struct {
+	mu        sync.Mutex
+	threshold int32
+	window    time.Duration
+	tracked   map[restartStormKey][]restartSample
+	now       func() time.Time // allows time injection for testing
+}
+
+// NewRestartStormDetector creates a new RestartStormDetector with the default threshold and window.
+func NewRestartStormDetector() *RestartStormDetector {
+	return NewRestartStormDetectorWithThreshold(DefaultRestartStormThreshold, DefaultRestartStormWindow)
+}
+
+// NewRestartStormDetectorWithThreshold creates a new RestartStormDetector that reports
+// containers restarting more than threshold times within window.
+func NewRestartStormDetectorWithThreshold(threshold int32, window time.Duration) *RestartStormDetector {
+	return &RestartStormDetector{
+		threshold: threshold,
+		window:    window,
+		tracked:   make(map[restartStormKey][]restartSample),
+		now:       time.Now,
+	}
+}
+
+// FaultType returns the type of the fault signals emitted by this detector.
+func (d *RestartStormDetector) FaultType() events.FaultType {
+	return events.FaultTypeRestartStorm
+}
+
+// ResourceKind returns the kind of resource this detector inspects.
+func (d *RestartStormDetector) ResourceKind() string {
+	return "Pod"
+}
+
+// Describe returns the fault type emitted and the resource kind inspected by this detector.
+func (d *RestartStormDetector) Describe() events.DetectorInfo {
+	return events.DetectorInfo{
+		FaultTypes:    []events.FaultType{d.FaultType()},
+		ResourceKinds: []string{d.ResourceKind()},
+		Description:   "Reports containers restarting faster than a configured rate, even outside CrashLoopBackOff.",
+	}
+}
+
+// Detect analyzes pod updates and returns fault signals for containers whose
+// restart count grew faster than the configured rate.
+func (d *RestartStormDetector) Detect(oldObj, newObj interface{}) []events.FaultSignal {
+	// Handle nil newObj - nothing to detect
+	if newObj == nil {
+		return []events.FaultSignal{}
+	}
+
+	// Type assert to Pod
+	newPod, ok := newObj.(*corev1.Pod)
+	if !ok {
+		return []events.FaultSignal{}
+	}
+
+	// If oldObj is nil (Add event), no update to evaluate
+	if oldObj == nil {
+		return []events.FaultSignal{}
+	}
+
+	if _, ok := oldObj.(*corev1.Pod); !ok {
+		return []events.FaultSignal{}
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	now := d.now()
+	d.pruneLocked(now)
+
+	var signals []events.FaultSignal
+
+	for _, status := range newPod.Status.ContainerStatuses {
+		key := restartStormKey{uid: newPod.UID, container: status.Name}
+		samples := d.tracked[key]
+
+		// First observation, or the count went back down: start over from here
+		if len(samples) == 0 || status.RestartCount < samples[len(samples)-1].restartCount {
+			d.tracked[key] = []restartSample{{restartCount: status.RestartCount, observedAt: now}}
+			continue
+		}
+
+		// Keep the newest observation from before the window as the baseline
+		for len(samples) > 1 && now.Sub(samples[1].observedAt) >= d.window {
+			samples = samples[1:]
+		}
+		samples = append(samples, restartSample{restartCount: status.RestartCount, observedAt: now})
+		d.tracked[key] = samples
+
+		restarts := status.RestartCount - samples[0].restartCount
+		elapsed := now.Sub(samples[0].observedAt)
+		if !d.exceedsRate(restarts, elapsed) {
+			continue
+		}
+
+		signal := events.FaultSignal{
+			FaultType:     events.FaultTypeRestartStorm,
+			ResourceUID:   types.UID(newPod.UID),
+			Kind:          "Pod",
+			Name:          newPod.Name,
+			Namespace:     newPod.Namespace,
+			ContainerName: status.Name,
+			Severity:      events.SeverityCritical,
+			Context:       buildRestartStormContext(status, restarts, elapsed),
+			Details:       buildRestartStormDetails(status, restarts, elapsed),
+			Timestamp:     now,
+		}
+		signals = append(signals, signal)
+
+		// Require a fresh burst before reporting this container again
+		d.tracked[key] = []restartSample{{restartCount: status.RestartCount, observedAt: now}}
+	}
+
+	return signals
+}
+
+// exceedsRate reports whether restarts over elapsed is more than the threshold per window.
+func (d *RestartStormDetector) exceedsRate(restarts int32, elapsed time.Duration) bool {
+	if restarts <= d.threshold {
+		return false
+	}
+	if elapsed <= d.window {
+		return true
+	}
+	// Scale restarts observed over a longer period down to the window
+	return float64(restarts)*float64(d.window)/float64(elapsed) > float64(d.threshold)
+}
+
+// pruneLocked drops tracked containers that have not been observed recently.
+// Must be called with the lock held.
+func (d *RestartStormDetector) pruneLocked(now time.Time) {
+	for key, samples := range d.tracked {
+		if now.Sub(samples[len(samples)-1].observedAt) > restartStormStaleAfter {
+			delete(d.tracked, key)
+		}
+	}
+}
+
+// buildRestartStormContext creates a human-readable context string for a restart storm.
+func buildRestartStormContext(status corev1.ContainerStatus, restarts int32, elapsed time.Duration) string {
+	context := fmt.Sprintf("Container restarted %d times in %s, restart count: %d",
+		restarts, elapsed.Truncate(time.Second), status.RestartCount)
+
+	if status.LastTerminationState.Terminated != nil {
+		terminated := status.LastTerminationState.Terminated
+		if terminated.ExitCode != 0 {
+			context += fmt.Sprintf(", last exit code: %d", terminated.ExitCode)
+		}
+		if terminated.Reason != "" {
+			context += fmt.Sprintf(", last reason: %s", terminated.Reason)
+		}
+	}
+
+	return context
+}
+
+// buildRestartStormDetails returns the fields embedded in the restart storm context.
+func buildRestartStormDetails(status corev1.ContainerStatus, restarts int32, elapsed time.Duration) map[string]string {
+	details := map[string]string{
+		"restarts":     strconv.Itoa(int(restarts)),
+		"period":       elapsed.Truncate(time.Second).String(),
+		"restartCount": strconv.Itoa(int(status.RestartCount)),
+	}
+
+	if status.LastTerminationState.Terminated != nil {
+		terminated := status.LastTerminationState.Terminated
+		if terminated.ExitCode != 0 {
+			details["lastExitCode"] = strconv.Itoa(int(terminated.ExitCode))
+		}
+		if terminated.Reason != "" {
+			details["lastReason"] = terminated.Reason
+		}
+	}
+
+	return details
+}
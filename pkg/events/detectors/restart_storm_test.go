@@ -0,0 +1,246 @@
+package detectors
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/events"
+)
+
+// RestartStormDetectorSuite contains tests for RestartStormDetector
+type RestartStormDetectorSuite struct {
+	suite.Suite
+	detector    *RestartStormDetector
+	currentTime time.Time
+}
+
+func TestRestartStormDetectorSuite(t *testing.T) {
+	suite.Run(t, new(RestartStormDetectorSuite))
+}
+
+// SetupTest runs before each test
+func (s *RestartStormDetectorSuite) SetupTest() {
+	s.currentTime = time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	s.detector = NewRestartStormDetectorWithThreshold(3, 5*time.Minute)
+	s.detector.now = func() time.Time {
+		return s.currentTime
+	}
+}
+
+// SetupSubTest resets detector state between subtests
+func (s *RestartStormDetectorSuite) SetupSubTest() {
+	s.SetupTest()
+}
+
+func (s *RestartStormDetectorSuite) advanceTime(d time.Duration) {
+	s.currentTime = s.currentTime.Add(d)
+}
+
+// observe feeds the detector an update moving the container to restartCount
+// after advancing the clock by d.
+func (s *RestartStormDetectorSuite) observe(pod *corev1.Pod, d time.Duration, restartCount int32) (*corev1.Pod, []events.FaultSignal) {
+	s.advanceTime(d)
+	updated := createRestartingPod(pod.Name, "app", restartCount)
+	return updated, s.detector.Detect(pod, updated)
+}
+
+// TestRestartStormDetector_Rate tests detection relative to the configured rate
+func (s *RestartStormDetectorSuite) TestRestartStormDetector_Rate() {
+	s.Run("rapid restart count jump emits signal", func() {
+		pod := createRestartingPod("web-1", "app", 0)
+		pod, signals := s.observe(pod, 0, 0)
+		s.Empty(signals, "first observation only records the restart count")
+
+		_, signals = s.observe(pod, 30*time.Second, 5)
+
+		s.Require().Len(signals, 1)
+		signal := signals[0]
+		s.Equal(events.FaultTypeRestartStorm, signal.FaultType)
+		s.Equal(types.UID("pod-uid-web-1"), signal.ResourceUID)
+		s.Equal("Pod", signal.Kind)
+		s.Equal("web-1", signal.Name)
+		s.Equal("default", signal.Namespace)
+		s.Equal("app", signal.ContainerName)
+		s.Equal(events.SeverityCritical, signal.Severity)
+		s.Contains(signal.Context, "restarted 5 times in 30s")
+		s.Contains(signal.Context, "restart count: 5")
+		s.Equal(s.currentTime, signal.Timestamp)
+	})
+
+	s.Run("restarts accumulated over several updates emit signal", func() {
+		pod := createRestartingPod("web-1", "app", 0)
+		pod, _ = s.observe(pod, 0, 0)
+
+		var signals []events.FaultSignal
+		for count := int32(1); count <= 3; count++ {
+			pod, signals = s.observe(pod, 20*time.Second, count)
+			s.Empty(signals, "restart %d is within the threshold", count)
+		}
+
+		_, signals = s.observe(pod, 20*time.Second, 4)
+		s.Require().Len(signals, 1)
+		s.Contains(signals[0].Context, "restarted 4 times in 1m20s")
+	})
+
+	s.Run("slow restart count increase does not emit signal", func() {
+		pod := createRestartingPod("web-1", "app", 0)
+		pod, _ = s.observe(pod, 0, 0)
+
+		for count := int32(1); count <= 10; count++ {
+			var signals []events.FaultSignal
+			pod, signals = s.observe(pod, 10*time.Minute, count)
+			s.Empty(signals, "one restart every 10 minutes is not a storm")
+		}
+	})
+
+	s.Run("large jump over a long period is scaled to the window", func() {
+		pod := createRestartingPod("web-1", "app", 0)
+		pod, _ = s.observe(pod, 0, 0)
+
+		_, signals := s.observe(pod, time.Hour, 6)
+		s.Empty(signals, "6 restarts in an hour is below 3 per 5 minutes")
+	})
+
+	s.Run("signal is not repeated until a new burst", func() {
+		pod := createRestartingPod("web-1", "app", 0)
+		pod, _ = s.observe(pod, 0, 0)
+
+		pod, signals := s.observe(pod, 30*time.Second, 5)
+		s.Require().Len(signals, 1)
+
+		pod, signals = s.observe(pod, 30*time.Second, 6)
+		s.Empty(signals, "one restart after the storm was reported")
+
+		_, signals = s.observe(pod, 30*time.Second, 10)
+		s.Len(signals, 1, "a new burst is reported again")
+	})
+
+	s.Run("restart count reset is not treated as a storm", func() {
+		pod := createRestartingPod("web-1", "app", 10)
+		pod, _ = s.observe(pod, 0, 10)
+
+		pod, signals := s.observe(pod, 10*time.Second, 0)
+		s.Empty(signals)
+
+		_, signals = s.observe(pod, 10*time.Second, 2)
+		s.Empty(signals, "restarts are counted from the reset")
+	})
+
+	s.Run("threshold is configurable", func() {
+		s.detector = NewRestartStormDetectorWithThreshold(1, time.Minute)
+		s.detector.now = func() time.Time {
+			return s.currentTime
+		}
+
+		pod := createRestartingPod("web-1", "app", 0)
+		pod, _ = s.observe(pod, 0, 0)
+
+		_, signals := s.observe(pod, 30*time.Second, 2)
+		s.Len(signals, 1)
+	})
+
+	s.Run("stale entries for deleted pods are pruned", func() {
+		gone := createRestartingPod("gone", "app", 0)
+		other := createRestartingPod("other", "app", 0)
+
+		s.detector.Detect(gone, gone)
+		s.advanceTime(2 * time.Hour)
+		s.detector.Detect(other, other)
+
+		s.Len(s.detector.tracked, 1)
+		s.Contains(s.detector.tracked, restartStormKey{uid: other.UID, container: "app"})
+	})
+}
+
+// TestRestartStormDetector_EdgeCases tests edge cases and error handling
+func (s *RestartStormDetectorSuite) TestRestartStormDetector_EdgeCases() {
+	s.Run("returns empty slice for nil newObj", func() {
+		signals := s.detector.Detect(createRestartingPod("web-1", "app", 0), nil)
+		s.Empty(signals)
+		s.NotNil(signals)
+	})
+
+	s.Run("returns empty slice for nil oldObj", func() {
+		signals := s.detector.Detect(nil, createRestartingPod("web-1", "app", 0))
+		s.Empty(signals, "nil oldObj means Add event, nothing to evaluate")
+	})
+
+	s.Run("returns empty slice when objects are not Pods", func() {
+		pod := createRestartingPod("web-1", "app", 0)
+		s.Empty(s.detector.Detect(pod, "not a pod"))
+		s.Empty(s.detector.Detect("not a pod", pod))
+	})
+
+	s.Run("containers are tracked independently", func() {
+		pod := createRestartingPod("web-1", "app", 0)
+		pod.Status.ContainerStatuses = append(pod.Status.ContainerStatuses, corev1.ContainerStatus{Name: "sidecar"})
+		s.detector.Detect(pod, pod)
+
+		s.advanceTime(30 * time.Second)
+		updated := pod.DeepCopy()
+		updated.Status.ContainerStatuses[1].RestartCount = 5
+		signals := s.detector.Detect(pod, updated)
+
+		s.Require().Len(signals, 1)
+		s.Equal("sidecar", signals[0].ContainerName)
+	})
+}
+
+// TestRestartStormDetector_DetectorInterface verifies RestartStormDetector implements Detector
+func (s *RestartStormDetectorSuite) TestRestartStormDetector_DetectorInterface() {
+	s.Run("RestartStormDetector implements Detector interface", func() {
+		var _ events.Detector = &RestartStormDetector{}
+		var _ events.Detector = s.detector
+	})
+}
+
+// TestRestartStormDetector_Details tests the structured fields of restart storm signals
+func (s *RestartStormDetectorSuite) TestRestartStormDetector_Details() {
+	s.Run("details match the fields in the context", func() {
+		pod := createRestartingPod("web-1", "app", 0)
+		pod, _ = s.observe(pod, 0, 0)
+
+		s.advanceTime(30 * time.Second)
+		updated := createRestartingPod("web-1", "app", 5)
+		updated.Status.ContainerStatuses[0].LastTerminationState = corev1.ContainerState{
+			Terminated: &corev1.ContainerStateTerminated{ExitCode: 1, Reason: "Error"},
+		}
+		signals := s.detector.Detect(pod, updated)
+
+		s.Require().Len(signals, 1)
+		details := signals[0].Details
+		s.Equal(map[string]string{
+			"restarts":     "5",
+			"period":       "30s",
+			"restartCount": "5",
+			"lastExitCode": "1",
+			"lastReason":   "Error",
+		}, details)
+		s.Contains(signals[0].Context, "last exit code: "+details["lastExitCode"])
+		s.Contains(signals[0].Context, "last reason: "+details["lastReason"])
+	})
+}
+
+// createRestartingPod creates a pod with a single container at the given restart count.
+func createRestartingPod(name, container string, restartCount int32) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "default",
+			UID:       types.UID("pod-uid-" + name),
+		},
+		Status: corev1.PodStatus{
+			ContainerStatuses: []corev1.ContainerStatus{
+				{
+					Name:         container,
+					RestartCount: restartCount,
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,100 @@
+package detectors
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/events"
+)
+
+// ScaledToZeroDetector detects when a Deployment is scaled down to zero replicas.
+// A scale-down is detected when Spec.Replicas transitions from a non-zero value to 0.
+// Scaling to zero is usually intentional, so the signal is informational: it marks
+// the change on a timeline to help correlate outages with scale-downs.
+//
+// The detector is registered as opt-in and only runs for subscriptions selecting it.
+type ScaledToZeroDetector struct{}
+
+// NewScaledToZeroDetector creates a new ScaledToZeroDetector instance.
+func NewScaledToZeroDetector() *ScaledToZeroDetector {
+	return &ScaledToZeroDetector{}
+}
+
+// FaultType returns the type of the fault signals emitted by this detector.
+func (d *ScaledToZeroDetector) FaultType() events.FaultType {
+	return events.FaultTypeScaledToZero
+}
+
+// ResourceKind returns the kind of resource this detector inspects.
+func (d *ScaledToZeroDetector) ResourceKind() string {
+	return "Deployment"
+}
+
+// Describe returns the fault type emitted and the resource kind inspected by this detector.
+func (d *ScaledToZeroDetector) Describe() events.DetectorInfo {
+	return events.DetectorInfo{
+		FaultTypes:    []events.FaultType{d.FaultType()},
+		ResourceKinds: []string{d.ResourceKind()},
+		Description:   "Reports Deployments being scaled to zero replicas. Opt-in, as scaling to zero is usually intentional.",
+	}
+}
+
+// Detect analyzes Deployment state changes and returns fault signals for Deployments
+// whose desired replicas transitioned from non-zero to zero.
+func (d *ScaledToZeroDetector) Detect(oldObj, newObj interface{}) []events.FaultSignal {
+	// Handle nil newObj - nothing to detect
+	if newObj == nil {
+		return []events.FaultSignal{}
+	}
+
+	// Type assert to Deployment
+	newDeployment, ok := newObj.(*appsv1.Deployment)
+	if !ok {
+		return []events.FaultSignal{}
+	}
+
+	// If oldObj is nil (Add event), no transition to detect
+	if oldObj == nil {
+		return []events.FaultSignal{}
+	}
+
+	oldDeployment, ok := oldObj.(*appsv1.Deployment)
+	if !ok {
+		return []events.FaultSignal{}
+	}
+
+	// Detect transition from non-zero to zero desired replicas
+	oldReplicas := desiredReplicas(oldDeployment)
+	if oldReplicas == 0 || desiredReplicas(newDeployment) != 0 {
+		return []events.FaultSignal{}
+	}
+
+	signal := events.FaultSignal{
+		FaultType:   events.FaultTypeScaledToZero,
+		ResourceUID: types.UID(newDeployment.UID),
+		Kind:        "Deployment",
+		Name:        newDeployment.Name,
+		Namespace:   newDeployment.Namespace,
+		Severity:    events.SeverityInfo,
+		Context:     fmt.Sprintf("Deployment was scaled to zero replicas (from %d)", oldReplicas),
+		Details: map[string]string{
+			"previousReplicas": strconv.Itoa(int(oldReplicas)),
+		},
+		Timestamp: time.Now(),
+	}
+
+	return []events.FaultSignal{signal}
+}
+
+// desiredReplicas returns a Deployment's desired number of replicas. An unset
+// Spec.Replicas defaults to 1, as it does in the API server.
+func desiredReplicas(deployment *appsv1.Deployment) int32 {
+	if deployment.Spec.Replicas == nil {
+		return 1
+	}
+	return *deployment.Spec.Replicas
+}
@@ -0,0 +1,130 @@
+package detectors
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/events"
+)
+
+// ScaledToZeroDetectorSuite contains tests for ScaledToZeroDetector
+type ScaledToZeroDetectorSuite struct {
+	suite.Suite
+	detector *ScaledToZeroDetector
+}
+
+func TestScaledToZeroDetectorSuite(t *testing.T) {
+	suite.Run(t, new(ScaledToZeroDetectorSuite))
+}
+
+// SetupTest runs before each test
+func (s *ScaledToZeroDetectorSuite) SetupTest() {
+	s.detector = NewScaledToZeroDetector()
+}
+
+// TestScaledToZeroDetector_Transitions tests detection of scale-downs to zero replicas
+func (s *ScaledToZeroDetectorSuite) TestScaledToZeroDetector_Transitions() {
+	s.Run("scale to zero emits info signal", func() {
+		oldDeployment := createDeploymentWithReplicas("test-deployment", "default", ptr.To[int32](3))
+		newDeployment := createDeploymentWithReplicas("test-deployment", "default", ptr.To[int32](0))
+
+		signals := s.detector.Detect(oldDeployment, newDeployment)
+
+		s.Require().Len(signals, 1)
+		signal := signals[0]
+
+		s.Equal(events.FaultTypeScaledToZero, signal.FaultType)
+		s.Equal(types.UID(newDeployment.UID), signal.ResourceUID)
+		s.Equal("Deployment", signal.Kind)
+		s.Equal("test-deployment", signal.Name)
+		s.Equal("default", signal.Namespace)
+		s.Equal(events.SeverityInfo, signal.Severity)
+		s.Equal("Deployment was scaled to zero replicas (from 3)", signal.Context)
+		s.Equal(map[string]string{"previousReplicas": "3"}, signal.Details)
+		s.False(signal.Timestamp.IsZero())
+	})
+
+	s.Run("scale to zero from unset replicas emits signal", func() {
+		oldDeployment := createDeploymentWithReplicas("test-deployment", "default", nil)
+		newDeployment := createDeploymentWithReplicas("test-deployment", "default", ptr.To[int32](0))
+
+		signals := s.detector.Detect(oldDeployment, newDeployment)
+
+		s.Require().Len(signals, 1)
+		s.Equal(map[string]string{"previousReplicas": "1"}, signals[0].Details, "unset replicas defaults to 1")
+	})
+
+	s.Run("scale up does not emit signal", func() {
+		oldDeployment := createDeploymentWithReplicas("test-deployment", "default", ptr.To[int32](0))
+		newDeployment := createDeploymentWithReplicas("test-deployment", "default", ptr.To[int32](2))
+
+		signals := s.detector.Detect(oldDeployment, newDeployment)
+
+		s.Empty(signals)
+	})
+
+	s.Run("scale down to non-zero does not emit signal", func() {
+		oldDeployment := createDeploymentWithReplicas("test-deployment", "default", ptr.To[int32](3))
+		newDeployment := createDeploymentWithReplicas("test-deployment", "default", ptr.To[int32](1))
+
+		signals := s.detector.Detect(oldDeployment, newDeployment)
+
+		s.Empty(signals)
+	})
+
+	s.Run("unchanged replicas do not emit signal", func() {
+		oldDeployment := createDeploymentWithReplicas("test-deployment", "default", ptr.To[int32](3))
+		newDeployment := createDeploymentWithReplicas("test-deployment", "default", ptr.To[int32](3))
+
+		signals := s.detector.Detect(oldDeployment, newDeployment)
+
+		s.Empty(signals)
+	})
+
+	s.Run("deployment staying at zero does not emit signal", func() {
+		oldDeployment := createDeploymentWithReplicas("test-deployment", "default", ptr.To[int32](0))
+		newDeployment := createDeploymentWithReplicas("test-deployment", "default", ptr.To[int32](0))
+
+		signals := s.detector.Detect(oldDeployment, newDeployment)
+
+		s.Empty(signals)
+	})
+}
+
+// TestScaledToZeroDetector_EdgeCases tests edge cases and invalid inputs
+func (s *ScaledToZeroDetectorSuite) TestScaledToZeroDetector_EdgeCases() {
+	s.Run("add event does not emit signal", func() {
+		newDeployment := createDeploymentWithReplicas("test-deployment", "default", ptr.To[int32](0))
+
+		s.Empty(s.detector.Detect(nil, newDeployment))
+	})
+
+	s.Run("nil newObj does not emit signal", func() {
+		oldDeployment := createDeploymentWithReplicas("test-deployment", "default", ptr.To[int32](3))
+
+		s.Empty(s.detector.Detect(oldDeployment, nil))
+	})
+
+	s.Run("non-Deployment objects do not emit signal", func() {
+		s.Empty(s.detector.Detect("not a deployment", "not a deployment"))
+	})
+}
+
+// Helper function to create a Deployment with the given desired replicas
+func createDeploymentWithReplicas(name, namespace string, replicas *int32) *appsv1.Deployment {
+	return &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+			UID:       "deployment-uid-123",
+		},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: replicas,
+		},
+	}
+}
@@ -0,0 +1,177 @@
+package detectors
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/events"
+)
+
+// DefaultStuckTerminatingWindow is how long a pod may remain terminating
+// before it is reported as stuck.
+const DefaultStuckTerminatingWindow = 5 * time.Minute
+
+// stuckTerminatingStaleAfter is how long a tracked pod may go unobserved before
+// its entry is dropped. Informer resyncs re-deliver terminating pods well within
+// this period, so an entry this old belongs to a pod that is already gone.
+const stuckTerminatingStaleAfter = 1 * time.Hour
+
+// terminatingRecord tracks when a terminating pod was first and last observed.
+type terminatingRecord struct {
+	firstObserved time.Time
+	lastObserved  time.Time
+}
+
+// StuckTerminatingDetector detects pods that remain in Terminating state
+// (non-nil DeletionTimestamp) for longer than a grace window, typically
+// because of unresolved finalizers or an unreachable node.
+//
+// The detector records when it first observed each pod's deletion, keyed by UID,
+// and emits a signal on every update once the pod has been terminating longer
+// than the window. Repeated signals for the same pod are suppressed by the
+// ResourceWatcher's FaultDeduplicator. Because stuck pods rarely change, the
+// signal usually fires on an informer resync.
+//
+// It is safe for concurrent use.
+type StuckTerminatingDetector struct {
+	mu      sync.Mutex
+	window  time.Duration
+	tracked map[types.UID]*terminatingRecord
+	now     func() time.Time // allows time injection for testing
+}
+
+// NewStuckTerminatingDetector creates a new StuckTerminatingDetector with the default window.
+func NewStuckTerminatingDetector() *StuckTerminatingDetector {
+	return NewStuckTerminatingDetectorWithWindow(DefaultStuckTerminatingWindow)
+}
+
+// NewStuckTerminatingDetectorWithWindow creates a new StuckTerminatingDetector that
+// reports pods terminating for longer than window.
+func NewStuckTerminatingDetectorWithWindow(window time.Duration) *StuckTerminatingDetector {
+	return &StuckTerminatingDetector{
+		window:  window,
+		tracked: make(map[types.UID]*terminatingRecord),
+		now:     time.Now,
+	}
+}
+
+// FaultType returns the type of the fault signals emitted by this detector.
+func (d *StuckTerminatingDetector) FaultType() events.FaultType {
+	return events.FaultTypeStuckTerminating
+}
+
+// ResourceKind returns the kind of resource this detector inspects.
+func (d *StuckTerminatingDetector) ResourceKind() string {
+	return "Pod"
+}
+
+// Describe returns the fault type emitted and the resource kind inspected by this detector.
+func (d *StuckTerminatingDetector) Describe() events.DetectorInfo {
+	return events.DetectorInfo{
+		FaultTypes:    []events.FaultType{d.FaultType()},
+		ResourceKinds: []string{d.ResourceKind()},
+		Description:   "Reports pods remaining in Terminating state longer than a grace window.",
+	}
+}
+
+// Detect analyzes pod updates and returns a fault signal when a pod has been
+// terminating for longer than the configured window.
+func (d *StuckTerminatingDetector) Detect(oldObj, newObj interface{}) []events.FaultSignal {
+	// Handle nil newObj - nothing to detect
+	if newObj == nil {
+		return []events.FaultSignal{}
+	}
+
+	// Type assert to Pod
+	newPod, ok := newObj.(*corev1.Pod)
+	if !ok {
+		return []events.FaultSignal{}
+	}
+
+	// If oldObj is nil (Add event), no update to evaluate
+	if oldObj == nil {
+		return []events.FaultSignal{}
+	}
+
+	if _, ok := oldObj.(*corev1.Pod); !ok {
+		return []events.FaultSignal{}
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	now := d.now()
+	d.pruneLocked(now)
+
+	// Pod is not terminating - stop tracking it
+	if newPod.DeletionTimestamp == nil {
+		delete(d.tracked, newPod.UID)
+		return []events.FaultSignal{}
+	}
+
+	record, exists := d.tracked[newPod.UID]
+	if !exists {
+		record = &terminatingRecord{firstObserved: now}
+		d.tracked[newPod.UID] = record
+	}
+	record.lastObserved = now
+
+	terminatingFor := now.Sub(record.firstObserved)
+	if terminatingFor <= d.window {
+		return []events.FaultSignal{}
+	}
+
+	signal := events.FaultSignal{
+		FaultType:   events.FaultTypeStuckTerminating,
+		ResourceUID: types.UID(newPod.UID),
+		Kind:        "Pod",
+		Name:        newPod.Name,
+		Namespace:   newPod.Namespace,
+		Severity:    events.SeverityWarning,
+		Context:     buildStuckTerminatingContext(newPod, terminatingFor),
+		Details:     buildStuckTerminatingDetails(newPod, terminatingFor),
+		Timestamp:   now,
+	}
+
+	return []events.FaultSignal{signal}
+}
+
+// pruneLocked drops tracked pods that have not been observed recently.
+// Must be called with the lock held.
+func (d *StuckTerminatingDetector) pruneLocked(now time.Time) {
+	for uid, record := range d.tracked {
+		if now.Sub(record.lastObserved) > stuckTerminatingStaleAfter {
+			delete(d.tracked, uid)
+		}
+	}
+}
+
+// buildStuckTerminatingContext creates a human-readable context string for a
+// pod stuck in Terminating state, including its remaining finalizers.
+func buildStuckTerminatingContext(pod *corev1.Pod, terminatingFor time.Duration) string {
+	finalizers := "none"
+	if len(pod.Finalizers) > 0 {
+		finalizers = strings.Join(pod.Finalizers, ", ")
+	}
+	return fmt.Sprintf("Pod has been terminating for %s, remaining finalizers: %s",
+		terminatingFor.Truncate(time.Second), finalizers)
+}
+
+// buildStuckTerminatingDetails returns the fields embedded in the stuck terminating context.
+// Finalizers are comma-separated and omitted if there are none.
+func buildStuckTerminatingDetails(pod *corev1.Pod, terminatingFor time.Duration) map[string]string {
+	details := map[string]string{
+		"terminatingFor": terminatingFor.Truncate(time.Second).String(),
+	}
+
+	if len(pod.Finalizers) > 0 {
+		details["finalizers"] = strings.Join(pod.Finalizers, ", ")
+	}
+
+	return details
+}
@@ -0,0 +1,194 @@
+package detectors
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/events"
+)
+
+// StuckTerminatingDetectorSuite contains tests for StuckTerminatingDetector
+type StuckTerminatingDetectorSuite struct {
+	suite.Suite
+	detector    *StuckTerminatingDetector
+	currentTime time.Time
+}
+
+func TestStuckTerminatingDetectorSuite(t *testing.T) {
+	suite.Run(t, new(StuckTerminatingDetectorSuite))
+}
+
+// SetupTest runs before each test
+func (s *StuckTerminatingDetectorSuite) SetupTest() {
+	s.currentTime = time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	s.detector = NewStuckTerminatingDetectorWithWindow(5 * time.Minute)
+	s.detector.now = func() time.Time {
+		return s.currentTime
+	}
+}
+
+// SetupSubTest resets detector state between subtests
+func (s *StuckTerminatingDetectorSuite) SetupSubTest() {
+	s.SetupTest()
+}
+
+func (s *StuckTerminatingDetectorSuite) advanceTime(d time.Duration) {
+	s.currentTime = s.currentTime.Add(d)
+}
+
+// TestStuckTerminatingDetector_Window tests detection relative to the grace window
+func (s *StuckTerminatingDetectorSuite) TestStuckTerminatingDetector_Window() {
+	s.Run("pod deleted normally does not emit signal", func() {
+		running := createTerminatingPod("web-1", "default", false)
+		terminating := createTerminatingPod("web-1", "default", true)
+
+		signals := s.detector.Detect(running, terminating)
+		s.Empty(signals, "pod just started terminating")
+
+		s.advanceTime(30 * time.Second)
+		signals = s.detector.Detect(terminating, terminating)
+		s.Empty(signals, "pod still within grace window")
+	})
+
+	s.Run("pod terminating past the window emits signal", func() {
+		running := createTerminatingPod("web-1", "default", false)
+		terminating := createTerminatingPod("web-1", "default", true, "example.com/protect", "kubernetes.io/pvc-protection")
+
+		s.Empty(s.detector.Detect(running, terminating))
+
+		s.advanceTime(6 * time.Minute)
+		signals := s.detector.Detect(terminating, terminating)
+
+		s.Require().Len(signals, 1)
+		signal := signals[0]
+		s.Equal(events.FaultTypeStuckTerminating, signal.FaultType)
+		s.Equal(types.UID("pod-uid-web-1"), signal.ResourceUID)
+		s.Equal("Pod", signal.Kind)
+		s.Equal("web-1", signal.Name)
+		s.Equal("default", signal.Namespace)
+		s.Equal(events.SeverityWarning, signal.Severity)
+		s.Contains(signal.Context, "terminating for 6m0s")
+		s.Contains(signal.Context, "remaining finalizers: example.com/protect, kubernetes.io/pvc-protection")
+		s.Equal(s.currentTime, signal.Timestamp)
+	})
+
+	s.Run("window is measured from first observation", func() {
+		terminating := createTerminatingPod("web-1", "default", true)
+
+		s.Empty(s.detector.Detect(terminating, terminating))
+		s.advanceTime(3 * time.Minute)
+		s.Empty(s.detector.Detect(terminating, terminating), "later observations must not reset the clock")
+		s.advanceTime(3 * time.Minute)
+		s.Len(s.detector.Detect(terminating, terminating), 1)
+	})
+
+	s.Run("reports no finalizers when none remain", func() {
+		terminating := createTerminatingPod("web-1", "default", true)
+
+		s.detector.Detect(terminating, terminating)
+		s.advanceTime(10 * time.Minute)
+		signals := s.detector.Detect(terminating, terminating)
+
+		s.Require().Len(signals, 1)
+		s.Contains(signals[0].Context, "remaining finalizers: none")
+	})
+
+	s.Run("pod that stops terminating is no longer tracked", func() {
+		terminating := createTerminatingPod("web-1", "default", true)
+		running := createTerminatingPod("web-1", "default", false)
+
+		s.detector.Detect(terminating, terminating)
+		s.Empty(s.detector.Detect(terminating, running))
+		s.Empty(s.detector.tracked)
+	})
+
+	s.Run("stale entries for deleted pods are pruned", func() {
+		gone := createTerminatingPod("gone", "default", true)
+		other := createTerminatingPod("other", "default", true)
+
+		s.detector.Detect(gone, gone)
+		s.advanceTime(2 * time.Hour)
+		s.detector.Detect(other, other)
+
+		s.Len(s.detector.tracked, 1)
+		s.Contains(s.detector.tracked, other.UID)
+	})
+}
+
+// TestStuckTerminatingDetector_EdgeCases tests edge cases and error handling
+func (s *StuckTerminatingDetectorSuite) TestStuckTerminatingDetector_EdgeCases() {
+	s.Run("returns empty slice for nil newObj", func() {
+		signals := s.detector.Detect(createTerminatingPod("web-1", "default", true), nil)
+		s.Empty(signals)
+		s.NotNil(signals)
+	})
+
+	s.Run("returns empty slice for nil oldObj", func() {
+		signals := s.detector.Detect(nil, createTerminatingPod("web-1", "default", true))
+		s.Empty(signals, "nil oldObj means Add event, nothing to evaluate")
+	})
+
+	s.Run("returns empty slice when objects are not Pods", func() {
+		pod := createTerminatingPod("web-1", "default", true)
+		s.Empty(s.detector.Detect(pod, "not a pod"))
+		s.Empty(s.detector.Detect("not a pod", pod))
+	})
+}
+
+// TestStuckTerminatingDetector_DetectorInterface verifies StuckTerminatingDetector implements Detector
+func (s *StuckTerminatingDetectorSuite) TestStuckTerminatingDetector_DetectorInterface() {
+	s.Run("StuckTerminatingDetector implements Detector interface", func() {
+		var _ events.Detector = &StuckTerminatingDetector{}
+		var _ events.Detector = s.detector
+	})
+}
+
+// TestStuckTerminatingDetector_Details tests the structured fields of stuck terminating signals
+func (s *StuckTerminatingDetectorSuite) TestStuckTerminatingDetector_Details() {
+	s.Run("details match the fields in the context", func() {
+		terminating := createTerminatingPod("web-1", "default", true, "example.com/protect", "kubernetes.io/pvc-protection")
+
+		s.detector.Detect(terminating, terminating)
+		s.advanceTime(6 * time.Minute)
+		signals := s.detector.Detect(terminating, terminating)
+
+		s.Require().Len(signals, 1)
+		details := signals[0].Details
+		s.Equal(map[string]string{"terminatingFor": "6m0s", "finalizers": "example.com/protect, kubernetes.io/pvc-protection"}, details)
+		s.Contains(signals[0].Context, "terminating for "+details["terminatingFor"])
+		s.Contains(signals[0].Context, "remaining finalizers: "+details["finalizers"])
+	})
+
+	s.Run("finalizers are omitted when none remain", func() {
+		terminating := createTerminatingPod("web-1", "default", true)
+
+		s.detector.Detect(terminating, terminating)
+		s.advanceTime(10 * time.Minute)
+		signals := s.detector.Detect(terminating, terminating)
+
+		s.Require().Len(signals, 1)
+		s.Equal(map[string]string{"terminatingFor": "10m0s"}, signals[0].Details)
+	})
+}
+
+// createTerminatingPod creates a pod that is optionally marked for deletion with the given finalizers.
+func createTerminatingPod(name, namespace string, terminating bool, finalizers ...string) *corev1.Pod {
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       name,
+			Namespace:  namespace,
+			UID:        types.UID("pod-uid-" + name),
+			Finalizers: finalizers,
+		},
+	}
+	if terminating {
+		deletionTime := metav1.NewTime(time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC))
+		pod.DeletionTimestamp = &deletionTime
+	}
+	return pod
+}
@@ -0,0 +1,192 @@
+package detectors
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/events"
+)
+
+// DefaultUnschedulableWindow is how long a pod may remain unschedulable before it
+// is reported, giving the scheduler and cluster autoscaler time to place it.
+const DefaultUnschedulableWindow = 1 * time.Minute
+
+// unschedulableStaleAfter is how long a tracked pod may go unobserved before its
+// entry is dropped, so pods deleted while pending don't accumulate.
+const unschedulableStaleAfter = 1 * time.Hour
+
+// unschedulableRecord tracks when an unschedulable pod was first and last observed.
+type unschedulableRecord struct {
+	firstObserved time.Time
+	lastObserved  time.Time
+}
+
+// UnschedulableDetector detects pods the scheduler cannot place on any node. Such
+// pods stay Pending with no container statuses, so container-based detectors never
+// fire for them.
+//
+// A pod is considered unschedulable while its phase is Pending and its PodScheduled
+// condition is False with reason Unschedulable. The detector records when it first
+// observed each unschedulable pod, keyed by UID, and emits a signal on every update
+// once the pod has been unschedulable longer than the window. Repeated signals for
+// the same pod are suppressed by the ResourceWatcher's FaultDeduplicator.
+//
+// It is safe for concurrent use.
+type UnschedulableDetector struct {
+	mu      sync.Mutex
+	window  time.Duration
+	tracked map[types.UID]*unschedulableRecord
+	now     func() time.Time // allows time injection for testing
+}
+
+// NewUnschedulableDetector creates a new UnschedulableDetector with the default window.
+func NewUnschedulableDetector() *UnschedulableDetector {
+	return NewUnschedulableDetectorWithWindow(DefaultUnschedulableWindow)
+}
+
+// NewUnschedulableDetectorWithWindow creates a new UnschedulableDetector that
+// reports pods unschedulable for longer than window.
+func NewUnschedulableDetectorWithWindow(window time.Duration) *UnschedulableDetector {
+	return &UnschedulableDetector{
+		window:  window,
+		tracked: make(map[types.UID]*unschedulableRecord),
+		now:     time.Now,
+	}
+}
+
+// FaultType returns the type of the fault signals emitted by this detector.
+func (d *UnschedulableDetector) FaultType() events.FaultType {
+	return events.FaultTypeUnschedulable
+}
+
+// ResourceKind returns the kind of resource this detector inspects.
+func (d *UnschedulableDetector) ResourceKind() string {
+	return "Pod"
+}
+
+// Describe returns the fault type emitted and the resource kind inspected by this detector.
+func (d *UnschedulableDetector) Describe() events.DetectorInfo {
+	return events.DetectorInfo{
+		FaultTypes:    []events.FaultType{d.FaultType()},
+		ResourceKinds: []string{d.ResourceKind()},
+		Description:   "Reports pods the scheduler has been unable to place on any node for longer than a grace window.",
+	}
+}
+
+// Detect analyzes pod updates and returns a fault signal when a pod has been
+// unschedulable for longer than the configured window.
+func (d *UnschedulableDetector) Detect(oldObj, newObj interface{}) []events.FaultSignal {
+	// Handle nil newObj - nothing to detect
+	if newObj == nil {
+		return []events.FaultSignal{}
+	}
+
+	// Type assert to Pod
+	newPod, ok := newObj.(*corev1.Pod)
+	if !ok {
+		return []events.FaultSignal{}
+	}
+
+	// If oldObj is nil (Add event), no update to evaluate
+	if oldObj == nil {
+		return []events.FaultSignal{}
+	}
+
+	if _, ok := oldObj.(*corev1.Pod); !ok {
+		return []events.FaultSignal{}
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	now := d.now()
+	d.pruneLocked(now)
+
+	// Pod was scheduled, or is no longer pending - stop tracking it
+	condition := unschedulableCondition(newPod)
+	if condition == nil {
+		delete(d.tracked, newPod.UID)
+		return []events.FaultSignal{}
+	}
+
+	record, exists := d.tracked[newPod.UID]
+	if !exists {
+		record = &unschedulableRecord{firstObserved: now}
+		d.tracked[newPod.UID] = record
+	}
+	record.lastObserved = now
+
+	unschedulableFor := now.Sub(record.firstObserved)
+	if unschedulableFor <= d.window {
+		return []events.FaultSignal{}
+	}
+
+	signal := events.FaultSignal{
+		FaultType:   events.FaultTypeUnschedulable,
+		ResourceUID: types.UID(newPod.UID),
+		Kind:        "Pod",
+		Name:        newPod.Name,
+		Namespace:   newPod.Namespace,
+		Severity:    events.SeverityWarning,
+		Context:     buildUnschedulableContext(condition, unschedulableFor),
+		Details:     buildUnschedulableDetails(condition, unschedulableFor),
+		Timestamp:   now,
+	}
+
+	return []events.FaultSignal{signal}
+}
+
+// pruneLocked drops tracked pods that have not been observed recently.
+// Must be called with the lock held.
+func (d *UnschedulableDetector) pruneLocked(now time.Time) {
+	for uid, record := range d.tracked {
+		if now.Sub(record.lastObserved) > unschedulableStaleAfter {
+			delete(d.tracked, uid)
+		}
+	}
+}
+
+// unschedulableCondition returns the pod's PodScheduled condition if the pod is
+// Pending and the scheduler reported it Unschedulable, or nil otherwise.
+func unschedulableCondition(pod *corev1.Pod) *corev1.PodCondition {
+	if pod.Status.Phase != corev1.PodPending {
+		return nil
+	}
+	for i := range pod.Status.Conditions {
+		condition := &pod.Status.Conditions[i]
+		if condition.Type == corev1.PodScheduled &&
+			condition.Status == corev1.ConditionFalse &&
+			condition.Reason == corev1.PodReasonUnschedulable {
+			return condition
+		}
+	}
+	return nil
+}
+
+// buildUnschedulableContext creates a human-readable context string for an
+// unschedulable pod, including the scheduler's explanation.
+func buildUnschedulableContext(condition *corev1.PodCondition, unschedulableFor time.Duration) string {
+	context := fmt.Sprintf("Pod has been unschedulable for %s", unschedulableFor.Truncate(time.Second))
+	if condition.Message != "" {
+		context += fmt.Sprintf(", scheduler message: %s", condition.Message)
+	}
+	return context
+}
+
+// buildUnschedulableDetails returns the fields embedded in the unschedulable context.
+// The message is omitted if the scheduler didn't give one.
+func buildUnschedulableDetails(condition *corev1.PodCondition, unschedulableFor time.Duration) map[string]string {
+	details := map[string]string{
+		"unschedulableFor": unschedulableFor.Truncate(time.Second).String(),
+	}
+
+	if condition.Message != "" {
+		details["message"] = condition.Message
+	}
+
+	return details
+}
@@ -0,0 +1,213 @@
+package detectors
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/events"
+)
+
+const insufficientCPUMessage = "0/3 nodes are available: 3 Insufficient cpu."
+
+// UnschedulableDetectorSuite contains tests for UnschedulableDetector
+type UnschedulableDetectorSuite struct {
+	suite.Suite
+	detector    *UnschedulableDetector
+	currentTime time.Time
+}
+
+func TestUnschedulableDetectorSuite(t *testing.T) {
+	suite.Run(t, new(UnschedulableDetectorSuite))
+}
+
+// SetupTest runs before each test
+func (s *UnschedulableDetectorSuite) SetupTest() {
+	s.currentTime = time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	s.detector = NewUnschedulableDetectorWithWindow(time.Minute)
+	s.detector.now = func() time.Time {
+		return s.currentTime
+	}
+}
+
+// SetupSubTest resets detector state between subtests
+func (s *UnschedulableDetectorSuite) SetupSubTest() {
+	s.SetupTest()
+}
+
+func (s *UnschedulableDetectorSuite) advanceTime(d time.Duration) {
+	s.currentTime = s.currentTime.Add(d)
+}
+
+// TestUnschedulableDetector_Window tests detection relative to the window
+func (s *UnschedulableDetectorSuite) TestUnschedulableDetector_Window() {
+	s.Run("pod that schedules quickly does not emit signal", func() {
+		pending := createUnschedulablePod("web-1", insufficientCPUMessage)
+		scheduled := createScheduledPod("web-1")
+
+		s.Empty(s.detector.Detect(pending, pending), "pod just became unschedulable")
+
+		s.advanceTime(20 * time.Second)
+		s.Empty(s.detector.Detect(pending, scheduled), "pod was scheduled within the window")
+
+		s.advanceTime(time.Minute)
+		s.Empty(s.detector.Detect(scheduled, scheduled))
+		s.Empty(s.detector.tracked)
+	})
+
+	s.Run("pod stuck unschedulable past the window emits signal", func() {
+		pending := createUnschedulablePod("web-1", insufficientCPUMessage)
+
+		s.Empty(s.detector.Detect(pending, pending))
+
+		s.advanceTime(90 * time.Second)
+		signals := s.detector.Detect(pending, pending)
+
+		s.Require().Len(signals, 1)
+		signal := signals[0]
+		s.Equal(events.FaultTypeUnschedulable, signal.FaultType)
+		s.Equal(types.UID("pod-uid-web-1"), signal.ResourceUID)
+		s.Equal("Pod", signal.Kind)
+		s.Equal("web-1", signal.Name)
+		s.Equal("default", signal.Namespace)
+		s.Equal(events.SeverityWarning, signal.Severity)
+		s.Contains(signal.Context, "unschedulable for 1m30s")
+		s.Contains(signal.Context, "scheduler message: "+insufficientCPUMessage)
+		s.Equal(s.currentTime, signal.Timestamp)
+	})
+
+	s.Run("window is measured from first observation", func() {
+		pending := createUnschedulablePod("web-1", insufficientCPUMessage)
+
+		s.detector.Detect(pending, pending)
+		s.advanceTime(40 * time.Second)
+		s.Empty(s.detector.Detect(pending, pending))
+		s.advanceTime(40 * time.Second)
+		s.Len(s.detector.Detect(pending, pending), 1)
+	})
+
+	s.Run("pending pod that is not unschedulable does not emit signal", func() {
+		pending := createScheduledPod("web-1")
+		pending.Status.Phase = corev1.PodPending
+
+		s.detector.Detect(pending, pending)
+		s.advanceTime(10 * time.Minute)
+		s.Empty(s.detector.Detect(pending, pending), "pod is scheduled and waiting for its containers")
+	})
+
+	s.Run("stale entries for deleted pods are pruned", func() {
+		gone := createUnschedulablePod("gone", insufficientCPUMessage)
+		other := createUnschedulablePod("other", insufficientCPUMessage)
+
+		s.detector.Detect(gone, gone)
+		s.advanceTime(2 * time.Hour)
+		s.detector.Detect(other, other)
+
+		s.Len(s.detector.tracked, 1)
+		s.Contains(s.detector.tracked, other.UID)
+	})
+}
+
+// TestUnschedulableDetector_EdgeCases tests edge cases and error handling
+func (s *UnschedulableDetectorSuite) TestUnschedulableDetector_EdgeCases() {
+	s.Run("returns empty slice for nil newObj", func() {
+		signals := s.detector.Detect(createUnschedulablePod("web-1", ""), nil)
+		s.Empty(signals)
+		s.NotNil(signals)
+	})
+
+	s.Run("returns empty slice for nil oldObj", func() {
+		signals := s.detector.Detect(nil, createUnschedulablePod("web-1", ""))
+		s.Empty(signals, "nil oldObj means Add event, nothing to evaluate")
+	})
+
+	s.Run("returns empty slice when objects are not Pods", func() {
+		pod := createUnschedulablePod("web-1", "")
+		s.Empty(s.detector.Detect(pod, "not a pod"))
+		s.Empty(s.detector.Detect("not a pod", pod))
+	})
+
+	s.Run("omits scheduler message when empty", func() {
+		pending := createUnschedulablePod("web-1", "")
+
+		s.detector.Detect(pending, pending)
+		s.advanceTime(2 * time.Minute)
+		signals := s.detector.Detect(pending, pending)
+
+		s.Require().Len(signals, 1)
+		s.NotContains(signals[0].Context, "scheduler message")
+		s.NotContains(signals[0].Details, "message")
+	})
+}
+
+// TestUnschedulableDetector_DetectorInterface verifies UnschedulableDetector implements Detector
+func (s *UnschedulableDetectorSuite) TestUnschedulableDetector_DetectorInterface() {
+	s.Run("UnschedulableDetector implements Detector interface", func() {
+		var _ events.Detector = &UnschedulableDetector{}
+		var _ events.Detector = s.detector
+	})
+}
+
+// TestUnschedulableDetector_Details tests the structured fields of unschedulable signals
+func (s *UnschedulableDetectorSuite) TestUnschedulableDetector_Details() {
+	s.Run("details match the fields in the context", func() {
+		pending := createUnschedulablePod("web-1", insufficientCPUMessage)
+
+		s.detector.Detect(pending, pending)
+		s.advanceTime(2 * time.Minute)
+		signals := s.detector.Detect(pending, pending)
+
+		s.Require().Len(signals, 1)
+		details := signals[0].Details
+		s.Equal(map[string]string{
+			"unschedulableFor": "2m0s",
+			"message":          insufficientCPUMessage,
+		}, details)
+		s.Contains(signals[0].Context, "unschedulable for "+details["unschedulableFor"])
+		s.Contains(signals[0].Context, "scheduler message: "+details["message"])
+	})
+}
+
+// createUnschedulablePod creates a Pending pod the scheduler reported as unschedulable.
+func createUnschedulablePod(name, message string) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "default",
+			UID:       types.UID("pod-uid-" + name),
+		},
+		Status: corev1.PodStatus{
+			Phase: corev1.PodPending,
+			Conditions: []corev1.PodCondition{
+				{
+					Type:    corev1.PodScheduled,
+					Status:  corev1.ConditionFalse,
+					Reason:  corev1.PodReasonUnschedulable,
+					Message: message,
+				},
+			},
+		},
+	}
+}
+
+// createScheduledPod creates a Running pod that has been scheduled to a node.
+func createScheduledPod(name string) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "default",
+			UID:       types.UID("pod-uid-" + name),
+		},
+		Spec: corev1.PodSpec{NodeName: "node-1"},
+		Status: corev1.PodStatus{
+			Phase: corev1.PodRunning,
+			Conditions: []corev1.PodCondition{
+				{Type: corev1.PodScheduled, Status: corev1.ConditionTrue},
+			},
+		},
+	}
+}
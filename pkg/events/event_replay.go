@@ -0,0 +1,66 @@
+package events
+
+import "sync"
+
+// eventReplayBuffer is a fixed-capacity ring buffer of the events most recently
+// processed for a subscription, so a client that briefly lost its connection can
+// request the ones it may have missed. Once full, recording a new event overwrites
+// the oldest one, bounding memory per subscription.
+//
+// Thread-safe for concurrent use.
+type eventReplayBuffer struct {
+	mu      sync.Mutex
+	entries []*EventDetails
+	next    int // index of the slot the next entry is written to
+	count   int // number of valid entries (<= len(entries))
+}
+
+// newEventReplayBuffer creates an eventReplayBuffer retaining up to size events.
+// Returns nil if size is zero or less, which disables replay.
+func newEventReplayBuffer(size int) *eventReplayBuffer {
+	if size <= 0 {
+		return nil
+	}
+	return &eventReplayBuffer{entries: make([]*EventDetails, size)}
+}
+
+// record stores an event in the buffer. Recording to a nil buffer has no effect.
+func (b *eventReplayBuffer) record(details *EventDetails) {
+	if b == nil {
+		return
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.entries[b.next] = details
+	b.next = (b.next + 1) % len(b.entries)
+	if b.count < len(b.entries) {
+		b.count++
+	}
+}
+
+// recent returns up to limit of the most recently recorded events, ordered
+// newest-first. A limit of zero or less returns every buffered event.
+// The returned details are copies, but share their labels, involved object and owner
+// with the buffered ones, which must not be modified.
+func (b *eventReplayBuffer) recent(limit int) []*EventDetails {
+	if b == nil {
+		return []*EventDetails{}
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if limit <= 0 || limit > b.count {
+		limit = b.count
+	}
+
+	events := make([]*EventDetails, 0, limit)
+	for i := 1; i <= limit; i++ {
+		// Walk backwards from the most recently written slot
+		details := *b.entries[(b.next-i+len(b.entries))%len(b.entries)]
+		events = append(events, &details)
+	}
+	return events
+}
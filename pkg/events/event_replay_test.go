@@ -0,0 +1,98 @@
+package events
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type EventReplayBufferSuite struct {
+	suite.Suite
+	buffer *eventReplayBuffer
+}
+
+func TestEventReplayBufferSuite(t *testing.T) {
+	suite.Run(t, new(EventReplayBufferSuite))
+}
+
+func (s *EventReplayBufferSuite) SetupSubTest() {
+	s.buffer = newEventReplayBuffer(3)
+}
+
+// makeDetails creates the details of a distinct event for the nth occurrence.
+func (s *EventReplayBufferSuite) makeDetails(n int) *EventDetails {
+	return &EventDetails{
+		Namespace:       "default",
+		Type:            "Warning",
+		Reason:          "BackOff",
+		Message:         fmt.Sprintf("occurrence %d", n),
+		ResourceVersion: fmt.Sprint(n),
+	}
+}
+
+// replayMessages returns the messages of events, in order.
+func replayMessages(events []*EventDetails) []string {
+	result := make([]string, 0, len(events))
+	for _, details := range events {
+		result = append(result, details.Message)
+	}
+	return result
+}
+
+func (s *EventReplayBufferSuite) TestRecent() {
+	s.Run("returns empty slice when nothing was recorded", func() {
+		events := s.buffer.recent(0)
+		s.NotNil(events)
+		s.Empty(events)
+	})
+
+	s.Run("returns recorded events newest-first", func() {
+		for i := 0; i < 2; i++ {
+			s.buffer.record(s.makeDetails(i))
+		}
+
+		s.Equal([]string{"occurrence 1", "occurrence 0"}, replayMessages(s.buffer.recent(0)))
+	})
+
+	s.Run("keeps only the most recent events once full", func() {
+		for i := 0; i < 5; i++ {
+			s.buffer.record(s.makeDetails(i))
+		}
+
+		s.Equal([]string{"occurrence 4", "occurrence 3", "occurrence 2"}, replayMessages(s.buffer.recent(0)))
+	})
+
+	s.Run("respects the limit", func() {
+		for i := 0; i < 5; i++ {
+			s.buffer.record(s.makeDetails(i))
+		}
+
+		s.Equal([]string{"occurrence 4", "occurrence 3"}, replayMessages(s.buffer.recent(2)))
+		s.Len(s.buffer.recent(10), 3, "a limit above the buffered count returns every event")
+		s.Len(s.buffer.recent(-1), 3, "a negative limit returns every event")
+	})
+
+	s.Run("returns copies of the buffered events", func() {
+		s.buffer.record(s.makeDetails(0))
+
+		s.buffer.recent(1)[0].Message = "modified"
+		s.Equal("occurrence 0", s.buffer.recent(1)[0].Message)
+	})
+}
+
+func (s *EventReplayBufferSuite) TestDisabled() {
+	s.Run("size zero or less disables replay", func() {
+		s.Nil(newEventReplayBuffer(0))
+		s.Nil(newEventReplayBuffer(-1))
+	})
+
+	s.Run("nil buffer records nothing", func() {
+		var buffer *eventReplayBuffer
+		buffer.record(s.makeDetails(0))
+
+		events := buffer.recent(0)
+		s.NotNil(events)
+		s.Empty(events)
+	})
+}
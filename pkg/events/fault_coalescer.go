@@ -0,0 +1,137 @@
+package events
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// faultCoalescer groups fault signals for the same resource UID that are reported
+// within a window, so correlated faults (e.g. a container crash and the resulting
+// CrashLoopBackOff) are emitted together as a single notification.
+// The window starts with the first signal for a resource; signals without a
+// resource UID, or all signals when the window is not positive, are emitted immediately.
+//
+// Thread-safe for concurrent use.
+type faultCoalescer struct {
+	mu      sync.Mutex
+	window  time.Duration
+	clock   Clock
+	pending map[types.UID]*coalescedFaults
+	emit    func(ctx context.Context, signals []FaultSignal)
+}
+
+// coalescedFaults holds the signals collected for a resource during its window.
+type coalescedFaults struct {
+	ctx     context.Context
+	signals []FaultSignal
+}
+
+// newFaultCoalescer creates a faultCoalescer that passes each group of signals to emit,
+// timing windows with clock (the real clock if nil).
+func newFaultCoalescer(window time.Duration, clock Clock, emit func(ctx context.Context, signals []FaultSignal)) *faultCoalescer {
+	return &faultCoalescer{
+		window:  window,
+		clock:   clockOrDefault(clock),
+		pending: make(map[types.UID]*coalescedFaults),
+		emit:    emit,
+	}
+}
+
+// add collects a signal, emitting its group once the resource's window elapses.
+func (c *faultCoalescer) add(ctx context.Context, signal FaultSignal) {
+	if c.window <= 0 || signal.ResourceUID == "" {
+		c.emit(ctx, []FaultSignal{signal})
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if group, exists := c.pending[signal.ResourceUID]; exists {
+		group.signals = append(group.signals, signal)
+		return
+	}
+
+	c.pending[signal.ResourceUID] = &coalescedFaults{ctx: ctx, signals: []FaultSignal{signal}}
+	timer := c.clock.NewTimer(c.window)
+	go func() {
+		select {
+		case <-timer.C():
+		case <-ctx.Done():
+			timer.Stop()
+		}
+		c.flush(signal.ResourceUID)
+	}()
+}
+
+// flush emits the signals collected for a resource. Groups whose context was
+// cancelled in the meantime (e.g. the subscription was cancelled) are dropped.
+func (c *faultCoalescer) flush(uid types.UID) {
+	c.mu.Lock()
+	group := c.pending[uid]
+	delete(c.pending, uid)
+	c.mu.Unlock()
+
+	if group == nil || group.ctx.Err() != nil {
+		return
+	}
+	c.emit(group.ctx, group.signals)
+}
+
+// primaryFault returns the most severe of the given signals, preferring the
+// earliest reported one among signals of equal severity.
+func primaryFault(signals []FaultSignal) FaultSignal {
+	primary := signals[0]
+	for _, signal := range signals[1:] {
+		if severityRank(signal.Severity) > severityRank(primary.Severity) {
+			primary = signal
+		}
+	}
+	return primary
+}
+
+// coalescedFaultTypes returns the distinct fault types of the given signals in reporting order.
+func coalescedFaultTypes(signals []FaultSignal) []FaultType {
+	var faultTypes []FaultType
+	seen := make(map[FaultType]bool)
+	for _, signal := range signals {
+		if !seen[signal.FaultType] {
+			seen[signal.FaultType] = true
+			faultTypes = append(faultTypes, signal.FaultType)
+		}
+	}
+	return faultTypes
+}
+
+// coalescedContext combines the context of the given signals, prefixing each
+// with its fault type. A single signal's context is returned unchanged.
+func coalescedContext(signals []FaultSignal) string {
+	if len(signals) == 1 {
+		return signals[0].Context
+	}
+
+	parts := make([]string, 0, len(signals))
+	for _, signal := range signals {
+		if signal.Context == "" {
+			continue
+		}
+		parts = append(parts, string(signal.FaultType)+": "+signal.Context)
+	}
+	return strings.Join(parts, "; ")
+}
+
+// severityRank orders severities from least to most severe.
+func severityRank(severity Severity) int {
+	switch severity {
+	case SeverityCritical:
+		return 2
+	case SeverityWarning:
+		return 1
+	default:
+		return 0
+	}
+}
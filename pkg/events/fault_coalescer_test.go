@@ -0,0 +1,115 @@
+package events
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	clocktesting "k8s.io/utils/clock/testing"
+)
+
+type FaultCoalescerTestSuite struct {
+	suite.Suite
+}
+
+func TestFaultCoalescerSuite(t *testing.T) {
+	suite.Run(t, new(FaultCoalescerTestSuite))
+}
+
+func (s *FaultCoalescerTestSuite) TestAdd() {
+	// newTestCoalescer returns a coalescer on a fake clock and the groups it emitted so far
+	newTestCoalescer := func() (*faultCoalescer, *clocktesting.FakeClock, func() [][]FaultSignal) {
+		fakeClock := clocktesting.NewFakeClock(time.Now())
+		var mu sync.Mutex
+		var emitted [][]FaultSignal
+		coalescer := newFaultCoalescer(time.Second, fakeClock, func(_ context.Context, signals []FaultSignal) {
+			mu.Lock()
+			defer mu.Unlock()
+			emitted = append(emitted, signals)
+		})
+		return coalescer, fakeClock, func() [][]FaultSignal {
+			mu.Lock()
+			defer mu.Unlock()
+			return emitted
+		}
+	}
+
+	s.Run("emits signals for a resource together once the window elapses on the clock", func() {
+		coalescer, fakeClock, emitted := newTestCoalescer()
+		coalescer.add(context.Background(), FaultSignal{FaultType: FaultTypePodCrash, ResourceUID: "pod-uid"})
+		coalescer.add(context.Background(), FaultSignal{FaultType: FaultTypeCrashLoop, ResourceUID: "pod-uid"})
+
+		s.Never(func() bool { return len(emitted()) > 0 }, 50*time.Millisecond, 10*time.Millisecond,
+			"signals should wait for the window")
+
+		fakeClock.Step(time.Second)
+		s.Eventually(func() bool { return len(emitted()) == 1 }, time.Second, 10*time.Millisecond)
+		s.Len(emitted()[0], 2)
+	})
+
+	s.Run("drops the group once its context is cancelled", func() {
+		coalescer, fakeClock, emitted := newTestCoalescer()
+		ctx, cancel := context.WithCancel(context.Background())
+		coalescer.add(ctx, FaultSignal{FaultType: FaultTypePodCrash, ResourceUID: "pod-uid"})
+
+		cancel()
+		s.Eventually(func() bool {
+			coalescer.mu.Lock()
+			defer coalescer.mu.Unlock()
+			return len(coalescer.pending) == 0
+		}, time.Second, 10*time.Millisecond, "cancelled group should be discarded without waiting for the window")
+		fakeClock.Step(time.Second)
+		s.Empty(emitted())
+	})
+}
+
+func (s *FaultCoalescerTestSuite) TestPrimaryFault() {
+	s.Run("picks the most severe signal", func() {
+		signals := []FaultSignal{
+			{FaultType: FaultTypePodCrash, Severity: SeverityWarning},
+			{FaultType: FaultTypeCrashLoop, Severity: SeverityCritical},
+			{FaultType: FaultTypeOOMKilled, Severity: SeverityWarning},
+		}
+
+		s.Equal(FaultTypeCrashLoop, primaryFault(signals).FaultType)
+	})
+
+	s.Run("prefers the earliest signal among equal severities", func() {
+		signals := []FaultSignal{
+			{FaultType: FaultTypeOOMKilled, Severity: SeverityWarning},
+			{FaultType: FaultTypePodCrash, Severity: SeverityWarning},
+		}
+
+		s.Equal(FaultTypeOOMKilled, primaryFault(signals).FaultType)
+	})
+}
+
+func (s *FaultCoalescerTestSuite) TestCoalescedFaultTypes() {
+	s.Run("lists distinct fault types in reporting order", func() {
+		signals := []FaultSignal{
+			{FaultType: FaultTypePodCrash},
+			{FaultType: FaultTypeCrashLoop},
+			{FaultType: FaultTypePodCrash},
+		}
+
+		s.Equal([]FaultType{FaultTypePodCrash, FaultTypeCrashLoop}, coalescedFaultTypes(signals))
+	})
+}
+
+func (s *FaultCoalescerTestSuite) TestCoalescedContext() {
+	s.Run("single signal context is unchanged", func() {
+		s.Equal("exit code 1", coalescedContext([]FaultSignal{{FaultType: FaultTypePodCrash, Context: "exit code 1"}}))
+	})
+
+	s.Run("combines contexts prefixed by fault type", func() {
+		signals := []FaultSignal{
+			{FaultType: FaultTypePodCrash, Context: "exit code 1"},
+			{FaultType: FaultTypeCrashLoop},
+			{FaultType: FaultTypeCrashLoop, Context: "back-off 10s"},
+		}
+
+		s.Equal("PodCrash: exit code 1; CrashLoop: back-off 10s", coalescedContext(signals))
+	})
+}
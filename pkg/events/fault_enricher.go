@@ -5,10 +5,15 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"maps"
+	"sort"
+	"sync/atomic"
 
+	"golang.org/x/sync/errgroup"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
+	"k8s.io/klog/v2"
 )
 
 // FaultContextEnricher enriches fault signals with additional context.
@@ -19,9 +24,62 @@ import (
 // Logs are only fetched when:
 // - FaultSignal.Context is empty (no termination message available)
 // - Severity is SeverityCritical (e.g., CrashLoopBackOff)
+//
+// When enabled with WithRelatedEvents, it also attaches the most recent
+// Kubernetes events for the faulty resource to every signal.
+//
+// Node unhealthy signals are given the status of every node condition
+// (DiskPressure, MemoryPressure, PIDPressure, NetworkUnavailable, ...), not
+// just Ready, in their details.
+//
+// When given an EnrichmentLimiter with WithLimiter, enrichments needing API calls
+// beyond the limit are shed: the signal is left as detected.
 type FaultContextEnricher struct {
 	maxContainers        int
 	maxBytesPerContainer int
+	maxRelatedEvents     int
+	limiter              *EnrichmentLimiter
+}
+
+// DefaultMaxConcurrentEnrichments is the default number of fault enrichments that may
+// fetch logs or related events at the same time across all faults subscriptions.
+const DefaultMaxConcurrentEnrichments = 10
+
+// EnrichmentLimiter caps how many enrichments are in flight across the
+// FaultContextEnrichers sharing it, so a storm of faults doesn't open a log stream
+// per fault against the kubelets and API server. Enrichments that would exceed the
+// cap are shed rather than queued, so emitting faults is never delayed.
+//
+// Thread-safe for concurrent use.
+type EnrichmentLimiter struct {
+	slots chan struct{}
+	shed  atomic.Int64
+}
+
+// NewEnrichmentLimiter creates a limiter allowing up to maxConcurrent enrichments in flight.
+func NewEnrichmentLimiter(maxConcurrent int) *EnrichmentLimiter {
+	return &EnrichmentLimiter{slots: make(chan struct{}, maxConcurrent)}
+}
+
+// tryAcquire takes a slot if one is free and reports whether it did.
+func (l *EnrichmentLimiter) tryAcquire() bool {
+	select {
+	case l.slots <- struct{}{}:
+		return true
+	default:
+		l.shed.Add(1)
+		return false
+	}
+}
+
+// release frees a slot taken by tryAcquire.
+func (l *EnrichmentLimiter) release() {
+	<-l.slots
+}
+
+// Shed returns how many enrichments were shed because the limit was reached.
+func (l *EnrichmentLimiter) Shed() int64 {
+	return l.shed.Load()
 }
 
 // NewFaultContextEnricher creates a new FaultContextEnricher with default limits.
@@ -40,13 +98,31 @@ func NewFaultContextEnricherWithLimits(maxContainers, maxBytesPerContainer int)
 	}
 }
 
+// WithRelatedEvents enables attaching up to maxEvents of the most recent Kubernetes
+// events for the faulty resource to each signal. Zero or less disables related events.
+// Returns the enricher for chaining.
+func (e *FaultContextEnricher) WithRelatedEvents(maxEvents int) *FaultContextEnricher {
+	e.maxRelatedEvents = maxEvents
+	return e
+}
+
+// WithLimiter shares limiter between enrichers to cap their concurrent enrichments.
+// Returns the enricher for chaining.
+func (e *FaultContextEnricher) WithLimiter(limiter *EnrichmentLimiter) *FaultContextEnricher {
+	e.limiter = limiter
+	return e
+}
+
 // Enrich enriches a fault signal with additional context by fetching logs if needed.
-// It modifies the signal's Context field in place.
+// It modifies the signal's Context field in place, sets RelatedEvents when enabled,
+// and adds the node's conditions to the Details of node unhealthy signals.
 //
 // Logs are only fetched when:
 // 1. The signal's Context is empty (no termination message)
 // 2. The signal's Severity is SeverityCritical
 //
+// If the enricher's limiter has no slot free, the signal is left unchanged.
+//
 // Returns an error if log fetching fails, but this is not a critical error
 // since the signal already contains basic fault information.
 func (e *FaultContextEnricher) Enrich(ctx context.Context, signal *FaultSignal, clientset kubernetes.Interface) error {
@@ -54,18 +130,38 @@ func (e *FaultContextEnricher) Enrich(ctx context.Context, signal *FaultSignal,
 		return fmt.Errorf("signal cannot be nil")
 	}
 
-	// Skip log fetch if context already exists (has termination message)
-	if signal.Context != "" {
+	fetchEvents := e.maxRelatedEvents > 0 && signal.ResourceUID != ""
+	fetchLogs := needsLogs(signal)
+	fetchConditions := signal.FaultType == FaultTypeNodeUnhealthy && signal.Name != ""
+	if !fetchEvents && !fetchLogs && !fetchConditions {
 		return nil
 	}
 
-	// Skip log fetch if severity is not critical
-	if signal.Severity != SeverityCritical {
-		return nil
+	// Enrichments beyond the limit are shed, sending the fault as detected
+	if e.limiter != nil {
+		if !e.limiter.tryAcquire() {
+			klog.V(2).Infof("Enrichment limit reached, sending %s fault for %s %s without logs, related events, or node conditions",
+				signal.FaultType, signal.Kind, resourceRef(signal.Namespace, signal.Name))
+			return nil
+		}
+		defer e.limiter.release()
+	}
+
+	// Related events are best-effort and don't prevent log fetching
+	if fetchEvents {
+		if err := e.attachRelatedEvents(ctx, signal, clientset); err != nil {
+			klog.V(2).Infof("Failed to fetch related events for %s %s/%s: %v", signal.Kind, signal.Namespace, signal.Name, err)
+		}
+	}
+
+	// Node conditions are best-effort as well, the signal already has the Ready condition
+	if fetchConditions {
+		if err := attachNodeConditions(ctx, signal, clientset); err != nil {
+			klog.V(2).Infof("Failed to fetch conditions of node %s: %v", signal.Name, err)
+		}
 	}
 
-	// Only fetch logs for pod-related faults
-	if signal.Kind != "Pod" {
+	if !fetchLogs {
 		return nil
 	}
 
@@ -98,6 +194,81 @@ func (e *FaultContextEnricher) Enrich(ctx context.Context, signal *FaultSignal,
 	return nil
 }
 
+// needsLogs reports whether logs should be fetched for a signal: a critical pod fault
+// without context (e.g. no termination message).
+func needsLogs(signal *FaultSignal) bool {
+	return signal.Context == "" && signal.Severity == SeverityCritical && signal.Kind == "Pod"
+}
+
+// attachRelatedEvents sets the signal's RelatedEvents to the most recent events whose
+// involved object is the faulty resource, newest first and bounded by maxRelatedEvents.
+func (e *FaultContextEnricher) attachRelatedEvents(ctx context.Context, signal *FaultSignal, clientset kubernetes.Interface) error {
+	if signal.ResourceUID == "" {
+		return nil
+	}
+
+	// Events of cluster-scoped resources are recorded in the default namespace,
+	// so those are listed across all namespaces
+	list, err := clientset.CoreV1().Events(signal.Namespace).List(ctx, metav1.ListOptions{
+		FieldSelector: fmt.Sprintf("involvedObject.uid=%s", signal.ResourceUID),
+	})
+	if err != nil {
+		return err
+	}
+
+	related := make([]*v1.Event, 0, len(list.Items))
+	for i := range list.Items {
+		// Field selectors are enforced by the API server, double-check in case it didn't
+		if list.Items[i].InvolvedObject.UID == signal.ResourceUID {
+			related = append(related, &list.Items[i])
+		}
+	}
+
+	sort.SliceStable(related, func(i, j int) bool {
+		return eventTimestamp(related[i]).After(eventTimestamp(related[j]))
+	})
+	if len(related) > e.maxRelatedEvents {
+		related = related[:e.maxRelatedEvents]
+	}
+
+	signal.RelatedEvents = make([]*EventDetails, 0, len(related))
+	for _, event := range related {
+		signal.RelatedEvents = append(signal.RelatedEvents, SerializeEvent(event))
+	}
+	return nil
+}
+
+// attachNodeConditions adds the status of each of the faulty node's conditions to the
+// signal's details, keyed by nodeConditionDetailPrefix and the condition type, e.g.
+// "condition.MemoryPressure": "True".
+func attachNodeConditions(ctx context.Context, signal *FaultSignal, clientset kubernetes.Interface) error {
+	node, err := clientset.CoreV1().Nodes().Get(ctx, signal.Name, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	// A node recreated under the same name isn't the faulty one
+	if signal.ResourceUID != "" && node.UID != signal.ResourceUID {
+		return fmt.Errorf("node %s has UID %s, not %s", signal.Name, node.UID, signal.ResourceUID)
+	}
+	if len(node.Status.Conditions) == 0 {
+		return nil
+	}
+
+	// Details are copied, as detectors may share them between signals
+	details := maps.Clone(signal.Details)
+	if details == nil {
+		details = make(map[string]string, len(node.Status.Conditions))
+	}
+	for _, condition := range node.Status.Conditions {
+		details[nodeConditionDetailPrefix+string(condition.Type)] = string(condition.Status)
+	}
+	signal.Details = details
+	return nil
+}
+
+// nodeConditionDetailPrefix prefixes the condition type in the details keys of node conditions.
+const nodeConditionDetailPrefix = "condition."
+
 // fetchPodLogs fetches logs from a pod's containers using kubernetes.Interface.
 func (e *FaultContextEnricher) fetchPodLogs(
 	ctx context.Context,
@@ -121,46 +292,79 @@ func (e *FaultContextEnricher) fetchPodLogs(
 		containerNames = containerNames[:e.maxContainers]
 	}
 
+	// Fetch each container's logs concurrently, collecting them per container so they
+	// are returned in the order of the pod spec however the fetches finish
+	containerLogs := make([][]ContainerLog, len(containerNames))
+	var group errgroup.Group
+	group.SetLimit(e.logFetchConcurrency())
+	for i, containerName := range containerNames {
+		group.Go(func() error {
+			containerLogs[i] = e.fetchContainerLogs(ctx, clientset, namespace, podName, containerName)
+			return nil
+		})
+	}
+	_ = group.Wait() // container fetches report their errors in the logs
+
 	var logs []ContainerLog
+	for _, fetched := range containerLogs {
+		logs = append(logs, fetched...)
+	}
+	return logs, nil
+}
 
-	// Fetch logs for each container (current and previous)
-	for _, containerName := range containerNames {
-		// Try to get current logs
-		currentLog, currentErr := e.getPodLogs(ctx, clientset, namespace, podName, containerName, false)
-		if currentErr == nil {
-			truncated := truncateLog(currentLog, e.maxBytesPerContainer)
-			logs = append(logs, ContainerLog{
-				Container: containerName,
-				Previous:  false,
-				HasPanic:  detectPanic(truncated),
-				Sample:    truncated,
-			})
-		} else {
-			// Include error information if log fetch failed (e.g., RBAC denied)
-			logs = append(logs, ContainerLog{
-				Container: containerName,
-				Previous:  false,
-				HasPanic:  false,
-				Sample:    "",
-				Error:     currentErr.Error(),
-			})
-		}
+// logFetchConcurrency returns how many containers' logs are fetched at the same time:
+// the limit of the enricher's limiter, or DefaultMaxConcurrentEnrichments without one.
+func (e *FaultContextEnricher) logFetchConcurrency() int {
+	if e.limiter != nil && cap(e.limiter.slots) > 0 {
+		return cap(e.limiter.slots)
+	}
+	return DefaultMaxConcurrentEnrichments
+}
 
-		// Try to get previous logs (if container has restarted)
-		previousLog, previousErr := e.getPodLogs(ctx, clientset, namespace, podName, containerName, true)
-		if previousErr == nil && previousLog != "" {
-			truncated := truncateLog(previousLog, e.maxBytesPerContainer)
-			logs = append(logs, ContainerLog{
-				Container: containerName,
-				Previous:  true,
-				HasPanic:  detectPanic(truncated),
-				Sample:    truncated,
-			})
-		}
-		// Don't add error for previous logs if they don't exist (common case)
+// fetchContainerLogs fetches the current logs of a container, and its previous logs if it
+// has restarted.
+func (e *FaultContextEnricher) fetchContainerLogs(
+	ctx context.Context,
+	clientset kubernetes.Interface,
+	namespace, podName, containerName string,
+) []ContainerLog {
+	var logs []ContainerLog
+
+	// Try to get current logs
+	currentLog, currentErr := e.getPodLogs(ctx, clientset, namespace, podName, containerName, false)
+	if currentErr == nil {
+		truncated := truncateLog(currentLog, e.maxBytesPerContainer)
+		logs = append(logs, ContainerLog{
+			Container: containerName,
+			Previous:  false,
+			HasPanic:  detectPanic(truncated),
+			Sample:    truncated,
+		})
+	} else {
+		// Include error information if log fetch failed (e.g., RBAC denied)
+		logs = append(logs, ContainerLog{
+			Container: containerName,
+			Previous:  false,
+			HasPanic:  false,
+			Sample:    "",
+			Error:     currentErr.Error(),
+		})
 	}
 
-	return logs, nil
+	// Try to get previous logs (if container has restarted)
+	previousLog, previousErr := e.getPodLogs(ctx, clientset, namespace, podName, containerName, true)
+	if previousErr == nil && previousLog != "" {
+		truncated := truncateLog(previousLog, e.maxBytesPerContainer)
+		logs = append(logs, ContainerLog{
+			Container: containerName,
+			Previous:  true,
+			HasPanic:  detectPanic(truncated),
+			Sample:    truncated,
+		})
+	}
+	// Don't add error for previous logs if they don't exist (common case)
+
+	return logs
 }
 
 // getPodLogs retrieves logs from a specific container in a pod using kubernetes.Interface.
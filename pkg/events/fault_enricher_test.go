@@ -3,15 +3,27 @@ package events
 import (
 	"context"
 	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/suite"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/kubernetes/scheme"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/rest"
+	fakerest "k8s.io/client-go/rest/fake"
+	k8stesting "k8s.io/client-go/testing"
 )
 
 type FaultEnricherSuite struct {
@@ -360,6 +372,125 @@ func (s *FaultEnricherSuite) TestFetchPodLogs() {
 		_, _ = enricher.fetchPodLogs(context.Background(), clientset, "default", "multi-container-pod")
 		// The test passes if no panic occurs and limiting logic is executed
 	})
+
+	newMultiContainerPod := func(containers ...string) *v1.Pod {
+		pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "multi-container-pod", Namespace: "default"}}
+		for _, container := range containers {
+			pod.Spec.Containers = append(pod.Spec.Containers, v1.Container{Name: container})
+		}
+		return pod
+	}
+
+	s.Run("gathers the logs of every container in pod spec order", func() {
+		containers := []string{"app", "sidecar", "proxy", "exporter"}
+		// Earlier containers answer slower, so fetches finish in reverse order
+		clientset := newContainerLogsClientset(newMultiContainerPod(containers...), map[string]time.Duration{
+			"app":     30 * time.Millisecond,
+			"sidecar": 20 * time.Millisecond,
+			"proxy":   10 * time.Millisecond,
+		})
+		enricher := NewFaultContextEnricherWithLimits(len(containers), DefaultMaxLogBytesPerContainer)
+
+		for attempt := 0; attempt < 3; attempt++ {
+			logs, err := enricher.fetchPodLogs(context.Background(), clientset, "default", "multi-container-pod")
+			s.Require().NoError(err)
+
+			var samples []string
+			for _, log := range logs {
+				samples = append(samples, fmt.Sprintf("%s previous=%t: %s", log.Container, log.Previous, log.Sample))
+			}
+			s.Equal([]string{
+				"app previous=false: app current logs",
+				"app previous=true: app previous logs",
+				"sidecar previous=false: sidecar current logs",
+				"sidecar previous=true: sidecar previous logs",
+				"proxy previous=false: proxy current logs",
+				"proxy previous=true: proxy previous logs",
+				"exporter previous=false: exporter current logs",
+				"exporter previous=true: exporter previous logs",
+			}, samples, "attempt %d", attempt)
+		}
+	})
+
+	s.Run("fetches containers concurrently within the enrichment limit", func() {
+		containers := []string{"c1", "c2", "c3", "c4", "c5", "c6"}
+		delays := map[string]time.Duration{}
+		for _, container := range containers {
+			delays[container] = 20 * time.Millisecond
+		}
+		clientset := newContainerLogsClientset(newMultiContainerPod(containers...), delays)
+		enricher := NewFaultContextEnricherWithLimits(len(containers), DefaultMaxLogBytesPerContainer).
+			WithLimiter(NewEnrichmentLimiter(3))
+
+		logs, err := enricher.fetchPodLogs(context.Background(), clientset, "default", "multi-container-pod")
+		s.Require().NoError(err)
+
+		s.Len(logs, 2*len(containers), "current and previous logs of every container")
+		s.Equal(int64(3), clientset.maxInFlight.Load(), "containers should be fetched concurrently up to the limit")
+	})
+}
+
+// containerLogsClientset is a fake clientset serving "<container> current logs" and
+// "<container> previous logs" for each container after its delay, recording the number
+// of log requests in flight and its peak. Unlike the fake clientset's reactors, log
+// requests are served concurrently.
+type containerLogsClientset struct {
+	*fake.Clientset
+	delays      map[string]time.Duration
+	inFlight    atomic.Int64
+	maxInFlight atomic.Int64
+}
+
+func newContainerLogsClientset(pod *v1.Pod, delays map[string]time.Duration) *containerLogsClientset {
+	return &containerLogsClientset{Clientset: fake.NewClientset(pod), delays: delays}
+}
+
+func (c *containerLogsClientset) CoreV1() corev1client.CoreV1Interface {
+	return &containerLogsCoreV1{CoreV1Interface: c.Clientset.CoreV1(), clientset: c}
+}
+
+type containerLogsCoreV1 struct {
+	corev1client.CoreV1Interface
+	clientset *containerLogsClientset
+}
+
+func (c *containerLogsCoreV1) Pods(namespace string) corev1client.PodInterface {
+	return &containerLogsPods{PodInterface: c.CoreV1Interface.Pods(namespace), clientset: c.clientset}
+}
+
+type containerLogsPods struct {
+	corev1client.PodInterface
+	clientset *containerLogsClientset
+}
+
+// GetLogs returns a request serving the container's logs after its delay.
+func (p *containerLogsPods) GetLogs(name string, opts *v1.PodLogOptions) *rest.Request {
+	c := p.clientset
+	kind := "current"
+	if opts.Previous {
+		kind = "previous"
+	}
+	client := &fakerest.RESTClient{
+		Client: fakerest.CreateHTTPClient(func(*http.Request) (*http.Response, error) {
+			current := c.inFlight.Add(1)
+			defer c.inFlight.Add(-1)
+			for {
+				peak := c.maxInFlight.Load()
+				if current <= peak || c.maxInFlight.CompareAndSwap(peak, current) {
+					break
+				}
+			}
+			time.Sleep(c.delays[opts.Container])
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(strings.NewReader(fmt.Sprintf("%s %s logs", opts.Container, kind))),
+			}, nil
+		}),
+		NegotiatedSerializer: scheme.Codecs.WithoutConversion(),
+		GroupVersion:         v1.SchemeGroupVersion,
+		VersionedAPIPath:     "/api/v1/namespaces/default/pods/" + name + "/log",
+	}
+	return client.Request()
 }
 
 func (s *FaultEnricherSuite) TestGetPodLogs() {
@@ -472,6 +603,367 @@ func (s *FaultEnricherSuite) TestIntegrationWithRealDetectors() {
 	})
 }
 
+func (s *FaultEnricherSuite) TestRelatedEvents() {
+	base := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	relatedEvent := func(name string, uid types.UID, reason string, at time.Time) *v1.Event {
+		return &v1.Event{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+			InvolvedObject: v1.ObjectReference{
+				Kind:      "Pod",
+				Name:      "test-pod",
+				Namespace: "default",
+				UID:       uid,
+			},
+			Type:           "Warning",
+			Reason:         reason,
+			Message:        reason + " message",
+			FirstTimestamp: metav1.NewTime(at),
+		}
+	}
+	newSignal := func() *FaultSignal {
+		return &FaultSignal{
+			FaultType:   FaultTypePodCrash,
+			ResourceUID: types.UID("pod-uid"),
+			Kind:        "Pod",
+			Name:        "test-pod",
+			Namespace:   "default",
+			Severity:    SeverityWarning,
+			Context:     "Container crashed with exit code 1",
+		}
+	}
+
+	s.Run("attaches related events newest first", func() {
+		clientset := fake.NewClientset(
+			relatedEvent("pulled", "pod-uid", "Pulled", base),
+			relatedEvent("backoff", "pod-uid", "BackOff", base.Add(2*time.Minute)),
+			relatedEvent("started", "pod-uid", "Started", base.Add(time.Minute)),
+			relatedEvent("other", "other-uid", "Killing", base.Add(3*time.Minute)),
+		)
+		enricher := NewFaultContextEnricher().WithRelatedEvents(5)
+
+		signal := newSignal()
+		s.Require().NoError(enricher.Enrich(context.Background(), signal, clientset))
+
+		s.Require().Len(signal.RelatedEvents, 3, "only events for the faulty resource are attached")
+		s.Equal("BackOff", signal.RelatedEvents[0].Reason)
+		s.Equal("Started", signal.RelatedEvents[1].Reason)
+		s.Equal("Pulled", signal.RelatedEvents[2].Reason)
+		s.Equal("BackOff message", signal.RelatedEvents[0].Message)
+		s.Equal("pod-uid", signal.RelatedEvents[0].InvolvedObject.UID)
+		s.Equal("Container crashed with exit code 1", signal.Context, "context is unchanged")
+	})
+
+	s.Run("lists events by involved object UID", func() {
+		clientset := fake.NewClientset()
+		var fieldSelector string
+		clientset.PrependReactor("list", "events", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			fieldSelector = action.(k8stesting.ListActionImpl).ListOptions.FieldSelector
+			return false, nil, nil
+		})
+		enricher := NewFaultContextEnricher().WithRelatedEvents(5)
+
+		s.Require().NoError(enricher.Enrich(context.Background(), newSignal(), clientset))
+		s.Equal("involvedObject.uid=pod-uid", fieldSelector)
+	})
+
+	s.Run("bounds the number of related events", func() {
+		clientset := fake.NewClientset(
+			relatedEvent("first", "pod-uid", "First", base),
+			relatedEvent("second", "pod-uid", "Second", base.Add(time.Minute)),
+			relatedEvent("third", "pod-uid", "Third", base.Add(2*time.Minute)),
+		)
+		enricher := NewFaultContextEnricher().WithRelatedEvents(2)
+
+		signal := newSignal()
+		s.Require().NoError(enricher.Enrich(context.Background(), signal, clientset))
+
+		s.Require().Len(signal.RelatedEvents, 2)
+		s.Equal("Third", signal.RelatedEvents[0].Reason)
+		s.Equal("Second", signal.RelatedEvents[1].Reason)
+	})
+
+	s.Run("does not fetch related events by default", func() {
+		clientset := fake.NewClientset(relatedEvent("backoff", "pod-uid", "BackOff", base))
+
+		signal := newSignal()
+		s.Require().NoError(NewFaultContextEnricher().Enrich(context.Background(), signal, clientset))
+
+		s.Nil(signal.RelatedEvents)
+		s.Empty(clientset.Actions(), "no API calls expected")
+	})
+
+	s.Run("fetch errors don't fail enrichment", func() {
+		clientset := fake.NewClientset()
+		clientset.PrependReactor("list", "events", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			return true, nil, fmt.Errorf("events is forbidden")
+		})
+		enricher := NewFaultContextEnricher().WithRelatedEvents(5)
+
+		signal := newSignal()
+		s.NoError(enricher.Enrich(context.Background(), signal, clientset))
+		s.Nil(signal.RelatedEvents)
+	})
+
+	s.Run("related events are serialized in notifications", func() {
+		clientset := fake.NewClientset(relatedEvent("backoff", "pod-uid", "BackOff", base))
+		enricher := NewFaultContextEnricher().WithRelatedEvents(5)
+
+		signal := newSignal()
+		s.Require().NoError(enricher.Enrich(context.Background(), signal, clientset))
+
+		data, err := json.Marshal(signal)
+		s.Require().NoError(err)
+		s.Contains(string(data), `"relatedEvents":[{`)
+		s.Contains(string(data), `"reason":"BackOff"`)
+	})
+}
+
+func (s *FaultEnricherSuite) TestNodeConditions() {
+	unhealthyNode := func() *v1.Node {
+		return &v1.Node{
+			ObjectMeta: metav1.ObjectMeta{Name: "worker-1", UID: "node-uid"},
+			Status: v1.NodeStatus{Conditions: []v1.NodeCondition{
+				{Type: v1.NodeReady, Status: v1.ConditionFalse, Reason: "KubeletNotReady"},
+				{Type: v1.NodeDiskPressure, Status: v1.ConditionFalse},
+				{Type: v1.NodeMemoryPressure, Status: v1.ConditionTrue, Reason: "KubeletHasInsufficientMemory"},
+				{Type: v1.NodePIDPressure, Status: v1.ConditionFalse},
+				{Type: v1.NodeNetworkUnavailable, Status: v1.ConditionUnknown},
+			}},
+		}
+	}
+	newSignal := func() *FaultSignal {
+		return &FaultSignal{
+			FaultType:   FaultTypeNodeUnhealthy,
+			ResourceUID: types.UID("node-uid"),
+			Kind:        "Node",
+			Name:        "worker-1",
+			Severity:    SeverityCritical,
+			Context:     "Node became unhealthy: Ready=False, reason: KubeletNotReady",
+			Details:     map[string]string{"readyStatus": "False", "reason": "KubeletNotReady"},
+		}
+	}
+
+	s.Run("adds every node condition to the details", func() {
+		clientset := fake.NewClientset(unhealthyNode())
+
+		signal := newSignal()
+		s.Require().NoError(NewFaultContextEnricher().Enrich(context.Background(), signal, clientset))
+
+		s.Equal(map[string]string{
+			"readyStatus":                  "False",
+			"reason":                       "KubeletNotReady",
+			"condition.Ready":              "False",
+			"condition.DiskPressure":       "False",
+			"condition.MemoryPressure":     "True",
+			"condition.PIDPressure":        "False",
+			"condition.NetworkUnavailable": "Unknown",
+		}, signal.Details)
+		s.Equal("Node became unhealthy: Ready=False, reason: KubeletNotReady", signal.Context, "context is unchanged")
+	})
+
+	s.Run("does not modify details shared with other signals", func() {
+		clientset := fake.NewClientset(unhealthyNode())
+		signal := newSignal()
+		detected := signal.Details
+
+		s.Require().NoError(NewFaultContextEnricher().Enrich(context.Background(), signal, clientset))
+
+		s.Len(detected, 2)
+		s.Len(signal.Details, 7)
+	})
+
+	s.Run("node conditions are serialized in notifications", func() {
+		clientset := fake.NewClientset(unhealthyNode())
+
+		signal := newSignal()
+		s.Require().NoError(NewFaultContextEnricher().Enrich(context.Background(), signal, clientset))
+
+		data, err := json.Marshal(signal)
+		s.Require().NoError(err)
+		s.Contains(string(data), `"condition.MemoryPressure":"True"`)
+	})
+
+	s.Run("fetch errors don't fail enrichment", func() {
+		clientset := fake.NewClientset()
+
+		signal := newSignal()
+		s.NoError(NewFaultContextEnricher().Enrich(context.Background(), signal, clientset))
+		s.Len(signal.Details, 2, "details are left as detected")
+	})
+
+	s.Run("skips a node recreated under the same name", func() {
+		node := unhealthyNode()
+		node.UID = "other-uid"
+		clientset := fake.NewClientset(node)
+
+		signal := newSignal()
+		s.NoError(NewFaultContextEnricher().Enrich(context.Background(), signal, clientset))
+		s.Len(signal.Details, 2)
+	})
+
+	s.Run("other faults on nodes don't fetch the node", func() {
+		clientset := fake.NewClientset(unhealthyNode())
+
+		signal := newSignal()
+		signal.FaultType = FaultTypeNodeCordoned
+		s.NoError(NewFaultContextEnricher().Enrich(context.Background(), signal, clientset))
+		s.Empty(clientset.Actions(), "no API calls expected")
+	})
+}
+
+func (s *FaultEnricherSuite) TestEnrichmentLimiter() {
+	newSignal := func(i int) *FaultSignal {
+		return &FaultSignal{
+			FaultType:   FaultTypePodCrash,
+			ResourceUID: types.UID(fmt.Sprintf("pod-uid-%d", i)),
+			Kind:        "Pod",
+			Name:        fmt.Sprintf("pod-%d", i),
+			Namespace:   "default",
+			Severity:    SeverityWarning,
+			Context:     "Container crashed with exit code 1",
+		}
+	}
+	// blockingClientset returns a clientset whose event lists block until unblock is
+	// closed, tracking how many are in flight. Reactors of the fake clientset run one at
+	// a time, so the lists are intercepted before reaching them.
+	blockingClientset := func(unblock <-chan struct{}, inFlight, maxInFlight *atomic.Int64) kubernetes.Interface {
+		return &blockingEventsClientset{
+			Clientset:   fake.NewClientset(),
+			unblock:     unblock,
+			inFlight:    inFlight,
+			maxInFlight: maxInFlight,
+		}
+	}
+
+	s.Run("concurrent enrichments never exceed the limit", func() {
+		const limit, faults = 3, 20
+		unblock := make(chan struct{})
+		var inFlight, maxInFlight atomic.Int64
+		clientset := blockingClientset(unblock, &inFlight, &maxInFlight)
+		limiter := NewEnrichmentLimiter(limit)
+
+		signals := make([]*FaultSignal, faults)
+		var wg sync.WaitGroup
+		for i := range signals {
+			signals[i] = newSignal(i)
+			// Each fault gets its own enricher, as each subscription does
+			enricher := NewFaultContextEnricher().WithRelatedEvents(5).WithLimiter(limiter)
+			wg.Add(1)
+			go func(signal *FaultSignal) {
+				defer wg.Done()
+				s.NoError(enricher.Enrich(context.Background(), signal, clientset))
+			}(signals[i])
+		}
+
+		s.Eventually(func() bool {
+			return limiter.Shed() == faults-limit
+		}, time.Second, 5*time.Millisecond, "faults beyond the limit should be shed")
+		s.Equal(int64(limit), inFlight.Load())
+		close(unblock)
+		wg.Wait()
+
+		s.Equal(int64(limit), maxInFlight.Load(), "in-flight enrichments should never exceed the limit")
+		enriched := 0
+		for _, signal := range signals {
+			if len(signal.RelatedEvents) == 1 {
+				enriched++
+			}
+			s.Equal("Container crashed with exit code 1", signal.Context, "shed faults are sent as detected")
+		}
+		s.Equal(limit, enriched)
+	})
+
+	s.Run("slots are released after enrichment", func() {
+		unblock := make(chan struct{})
+		close(unblock)
+		var inFlight, maxInFlight atomic.Int64
+		clientset := blockingClientset(unblock, &inFlight, &maxInFlight)
+		enricher := NewFaultContextEnricher().WithRelatedEvents(5).WithLimiter(NewEnrichmentLimiter(1))
+
+		for i := 0; i < 5; i++ {
+			signal := newSignal(i)
+			s.Require().NoError(enricher.Enrich(context.Background(), signal, clientset))
+			s.Len(signal.RelatedEvents, 1, "fault %d should be enriched", i)
+		}
+		s.Zero(enricher.limiter.Shed())
+	})
+
+	s.Run("signals needing no API calls don't take a slot", func() {
+		limiter := NewEnrichmentLimiter(1)
+		s.Require().True(limiter.tryAcquire(), "the only slot is taken")
+		defer limiter.release()
+		clientset := fake.NewClientset()
+		enricher := NewFaultContextEnricher().WithLimiter(limiter)
+
+		s.NoError(enricher.Enrich(context.Background(), newSignal(0), clientset))
+		s.Zero(limiter.Shed())
+		s.Empty(clientset.Actions())
+	})
+
+	s.Run("shed critical faults are sent without logs", func() {
+		limiter := NewEnrichmentLimiter(1)
+		s.Require().True(limiter.tryAcquire(), "the only slot is taken")
+		defer limiter.release()
+		clientset := fake.NewClientset()
+		enricher := NewFaultContextEnricher().WithLimiter(limiter)
+
+		signal := newSignal(0)
+		signal.Severity = SeverityCritical
+		signal.Context = ""
+		s.NoError(enricher.Enrich(context.Background(), signal, clientset))
+		s.Empty(signal.Context)
+		s.Equal(int64(1), limiter.Shed())
+		s.Empty(clientset.Actions(), "no API calls expected")
+	})
+}
+
+// blockingEventsClientset is a fake clientset whose event lists block until unblock is
+// closed, recording the number of lists in flight and its peak.
+type blockingEventsClientset struct {
+	*fake.Clientset
+	unblock     <-chan struct{}
+	inFlight    *atomic.Int64
+	maxInFlight *atomic.Int64
+}
+
+func (c *blockingEventsClientset) CoreV1() corev1client.CoreV1Interface {
+	return &blockingEventsCoreV1{CoreV1Interface: c.Clientset.CoreV1(), clientset: c}
+}
+
+type blockingEventsCoreV1 struct {
+	corev1client.CoreV1Interface
+	clientset *blockingEventsClientset
+}
+
+func (c *blockingEventsCoreV1) Events(namespace string) corev1client.EventInterface {
+	return &blockingEvents{EventInterface: c.CoreV1Interface.Events(namespace), clientset: c.clientset}
+}
+
+type blockingEvents struct {
+	corev1client.EventInterface
+	clientset *blockingEventsClientset
+}
+
+// List blocks until unblock is closed and returns a single event involving the object
+// selected by the involvedObject.uid field selector.
+func (e *blockingEvents) List(_ context.Context, opts metav1.ListOptions) (*v1.EventList, error) {
+	c := e.clientset
+	current := c.inFlight.Add(1)
+	defer c.inFlight.Add(-1)
+	for {
+		peak := c.maxInFlight.Load()
+		if current <= peak || c.maxInFlight.CompareAndSwap(peak, current) {
+			break
+		}
+	}
+	<-c.unblock
+	uid := strings.TrimPrefix(opts.FieldSelector, "involvedObject.uid=")
+	return &v1.EventList{Items: []v1.Event{{
+		Reason:         "BackOff",
+		InvolvedObject: v1.ObjectReference{UID: types.UID(uid)},
+	}}}, nil
+}
+
 func (s *FaultEnricherSuite) TestEdgeCases() {
 	s.Run("handles context cancellation gracefully", func() {
 		enricher := NewFaultContextEnricher()
@@ -0,0 +1,123 @@
+package events
+
+import (
+	"sync"
+	"time"
+)
+
+// faultHistoryEntry is a single fault signal stored in a cluster's history ring.
+type faultHistoryEntry struct {
+	faultID string
+	signal  FaultSignal
+}
+
+// faultRing is a fixed-capacity ring buffer of fault history entries.
+// Once full, recording a new entry overwrites the oldest one.
+type faultRing struct {
+	entries []faultHistoryEntry
+	next    int // index of the slot the next entry is written to
+	count   int // number of valid entries (<= len(entries))
+}
+
+// FaultHistory keeps an in-memory record of the most recent fault signals per cluster
+// so that sessions subscribing after a fault occurred can still retrieve it.
+//
+// Every fault-mode subscription on a cluster runs its own ResourceWatcher, so the same
+// fault is typically reported once per subscription. Signals sharing a fault ID within
+// the duplicate window of a buffered entry are recorded only once.
+//
+// Thread-safe for concurrent use.
+type FaultHistory struct {
+	mu       sync.RWMutex
+	size     int
+	window   time.Duration
+	clusters map[string]*faultRing
+}
+
+// NewFaultHistory creates a FaultHistory that retains up to size faults per cluster.
+// Signals with the same fault ID reported within window of each other are treated as
+// a single fault. A size of zero or less disables recording.
+func NewFaultHistory(size int, window time.Duration) *FaultHistory {
+	return &FaultHistory{
+		size:     size,
+		window:   window,
+		clusters: make(map[string]*faultRing),
+	}
+}
+
+// Record stores a fault signal in the history of the given cluster.
+// Returns false if the signal was not recorded because history is disabled
+// or the fault is already buffered.
+func (h *FaultHistory) Record(cluster string, signal FaultSignal) bool {
+	if h.size <= 0 {
+		return false
+	}
+
+	faultID := GenerateFaultID(cluster, signal.FaultType, signal.ResourceUID, signal.ContainerName)
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	ring, exists := h.clusters[cluster]
+	if !exists {
+		ring = &faultRing{entries: make([]faultHistoryEntry, h.size)}
+		h.clusters[cluster] = ring
+	}
+
+	// Skip signals already reported by another subscription's watcher
+	for i := 0; i < ring.count; i++ {
+		entry := ring.entries[i]
+		if entry.faultID == faultID && absDuration(entry.signal.Timestamp.Sub(signal.Timestamp)) <= h.window {
+			return false
+		}
+	}
+
+	ring.entries[ring.next] = faultHistoryEntry{faultID: faultID, signal: signal}
+	ring.next = (ring.next + 1) % len(ring.entries)
+	if ring.count < len(ring.entries) {
+		ring.count++
+	}
+	return true
+}
+
+// GetRecentFaults returns up to limit of the most recent faults recorded for a cluster,
+// ordered newest-first. A limit of zero or less returns every buffered fault.
+// The returned signals are copies and may be modified by the caller.
+func (h *FaultHistory) GetRecentFaults(cluster string, limit int) []*FaultSignal {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	ring, exists := h.clusters[cluster]
+	if !exists {
+		return []*FaultSignal{}
+	}
+
+	if limit <= 0 || limit > ring.count {
+		limit = ring.count
+	}
+
+	faults := make([]*FaultSignal, 0, limit)
+	for i := 1; i <= limit; i++ {
+		// Walk backwards from the most recently written slot
+		idx := (ring.next - i + len(ring.entries)) % len(ring.entries)
+		signal := ring.entries[idx].signal
+		faults = append(faults, &signal)
+	}
+	return faults
+}
+
+// Clear removes all buffered faults for a cluster.
+func (h *FaultHistory) Clear(cluster string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	delete(h.clusters, cluster)
+}
+
+// absDuration returns the absolute value of a duration.
+func absDuration(d time.Duration) time.Duration {
+	if d < 0 {
+		return -d
+	}
+	return d
+}
@@ -0,0 +1,159 @@
+package events
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+type FaultHistorySuite struct {
+	suite.Suite
+	history  *FaultHistory
+	baseTime time.Time
+}
+
+func TestFaultHistorySuite(t *testing.T) {
+	suite.Run(t, new(FaultHistorySuite))
+}
+
+func (s *FaultHistorySuite) SetupTest() {
+	s.history = NewFaultHistory(5, time.Minute)
+	s.baseTime = time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+}
+
+// makeSignal creates a distinct fault signal for the nth resource.
+func (s *FaultHistorySuite) makeSignal(n int) FaultSignal {
+	return FaultSignal{
+		FaultType:   FaultTypePodCrash,
+		ResourceUID: types.UID(fmt.Sprintf("pod-uid-%d", n)),
+		Kind:        "Pod",
+		Name:        fmt.Sprintf("pod-%d", n),
+		Namespace:   "default",
+		Severity:    SeverityCritical,
+		Timestamp:   s.baseTime.Add(time.Duration(n) * time.Second),
+	}
+}
+
+func (s *FaultHistorySuite) TestRecordAndRetrieve() {
+	s.Run("returns empty slice for unknown cluster", func() {
+		faults := s.history.GetRecentFaults("unknown", 0)
+		s.NotNil(faults)
+		s.Empty(faults)
+	})
+
+	s.Run("returns recorded faults newest-first", func() {
+		for i := 0; i < 3; i++ {
+			s.True(s.history.Record("cluster-a", s.makeSignal(i)))
+		}
+
+		faults := s.history.GetRecentFaults("cluster-a", 0)
+		s.Require().Len(faults, 3)
+		s.Equal("pod-2", faults[0].Name)
+		s.Equal("pod-1", faults[1].Name)
+		s.Equal("pod-0", faults[2].Name)
+	})
+}
+
+func (s *FaultHistorySuite) TestBufferKeepsOnlyMostRecent() {
+	s.Run("pushing more than size faults evicts the oldest", func() {
+		for i := 0; i < 12; i++ {
+			s.history.Record("cluster-a", s.makeSignal(i))
+		}
+
+		faults := s.history.GetRecentFaults("cluster-a", 0)
+		s.Require().Len(faults, 5, "buffer should hold at most size faults")
+		for i, fault := range faults {
+			s.Equal(fmt.Sprintf("pod-%d", 11-i), fault.Name, "faults should be ordered newest-first")
+		}
+	})
+}
+
+func (s *FaultHistorySuite) TestLimit() {
+	for i := 0; i < 4; i++ {
+		s.history.Record("cluster-a", s.makeSignal(i))
+	}
+
+	s.Run("limit returns only the newest faults", func() {
+		faults := s.history.GetRecentFaults("cluster-a", 2)
+		s.Require().Len(faults, 2)
+		s.Equal("pod-3", faults[0].Name)
+		s.Equal("pod-2", faults[1].Name)
+	})
+
+	s.Run("limit larger than buffered count returns all", func() {
+		faults := s.history.GetRecentFaults("cluster-a", 100)
+		s.Len(faults, 4)
+	})
+
+	s.Run("negative limit returns all", func() {
+		faults := s.history.GetRecentFaults("cluster-a", -1)
+		s.Len(faults, 4)
+	})
+}
+
+func (s *FaultHistorySuite) TestClusterIsolation() {
+	s.Run("faults are buffered per cluster", func() {
+		s.history.Record("cluster-a", s.makeSignal(1))
+		s.history.Record("cluster-b", s.makeSignal(2))
+
+		faultsA := s.history.GetRecentFaults("cluster-a", 0)
+		s.Require().Len(faultsA, 1)
+		s.Equal("pod-1", faultsA[0].Name)
+
+		faultsB := s.history.GetRecentFaults("cluster-b", 0)
+		s.Require().Len(faultsB, 1)
+		s.Equal("pod-2", faultsB[0].Name)
+	})
+
+	s.Run("clear removes only the given cluster", func() {
+		s.history.Clear("cluster-a")
+
+		s.Empty(s.history.GetRecentFaults("cluster-a", 0))
+		s.Len(s.history.GetRecentFaults("cluster-b", 0), 1)
+	})
+}
+
+func (s *FaultHistorySuite) TestDuplicateSignals() {
+	s.Run("same fault reported by several watchers is recorded once", func() {
+		signal := s.makeSignal(1)
+		s.True(s.history.Record("cluster-a", signal))
+
+		duplicate := signal
+		duplicate.Timestamp = signal.Timestamp.Add(50 * time.Millisecond)
+		s.False(s.history.Record("cluster-a", duplicate))
+
+		s.Len(s.history.GetRecentFaults("cluster-a", 0), 1)
+	})
+
+	s.Run("same fault outside the window is recorded again", func() {
+		signal := s.makeSignal(1)
+		signal.Timestamp = signal.Timestamp.Add(2 * time.Minute)
+		s.True(s.history.Record("cluster-a", signal))
+
+		s.Len(s.history.GetRecentFaults("cluster-a", 0), 2)
+	})
+}
+
+func (s *FaultHistorySuite) TestReturnsCopies() {
+	s.Run("modifying a returned fault does not affect the buffer", func() {
+		s.history.Record("cluster-a", s.makeSignal(1))
+
+		faults := s.history.GetRecentFaults("cluster-a", 0)
+		s.Require().Len(faults, 1)
+		faults[0].Name = "mutated"
+
+		s.Equal("pod-1", s.history.GetRecentFaults("cluster-a", 0)[0].Name)
+	})
+}
+
+func (s *FaultHistorySuite) TestDisabled() {
+	s.Run("zero size disables recording", func() {
+		history := NewFaultHistory(0, time.Minute)
+
+		s.False(history.Record("cluster-a", s.makeSignal(1)))
+		s.Empty(history.GetRecentFaults("cluster-a", 0))
+	})
+}
@@ -0,0 +1,97 @@
+package events
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// FaultMute suppresses the faults of a single resource on a cluster until a point in time,
+// e.g. during planned maintenance. Namespace is empty for cluster-scoped resources like nodes.
+type FaultMute struct {
+	Cluster   string
+	Kind      string
+	Namespace string
+	Name      string
+	Until     time.Time
+}
+
+// faultMuteKey identifies a muted resource.
+type faultMuteKey struct {
+	cluster   string
+	kind      string
+	namespace string
+	name      string
+}
+
+// faultMutes holds the resources whose faults are muted and when each mute expires.
+// Expired mutes are dropped as they are found.
+//
+// Thread-safe for concurrent use.
+type faultMutes struct {
+	mu    sync.Mutex
+	until map[faultMuteKey]time.Time
+}
+
+// newFaultMutes creates an empty set of mutes.
+func newFaultMutes() *faultMutes {
+	return &faultMutes{until: make(map[faultMuteKey]time.Time)}
+}
+
+// mute mutes a resource until the given time, replacing any earlier mute of it.
+func (f *faultMutes) mute(key faultMuteKey, until time.Time) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.until[key] = until
+}
+
+// unmute removes the mute of a resource, if any.
+func (f *faultMutes) unmute(key faultMuteKey) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	delete(f.until, key)
+}
+
+// muted reports whether a resource is muted at now.
+func (f *faultMutes) muted(key faultMuteKey, now time.Time) bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	until, exists := f.until[key]
+	if !exists {
+		return false
+	}
+	if !now.Before(until) {
+		delete(f.until, key)
+		return false
+	}
+	return true
+}
+
+// active returns the mutes in effect at now, ordered by cluster, kind, namespace, and name.
+func (f *faultMutes) active(now time.Time) []FaultMute {
+	f.mu.Lock()
+	mutes := make([]FaultMute, 0, len(f.until))
+	for key, until := range f.until {
+		if !now.Before(until) {
+			delete(f.until, key)
+			continue
+		}
+		mutes = append(mutes, FaultMute{Cluster: key.cluster, Kind: key.kind, Namespace: key.namespace, Name: key.name, Until: until})
+	}
+	f.mu.Unlock()
+
+	sort.Slice(mutes, func(i, j int) bool {
+		a, b := mutes[i], mutes[j]
+		if a.Cluster != b.Cluster {
+			return a.Cluster < b.Cluster
+		}
+		if a.Kind != b.Kind {
+			return a.Kind < b.Kind
+		}
+		if a.Namespace != b.Namespace {
+			return a.Namespace < b.Namespace
+		}
+		return a.Name < b.Name
+	})
+	return mutes
+}
@@ -1,8 +1,10 @@
 package events
 
 import (
+	"fmt"
 	"time"
 
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 )
 
@@ -14,12 +16,46 @@ const (
 	FaultTypePodCrash FaultType = "PodCrash"
 	// FaultTypeCrashLoop indicates a pod is in a crash loop (CrashLoopBackOff)
 	FaultTypeCrashLoop FaultType = "CrashLoop"
+	// FaultTypeRestartStorm indicates a container is restarting faster than expected, with or without CrashLoopBackOff
+	FaultTypeRestartStorm FaultType = "RestartStorm"
+	// FaultTypeOOMKilled indicates a container was killed for exceeding its memory limit
+	FaultTypeOOMKilled FaultType = "OOMKilled"
+	// FaultTypeConfigError indicates a container cannot be created, typically due to a missing Secret or ConfigMap
+	FaultTypeConfigError FaultType = "ConfigError"
 	// FaultTypeNodeUnhealthy indicates a node is in an unhealthy state
 	FaultTypeNodeUnhealthy FaultType = "NodeUnhealthy"
+	// FaultTypeNodeCordoned indicates a node was marked unschedulable
+	FaultTypeNodeCordoned FaultType = "NodeCordoned"
 	// FaultTypeDeploymentFailure indicates a deployment has failed to roll out
 	FaultTypeDeploymentFailure FaultType = "DeploymentFailure"
+	// FaultTypeReplicaFailure indicates a deployment's ReplicaSet cannot create pods
+	FaultTypeReplicaFailure FaultType = "ReplicaFailure"
+	// FaultTypeScaledToZero indicates a deployment was scaled down to zero replicas
+	FaultTypeScaledToZero FaultType = "ScaledToZero"
 	// FaultTypeJobFailure indicates a job has failed
 	FaultTypeJobFailure FaultType = "JobFailure"
+	// FaultTypeNoEndpoints indicates a Service has lost all of its ready endpoints
+	FaultTypeNoEndpoints FaultType = "NoEndpoints"
+	// FaultTypeEndpointFlapping indicates a Service's ready endpoint count keeps going up and down
+	FaultTypeEndpointFlapping FaultType = "EndpointFlapping"
+	// FaultTypeStuckTerminating indicates a pod has been terminating for longer than expected
+	FaultTypeStuckTerminating FaultType = "StuckTerminating"
+	// FaultTypeUnschedulable indicates a pod has been pending for longer than expected because no node can run it
+	FaultTypeUnschedulable FaultType = "Unschedulable"
+	// FaultTypeDeadlineExceeded indicates a pod was terminated for running longer than its activeDeadlineSeconds
+	FaultTypeDeadlineExceeded FaultType = "DeadlineExceeded"
+	// FaultTypeInitContainerFailure indicates an init container exited with a non-zero exit code, blocking pod startup
+	FaultTypeInitContainerFailure FaultType = "InitContainerFailure"
+	// FaultTypeAdmissionRejected indicates an admission controller (e.g. Pod Security Admission or a policy webhook) rejected a pod
+	FaultTypeAdmissionRejected FaultType = "AdmissionRejected"
+	// FaultTypeLoadBalancerPending indicates a Service of type LoadBalancer has been waiting for an ingress IP or hostname for longer than expected
+	FaultTypeLoadBalancerPending FaultType = "LoadBalancerPending"
+	// FaultTypePVFailed indicates a PersistentVolume's recycle or delete failed, or it stayed Released without being reclaimed
+	FaultTypePVFailed FaultType = "PVFailed"
+	// FaultTypeRecurringWarning indicates a Warning event recurred for the same object and reason more often than expected
+	FaultTypeRecurringWarning FaultType = "RecurringWarning"
+	// FaultTypeCustom indicates a condition on a custom resource changed to a configured bad status
+	FaultTypeCustom FaultType = "Custom"
 )
 
 // Severity represents the severity level of a fault signal.
@@ -34,6 +70,25 @@ const (
 	SeverityCritical Severity = "critical"
 )
 
+// IsValid reports whether the severity is one of the known severity levels.
+func (s Severity) IsValid() bool {
+	switch s {
+	case SeverityInfo, SeverityWarning, SeverityCritical:
+		return true
+	}
+	return false
+}
+
+// ParseSeverity converts a string to a Severity.
+// Returns an error if the value is not "info", "warning", or "critical".
+func ParseSeverity(value string) (Severity, error) {
+	severity := Severity(value)
+	if !severity.IsValid() {
+		return "", fmt.Errorf("invalid severity %q: must be 'info', 'warning', or 'critical'", value)
+	}
+	return severity, nil
+}
+
 // FaultSignal represents a detected fault condition in a Kubernetes resource.
 // It is produced by fault detectors when analyzing resource state changes.
 type FaultSignal struct {
@@ -43,6 +98,10 @@ type FaultSignal struct {
 	// ResourceUID is the unique identifier of the affected resource
 	ResourceUID types.UID `json:"resourceUid"`
 
+	// APIVersion is the API version of the affected resource. Detectors of built-in
+	// kinds may leave it empty, in which case it is derived from Kind.
+	APIVersion string `json:"apiVersion,omitempty"`
+
 	// Kind is the Kubernetes resource kind (e.g., Pod, Node, Deployment)
 	Kind string `json:"kind"`
 
@@ -59,8 +118,17 @@ type FaultSignal struct {
 	Severity Severity `json:"severity"`
 
 	// Context provides additional information about the fault (e.g., termination message, error logs)
+	// for human display
 	Context string `json:"context,omitempty"`
 
+	// Details holds the discrete fields embedded in Context (e.g., exitCode, reason, restartCount)
+	// so clients don't need to parse it. Keys are specific to each fault type.
+	Details map[string]string `json:"details,omitempty"`
+
+	// RelatedEvents are the most recent Kubernetes events for the affected resource, newest first.
+	// Only set when the FaultContextEnricher has related events enabled.
+	RelatedEvents []*EventDetails `json:"relatedEvents,omitempty"`
+
 	// Timestamp is when the fault was detected
 	Timestamp time.Time `json:"timestamp"`
 }
@@ -80,4 +148,63 @@ type Detector interface {
 	// Returns:
 	//   - A slice of FaultSignal representing any detected faults (empty if no faults)
 	Detect(oldObj, newObj interface{}) []FaultSignal
+	// Describe returns what the detector emits and watches, e.g. to list the
+	// available detectors to users.
+	Describe() DetectorInfo
+}
+
+// DetectorInfo is the self-description of a Detector.
+type DetectorInfo struct {
+	// FaultTypes are the types of the fault signals the detector emits
+	FaultTypes []FaultType `json:"faultTypes"`
+	// ResourceKinds are the kinds of resource the detector watches (e.g., "Pod").
+	// Dynamic detectors report their resource as "resource.group" instead.
+	ResourceKinds []string `json:"resourceKinds"`
+	// Description is a human-readable description of the faults the detector reports
+	Description string `json:"description"`
+}
+
+// DynamicDetector is a Detector for a resource without a typed informer, such as a
+// custom resource. ResourceWatcher watches the detector's GVR through a dynamic
+// informer and passes *unstructured.Unstructured objects to Detect.
+type DynamicDetector interface {
+	Detector
+	// GVR returns the resource the detector watches.
+	GVR() schema.GroupVersionResource
+}
+
+// TypedDetector is a Detector that reports the fault type it emits and the kind of
+// resource it inspects. Faults subscriptions select TypedDetectors by fault type,
+// and ResourceWatcher only starts informers for the kinds its detectors need.
+type TypedDetector interface {
+	Detector
+	// FaultType returns the type of the fault signals the detector emits.
+	FaultType() FaultType
+	// ResourceKind returns the kind of resource passed to Detect (e.g., "Pod").
+	ResourceKind() string
+}
+
+// InitialStateDetector is a Detector that can also report the faults a resource is
+// currently in, without a state change. When ResourceWatcherConfig.SendInitialState
+// is set, ResourceWatcher calls DetectState for every cached resource once its
+// informers have synced, so faults that began before the watch are reported too.
+// The calls happen in the background, after Start has returned.
+type InitialStateDetector interface {
+	Detector
+	// DetectState returns fault signals for the faults obj is currently in
+	// (empty if none). obj is of the same type as the objects passed to Detect.
+	DetectState(obj interface{}) []FaultSignal
+}
+
+// TrackingDetector is a TypedDetector keeping state about each resource of its kind,
+// e.g. to aggregate the EndpointSlices of a Service. ResourceWatcher tells it about the
+// resources added to its informer cache, including those listed when the watch starts,
+// and about the deleted ones, so its state matches the cluster without waiting for the
+// resources to be updated.
+type TrackingDetector interface {
+	TypedDetector
+	// Observe records a resource added to the informer cache.
+	Observe(obj interface{})
+	// Forget drops the state kept for a deleted resource.
+	Forget(obj interface{})
 }
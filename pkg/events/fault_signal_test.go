@@ -1,12 +1,16 @@
 package events
 
 import (
+	"context"
 	"encoding/json"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/suite"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/fake"
 )
 
 // FaultSignalTestSuite contains tests for FaultSignal struct and related types
@@ -345,6 +349,113 @@ type mockDetector struct {
 	signals []FaultSignal
 }
 
+func (m *mockDetector) Describe() DetectorInfo {
+	return DetectorInfo{}
+}
+
 func (m *mockDetector) Detect(oldObj, newObj interface{}) []FaultSignal {
 	return m.signals
 }
+
+// TestSortFaultSignals tests the ordering applied to signals from a single update
+func (s *FaultSignalTestSuite) TestSortFaultSignals() {
+	signal := func(namespace, name, container string, faultType FaultType, context string) FaultSignal {
+		return FaultSignal{FaultType: faultType, Kind: "Pod", Namespace: namespace, Name: name, ContainerName: container, Context: context}
+	}
+
+	s.Run("orders by namespace, name, container, and fault type", func() {
+		signals := []FaultSignal{
+			signal("prod", "web", "app", FaultTypePodCrash, ""),
+			signal("default", "web", "sidecar", FaultTypeOOMKilled, ""),
+			signal("default", "web", "app", FaultTypePodCrash, ""),
+			signal("default", "api", "app", FaultTypePodCrash, ""),
+			signal("default", "web", "app", FaultTypeCrashLoop, ""),
+			signal("default", "web", "", FaultTypeStuckTerminating, ""),
+		}
+
+		sortFaultSignals(signals)
+
+		s.Equal([]FaultSignal{
+			signal("default", "api", "app", FaultTypePodCrash, ""),
+			signal("default", "web", "", FaultTypeStuckTerminating, ""),
+			signal("default", "web", "app", FaultTypeCrashLoop, ""),
+			signal("default", "web", "app", FaultTypePodCrash, ""),
+			signal("default", "web", "sidecar", FaultTypeOOMKilled, ""),
+			signal("prod", "web", "app", FaultTypePodCrash, ""),
+		}, signals)
+	})
+
+	s.Run("signals with equal keys keep their detection order", func() {
+		signals := []FaultSignal{
+			signal("default", "web", "app", FaultTypePodCrash, "first"),
+			signal("default", "api", "app", FaultTypePodCrash, ""),
+			signal("default", "web", "app", FaultTypePodCrash, "second"),
+		}
+
+		sortFaultSignals(signals)
+
+		s.Equal("first", signals[1].Context)
+		s.Equal("second", signals[2].Context)
+	})
+
+	s.Run("watcher emits signals in the same order regardless of detector order", func() {
+		pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default", UID: "pod-uid"}}
+		crashes := &mockDetector{signals: []FaultSignal{
+			signal("default", "web", "sidecar", FaultTypePodCrash, ""),
+			signal("default", "web", "app", FaultTypePodCrash, ""),
+		}}
+		loops := &mockDetector{signals: []FaultSignal{
+			signal("default", "web", "sidecar", FaultTypeCrashLoop, ""),
+			signal("default", "web", "app", FaultTypeCrashLoop, ""),
+		}}
+		want := []FaultSignal{
+			signal("default", "web", "app", FaultTypeCrashLoop, ""),
+			signal("default", "web", "app", FaultTypePodCrash, ""),
+			signal("default", "web", "sidecar", FaultTypeCrashLoop, ""),
+			signal("default", "web", "sidecar", FaultTypePodCrash, ""),
+		}
+
+		for _, detectors := range [][]Detector{{crashes, loops}, {loops, crashes}} {
+			for run := 0; run < 5; run++ {
+				var emitted []FaultSignal
+				watcher, err := NewResourceWatcher(ResourceWatcherConfig{
+					Clientset: fake.NewClientset(),
+					Cluster:   "cluster1",
+					Detectors: detectors,
+					SignalCallback: func(_ context.Context, signal FaultSignal) {
+						emitted = append(emitted, signal)
+					},
+				})
+				s.Require().NoError(err)
+
+				watcher.processUpdate(context.Background(), "Pod", pod.Namespace, pod.Name, pod, pod)
+
+				s.Require().Len(emitted, len(want))
+				for i := range want {
+					s.Equal(want[i].ContainerName, emitted[i].ContainerName, "signal %d", i)
+					s.Equal(want[i].FaultType, emitted[i].FaultType, "signal %d", i)
+				}
+			}
+		}
+	})
+}
+
+// TestParseSeverity tests parsing and validation of severity levels
+func (s *FaultSignalTestSuite) TestParseSeverity() {
+	s.Run("accepts all known severity levels", func() {
+		for _, value := range []string{"info", "warning", "critical"} {
+			severity, err := ParseSeverity(value)
+			s.NoError(err, "severity %q should be valid", value)
+			s.Equal(Severity(value), severity)
+			s.True(severity.IsValid())
+		}
+	})
+
+	s.Run("rejects unknown severity levels", func() {
+		for _, value := range []string{"", "debug", "Info", "error"} {
+			_, err := ParseSeverity(value)
+			s.Error(err, "severity %q should be invalid", value)
+			s.False(Severity(value).IsValid())
+		}
+	})
+}
@@ -1,11 +1,16 @@
 package events
 
 import (
+	"errors"
 	"fmt"
+	"slices"
 	"strings"
 
+	"github.com/google/jsonschema-go/jsonschema"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation"
 )
 
 // SubscriptionFilters defines the filtering criteria for event subscriptions.
@@ -20,19 +25,47 @@ type SubscriptionFilters struct {
 	// Empty means no label filtering.
 	LabelSelector string
 
+	// EventLabelSelector filters events by their own labels.
+	// Uses standard Kubernetes label selector syntax. Unlike LabelSelector, it can be
+	// applied by the API server, so non-matching events are never sent to the watch.
+	// Only applies to events mode. Empty means no event label filtering.
+	EventLabelSelector string
+
+	// AnnotationSelector filters events by their own annotations.
+	// Uses label selector syntax, but annotations can't be selected server-side,
+	// so it is always matched client-side.
+	// Empty means no annotation filtering.
+	AnnotationSelector string
+
 	// InvolvedKind filters events by the kind of the involved object.
 	// Examples: "Pod", "Deployment", "Node"
 	// Empty means all kinds.
 	InvolvedKind string
 
+	// InvolvedAPIVersion filters events by the API version of the involved object:
+	// "group/version" (e.g. "apps/v1"), or "version" for the core group (e.g. "v1").
+	// Combined with InvolvedKind, it tells apart kinds of the same name in different groups.
+	// Empty means all API versions.
+	InvolvedAPIVersion string
+
 	// InvolvedName filters events by the name of the involved object.
 	// Empty means all names.
 	InvolvedName string
 
+	// ExcludeInvolvedNames drops events whose involved object has one of these exact
+	// names (e.g. known-noisy pods), applied after InvolvedName.
+	// Empty means no names are excluded.
+	ExcludeInvolvedNames []string
+
 	// InvolvedNamespace filters events by the namespace of the involved object.
 	// Empty means all namespaces.
 	InvolvedNamespace string
 
+	// InvolvedUID filters events by the UID of the involved object.
+	// Unlike InvolvedName, this distinguishes between objects recreated with the same name.
+	// Empty means all UIDs.
+	InvolvedUID string
+
 	// Type filters events by type: "Normal" or "Warning".
 	// Empty means both types.
 	Type string
@@ -41,6 +74,54 @@ type SubscriptionFilters struct {
 	// Examples: "BackOff", "Failed", "Killing"
 	// Empty means all reasons.
 	Reason string
+
+	// IncludeReasons limits events to these exact reasons.
+	// Only applies to events mode. Empty means all reasons.
+	IncludeReasons []string
+
+	// ExcludeReasons drops events with these exact reasons, applied after IncludeReasons.
+	// Only applies to events mode. Empty means no reasons are excluded.
+	ExcludeReasons []string
+
+	// SourceComponent filters events by the component that reported them.
+	// Matched against the legacy Source.Component and the newer ReportingController fields.
+	// Examples: "kubelet", "default-scheduler"
+	// Empty means all components.
+	SourceComponent string
+
+	// CELExpression filters events by a CEL expression that must evaluate to a bool.
+	// The event variable has the fields namespace, type, reason, message, count, and
+	// involvedObject (kind, name, namespace, uid, apiVersion, fieldPath).
+	// Example: "event.reason == 'BackOff' && event.count > 5"
+	// Empty means no expression filtering.
+	CELExpression string
+
+	// IncludeModifications controls whether updates to existing events (e.g. count
+	// bumps on recurring events) are delivered in addition to newly created events.
+	// Only applies to events mode. Nil means true.
+	IncludeModifications *bool
+
+	// FirstOccurrenceOnly delivers only the first event for each distinct involved
+	// object and reason; repeats are suppressed for the lifetime of the subscription.
+	// Only applies to events mode.
+	FirstOccurrenceOnly bool
+
+	// DetectorTypes limits a faults subscription to the detectors emitting these
+	// fault types (e.g. "PodCrash", "OOMKilled"). Names are validated against the
+	// detectors registered with the subscription manager.
+	// Only applies to faults mode. Empty means all detectors except opt-in ones
+	// (see DetectorRegistry.RegisterOptIn).
+	DetectorTypes []string
+
+	// SendInitialState reports, when a faults subscription starts, the faults that
+	// resources are already in (e.g. a pod already in CrashLoopBackOff), not only
+	// later transitions. Only applies to faults mode.
+	SendInitialState bool
+
+	// Delivery selects how notifications are delivered: DeliveryLog sends them as MCP
+	// log messages, DeliveryResource publishes them to the subscription's MCP resource
+	// (see SubscriptionResourceURI). Empty means DeliveryLog.
+	Delivery string
 }
 
 // Validate checks if the filters are valid.
@@ -54,11 +135,46 @@ func (f *SubscriptionFilters) Validate() error {
 		}
 	}
 
+	// Validate event label selector syntax if provided
+	if f.EventLabelSelector != "" {
+		_, err := labels.Parse(f.EventLabelSelector)
+		if err != nil {
+			return fmt.Errorf("invalid event label selector: %w", err)
+		}
+	}
+
+	// Validate annotation selector syntax if provided
+	if f.AnnotationSelector != "" {
+		_, err := labels.Parse(f.AnnotationSelector)
+		if err != nil {
+			return fmt.Errorf("invalid annotation selector: %w", err)
+		}
+	}
+
+	// Validate involved API version format if provided
+	if f.InvolvedAPIVersion != "" {
+		if err := validateAPIVersion(f.InvolvedAPIVersion); err != nil {
+			return fmt.Errorf("invalid involved API version: %w", err)
+		}
+	}
+
+	// Validate CEL expression if provided; compiling also caches the program
+	if f.CELExpression != "" {
+		if _, err := compileCELExpression(f.CELExpression); err != nil {
+			return fmt.Errorf("invalid CEL expression: %w", err)
+		}
+	}
+
 	// Validate type field if provided
 	if f.Type != "" && f.Type != "Normal" && f.Type != "Warning" {
 		return fmt.Errorf("invalid type: must be 'Normal', 'Warning', or empty")
 	}
 
+	// Validate delivery mode if provided
+	if f.Delivery != "" && f.Delivery != DeliveryLog && f.Delivery != DeliveryResource {
+		return fmt.Errorf("invalid delivery: must be '%s', '%s', or empty", DeliveryLog, DeliveryResource)
+	}
+
 	return nil
 }
 
@@ -75,6 +191,36 @@ func (f *SubscriptionFilters) ValidateForMode(mode string) error {
 		return fmt.Errorf("faults mode cannot filter for Normal events")
 	}
 
+	// Faults mode watches resources, not events, so there are no event modifications to exclude
+	if mode == "faults" && f.IncludeModifications != nil {
+		return fmt.Errorf("includeModifications is only supported in events mode")
+	}
+
+	// Faults mode already deduplicates faults per resource
+	if mode == "faults" && f.FirstOccurrenceOnly {
+		return fmt.Errorf("firstOccurrenceOnly is only supported in events mode")
+	}
+
+	// Faults mode watches resources, whose labels are selected with labelSelector
+	if mode == "faults" && f.EventLabelSelector != "" {
+		return fmt.Errorf("eventLabelSelector is only supported in events mode")
+	}
+
+	// Faults are not Kubernetes events and have no event reasons
+	if mode == "faults" && (len(f.IncludeReasons) > 0 || len(f.ExcludeReasons) > 0) {
+		return fmt.Errorf("includeReasons and excludeReasons are only supported in events mode")
+	}
+
+	// Detectors only run for faults subscriptions
+	if mode == "events" && len(f.DetectorTypes) > 0 {
+		return fmt.Errorf("detectorTypes is only supported in faults mode")
+	}
+
+	// Events mode has no resource state to report
+	if mode == "events" && f.SendInitialState {
+		return fmt.Errorf("sendInitialState is only supported in faults mode")
+	}
+
 	return nil
 }
 
@@ -105,19 +251,53 @@ func (f *SubscriptionFilters) Matches(event *corev1.Event) bool {
 		return false
 	}
 
+	// Check reason allowlist and denylist
+	if !f.matchesReasonLists(event.Reason) {
+		return false
+	}
+
 	// Check involved object filters
 	if f.InvolvedKind != "" && event.InvolvedObject.Kind != f.InvolvedKind {
 		return false
 	}
 
+	if f.InvolvedAPIVersion != "" && event.InvolvedObject.APIVersion != f.InvolvedAPIVersion {
+		return false
+	}
+
 	if f.InvolvedName != "" && event.InvolvedObject.Name != f.InvolvedName {
 		return false
 	}
 
+	if slices.Contains(f.ExcludeInvolvedNames, event.InvolvedObject.Name) {
+		return false
+	}
+
 	if f.InvolvedNamespace != "" && event.InvolvedObject.Namespace != f.InvolvedNamespace {
 		return false
 	}
 
+	if f.InvolvedUID != "" && string(event.InvolvedObject.UID) != f.InvolvedUID {
+		return false
+	}
+
+	if f.SourceComponent != "" && !matchesSourceComponent(event, f.SourceComponent) {
+		return false
+	}
+
+	// Watches select event labels server-side, so this only guards other event sources
+	if f.EventLabelSelector != "" && !matchesEventLabelSelector(event, f.EventLabelSelector) {
+		return false
+	}
+
+	if f.AnnotationSelector != "" && !matchesAnnotationSelector(event, f.AnnotationSelector) {
+		return false
+	}
+
+	if f.CELExpression != "" && !matchesCELExpression(event, f.CELExpression) {
+		return false
+	}
+
 	// Check label selector
 	if f.LabelSelector != "" {
 		selector, err := labels.Parse(f.LabelSelector)
@@ -126,9 +306,9 @@ func (f *SubscriptionFilters) Matches(event *corev1.Event) bool {
 			return false
 		}
 
-		// Events have their own labels, not the involved object's labels
-		// However, in practice we'll need to fetch the involved object to get its labels
-		// For now, check event labels (this will be enhanced by the watch implementation)
+		// Events have their own labels, not the involved object's labels.
+		// Watches resolve object labels with a LabelResolver and use MatchesWithObjectLabels;
+		// without one, fall back to the event's own labels.
 		eventLabels := labels.Set(event.Labels)
 		if !selector.Matches(eventLabels) {
 			return false
@@ -138,6 +318,56 @@ func (f *SubscriptionFilters) Matches(event *corev1.Event) bool {
 	return true
 }
 
+// matchesReasonLists checks a reason against IncludeReasons and ExcludeReasons.
+// A reason must be included, if IncludeReasons is set, and not excluded.
+func (f *SubscriptionFilters) matchesReasonLists(reason string) bool {
+	if len(f.IncludeReasons) > 0 && !slices.Contains(f.IncludeReasons, reason) {
+		return false
+	}
+	return !slices.Contains(f.ExcludeReasons, reason)
+}
+
+// matchesSourceComponent checks if an event was reported by the given component.
+// Older events set Source.Component while events created through the events.k8s.io
+// API set ReportingController, so both fields are checked.
+func matchesSourceComponent(event *corev1.Event, component string) bool {
+	return event.Source.Component == component || event.ReportingController == component
+}
+
+// validateAPIVersion checks that apiVersion is "group/version" or, for the core
+// group, "version", where the group is a DNS subdomain and the version a DNS label
+// (e.g. "v1" or "v1beta1").
+func validateAPIVersion(apiVersion string) error {
+	gv, err := schema.ParseGroupVersion(apiVersion)
+	valid := err == nil && !strings.HasPrefix(apiVersion, "/") &&
+		len(validation.IsDNS1035Label(gv.Version)) == 0 &&
+		(gv.Group == "" || len(validation.IsDNS1123Subdomain(gv.Group)) == 0)
+	if !valid {
+		return fmt.Errorf("%q must be 'group/version' or 'version' (e.g. 'apps/v1' or 'v1')", apiVersion)
+	}
+	return nil
+}
+
+// matchesEventLabelSelector checks if an event's own labels match the given selector.
+func matchesEventLabelSelector(event *corev1.Event, eventLabelSelector string) bool {
+	selector, err := labels.Parse(eventLabelSelector)
+	if err != nil {
+		// This should not happen as we validated in Validate()
+		return false
+	}
+	return selector.Matches(labels.Set(event.Labels))
+}
+
+// matchesAnnotationSelector checks if an event's annotations match the given selector.
+func matchesAnnotationSelector(event *corev1.Event, annotationSelector string) bool {
+	selector, err := labels.Parse(annotationSelector)
+	if err != nil {
+		// This should not happen as we validated in Validate()
+		return false
+	}
+	return selector.Matches(labels.Set(event.Annotations))
+}
+
 // MatchesWithObjectLabels checks if an event matches the subscription filters,
 // using the provided object labels for label selector matching.
 // This allows matching against the involved object's labels without fetching it.
@@ -164,18 +394,51 @@ func (f *SubscriptionFilters) MatchesWithObjectLabels(event *corev1.Event, objec
 		return false
 	}
 
+	if !f.matchesReasonLists(event.Reason) {
+		return false
+	}
+
 	if f.InvolvedKind != "" && event.InvolvedObject.Kind != f.InvolvedKind {
 		return false
 	}
 
+	if f.InvolvedAPIVersion != "" && event.InvolvedObject.APIVersion != f.InvolvedAPIVersion {
+		return false
+	}
+
 	if f.InvolvedName != "" && event.InvolvedObject.Name != f.InvolvedName {
 		return false
 	}
 
+	if slices.Contains(f.ExcludeInvolvedNames, event.InvolvedObject.Name) {
+		return false
+	}
+
 	if f.InvolvedNamespace != "" && event.InvolvedObject.Namespace != f.InvolvedNamespace {
 		return false
 	}
 
+	if f.InvolvedUID != "" && string(event.InvolvedObject.UID) != f.InvolvedUID {
+		return false
+	}
+
+	if f.SourceComponent != "" && !matchesSourceComponent(event, f.SourceComponent) {
+		return false
+	}
+
+	// Watches select event labels server-side, so this only guards other event sources
+	if f.EventLabelSelector != "" && !matchesEventLabelSelector(event, f.EventLabelSelector) {
+		return false
+	}
+
+	if f.AnnotationSelector != "" && !matchesAnnotationSelector(event, f.AnnotationSelector) {
+		return false
+	}
+
+	if f.CELExpression != "" && !matchesCELExpression(event, f.CELExpression) {
+		return false
+	}
+
 	// Check label selector with provided object labels
 	if f.LabelSelector != "" {
 		selector, err := labels.Parse(f.LabelSelector)
@@ -192,6 +455,12 @@ func (f *SubscriptionFilters) MatchesWithObjectLabels(event *corev1.Event, objec
 	return true
 }
 
+// IncludesModifications reports whether updates to existing events should be delivered.
+// Defaults to true when IncludeModifications is not set.
+func (f *SubscriptionFilters) IncludesModifications() bool {
+	return f.IncludeModifications == nil || *f.IncludeModifications
+}
+
 // GetNamespaceFilter returns a field selector for namespace filtering,
 // suitable for use with client-go watch requests.
 // Returns empty string if no namespace filter is set or multiple namespaces are specified.
@@ -212,6 +481,11 @@ func (f *SubscriptionFilters) GetInvolvedObjectFieldSelector() string {
 		parts = append(parts, fmt.Sprintf("involvedObject.kind=%s", f.InvolvedKind))
 	}
 
+	// The API version only narrows down a kind, so it is selected together with one
+	if f.InvolvedKind != "" && f.InvolvedAPIVersion != "" {
+		parts = append(parts, fmt.Sprintf("involvedObject.apiVersion=%s", f.InvolvedAPIVersion))
+	}
+
 	if f.InvolvedName != "" {
 		parts = append(parts, fmt.Sprintf("involvedObject.name=%s", f.InvolvedName))
 	}
@@ -240,8 +514,48 @@ func (f *SubscriptionFilters) RequiresClientSideFiltering() bool {
 		return true
 	}
 
+	// Reason lists can't be expressed as a field selector, which has no set membership
+	if len(f.IncludeReasons) > 0 || len(f.ExcludeReasons) > 0 {
+		return true
+	}
+
+	// A field selector can only exclude a single name
+	if len(f.ExcludeInvolvedNames) > 0 {
+		return true
+	}
+
+	// involvedObject.uid is not a supported field selector for events
+	if f.InvolvedUID != "" {
+		return true
+	}
+
+	// The API version is only pushed down as a field selector together with the kind
+	if f.InvolvedAPIVersion != "" && f.InvolvedKind == "" {
+		return true
+	}
+
+	// Source component may be set in either of two fields, which a field selector cannot OR
+	if f.SourceComponent != "" {
+		return true
+	}
+
+	// The label selector matches the involved object's labels, which events don't carry
+	if f.LabelSelector != "" {
+		return true
+	}
+
+	// Annotations are not selectable server-side
+	if f.AnnotationSelector != "" {
+		return true
+	}
+
+	// CEL expressions are evaluated against each event
+	if f.CELExpression != "" {
+		return true
+	}
+
 	// Type filtering can be done server-side via field selector
-	// Label selector can be done server-side
+	// Event label selector can be done server-side via label selector
 	// Single namespace can be done via namespace-scoped client
 	// Involved object filters can be done via field selector
 
@@ -261,18 +575,38 @@ func (f *SubscriptionFilters) ToMap() map[string]interface{} {
 		m["labelSelector"] = f.LabelSelector
 	}
 
+	if f.EventLabelSelector != "" {
+		m["eventLabelSelector"] = f.EventLabelSelector
+	}
+
+	if f.AnnotationSelector != "" {
+		m["annotationSelector"] = f.AnnotationSelector
+	}
+
 	if f.InvolvedKind != "" {
 		m["involvedKind"] = f.InvolvedKind
 	}
 
+	if f.InvolvedAPIVersion != "" {
+		m["involvedApiVersion"] = f.InvolvedAPIVersion
+	}
+
 	if f.InvolvedName != "" {
 		m["involvedName"] = f.InvolvedName
 	}
 
+	if len(f.ExcludeInvolvedNames) > 0 {
+		m["excludeInvolvedNames"] = f.ExcludeInvolvedNames
+	}
+
 	if f.InvolvedNamespace != "" {
 		m["involvedNamespace"] = f.InvolvedNamespace
 	}
 
+	if f.InvolvedUID != "" {
+		m["involvedUid"] = f.InvolvedUID
+	}
+
 	if f.Type != "" {
 		m["type"] = f.Type
 	}
@@ -281,38 +615,87 @@ func (f *SubscriptionFilters) ToMap() map[string]interface{} {
 		m["reason"] = f.Reason
 	}
 
+	if len(f.IncludeReasons) > 0 {
+		m["includeReasons"] = f.IncludeReasons
+	}
+
+	if len(f.ExcludeReasons) > 0 {
+		m["excludeReasons"] = f.ExcludeReasons
+	}
+
+	if f.SourceComponent != "" {
+		m["sourceComponent"] = f.SourceComponent
+	}
+
+	if f.CELExpression != "" {
+		m["celExpression"] = f.CELExpression
+	}
+
+	if f.IncludeModifications != nil {
+		m["includeModifications"] = *f.IncludeModifications
+	}
+
+	if f.FirstOccurrenceOnly {
+		m["firstOccurrenceOnly"] = true
+	}
+
+	if len(f.DetectorTypes) > 0 {
+		m["detectorTypes"] = f.DetectorTypes
+	}
+
+	if f.SendInitialState {
+		m["sendInitialState"] = true
+	}
+
+	if f.Delivery != "" {
+		m["delivery"] = f.Delivery
+	}
+
 	return m
 }
 
 // ParseFiltersFromMap creates a SubscriptionFilters from a map of arguments.
-// This is the inverse of ToMap and is used by tool handlers.
+// This is the inverse of ToMap. Arguments of the wrong type are left unset; use
+// ParseFiltersFromMapStrict to report them.
 func ParseFiltersFromMap(args map[string]interface{}) SubscriptionFilters {
 	filters := SubscriptionFilters{}
 
-	if namespaces, ok := args["namespaces"].([]interface{}); ok {
-		for _, ns := range namespaces {
-			if nsStr, ok := ns.(string); ok {
-				filters.Namespaces = append(filters.Namespaces, nsStr)
-			}
-		}
-	}
+	filters.Namespaces = stringSliceArg(args, "namespaces")
 
 	if labelSelector, ok := args["labelSelector"].(string); ok {
 		filters.LabelSelector = labelSelector
 	}
 
+	if eventLabelSelector, ok := args["eventLabelSelector"].(string); ok {
+		filters.EventLabelSelector = eventLabelSelector
+	}
+
+	if annotationSelector, ok := args["annotationSelector"].(string); ok {
+		filters.AnnotationSelector = annotationSelector
+	}
+
 	if involvedKind, ok := args["involvedKind"].(string); ok {
 		filters.InvolvedKind = involvedKind
 	}
 
+	if involvedAPIVersion, ok := args["involvedApiVersion"].(string); ok {
+		filters.InvolvedAPIVersion = involvedAPIVersion
+	}
+
 	if involvedName, ok := args["involvedName"].(string); ok {
 		filters.InvolvedName = involvedName
 	}
 
+	filters.ExcludeInvolvedNames = stringSliceArg(args, "excludeInvolvedNames")
+
 	if involvedNamespace, ok := args["involvedNamespace"].(string); ok {
 		filters.InvolvedNamespace = involvedNamespace
 	}
 
+	if involvedUID, ok := args["involvedUid"].(string); ok {
+		filters.InvolvedUID = involvedUID
+	}
+
 	if eventType, ok := args["type"].(string); ok {
 		filters.Type = eventType
 	}
@@ -321,5 +704,133 @@ func ParseFiltersFromMap(args map[string]interface{}) SubscriptionFilters {
 		filters.Reason = reason
 	}
 
+	filters.IncludeReasons = stringSliceArg(args, "includeReasons")
+	filters.ExcludeReasons = stringSliceArg(args, "excludeReasons")
+
+	if sourceComponent, ok := args["sourceComponent"].(string); ok {
+		filters.SourceComponent = sourceComponent
+	}
+
+	if celExpression, ok := args["celExpression"].(string); ok {
+		filters.CELExpression = celExpression
+	}
+
+	if includeModifications, ok := args["includeModifications"].(bool); ok {
+		filters.IncludeModifications = &includeModifications
+	}
+
+	if firstOccurrenceOnly, ok := args["firstOccurrenceOnly"].(bool); ok {
+		filters.FirstOccurrenceOnly = firstOccurrenceOnly
+	}
+
+	filters.DetectorTypes = stringSliceArg(args, "detectorTypes")
+
+	if sendInitialState, ok := args["sendInitialState"].(bool); ok {
+		filters.SendInitialState = sendInitialState
+	}
+
+	if delivery, ok := args["delivery"].(string); ok {
+		filters.Delivery = delivery
+	}
+
 	return filters
 }
+
+// ParseFiltersFromMapStrict creates a SubscriptionFilters from a map of arguments like
+// ParseFiltersFromMap, but returns an error instead of ignoring arguments that aren't
+// filters or whose value has the wrong type, so a typo'd key (e.g. "namespace" instead
+// of "namespaces") doesn't silently widen the subscription. Null values are treated as
+// unset. The accepted keys and types are those of FilterSchemaProperties.
+func ParseFiltersFromMapStrict(args map[string]interface{}) (SubscriptionFilters, error) {
+	properties := FilterSchemaProperties()
+
+	var unknown, invalid []string
+	for key, value := range args {
+		property, ok := properties[key]
+		if !ok {
+			unknown = append(unknown, key)
+			continue
+		}
+		if value != nil && !matchesSchemaType(property, value) {
+			invalid = append(invalid, fmt.Sprintf("%s must be %s", key, schemaTypeName(property)))
+		}
+	}
+
+	var problems []string
+	if len(unknown) > 0 {
+		slices.Sort(unknown)
+		valid := make([]string, 0, len(properties))
+		for key := range properties {
+			valid = append(valid, key)
+		}
+		slices.Sort(valid)
+		problems = append(problems, fmt.Sprintf("unknown filters %s (valid filters: %s)", strings.Join(unknown, ", "), strings.Join(valid, ", ")))
+	}
+	if len(invalid) > 0 {
+		slices.Sort(invalid)
+		problems = append(problems, strings.Join(invalid, ", "))
+	}
+	if len(problems) > 0 {
+		return SubscriptionFilters{}, errors.New(strings.Join(problems, "; "))
+	}
+
+	return ParseFiltersFromMap(args), nil
+}
+
+// matchesSchemaType reports whether value, decoded from JSON, has the type of the
+// filter's schema property.
+func matchesSchemaType(property *jsonschema.Schema, value interface{}) bool {
+	switch property.Type {
+	case "string":
+		_, ok := value.(string)
+		return ok
+	case "boolean":
+		_, ok := value.(bool)
+		return ok
+	case "array":
+		if _, ok := value.([]string); ok {
+			return property.Items == nil || property.Items.Type == "string"
+		}
+		items, ok := value.([]interface{})
+		if !ok {
+			return false
+		}
+		for _, item := range items {
+			if property.Items != nil && !matchesSchemaType(property.Items, item) {
+				return false
+			}
+		}
+		return true
+	default:
+		return true
+	}
+}
+
+// schemaTypeName describes the type of a filter's schema property in error messages,
+// e.g. "an array of strings".
+func schemaTypeName(property *jsonschema.Schema) string {
+	if property.Type == "array" && property.Items != nil {
+		return "an array of " + property.Items.Type + "s"
+	}
+	return "a " + property.Type
+}
+
+// stringSliceArg returns the strings of an array argument, decoded from JSON as
+// []interface{} or passed as []string. Items that aren't strings are skipped, and
+// so is a value that isn't an array; ParseFiltersFromMapStrict reports both.
+func stringSliceArg(args map[string]interface{}, key string) []string {
+	switch value := args[key].(type) {
+	case []string:
+		return value
+	case []interface{}:
+		var values []string
+		for _, item := range value {
+			if itemStr, ok := item.(string); ok {
+				values = append(values, itemStr)
+			}
+		}
+		return values
+	default:
+		return nil
+	}
+}
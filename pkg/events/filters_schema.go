@@ -0,0 +1,133 @@
+package events
+
+import (
+	"encoding/json"
+
+	"github.com/google/jsonschema-go/jsonschema"
+)
+
+// FilterSchemaProperties returns the JSON Schema properties of every SubscriptionFilters
+// field, keyed by the argument names read by ParseFiltersFromMap.
+// A new map is returned on each call so callers may add their own properties.
+func FilterSchemaProperties() map[string]*jsonschema.Schema {
+	return map[string]*jsonschema.Schema{
+		"namespaces": {
+			Type:        "array",
+			Description: "Optional list of namespaces to watch. If not provided, watches all namespaces (cluster-wide)",
+			Items: &jsonschema.Schema{
+				Type: "string",
+			},
+		},
+		"labelSelector": {
+			Type:        "string",
+			Description: "Optional label selector for filtering events by involved object labels (e.g., 'app=nginx,tier=frontend')",
+		},
+		"eventLabelSelector": {
+			Type:        "string",
+			Description: "Optional label selector for filtering events by their own labels (e.g., 'team=payments'). Applied by the API server, so non-matching events are never sent. Only applies to mode 'events'",
+		},
+		"annotationSelector": {
+			Type:        "string",
+			Description: "Optional selector for filtering events by their own annotations, using label selector syntax (e.g., 'team=payments')",
+		},
+		"involvedKind": {
+			Type:        "string",
+			Description: "Optional involved object kind filter (e.g., 'Pod', 'Deployment')",
+		},
+		"involvedApiVersion": {
+			Type:        "string",
+			Description: "Optional involved object API version filter, 'group/version' or 'version' for the core group (e.g., 'apps/v1', 'v1'). Combine with involvedKind to tell apart kinds of the same name in different API groups",
+		},
+		"involvedName": {
+			Type:        "string",
+			Description: "Optional involved object name filter",
+		},
+		"excludeInvolvedNames": {
+			Type:        "array",
+			Description: "Optional list of exact involved object names whose events are dropped, applied after involvedName (e.g., ['noisy-cronjob-pod'])",
+			Items: &jsonschema.Schema{
+				Type: "string",
+			},
+		},
+		"involvedNamespace": {
+			Type:        "string",
+			Description: "Optional involved object namespace filter",
+		},
+		"involvedUid": {
+			Type:        "string",
+			Description: "Optional involved object UID filter. Unlike involvedName, distinguishes between objects recreated with the same name",
+		},
+		"type": {
+			Type:        "string",
+			Description: "Optional event type filter: 'Normal' or 'Warning'",
+			Enum:        []any{"Normal", "Warning"},
+		},
+		"reason": {
+			Type:        "string",
+			Description: "Optional event reason prefix filter (e.g., 'BackOff', 'Failed')",
+		},
+		"includeReasons": {
+			Type:        "array",
+			Description: "Optional list of exact event reasons to deliver (e.g., ['BackOff', 'FailedMount']). Only applies to mode 'events'",
+			Items: &jsonschema.Schema{
+				Type: "string",
+			},
+		},
+		"excludeReasons": {
+			Type:        "array",
+			Description: "Optional list of exact event reasons to drop, applied after includeReasons (e.g., ['Pulling', 'Pulled']). Only applies to mode 'events'",
+			Items: &jsonschema.Schema{
+				Type: "string",
+			},
+		},
+		"sourceComponent": {
+			Type:        "string",
+			Description: "Optional filter by the component that reported the event (e.g., 'kubelet', 'default-scheduler')",
+		},
+		"celExpression": {
+			Type:        "string",
+			Description: "Optional CEL expression evaluated against each event, which must return a bool (e.g., \"event.reason == 'BackOff' && event.count > 5\"). The event variable has the fields namespace, type, reason, message, count, and involvedObject (with kind, name, namespace, uid, apiVersion, fieldPath)",
+		},
+		"includeModifications": {
+			Type:        "boolean",
+			Description: "Whether to deliver updates to existing events (e.g., count bumps on recurring events). Set to false to receive only newly created events. Only applies to mode 'events'",
+			Default:     json.RawMessage(`true`),
+		},
+		"firstOccurrenceOnly": {
+			Type:        "boolean",
+			Description: "Whether to deliver only the first event for each distinct involved object and reason, suppressing repeats for the lifetime of the subscription. Only applies to mode 'events'",
+			Default:     json.RawMessage(`false`),
+		},
+		"detectorTypes": {
+			Type:        "array",
+			Description: "Optional list of fault types whose detectors to run (e.g., ['PodCrash', 'OOMKilled']). If not provided, all detectors run except opt-in ones such as 'ScaledToZero'. Only applies to mode 'faults'",
+			Items: &jsonschema.Schema{
+				Type: "string",
+			},
+		},
+		"sendInitialState": {
+			Type:        "boolean",
+			Description: "Whether to also report, when the subscription starts, the faults resources are already in (pods in CrashLoopBackOff, NotReady nodes, failed jobs), instead of only later transitions. Only applies to mode 'faults'",
+			Default:     json.RawMessage(`false`),
+		},
+		"delivery": {
+			Type:        "string",
+			Description: "How notifications are delivered: 'log' sends them as MCP log messages, 'resource' publishes them to the subscription's MCP resource (k8s-events://{cluster}/{subscriptionId}, returned as resourceUri) and sends a resource-updated notification for each, for clients that subscribe to it with resources/subscribe",
+			Enum:        []any{DeliveryLog, DeliveryResource},
+			Default:     json.RawMessage(`"log"`),
+		},
+	}
+}
+
+// FiltersJSONSchema returns a JSON Schema document describing SubscriptionFilters
+// as accepted by ParseFiltersFromMap, for clients building subscription UIs.
+func FiltersJSONSchema() []byte {
+	schema := &jsonschema.Schema{
+		Type:       "object",
+		Properties: FilterSchemaProperties(),
+	}
+
+	// The schema is static, so marshalling cannot fail
+	data, _ := json.Marshal(schema)
+	return data
+}
@@ -1,11 +1,15 @@
 package events
 
 import (
+	"encoding/json"
+	"strconv"
 	"testing"
 
 	"github.com/stretchr/testify/suite"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
 )
 
 type FiltersTestSuite struct {
@@ -92,6 +96,73 @@ func (s *FiltersTestSuite) TestValidate_FailsForInvalidLabelSelector() {
 	})
 }
 
+// TestValidate_FailsForInvalidEventLabelSelector tests that Validate() fails for invalid event label selectors
+func (s *FiltersTestSuite) TestValidate_FailsForInvalidEventLabelSelector() {
+	s.Run("accepts valid event label selector", func() {
+		filters := SubscriptionFilters{
+			EventLabelSelector: "team=payments,severity in (high,critical)",
+		}
+		s.NoError(filters.Validate())
+	})
+
+	s.Run("rejects invalid event label selector syntax", func() {
+		filters := SubscriptionFilters{
+			EventLabelSelector: "invalid=label=selector",
+		}
+		err := filters.Validate()
+		s.Error(err)
+		s.Contains(err.Error(), "invalid event label selector")
+	})
+}
+
+// TestValidate_InvolvedAPIVersion tests that Validate() checks the involved API version format
+func (s *FiltersTestSuite) TestValidate_InvolvedAPIVersion() {
+	s.Run("accepts group/version and core version", func() {
+		for _, apiVersion := range []string{"v1", "apps/v1", "example.com/v1beta1"} {
+			filters := SubscriptionFilters{InvolvedAPIVersion: apiVersion}
+			s.NoError(filters.Validate(), "apiVersion %q", apiVersion)
+		}
+	})
+
+	s.Run("rejects malformed API versions", func() {
+		for _, apiVersion := range []string{"apps/v1/extra", "/v1", "apps/", "Apps/v1", "apps/V1", "1"} {
+			filters := SubscriptionFilters{InvolvedAPIVersion: apiVersion}
+			err := filters.Validate()
+			s.Require().Error(err, "apiVersion %q", apiVersion)
+			s.Contains(err.Error(), "invalid involved API version")
+			s.Contains(err.Error(), "must be 'group/version' or 'version'")
+		}
+	})
+}
+
+// TestValidate_FailsForInvalidAnnotationSelector tests that Validate() fails for invalid annotation selectors
+func (s *FiltersTestSuite) TestValidate_FailsForInvalidAnnotationSelector() {
+	s.Run("accepts valid annotation selector", func() {
+		filters := SubscriptionFilters{
+			AnnotationSelector: "team=payments,severity in (high,critical)",
+		}
+		s.NoError(filters.Validate())
+	})
+
+	s.Run("rejects invalid annotation selector syntax", func() {
+		filters := SubscriptionFilters{
+			AnnotationSelector: "invalid=annotation=selector",
+		}
+		err := filters.Validate()
+		s.Error(err)
+		s.Contains(err.Error(), "invalid annotation selector")
+	})
+
+	s.Run("rejects annotation selector with unmatched parentheses", func() {
+		filters := SubscriptionFilters{
+			AnnotationSelector: "team in (payments",
+		}
+		err := filters.Validate()
+		s.Error(err)
+		s.Contains(err.Error(), "invalid annotation selector")
+	})
+}
+
 // TestValidate_RejectsInvalidType tests that Validate() rejects invalid type values
 func (s *FiltersTestSuite) TestValidate_RejectsInvalidType() {
 	s.Run("rejects invalid type value", func() {
@@ -113,6 +184,23 @@ func (s *FiltersTestSuite) TestValidate_RejectsInvalidType() {
 	})
 }
 
+// TestValidate_Delivery tests that Validate() accepts only the known delivery modes
+func (s *FiltersTestSuite) TestValidate_Delivery() {
+	s.Run("accepts the delivery modes", func() {
+		for _, delivery := range []string{"", DeliveryLog, DeliveryResource} {
+			filters := SubscriptionFilters{Delivery: delivery}
+			s.NoError(filters.Validate(), "delivery %q", delivery)
+		}
+	})
+
+	s.Run("rejects unknown delivery modes", func() {
+		filters := SubscriptionFilters{Delivery: "webhook"}
+		err := filters.Validate()
+		s.Require().Error(err)
+		s.Contains(err.Error(), "invalid delivery")
+	})
+}
+
 // TestValidateForMode_FaultsMode tests that ValidateForMode() enforces faults mode restrictions
 func (s *FiltersTestSuite) TestValidateForMode_FaultsMode() {
 	s.Run("rejects Normal type in faults mode", func() {
@@ -146,6 +234,58 @@ func (s *FiltersTestSuite) TestValidateForMode_FaultsMode() {
 		s.Error(err)
 		s.Contains(err.Error(), "invalid label selector")
 	})
+
+	s.Run("rejects includeModifications in faults mode", func() {
+		filters := SubscriptionFilters{
+			IncludeModifications: ptr.To(false),
+		}
+		err := filters.ValidateForMode("faults")
+		s.Error(err)
+		s.Contains(err.Error(), "includeModifications is only supported in events mode")
+	})
+
+	s.Run("accepts detectorTypes in faults mode", func() {
+		filters := SubscriptionFilters{
+			DetectorTypes: []string{"PodCrash"},
+		}
+		err := filters.ValidateForMode("faults")
+		s.NoError(err)
+	})
+
+	s.Run("accepts sendInitialState in faults mode", func() {
+		filters := SubscriptionFilters{
+			SendInitialState: true,
+		}
+		err := filters.ValidateForMode("faults")
+		s.NoError(err)
+	})
+
+	s.Run("rejects firstOccurrenceOnly in faults mode", func() {
+		filters := SubscriptionFilters{
+			FirstOccurrenceOnly: true,
+		}
+		err := filters.ValidateForMode("faults")
+		s.Error(err)
+		s.Contains(err.Error(), "firstOccurrenceOnly is only supported in events mode")
+	})
+
+	s.Run("rejects reason lists in faults mode", func() {
+		for _, filters := range []SubscriptionFilters{
+			{IncludeReasons: []string{"BackOff"}},
+			{ExcludeReasons: []string{"Pulled"}},
+		} {
+			err := filters.ValidateForMode("faults")
+			s.Error(err)
+			s.Contains(err.Error(), "includeReasons and excludeReasons are only supported in events mode")
+		}
+	})
+
+	s.Run("rejects event label selector in faults mode", func() {
+		filters := SubscriptionFilters{EventLabelSelector: "team=payments"}
+		err := filters.ValidateForMode("faults")
+		s.Error(err)
+		s.Contains(err.Error(), "eventLabelSelector is only supported in events mode")
+	})
 }
 
 // TestValidateForMode_EventsMode tests that ValidateForMode() works correctly for events mode
@@ -174,6 +314,67 @@ func (s *FiltersTestSuite) TestValidateForMode_EventsMode() {
 		s.Error(err)
 		s.Contains(err.Error(), "invalid label selector")
 	})
+
+	s.Run("accepts includeModifications in events mode", func() {
+		filters := SubscriptionFilters{
+			IncludeModifications: ptr.To(false),
+		}
+		err := filters.ValidateForMode("events")
+		s.NoError(err)
+	})
+
+	s.Run("accepts firstOccurrenceOnly in events mode", func() {
+		filters := SubscriptionFilters{
+			FirstOccurrenceOnly: true,
+		}
+		err := filters.ValidateForMode("events")
+		s.NoError(err)
+	})
+
+	s.Run("accepts reason lists in events mode", func() {
+		filters := SubscriptionFilters{
+			IncludeReasons: []string{"BackOff"},
+			ExcludeReasons: []string{"Pulled"},
+		}
+		err := filters.ValidateForMode("events")
+		s.NoError(err)
+	})
+
+	s.Run("rejects detectorTypes in events mode", func() {
+		filters := SubscriptionFilters{
+			DetectorTypes: []string{"PodCrash"},
+		}
+		err := filters.ValidateForMode("events")
+		s.Error(err)
+		s.Contains(err.Error(), "detectorTypes is only supported in faults mode")
+	})
+
+	s.Run("rejects sendInitialState in events mode", func() {
+		filters := SubscriptionFilters{
+			SendInitialState: true,
+		}
+		err := filters.ValidateForMode("events")
+		s.Error(err)
+		s.Contains(err.Error(), "sendInitialState is only supported in faults mode")
+	})
+}
+
+// TestIncludesModifications tests that modifications are included unless explicitly disabled
+func (s *FiltersTestSuite) TestIncludesModifications() {
+	s.Run("defaults to true when unset", func() {
+		filters := SubscriptionFilters{}
+		s.True(filters.IncludesModifications())
+	})
+
+	s.Run("returns true when explicitly enabled", func() {
+		filters := SubscriptionFilters{IncludeModifications: ptr.To(true)}
+		s.True(filters.IncludesModifications())
+	})
+
+	s.Run("returns false when explicitly disabled", func() {
+		filters := SubscriptionFilters{IncludeModifications: ptr.To(false)}
+		s.False(filters.IncludesModifications())
+	})
 }
 
 // TestMatches_FiltersByNamespace tests that Matches() filters by namespace
@@ -347,6 +548,117 @@ func (s *FiltersTestSuite) TestMatches_FiltersByReason() {
 	})
 }
 
+// TestMatches_FiltersByReasonLists tests that Matches() applies the reason allowlist and denylist
+func (s *FiltersTestSuite) TestMatches_FiltersByReasonLists() {
+	s.Run("include only matches listed reasons exactly", func() {
+		filters := SubscriptionFilters{
+			IncludeReasons: []string{"BackOff", "FailedMount"},
+		}
+
+		s.True(filters.Matches(&v1.Event{Reason: "BackOff"}))
+		s.True(filters.Matches(&v1.Event{Reason: "FailedMount"}))
+		s.False(filters.Matches(&v1.Event{Reason: "Failed"}), "include reasons are not prefixes")
+		s.False(filters.Matches(&v1.Event{Reason: "Pulled"}))
+	})
+
+	s.Run("exclude only drops listed reasons exactly", func() {
+		filters := SubscriptionFilters{
+			ExcludeReasons: []string{"Pulling", "Pulled"},
+		}
+
+		s.False(filters.Matches(&v1.Event{Reason: "Pulling"}))
+		s.False(filters.Matches(&v1.Event{Reason: "Pulled"}))
+		s.True(filters.Matches(&v1.Event{Reason: "PulledAgain"}), "exclude reasons are not prefixes")
+		s.True(filters.Matches(&v1.Event{Reason: "BackOff"}))
+	})
+
+	s.Run("exclude is applied after include", func() {
+		filters := SubscriptionFilters{
+			IncludeReasons: []string{"BackOff", "Killing"},
+			ExcludeReasons: []string{"Killing"},
+		}
+
+		s.True(filters.Matches(&v1.Event{Reason: "BackOff"}))
+		s.False(filters.Matches(&v1.Event{Reason: "Killing"}), "a reason both included and excluded is excluded")
+		s.False(filters.Matches(&v1.Event{Reason: "Pulled"}))
+	})
+
+	s.Run("combines with the reason prefix", func() {
+		filters := SubscriptionFilters{
+			Reason:         "Failed",
+			ExcludeReasons: []string{"FailedScheduling"},
+		}
+
+		s.True(filters.Matches(&v1.Event{Reason: "FailedMount"}))
+		s.False(filters.Matches(&v1.Event{Reason: "FailedScheduling"}))
+		s.False(filters.Matches(&v1.Event{Reason: "BackOff"}), "the prefix still applies")
+
+		filters = SubscriptionFilters{
+			Reason:         "Failed",
+			IncludeReasons: []string{"FailedMount", "BackOff"},
+		}
+
+		s.True(filters.Matches(&v1.Event{Reason: "FailedMount"}))
+		s.False(filters.Matches(&v1.Event{Reason: "BackOff"}), "included reasons must also match the prefix")
+	})
+
+	s.Run("applies with object labels", func() {
+		filters := SubscriptionFilters{
+			IncludeReasons: []string{"BackOff"},
+			ExcludeReasons: []string{"Pulled"},
+			LabelSelector:  "app=web",
+		}
+		objectLabels := map[string]string{"app": "web"}
+
+		s.True(filters.MatchesWithObjectLabels(&v1.Event{Reason: "BackOff"}, objectLabels))
+		s.False(filters.MatchesWithObjectLabels(&v1.Event{Reason: "Pulled"}, objectLabels))
+		s.False(filters.MatchesWithObjectLabels(&v1.Event{Reason: "Killing"}, objectLabels))
+	})
+}
+
+// TestMatches_ExcludesInvolvedNames tests that Matches() drops events for excluded involved object names
+func (s *FiltersTestSuite) TestMatches_ExcludesInvolvedNames() {
+	event := func(name string) *v1.Event {
+		return &v1.Event{InvolvedObject: v1.ObjectReference{Kind: "Pod", Name: name}}
+	}
+
+	s.Run("drops excluded names and passes others", func() {
+		filters := SubscriptionFilters{
+			ExcludeInvolvedNames: []string{"noisy-pod", "chatty-job"},
+		}
+
+		s.False(filters.Matches(event("noisy-pod")))
+		s.False(filters.Matches(event("chatty-job")))
+		s.True(filters.Matches(event("noisy-pod-2")), "excluded names are not prefixes")
+		s.True(filters.Matches(event("web")))
+	})
+
+	s.Run("exclude is applied after the involvedName include", func() {
+		filters := SubscriptionFilters{
+			InvolvedName:         "web",
+			ExcludeInvolvedNames: []string{"noisy-pod"},
+		}
+
+		s.True(filters.Matches(event("web")))
+		s.False(filters.Matches(event("noisy-pod")), "the include still applies")
+		s.False(filters.Matches(event("other")))
+
+		filters.ExcludeInvolvedNames = []string{"web"}
+		s.False(filters.Matches(event("web")), "a name both included and excluded is excluded")
+	})
+
+	s.Run("applies with object labels", func() {
+		filters := SubscriptionFilters{
+			ExcludeInvolvedNames: []string{"noisy-pod"},
+			LabelSelector:        "app=web",
+		}
+		objectLabels := map[string]string{"app": "web"}
+
+		s.True(filters.MatchesWithObjectLabels(event("web"), objectLabels))
+		s.False(filters.MatchesWithObjectLabels(event("noisy-pod"), objectLabels))
+	})
+}
+
 // TestMatches_FiltersByInvolvedObject tests that Matches() filters by involved object
 func (s *FiltersTestSuite) TestMatches_FiltersByInvolvedObject() {
 	s.Run("matches by involved object kind", func() {
@@ -377,6 +689,55 @@ func (s *FiltersTestSuite) TestMatches_FiltersByInvolvedObject() {
 		s.False(filters.Matches(event))
 	})
 
+	s.Run("matches by involved object kind and API version", func() {
+		filters := SubscriptionFilters{
+			InvolvedKind:       "Deployment",
+			InvolvedAPIVersion: "apps/v1",
+		}
+
+		event := &v1.Event{
+			InvolvedObject: v1.ObjectReference{
+				Kind:       "Deployment",
+				APIVersion: "apps/v1",
+			},
+		}
+
+		s.True(filters.Matches(event))
+		s.True(filters.MatchesWithObjectLabels(event, nil))
+	})
+
+	s.Run("rejects same kind in a different API group", func() {
+		filters := SubscriptionFilters{
+			InvolvedKind:       "Pod",
+			InvolvedAPIVersion: "v1",
+		}
+
+		event := &v1.Event{
+			InvolvedObject: v1.ObjectReference{
+				Kind:       "Pod",
+				APIVersion: "example.com/v1",
+			},
+		}
+
+		s.False(filters.Matches(event))
+		s.False(filters.MatchesWithObjectLabels(event, nil))
+	})
+
+	s.Run("rejects same group in a different version", func() {
+		filters := SubscriptionFilters{
+			InvolvedAPIVersion: "example.com/v1",
+		}
+
+		event := &v1.Event{
+			InvolvedObject: v1.ObjectReference{
+				Kind:       "Widget",
+				APIVersion: "example.com/v1beta1",
+			},
+		}
+
+		s.False(filters.Matches(event))
+	})
+
 	s.Run("matches by involved object name", func() {
 		filters := SubscriptionFilters{
 			InvolvedName: "nginx-pod",
@@ -391,96 +752,449 @@ func (s *FiltersTestSuite) TestMatches_FiltersByInvolvedObject() {
 		s.True(filters.Matches(event))
 	})
 
-	s.Run("rejects different involved object name", func() {
+	s.Run("rejects different involved object name", func() {
+		filters := SubscriptionFilters{
+			InvolvedName: "nginx-pod",
+		}
+
+		event := &v1.Event{
+			InvolvedObject: v1.ObjectReference{
+				Name: "redis-pod",
+			},
+		}
+
+		s.False(filters.Matches(event))
+	})
+
+	s.Run("matches by involved object namespace", func() {
+		filters := SubscriptionFilters{
+			InvolvedNamespace: "production",
+		}
+
+		event := &v1.Event{
+			InvolvedObject: v1.ObjectReference{
+				Namespace: "production",
+			},
+		}
+
+		s.True(filters.Matches(event))
+	})
+
+	s.Run("rejects different involved object namespace", func() {
+		filters := SubscriptionFilters{
+			InvolvedNamespace: "production",
+		}
+
+		event := &v1.Event{
+			InvolvedObject: v1.ObjectReference{
+				Namespace: "staging",
+			},
+		}
+
+		s.False(filters.Matches(event))
+	})
+
+	s.Run("matches by all involved object fields", func() {
+		filters := SubscriptionFilters{
+			InvolvedKind:      "Pod",
+			InvolvedName:      "nginx-pod",
+			InvolvedNamespace: "production",
+		}
+
+		event := &v1.Event{
+			InvolvedObject: v1.ObjectReference{
+				Kind:      "Pod",
+				Name:      "nginx-pod",
+				Namespace: "production",
+			},
+		}
+
+		s.True(filters.Matches(event))
+	})
+
+	s.Run("rejects when one involved object field doesn't match", func() {
+		filters := SubscriptionFilters{
+			InvolvedKind:      "Pod",
+			InvolvedName:      "nginx-pod",
+			InvolvedNamespace: "production",
+		}
+
+		event := &v1.Event{
+			InvolvedObject: v1.ObjectReference{
+				Kind:      "Pod",
+				Name:      "nginx-pod",
+				Namespace: "staging", // Wrong namespace
+			},
+		}
+
+		s.False(filters.Matches(event))
+	})
+}
+
+// TestMatches_FiltersByInvolvedUID tests that Matches() filters by involved object UID
+func (s *FiltersTestSuite) TestMatches_FiltersByInvolvedUID() {
+	s.Run("matches by involved object UID", func() {
+		filters := SubscriptionFilters{
+			InvolvedUID: "pod-uid-1",
+		}
+
+		event := &v1.Event{
+			InvolvedObject: v1.ObjectReference{
+				Kind: "Pod",
+				Name: "test-pod",
+				UID:  "pod-uid-1",
+			},
+		}
+
+		s.True(filters.Matches(event))
+		s.True(filters.MatchesWithObjectLabels(event, nil))
+	})
+
+	s.Run("rejects recreated object with same name but different UID", func() {
+		filters := SubscriptionFilters{
+			InvolvedName: "test-pod",
+			InvolvedUID:  "pod-uid-1",
+		}
+
+		event := &v1.Event{
+			InvolvedObject: v1.ObjectReference{
+				Kind: "Pod",
+				Name: "test-pod",
+				UID:  "pod-uid-2",
+			},
+		}
+
+		s.False(filters.Matches(event))
+		s.False(filters.MatchesWithObjectLabels(event, nil))
+	})
+
+	s.Run("empty UID filter matches all", func() {
+		filters := SubscriptionFilters{}
+
+		for _, uid := range []types.UID{"", "pod-uid-1", "pod-uid-2"} {
+			event := &v1.Event{
+				InvolvedObject: v1.ObjectReference{UID: uid},
+			}
+			s.True(filters.Matches(event), "empty filter should match UID %q", uid)
+		}
+	})
+}
+
+// TestMatches_FiltersBySourceComponent tests that Matches() filters by reporting component
+func (s *FiltersTestSuite) TestMatches_FiltersBySourceComponent() {
+	s.Run("matches legacy Source.Component", func() {
+		filters := SubscriptionFilters{
+			SourceComponent: "default-scheduler",
+		}
+
+		event := &v1.Event{
+			Reason: "FailedScheduling",
+			Source: v1.EventSource{Component: "default-scheduler"},
+		}
+
+		s.True(filters.Matches(event))
+		s.True(filters.MatchesWithObjectLabels(event, nil))
+	})
+
+	s.Run("matches newer ReportingController", func() {
+		filters := SubscriptionFilters{
+			SourceComponent: "default-scheduler",
+		}
+
+		event := &v1.Event{
+			Reason:              "Scheduled",
+			ReportingController: "default-scheduler",
+		}
+
+		s.True(filters.Matches(event))
+		s.True(filters.MatchesWithObjectLabels(event, nil))
+	})
+
+	s.Run("rejects events from other components", func() {
+		filters := SubscriptionFilters{
+			SourceComponent: "default-scheduler",
+		}
+
+		event := &v1.Event{
+			Reason:              "BackOff",
+			Source:              v1.EventSource{Component: "kubelet", Host: "node-1"},
+			ReportingController: "kubelet",
+		}
+
+		s.False(filters.Matches(event))
+		s.False(filters.MatchesWithObjectLabels(event, nil))
+	})
+
+	s.Run("requires an exact component match", func() {
+		filters := SubscriptionFilters{
+			SourceComponent: "kube",
+		}
+
+		event := &v1.Event{
+			Source: v1.EventSource{Component: "kubelet"},
+		}
+
+		s.False(filters.Matches(event), "component filter is not a prefix match")
+	})
+
+	s.Run("empty source component filter matches all", func() {
+		filters := SubscriptionFilters{}
+
+		s.True(filters.Matches(&v1.Event{Source: v1.EventSource{Component: "kubelet"}}))
+		s.True(filters.Matches(&v1.Event{ReportingController: "default-scheduler"}))
+		s.True(filters.Matches(&v1.Event{}))
+	})
+}
+
+// TestMatches_FiltersByLabels tests that Matches() filters by label selector
+func (s *FiltersTestSuite) TestMatches_FiltersByLabels() {
+	s.Run("matches event with matching labels", func() {
+		filters := SubscriptionFilters{
+			LabelSelector: "app=nginx",
+		}
+
+		event := &v1.Event{
+			ObjectMeta: metav1.ObjectMeta{
+				Labels: map[string]string{
+					"app": "nginx",
+				},
+			},
+		}
+
+		s.True(filters.Matches(event))
+	})
+
+	s.Run("rejects event without matching labels", func() {
+		filters := SubscriptionFilters{
+			LabelSelector: "app=nginx",
+		}
+
+		event := &v1.Event{
+			ObjectMeta: metav1.ObjectMeta{
+				Labels: map[string]string{
+					"app": "redis",
+				},
+			},
+		}
+
+		s.False(filters.Matches(event))
+	})
+
+	s.Run("matches event with multiple labels", func() {
+		filters := SubscriptionFilters{
+			LabelSelector: "app=nginx,tier=frontend",
+		}
+
+		event := &v1.Event{
+			ObjectMeta: metav1.ObjectMeta{
+				Labels: map[string]string{
+					"app":  "nginx",
+					"tier": "frontend",
+				},
+			},
+		}
+
+		s.True(filters.Matches(event))
+	})
+
+	s.Run("rejects event missing one required label", func() {
 		filters := SubscriptionFilters{
-			InvolvedName: "nginx-pod",
+			LabelSelector: "app=nginx,tier=frontend",
 		}
 
 		event := &v1.Event{
-			InvolvedObject: v1.ObjectReference{
-				Name: "redis-pod",
+			ObjectMeta: metav1.ObjectMeta{
+				Labels: map[string]string{
+					"app": "nginx",
+					// Missing tier label
+				},
 			},
 		}
 
 		s.False(filters.Matches(event))
 	})
 
-	s.Run("matches by involved object namespace", func() {
-		filters := SubscriptionFilters{
-			InvolvedNamespace: "production",
-		}
+	s.Run("empty label selector matches all", func() {
+		filters := SubscriptionFilters{}
 
 		event := &v1.Event{
-			InvolvedObject: v1.ObjectReference{
-				Namespace: "production",
+			ObjectMeta: metav1.ObjectMeta{
+				Labels: map[string]string{
+					"app": "nginx",
+				},
 			},
 		}
 
 		s.True(filters.Matches(event))
 	})
+}
 
-	s.Run("rejects different involved object namespace", func() {
-		filters := SubscriptionFilters{
-			InvolvedNamespace: "production",
+// TestValidate_CELExpression tests that Validate() compiles CEL expressions
+func (s *FiltersTestSuite) TestValidate_CELExpression() {
+	s.Run("accepts valid expressions", func() {
+		for _, expression := range []string{
+			"event.reason == 'BackOff'",
+			"event.type == 'Warning' && event.count > 5",
+			"event.involvedObject.kind == 'Pod' && event.namespace.startsWith('team-')",
+			"event.message.contains('OOM') || event.reason in ['Failed', 'Evicted']",
+		} {
+			filters := SubscriptionFilters{CELExpression: expression}
+			s.NoError(filters.Validate(), "expression %q", expression)
 		}
+	})
 
-		event := &v1.Event{
-			InvolvedObject: v1.ObjectReference{
-				Namespace: "staging",
-			},
+	s.Run("rejects expressions with syntax errors", func() {
+		filters := SubscriptionFilters{CELExpression: "event.reason == "}
+		err := filters.Validate()
+		s.Error(err)
+		s.Contains(err.Error(), "invalid CEL expression")
+	})
+
+	s.Run("rejects expressions with undeclared variables", func() {
+		filters := SubscriptionFilters{CELExpression: "severity == 'high'"}
+		err := filters.Validate()
+		s.Error(err)
+		s.Contains(err.Error(), "invalid CEL expression")
+	})
+
+	s.Run("rejects expressions that don't evaluate to bool", func() {
+		filters := SubscriptionFilters{CELExpression: "size(event.reason)"}
+		err := filters.Validate()
+		s.Error(err)
+		s.Contains(err.Error(), "invalid CEL expression")
+		s.Contains(err.Error(), "must evaluate to bool")
+	})
+}
+
+// TestMatches_FiltersByCELExpression tests that Matches() evaluates CEL expressions
+func (s *FiltersTestSuite) TestMatches_FiltersByCELExpression() {
+	event := &v1.Event{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-event",
+			Namespace: "team-a",
+		},
+		InvolvedObject: v1.ObjectReference{
+			Kind:      "Pod",
+			Name:      "api-7d9f",
+			Namespace: "team-a",
+			UID:       "pod-uid-1",
+		},
+		Type:    "Warning",
+		Reason:  "BackOff",
+		Message: "Back-off restarting failed container",
+		Count:   7,
+	}
+
+	s.Run("matches event satisfying the expression", func() {
+		filters := SubscriptionFilters{CELExpression: "event.reason == 'BackOff' && event.count > 5"}
+		s.True(filters.Matches(event))
+	})
+
+	s.Run("rejects event not satisfying the expression", func() {
+		filters := SubscriptionFilters{CELExpression: "event.reason == 'BackOff' && event.count > 10"}
+		s.False(filters.Matches(event))
+	})
+
+	s.Run("evaluates involved object fields", func() {
+		filters := SubscriptionFilters{CELExpression: "event.involvedObject.kind == 'Pod' && event.involvedObject.name.startsWith('api-') && event.involvedObject.uid == 'pod-uid-1'"}
+		s.True(filters.Matches(event))
+	})
+
+	s.Run("evaluates namespace, type, and message", func() {
+		filters := SubscriptionFilters{CELExpression: "event.namespace == 'team-a' && event.type == 'Warning' && event.message.contains('restarting')"}
+		s.True(filters.Matches(event))
+	})
+
+	s.Run("combines with other filters", func() {
+		filters := SubscriptionFilters{
+			Type:          "Normal",
+			CELExpression: "event.reason == 'BackOff'",
 		}
+		s.False(filters.Matches(event))
+	})
 
+	s.Run("treats evaluation errors as no match", func() {
+		filters := SubscriptionFilters{CELExpression: "event.involvedObject['missing'] == 'x'"}
+		s.Require().NoError(filters.Validate())
 		s.False(filters.Matches(event))
 	})
 
-	s.Run("matches by all involved object fields", func() {
+	s.Run("treats non-bool results as no match", func() {
+		filters := SubscriptionFilters{CELExpression: "event.reason"}
+		s.Require().NoError(filters.Validate())
+		s.False(filters.Matches(event))
+	})
+
+	s.Run("applies to MatchesWithObjectLabels", func() {
 		filters := SubscriptionFilters{
-			InvolvedKind:      "Pod",
-			InvolvedName:      "nginx-pod",
-			InvolvedNamespace: "production",
+			LabelSelector: "app=api",
+			CELExpression: "event.count > 5",
 		}
+		s.True(filters.MatchesWithObjectLabels(event, map[string]string{"app": "api"}))
 
-		event := &v1.Event{
-			InvolvedObject: v1.ObjectReference{
-				Kind:      "Pod",
-				Name:      "nginx-pod",
-				Namespace: "production",
-			},
+		filters.CELExpression = "event.count > 10"
+		s.False(filters.MatchesWithObjectLabels(event, map[string]string{"app": "api"}))
+	})
+
+	s.Run("reads count from the series of newer events", func() {
+		seriesEvent := event.DeepCopy()
+		seriesEvent.Count = 0
+		seriesEvent.Series = &v1.EventSeries{Count: 7}
+
+		filters := SubscriptionFilters{CELExpression: "event.count > 5"}
+		s.True(filters.Matches(seriesEvent))
+	})
+
+	s.Run("caches a bounded number of programs", func() {
+		for i := range celProgramCacheMaxEntries + 10 {
+			filters := SubscriptionFilters{CELExpression: "event.count > " + strconv.Itoa(i)}
+			filters.Matches(event)
 		}
+		s.Equal(celProgramCacheMaxEntries, celPrograms.Len())
+	})
 
-		s.True(filters.Matches(event))
+	s.Run("doesn't cache programs of validated expressions", func() {
+		celPrograms.Clear()
+		filters := SubscriptionFilters{CELExpression: "event.reason == 'Validated'"}
+		s.Require().NoError(filters.Validate())
+		s.Zero(celPrograms.Len())
 	})
+}
 
-	s.Run("rejects when one involved object field doesn't match", func() {
-		filters := SubscriptionFilters{
-			InvolvedKind:      "Pod",
-			InvolvedName:      "nginx-pod",
-			InvolvedNamespace: "production",
+// TestMatches_FiltersByEventLabels tests that Matches() filters by the event's own labels
+func (s *FiltersTestSuite) TestMatches_FiltersByEventLabels() {
+	s.Run("matches event with matching labels", func() {
+		filters := SubscriptionFilters{EventLabelSelector: "team=payments"}
+		event := &v1.Event{
+			ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"team": "payments"}},
 		}
 
+		s.True(filters.Matches(event))
+		s.True(filters.MatchesWithObjectLabels(event, nil), "event labels are not the involved object's labels")
+	})
+
+	s.Run("rejects event without matching labels", func() {
+		filters := SubscriptionFilters{EventLabelSelector: "team=payments"}
 		event := &v1.Event{
-			InvolvedObject: v1.ObjectReference{
-				Kind:      "Pod",
-				Name:      "nginx-pod",
-				Namespace: "staging", // Wrong namespace
-			},
+			ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"team": "search"}},
 		}
 
 		s.False(filters.Matches(event))
+		s.False(filters.MatchesWithObjectLabels(event, map[string]string{"team": "payments"}))
 	})
 }
 
-// TestMatches_FiltersByLabels tests that Matches() filters by label selector
-func (s *FiltersTestSuite) TestMatches_FiltersByLabels() {
-	s.Run("matches event with matching labels", func() {
+// TestMatches_FiltersByAnnotations tests that Matches() filters by event annotations
+func (s *FiltersTestSuite) TestMatches_FiltersByAnnotations() {
+	s.Run("matches event with matching annotations", func() {
 		filters := SubscriptionFilters{
-			LabelSelector: "app=nginx",
+			AnnotationSelector: "team=payments",
 		}
 
 		event := &v1.Event{
 			ObjectMeta: metav1.ObjectMeta{
-				Labels: map[string]string{
-					"app": "nginx",
+				Annotations: map[string]string{
+					"team": "payments",
 				},
 			},
 		}
@@ -488,15 +1202,15 @@ func (s *FiltersTestSuite) TestMatches_FiltersByLabels() {
 		s.True(filters.Matches(event))
 	})
 
-	s.Run("rejects event without matching labels", func() {
+	s.Run("rejects event without matching annotations", func() {
 		filters := SubscriptionFilters{
-			LabelSelector: "app=nginx",
+			AnnotationSelector: "team=payments",
 		}
 
 		event := &v1.Event{
 			ObjectMeta: metav1.ObjectMeta{
-				Labels: map[string]string{
-					"app": "redis",
+				Annotations: map[string]string{
+					"team": "search",
 				},
 			},
 		}
@@ -504,15 +1218,15 @@ func (s *FiltersTestSuite) TestMatches_FiltersByLabels() {
 		s.False(filters.Matches(event))
 	})
 
-	s.Run("matches event with multiple labels", func() {
+	s.Run("matches event with multiple annotations", func() {
 		filters := SubscriptionFilters{
-			LabelSelector: "app=nginx,tier=frontend",
+			AnnotationSelector: "team=payments,tier=frontend",
 		}
 
 		event := &v1.Event{
 			ObjectMeta: metav1.ObjectMeta{
-				Labels: map[string]string{
-					"app":  "nginx",
+				Annotations: map[string]string{
+					"team": "payments",
 					"tier": "frontend",
 				},
 			},
@@ -521,16 +1235,32 @@ func (s *FiltersTestSuite) TestMatches_FiltersByLabels() {
 		s.True(filters.Matches(event))
 	})
 
-	s.Run("rejects event missing one required label", func() {
+	s.Run("rejects event missing one required annotation", func() {
 		filters := SubscriptionFilters{
-			LabelSelector: "app=nginx,tier=frontend",
+			AnnotationSelector: "team=payments,tier=frontend",
+		}
+
+		event := &v1.Event{
+			ObjectMeta: metav1.ObjectMeta{
+				Annotations: map[string]string{
+					"team": "payments",
+					// Missing tier annotation
+				},
+			},
+		}
+
+		s.False(filters.Matches(event))
+	})
+
+	s.Run("does not match against labels", func() {
+		filters := SubscriptionFilters{
+			AnnotationSelector: "team=payments",
 		}
 
 		event := &v1.Event{
 			ObjectMeta: metav1.ObjectMeta{
 				Labels: map[string]string{
-					"app": "nginx",
-					// Missing tier label
+					"team": "payments",
 				},
 			},
 		}
@@ -538,13 +1268,13 @@ func (s *FiltersTestSuite) TestMatches_FiltersByLabels() {
 		s.False(filters.Matches(event))
 	})
 
-	s.Run("empty label selector matches all", func() {
+	s.Run("empty annotation selector matches all", func() {
 		filters := SubscriptionFilters{}
 
 		event := &v1.Event{
 			ObjectMeta: metav1.ObjectMeta{
-				Labels: map[string]string{
-					"app": "nginx",
+				Annotations: map[string]string{
+					"team": "payments",
 				},
 			},
 		}
@@ -692,6 +1422,26 @@ func (s *FiltersTestSuite) TestMatchesWithObjectLabels() {
 
 		s.True(filters.MatchesWithObjectLabels(event, objectLabels))
 	})
+
+	s.Run("matches annotation selector against event annotations", func() {
+		filters := SubscriptionFilters{
+			LabelSelector:      "app=nginx",
+			AnnotationSelector: "team=payments",
+		}
+
+		event := &v1.Event{
+			ObjectMeta: metav1.ObjectMeta{
+				Annotations: map[string]string{
+					"team": "payments",
+				},
+			},
+		}
+
+		s.True(filters.MatchesWithObjectLabels(event, map[string]string{"app": "nginx"}))
+
+		event.Annotations["team"] = "search"
+		s.False(filters.MatchesWithObjectLabels(event, map[string]string{"app": "nginx", "team": "payments"}))
+	})
 }
 
 // TestGetNamespaceFilter tests the GetNamespaceFilter method
@@ -730,6 +1480,24 @@ func (s *FiltersTestSuite) TestGetInvolvedObjectFieldSelector() {
 		s.Equal("involvedObject.kind=Pod", selector)
 	})
 
+	s.Run("builds field selector for kind and API version", func() {
+		filters := SubscriptionFilters{
+			InvolvedKind:       "Deployment",
+			InvolvedAPIVersion: "apps/v1",
+		}
+
+		selector := filters.GetInvolvedObjectFieldSelector()
+		s.Equal("involvedObject.kind=Deployment,involvedObject.apiVersion=apps/v1", selector)
+	})
+
+	s.Run("leaves out API version without kind", func() {
+		filters := SubscriptionFilters{
+			InvolvedAPIVersion: "apps/v1",
+		}
+
+		s.Equal("", filters.GetInvolvedObjectFieldSelector())
+	})
+
 	s.Run("builds field selector for name", func() {
 		filters := SubscriptionFilters{
 			InvolvedName: "nginx-pod",
@@ -787,6 +1555,51 @@ func (s *FiltersTestSuite) TestRequiresClientSideFiltering() {
 		s.True(filters.RequiresClientSideFiltering())
 	})
 
+	s.Run("returns true for reason lists", func() {
+		s.True((&SubscriptionFilters{IncludeReasons: []string{"BackOff"}}).RequiresClientSideFiltering())
+		s.True((&SubscriptionFilters{ExcludeReasons: []string{"Pulled"}}).RequiresClientSideFiltering())
+	})
+
+	s.Run("returns true for excluded involved object names", func() {
+		filters := SubscriptionFilters{
+			ExcludeInvolvedNames: []string{"noisy-pod"},
+		}
+
+		s.True(filters.RequiresClientSideFiltering())
+	})
+
+	s.Run("returns true for involved object UID filtering", func() {
+		filters := SubscriptionFilters{
+			InvolvedUID: "pod-uid-1",
+		}
+
+		s.True(filters.RequiresClientSideFiltering())
+	})
+
+	s.Run("returns true for source component filtering", func() {
+		filters := SubscriptionFilters{
+			SourceComponent: "kubelet",
+		}
+
+		s.True(filters.RequiresClientSideFiltering())
+	})
+
+	s.Run("returns true for annotation selector", func() {
+		filters := SubscriptionFilters{
+			AnnotationSelector: "team=payments",
+		}
+
+		s.True(filters.RequiresClientSideFiltering())
+	})
+
+	s.Run("returns true for CEL expression", func() {
+		filters := SubscriptionFilters{
+			CELExpression: "event.reason == 'BackOff'",
+		}
+
+		s.True(filters.RequiresClientSideFiltering())
+	})
+
 	s.Run("returns false for single namespace", func() {
 		filters := SubscriptionFilters{
 			Namespaces: []string{"default"},
@@ -795,11 +1608,36 @@ func (s *FiltersTestSuite) TestRequiresClientSideFiltering() {
 		s.False(filters.RequiresClientSideFiltering())
 	})
 
-	s.Run("returns false for label selector only", func() {
+	s.Run("returns true for label selector", func() {
 		filters := SubscriptionFilters{
 			LabelSelector: "app=nginx",
 		}
 
+		s.True(filters.RequiresClientSideFiltering())
+	})
+
+	s.Run("returns false for event label selector only", func() {
+		filters := SubscriptionFilters{
+			EventLabelSelector: "team=payments",
+		}
+
+		s.False(filters.RequiresClientSideFiltering())
+	})
+
+	s.Run("returns true for API version without kind", func() {
+		filters := SubscriptionFilters{
+			InvolvedAPIVersion: "apps/v1",
+		}
+
+		s.True(filters.RequiresClientSideFiltering())
+	})
+
+	s.Run("returns false for API version with kind", func() {
+		filters := SubscriptionFilters{
+			InvolvedKind:       "Deployment",
+			InvolvedAPIVersion: "apps/v1",
+		}
+
 		s.False(filters.RequiresClientSideFiltering())
 	})
 
@@ -817,24 +1655,52 @@ func (s *FiltersTestSuite) TestRequiresClientSideFiltering() {
 func (s *FiltersTestSuite) TestToMap() {
 	s.Run("converts all fields to map", func() {
 		filters := SubscriptionFilters{
-			Namespaces:        []string{"default", "kube-system"},
-			LabelSelector:     "app=nginx",
-			InvolvedKind:      "Pod",
-			InvolvedName:      "test-pod",
-			InvolvedNamespace: "production",
-			Type:              "Warning",
-			Reason:            "Failed",
+			Namespaces:           []string{"default", "kube-system"},
+			LabelSelector:        "app=nginx",
+			EventLabelSelector:   "team=payments",
+			AnnotationSelector:   "team=payments",
+			InvolvedKind:         "Pod",
+			InvolvedAPIVersion:   "v1",
+			InvolvedName:         "test-pod",
+			ExcludeInvolvedNames: []string{"noisy-pod"},
+			InvolvedNamespace:    "production",
+			InvolvedUID:          "pod-uid-1",
+			Type:                 "Warning",
+			Reason:               "Failed",
+			IncludeReasons:       []string{"FailedMount", "FailedScheduling"},
+			ExcludeReasons:       []string{"FailedScheduling"},
+			SourceComponent:      "kubelet",
+			CELExpression:        "event.count > 1",
+			IncludeModifications: ptr.To(false),
+			FirstOccurrenceOnly:  true,
+			DetectorTypes:        []string{"PodCrash", "OOMKilled"},
+			SendInitialState:     true,
+			Delivery:             DeliveryResource,
 		}
 
 		m := filters.ToMap()
 
 		s.Equal([]string{"default", "kube-system"}, m["namespaces"])
 		s.Equal("app=nginx", m["labelSelector"])
+		s.Equal("team=payments", m["eventLabelSelector"])
+		s.Equal("team=payments", m["annotationSelector"])
 		s.Equal("Pod", m["involvedKind"])
+		s.Equal("v1", m["involvedApiVersion"])
 		s.Equal("test-pod", m["involvedName"])
+		s.Equal([]string{"noisy-pod"}, m["excludeInvolvedNames"])
 		s.Equal("production", m["involvedNamespace"])
+		s.Equal("pod-uid-1", m["involvedUid"])
 		s.Equal("Warning", m["type"])
 		s.Equal("Failed", m["reason"])
+		s.Equal([]string{"FailedMount", "FailedScheduling"}, m["includeReasons"])
+		s.Equal([]string{"FailedScheduling"}, m["excludeReasons"])
+		s.Equal("kubelet", m["sourceComponent"])
+		s.Equal("event.count > 1", m["celExpression"])
+		s.Equal(false, m["includeModifications"])
+		s.Equal(true, m["firstOccurrenceOnly"])
+		s.Equal([]string{"PodCrash", "OOMKilled"}, m["detectorTypes"])
+		s.Equal(true, m["sendInitialState"])
+		s.Equal("resource", m["delivery"])
 	})
 
 	s.Run("omits empty fields from map", func() {
@@ -847,7 +1713,18 @@ func (s *FiltersTestSuite) TestToMap() {
 		s.Equal("Warning", m["type"])
 		s.NotContains(m, "namespaces")
 		s.NotContains(m, "labelSelector")
+		s.NotContains(m, "eventLabelSelector")
+		s.NotContains(m, "annotationSelector")
 		s.NotContains(m, "involvedKind")
+		s.NotContains(m, "involvedApiVersion")
+		s.NotContains(m, "excludeInvolvedNames")
+		s.NotContains(m, "includeModifications")
+		s.NotContains(m, "firstOccurrenceOnly")
+		s.NotContains(m, "includeReasons")
+		s.NotContains(m, "excludeReasons")
+		s.NotContains(m, "detectorTypes")
+		s.NotContains(m, "sendInitialState")
+		s.NotContains(m, "delivery")
 	})
 }
 
@@ -855,24 +1732,53 @@ func (s *FiltersTestSuite) TestToMap() {
 func (s *FiltersTestSuite) TestParseFiltersFromMap() {
 	s.Run("parses all fields from map", func() {
 		args := map[string]interface{}{
-			"namespaces":        []interface{}{"default", "kube-system"},
-			"labelSelector":     "app=nginx",
-			"involvedKind":      "Pod",
-			"involvedName":      "test-pod",
-			"involvedNamespace": "production",
-			"type":              "Warning",
-			"reason":            "Failed",
+			"namespaces":           []interface{}{"default", "kube-system"},
+			"labelSelector":        "app=nginx",
+			"eventLabelSelector":   "team=payments",
+			"annotationSelector":   "team=payments",
+			"involvedKind":         "Pod",
+			"involvedApiVersion":   "v1",
+			"involvedName":         "test-pod",
+			"excludeInvolvedNames": []interface{}{"noisy-pod"},
+			"involvedNamespace":    "production",
+			"involvedUid":          "pod-uid-1",
+			"type":                 "Warning",
+			"reason":               "Failed",
+			"includeReasons":       []interface{}{"FailedMount", "FailedScheduling"},
+			"excludeReasons":       []interface{}{"FailedScheduling"},
+			"sourceComponent":      "kubelet",
+			"celExpression":        "event.count > 1",
+			"includeModifications": false,
+			"firstOccurrenceOnly":  true,
+			"detectorTypes":        []interface{}{"PodCrash", "OOMKilled"},
+			"sendInitialState":     true,
+			"delivery":             "resource",
 		}
 
 		filters := ParseFiltersFromMap(args)
 
 		s.Equal([]string{"default", "kube-system"}, filters.Namespaces)
 		s.Equal("app=nginx", filters.LabelSelector)
+		s.Equal("team=payments", filters.EventLabelSelector)
+		s.Equal("team=payments", filters.AnnotationSelector)
 		s.Equal("Pod", filters.InvolvedKind)
+		s.Equal("v1", filters.InvolvedAPIVersion)
 		s.Equal("test-pod", filters.InvolvedName)
+		s.Equal([]string{"noisy-pod"}, filters.ExcludeInvolvedNames)
 		s.Equal("production", filters.InvolvedNamespace)
+		s.Equal("pod-uid-1", filters.InvolvedUID)
 		s.Equal("Warning", filters.Type)
 		s.Equal("Failed", filters.Reason)
+		s.Equal([]string{"FailedMount", "FailedScheduling"}, filters.IncludeReasons)
+		s.Equal([]string{"FailedScheduling"}, filters.ExcludeReasons)
+		s.Equal("kubelet", filters.SourceComponent)
+		s.Equal("event.count > 1", filters.CELExpression)
+		s.Require().NotNil(filters.IncludeModifications)
+		s.False(*filters.IncludeModifications)
+		s.True(filters.FirstOccurrenceOnly)
+		s.Equal([]string{"PodCrash", "OOMKilled"}, filters.DetectorTypes)
+		s.True(filters.SendInitialState)
+		s.Equal(DeliveryResource, filters.Delivery)
 	})
 
 	s.Run("handles empty map", func() {
@@ -883,6 +1789,13 @@ func (s *FiltersTestSuite) TestParseFiltersFromMap() {
 		s.Empty(filters.Namespaces)
 		s.Empty(filters.LabelSelector)
 		s.Empty(filters.InvolvedKind)
+		s.Empty(filters.ExcludeInvolvedNames)
+		s.Nil(filters.IncludeModifications)
+		s.False(filters.FirstOccurrenceOnly)
+		s.Empty(filters.IncludeReasons)
+		s.Empty(filters.ExcludeReasons)
+		s.Empty(filters.DetectorTypes)
+		s.False(filters.SendInitialState)
 	})
 
 	s.Run("handles missing fields", func() {
@@ -898,17 +1811,136 @@ func (s *FiltersTestSuite) TestParseFiltersFromMap() {
 	})
 }
 
+// TestParseFiltersFromMapStrict tests that ParseFiltersFromMapStrict rejects unknown keys and wrong value types
+func (s *FiltersTestSuite) TestParseFiltersFromMapStrict() {
+	s.Run("parses valid filters like ParseFiltersFromMap", func() {
+		args := map[string]interface{}{
+			"namespaces":          []interface{}{"default"},
+			"type":                "Warning",
+			"firstOccurrenceOnly": true,
+			"detectorTypes":       []interface{}{"PodCrash"},
+		}
+
+		filters, err := ParseFiltersFromMapStrict(args)
+
+		s.Require().NoError(err)
+		s.Equal(ParseFiltersFromMap(args), filters)
+	})
+
+	s.Run("accepts an empty map", func() {
+		filters, err := ParseFiltersFromMapStrict(map[string]interface{}{})
+
+		s.Require().NoError(err)
+		s.Equal(SubscriptionFilters{}, filters)
+	})
+
+	s.Run("treats null values as unset", func() {
+		filters, err := ParseFiltersFromMapStrict(map[string]interface{}{"namespaces": nil, "type": nil})
+
+		s.Require().NoError(err)
+		s.Equal(SubscriptionFilters{}, filters)
+	})
+
+	s.Run("rejects unknown keys, listing them and the valid filters", func() {
+		_, err := ParseFiltersFromMapStrict(map[string]interface{}{
+			"namespace": "default",
+			"kind":      "Pod",
+			"type":      "Warning",
+		})
+
+		s.Require().Error(err)
+		s.Contains(err.Error(), "unknown filters kind, namespace")
+		s.Contains(err.Error(), "valid filters: annotationSelector, celExpression,")
+		s.Contains(err.Error(), "namespaces")
+	})
+
+	s.Run("rejects values of the wrong type", func() {
+		_, err := ParseFiltersFromMapStrict(map[string]interface{}{
+			"namespaces":           "default",
+			"includeModifications": "false",
+			"reason":               42.0,
+		})
+
+		s.Require().Error(err)
+		s.Contains(err.Error(), "namespaces must be an array of strings")
+		s.Contains(err.Error(), "includeModifications must be a boolean")
+		s.Contains(err.Error(), "reason must be a string")
+	})
+
+	s.Run("rejects arrays with items of the wrong type", func() {
+		_, err := ParseFiltersFromMapStrict(map[string]interface{}{
+			"excludeReasons": []interface{}{"Pulled", 1.0},
+		})
+
+		s.Require().Error(err)
+		s.Contains(err.Error(), "excludeReasons must be an array of strings")
+	})
+
+	s.Run("rejects a value of the wrong type for each filter", func() {
+		wrongValues := map[string]interface{}{
+			"string":  42.0,
+			"boolean": "true",
+			"array":   "value",
+		}
+		for key, property := range FilterSchemaProperties() {
+			args := map[string]interface{}{key: wrongValues[property.Type]}
+
+			_, err := ParseFiltersFromMapStrict(args)
+
+			s.Require().Error(err, "wrong type for %s should be rejected", key)
+			s.Equal(key+" must be "+schemaTypeName(property), err.Error())
+			s.Equal(SubscriptionFilters{}, ParseFiltersFromMap(args), "wrong type for %s should be left unset", key)
+		}
+	})
+
+	s.Run("accepts string slices for array filters", func() {
+		filters, err := ParseFiltersFromMapStrict(map[string]interface{}{
+			"namespaces":    []string{"default"},
+			"detectorTypes": []string{"PodCrash"},
+		})
+
+		s.Require().NoError(err)
+		s.Equal([]string{"default"}, filters.Namespaces)
+		s.Equal([]string{"PodCrash"}, filters.DetectorTypes)
+	})
+
+	s.Run("reports unknown keys and wrong types together", func() {
+		_, err := ParseFiltersFromMapStrict(map[string]interface{}{
+			"namespace":  "default",
+			"namespaces": "default",
+		})
+
+		s.Require().Error(err)
+		s.Contains(err.Error(), "unknown filters namespace")
+		s.Contains(err.Error(), "namespaces must be an array of strings")
+	})
+}
+
 // TestFiltersRoundTrip tests ToMap and ParseFiltersFromMap round trip
 func (s *FiltersTestSuite) TestFiltersRoundTrip() {
 	s.Run("round trip preserves all data", func() {
 		original := SubscriptionFilters{
-			Namespaces:        []string{"default", "kube-system"},
-			LabelSelector:     "app=nginx",
-			InvolvedKind:      "Pod",
-			InvolvedName:      "test-pod",
-			InvolvedNamespace: "production",
-			Type:              "Warning",
-			Reason:            "Failed",
+			Namespaces:           []string{"default", "kube-system"},
+			LabelSelector:        "app=nginx",
+			EventLabelSelector:   "team=payments",
+			AnnotationSelector:   "team=payments",
+			InvolvedKind:         "Pod",
+			InvolvedAPIVersion:   "v1",
+			InvolvedName:         "test-pod",
+			ExcludeInvolvedNames: []string{"noisy-pod"},
+			InvolvedNamespace:    "production",
+			InvolvedUID:          "pod-uid-1",
+			Type:                 "Warning",
+			Reason:               "Failed",
+			IncludeReasons:       []string{"FailedMount", "FailedScheduling"},
+			ExcludeReasons:       []string{"FailedScheduling"},
+			SourceComponent:      "kubelet",
+			CELExpression:        "event.count > 1",
+			IncludeModifications: ptr.To(false),
+			FirstOccurrenceOnly:  true,
+			DetectorTypes:        []string{"PodCrash", "OOMKilled"},
+			SendInitialState:     true,
+			Delivery:             DeliveryResource,
 		}
 
 		m := original.ToMap()
@@ -921,15 +1953,136 @@ func (s *FiltersTestSuite) TestFiltersRoundTrip() {
 			}
 			m["namespaces"] = nsInterface
 		}
+		for _, field := range []string{"excludeInvolvedNames", "includeReasons", "excludeReasons", "detectorTypes"} {
+			if values, ok := m[field].([]string); ok {
+				valuesInterface := make([]interface{}, len(values))
+				for i, v := range values {
+					valuesInterface[i] = v
+				}
+				m[field] = valuesInterface
+			}
+		}
 
 		parsed := ParseFiltersFromMap(m)
 
 		s.Equal(original.Namespaces, parsed.Namespaces)
 		s.Equal(original.LabelSelector, parsed.LabelSelector)
+		s.Equal(original.EventLabelSelector, parsed.EventLabelSelector)
+		s.Equal(original.AnnotationSelector, parsed.AnnotationSelector)
 		s.Equal(original.InvolvedKind, parsed.InvolvedKind)
+		s.Equal(original.InvolvedAPIVersion, parsed.InvolvedAPIVersion)
 		s.Equal(original.InvolvedName, parsed.InvolvedName)
+		s.Equal(original.ExcludeInvolvedNames, parsed.ExcludeInvolvedNames)
 		s.Equal(original.InvolvedNamespace, parsed.InvolvedNamespace)
+		s.Equal(original.InvolvedUID, parsed.InvolvedUID)
 		s.Equal(original.Type, parsed.Type)
 		s.Equal(original.Reason, parsed.Reason)
+		s.Equal(original.IncludeReasons, parsed.IncludeReasons)
+		s.Equal(original.ExcludeReasons, parsed.ExcludeReasons)
+		s.Equal(original.SourceComponent, parsed.SourceComponent)
+		s.Equal(original.CELExpression, parsed.CELExpression)
+		s.Equal(original.IncludeModifications, parsed.IncludeModifications)
+		s.Equal(original.FirstOccurrenceOnly, parsed.FirstOccurrenceOnly)
+		s.Equal(original.DetectorTypes, parsed.DetectorTypes)
+		s.Equal(original.SendInitialState, parsed.SendInitialState)
+		s.Equal(original.Delivery, parsed.Delivery)
+	})
+}
+
+// TestFiltersJSONSchema tests that the exported schema describes every filter field
+func (s *FiltersTestSuite) TestFiltersJSONSchema() {
+	var schema struct {
+		Type       string `json:"type"`
+		Properties map[string]struct {
+			Type    string          `json:"type"`
+			Enum    []string        `json:"enum"`
+			Default json.RawMessage `json:"default"`
+			Items   *struct {
+				Type string `json:"type"`
+			} `json:"items"`
+		} `json:"properties"`
+	}
+	s.Require().NoError(json.Unmarshal(FiltersJSONSchema(), &schema))
+
+	s.Run("describes an object", func() {
+		s.Equal("object", schema.Type)
+	})
+
+	s.Run("describes all fields with their types", func() {
+		expected := map[string]string{
+			"namespaces":           "array",
+			"labelSelector":        "string",
+			"eventLabelSelector":   "string",
+			"annotationSelector":   "string",
+			"involvedKind":         "string",
+			"involvedApiVersion":   "string",
+			"involvedName":         "string",
+			"excludeInvolvedNames": "array",
+			"involvedNamespace":    "string",
+			"involvedUid":          "string",
+			"type":                 "string",
+			"reason":               "string",
+			"includeReasons":       "array",
+			"excludeReasons":       "array",
+			"sourceComponent":      "string",
+			"celExpression":        "string",
+			"includeModifications": "boolean",
+			"firstOccurrenceOnly":  "boolean",
+			"detectorTypes":        "array",
+			"sendInitialState":     "boolean",
+			"delivery":             "string",
+		}
+
+		s.Len(schema.Properties, len(expected))
+		for field, fieldType := range expected {
+			s.Require().Contains(schema.Properties, field)
+			s.Equal(fieldType, schema.Properties[field].Type, "field %s", field)
+		}
+		s.Require().NotNil(schema.Properties["namespaces"].Items)
+		s.Equal("string", schema.Properties["namespaces"].Items.Type)
+		for _, field := range []string{"excludeInvolvedNames", "includeReasons", "excludeReasons", "detectorTypes"} {
+			s.Require().NotNil(schema.Properties[field].Items, "field %s", field)
+			s.Equal("string", schema.Properties[field].Items.Type, "field %s", field)
+		}
+	})
+
+	s.Run("describes constraints", func() {
+		s.Equal([]string{"Normal", "Warning"}, schema.Properties["type"].Enum)
+		s.JSONEq("true", string(schema.Properties["includeModifications"].Default))
+		s.JSONEq("false", string(schema.Properties["firstOccurrenceOnly"].Default))
+		s.JSONEq("false", string(schema.Properties["sendInitialState"].Default))
+		s.Equal([]string{"log", "resource"}, schema.Properties["delivery"].Enum)
+		s.JSONEq(`"log"`, string(schema.Properties["delivery"].Default))
+	})
+
+	s.Run("matches the fields read by ParseFiltersFromMap", func() {
+		filters := SubscriptionFilters{
+			Namespaces:           []string{"default"},
+			LabelSelector:        "app=nginx",
+			EventLabelSelector:   "team=payments",
+			AnnotationSelector:   "team=payments",
+			InvolvedKind:         "Pod",
+			InvolvedAPIVersion:   "v1",
+			InvolvedName:         "test-pod",
+			ExcludeInvolvedNames: []string{"noisy-pod"},
+			InvolvedNamespace:    "production",
+			InvolvedUID:          "pod-uid-1",
+			Type:                 "Warning",
+			Reason:               "Failed",
+			IncludeReasons:       []string{"FailedMount", "FailedScheduling"},
+			ExcludeReasons:       []string{"FailedScheduling"},
+			SourceComponent:      "kubelet",
+			CELExpression:        "event.count > 1",
+			IncludeModifications: ptr.To(false),
+			FirstOccurrenceOnly:  true,
+			DetectorTypes:        []string{"PodCrash", "OOMKilled"},
+			SendInitialState:     true,
+			Delivery:             DeliveryResource,
+		}
+
+		for field := range filters.ToMap() {
+			s.Contains(schema.Properties, field, "filter %s is missing from the schema", field)
+		}
+		s.Len(filters.ToMap(), len(schema.Properties), "every schema field should be set in this test")
 	})
 }
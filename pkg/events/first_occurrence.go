@@ -0,0 +1,56 @@
+package events
+
+import (
+	"sync"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/utils/lru"
+)
+
+// firstOccurrenceMaxEntries bounds the number of distinct (involved object, reason)
+// pairs remembered per subscription. Once full, the least recently seen pairs are
+// evicted and would be delivered again.
+const firstOccurrenceMaxEntries = 10000
+
+// firstOccurrenceCache remembers which (involved object, reason) pairs a subscription
+// has already been sent, so repeats are suppressed for the subscription's lifetime
+// rather than only for the deduplication TTL.
+//
+// Thread-safe for concurrent use.
+type firstOccurrenceCache struct {
+	mu   sync.Mutex
+	seen *lru.Cache
+}
+
+// newFirstOccurrenceCache creates a firstOccurrenceCache holding at most maxEntries pairs.
+func newFirstOccurrenceCache(maxEntries int) *firstOccurrenceCache {
+	return &firstOccurrenceCache{
+		seen: lru.New(maxEntries),
+	}
+}
+
+// isFirst reports whether the event is the first with its involved object and reason,
+// recording it as seen. A nil cache treats every event as a first occurrence.
+func (c *firstOccurrenceCache) isFirst(event *v1.Event) bool {
+	if c == nil {
+		return true
+	}
+
+	key := firstOccurrenceKey(event)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, seen := c.seen.Get(key); seen {
+		return false
+	}
+	c.seen.Add(key, struct{}{})
+	return true
+}
+
+// firstOccurrenceKey identifies an event by its involved object and reason.
+// Format: <kind>/<namespace>/<name>/<uid>/<reason>
+func firstOccurrenceKey(event *v1.Event) string {
+	obj := event.InvolvedObject
+	return obj.Kind + "/" + obj.Namespace + "/" + obj.Name + "/" + string(obj.UID) + "/" + event.Reason
+}
@@ -0,0 +1,80 @@
+package events
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+type FirstOccurrenceCacheTestSuite struct {
+	suite.Suite
+}
+
+func TestFirstOccurrenceCacheSuite(t *testing.T) {
+	suite.Run(t, new(FirstOccurrenceCacheTestSuite))
+}
+
+func makeOccurrenceEvent(name, involvedName, reason string) *v1.Event {
+	return &v1.Event{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            name,
+			Namespace:       "default",
+			ResourceVersion: "1",
+		},
+		InvolvedObject: v1.ObjectReference{Kind: "Pod", Name: involvedName, Namespace: "default", UID: types.UID("uid-" + involvedName)},
+		Reason:         reason,
+	}
+}
+
+func (s *FirstOccurrenceCacheTestSuite) TestIsFirst() {
+	s.Run("first event is delivered", func() {
+		cache := newFirstOccurrenceCache(10)
+		s.True(cache.isFirst(makeOccurrenceEvent("e1", "pod-a", "BackOff")))
+	})
+
+	s.Run("repeats are suppressed regardless of event object or resource version", func() {
+		cache := newFirstOccurrenceCache(10)
+		s.True(cache.isFirst(makeOccurrenceEvent("e1", "pod-a", "BackOff")))
+
+		repeat := makeOccurrenceEvent("e2", "pod-a", "BackOff")
+		repeat.ResourceVersion = "42"
+		repeat.Count = 10
+		s.False(cache.isFirst(repeat))
+		s.False(cache.isFirst(makeOccurrenceEvent("e1", "pod-a", "BackOff")))
+	})
+
+	s.Run("different reasons and objects are distinct", func() {
+		cache := newFirstOccurrenceCache(10)
+		s.True(cache.isFirst(makeOccurrenceEvent("e1", "pod-a", "BackOff")))
+		s.True(cache.isFirst(makeOccurrenceEvent("e2", "pod-a", "Failed")))
+		s.True(cache.isFirst(makeOccurrenceEvent("e3", "pod-b", "BackOff")))
+	})
+
+	s.Run("recreated objects with the same name are distinct", func() {
+		cache := newFirstOccurrenceCache(10)
+		s.True(cache.isFirst(makeOccurrenceEvent("e1", "pod-a", "BackOff")))
+
+		recreated := makeOccurrenceEvent("e2", "pod-a", "BackOff")
+		recreated.InvolvedObject.UID = "uid-pod-a-recreated"
+		s.True(cache.isFirst(recreated))
+	})
+
+	s.Run("least recently seen pairs are evicted when full", func() {
+		cache := newFirstOccurrenceCache(2)
+		s.True(cache.isFirst(makeOccurrenceEvent("e1", "pod-a", "BackOff")))
+		s.True(cache.isFirst(makeOccurrenceEvent("e2", "pod-b", "BackOff")))
+		s.True(cache.isFirst(makeOccurrenceEvent("e3", "pod-c", "BackOff")))
+
+		s.True(cache.isFirst(makeOccurrenceEvent("e4", "pod-a", "BackOff")), "evicted pair should be delivered again")
+		s.False(cache.isFirst(makeOccurrenceEvent("e5", "pod-c", "BackOff")))
+	})
+
+	s.Run("nil cache delivers every event", func() {
+		var cache *firstOccurrenceCache
+		s.True(cache.isFirst(makeOccurrenceEvent("e1", "pod-a", "BackOff")))
+		s.True(cache.isFirst(makeOccurrenceEvent("e1", "pod-a", "BackOff")))
+	})
+}
@@ -12,14 +12,20 @@ import (
 	"github.com/stretchr/testify/suite"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/discovery/cached/memory"
+	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
+	"k8s.io/client-go/restmapper"
 	"sigs.k8s.io/controller-runtime/pkg/envtest"
 	"sigs.k8s.io/controller-runtime/tools/setup-envtest/env"
 	"sigs.k8s.io/controller-runtime/tools/setup-envtest/remote"
 	"sigs.k8s.io/controller-runtime/tools/setup-envtest/store"
 	"sigs.k8s.io/controller-runtime/tools/setup-envtest/versions"
 	"sigs.k8s.io/controller-runtime/tools/setup-envtest/workflows"
+
+	pkgkubernetes "github.com/containers/kubernetes-mcp-server/pkg/kubernetes"
 )
 
 // IntegrationTestSuite tests resource version filtering with a real Kubernetes API server
@@ -174,7 +180,7 @@ func (s *IntegrationTestSuite) TestHistoricalEventsAreNotSent() {
 			Filters:    &SubscriptionFilters{Type: "Warning"},
 			MaxRetries: 1,
 			DedupCache: dedupCache,
-			ProcessEvent: func(event *v1.Event) {
+			ProcessEvent: func(_ context.Context, event *v1.Event) {
 				receivedEvents <- event
 			},
 		}
@@ -253,7 +259,7 @@ func (s *IntegrationTestSuite) TestNewEventsAfterSubscriptionAreDelivered() {
 			Filters:    &SubscriptionFilters{},
 			MaxRetries: 1,
 			DedupCache: dedupCache,
-			ProcessEvent: func(event *v1.Event) {
+			ProcessEvent: func(_ context.Context, event *v1.Event) {
 				receivedEvents <- event
 			},
 		}
@@ -349,6 +355,222 @@ func (s *IntegrationTestSuite) TestNewEventsAfterSubscriptionAreDelivered() {
 	})
 }
 
+// TestLabelSelectorFilteringResolvesObjectLabels verifies that label selectors match the involved object's labels
+func (s *IntegrationTestSuite) TestLabelSelectorFilteringResolvesObjectLabels() {
+	s.Run("label selector matches events for labeled objects", func() {
+		ctx := context.Background()
+		namespace := "default"
+
+		// Create a labeled and an unlabeled pod to act as involved objects
+		newPod := func(name string, podLabels map[string]string) *v1.Pod {
+			pod, err := s.clientset.CoreV1().Pods(namespace).Create(ctx, &v1.Pod{
+				ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace, Labels: podLabels},
+				Spec: v1.PodSpec{
+					Containers: []v1.Container{{Name: "app", Image: "busybox"}},
+				},
+			}, metav1.CreateOptions{})
+			s.Require().NoError(err, "failed to create pod %s", name)
+			return pod
+		}
+		labeledPod := newPod("labeled-pod", map[string]string{"app": "web"})
+		unlabeledPod := newPod("unlabeled-pod", nil)
+
+		dynamicClient, err := dynamic.NewForConfig(s.cfg)
+		s.Require().NoError(err, "failed to create dynamic client")
+		discoveryClient, err := discovery.NewDiscoveryClientForConfig(s.cfg)
+		s.Require().NoError(err, "failed to create discovery client")
+		mapper := restmapper.NewDeferredDiscoveryRESTMapper(memory.NewMemCacheClient(discoveryClient))
+
+		receivedEvents := make(chan *v1.Event, 10)
+		manager := &EventSubscriptionManager{}
+		currentRV, err := manager.getCurrentResourceVersion(s.clientset, namespace)
+		s.Require().NoError(err, "failed to get current resource version")
+
+		watcher := NewEventWatcher(EventWatcherConfig{
+			Clientset:              s.clientset,
+			Namespace:              namespace,
+			Filters:                &SubscriptionFilters{LabelSelector: "app=web"},
+			MaxRetries:             1,
+			DedupCache:             NewDeduplicationCache(5 * time.Second),
+			LabelResolver:          NewDynamicLabelResolver(dynamicClient, mapper),
+			InitialResourceVersion: currentRV,
+			ProcessEvent: func(_ context.Context, event *v1.Event) {
+				receivedEvents <- event
+			},
+		})
+		watcherCtx, cancelWatcher := context.WithCancel(ctx)
+		defer cancelWatcher()
+		watcher.Start(watcherCtx)
+
+		// Wait for watcher to be ready
+		time.Sleep(100 * time.Millisecond)
+
+		// Events carry no labels of their own; only the involved pods are labeled
+		for _, pod := range []*v1.Pod{unlabeledPod, labeledPod} {
+			_, err := s.clientset.CoreV1().Events(namespace).Create(ctx, &v1.Event{
+				ObjectMeta: metav1.ObjectMeta{Name: pod.Name + "-event", Namespace: namespace},
+				InvolvedObject: v1.ObjectReference{
+					APIVersion: "v1",
+					Kind:       "Pod",
+					Name:       pod.Name,
+					Namespace:  namespace,
+					UID:        pod.UID,
+				},
+				Type:    "Warning",
+				Reason:  "BackOff",
+				Message: "Back-off restarting failed container",
+			}, metav1.CreateOptions{})
+			s.Require().NoError(err, "failed to create event for pod %s", pod.Name)
+		}
+
+		select {
+		case event := <-receivedEvents:
+			s.Equal("labeled-pod-event", event.Name, "only the event for the labeled pod should match")
+		case <-time.After(3 * time.Second):
+			s.Fail("timeout waiting for event matching the label selector")
+		}
+
+		select {
+		case event := <-receivedEvents:
+			s.Fail("unexpected event delivered", "event %s should not match the label selector", event.Name)
+		case <-time.After(500 * time.Millisecond):
+		}
+
+		cancelWatcher()
+	})
+}
+
+// TestEventLabelSelectorIsAppliedServerSide verifies that event label selectors are sent to the API server
+func (s *IntegrationTestSuite) TestEventLabelSelectorIsAppliedServerSide() {
+	s.Run("watch only receives events with matching labels", func() {
+		ctx := context.Background()
+		namespace := "default"
+
+		manager := &EventSubscriptionManager{}
+		currentRV, err := manager.getCurrentResourceVersion(s.clientset, namespace)
+		s.Require().NoError(err, "failed to get current resource version")
+
+		// Open the watch with the options the event watcher would use, bypassing its
+		// client-side filtering, so only the API server's selection is observed
+		eventWatcher := NewEventWatcher(EventWatcherConfig{
+			Clientset:              s.clientset,
+			Namespace:              namespace,
+			Filters:                &SubscriptionFilters{Namespaces: []string{namespace}, EventLabelSelector: "team=payments"},
+			InitialResourceVersion: currentRV,
+		})
+		watchCtx, cancelWatch := context.WithCancel(ctx)
+		defer cancelWatch()
+		rawWatch, err := s.clientset.CoreV1().Events(namespace).Watch(watchCtx, eventWatcher.watchOptions())
+		s.Require().NoError(err, "failed to open watch")
+		defer rawWatch.Stop()
+
+		for name, eventLabels := range map[string]map[string]string{
+			"payments-event":  {"team": "payments"},
+			"search-event":    {"team": "search"},
+			"unlabeled-event": nil,
+		} {
+			_, err := s.clientset.CoreV1().Events(namespace).Create(ctx, &v1.Event{
+				ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace, Labels: eventLabels},
+				InvolvedObject: v1.ObjectReference{
+					Kind:      "Pod",
+					Name:      "test-pod",
+					Namespace: namespace,
+				},
+				Type:    "Warning",
+				Reason:  "BackOff",
+				Message: "Back-off restarting failed container",
+			}, metav1.CreateOptions{})
+			s.Require().NoError(err, "failed to create event %s", name)
+		}
+
+		select {
+		case watchEvent := <-rawWatch.ResultChan():
+			event, ok := watchEvent.Object.(*v1.Event)
+			s.Require().True(ok, "watch should deliver events")
+			s.Equal("payments-event", event.Name, "only the labeled event should be sent by the server")
+		case <-time.After(3 * time.Second):
+			s.Fail("timeout waiting for event matching the event label selector")
+		}
+
+		select {
+		case watchEvent := <-rawWatch.ResultChan():
+			if event, ok := watchEvent.Object.(*v1.Event); ok {
+				s.Fail("unexpected event sent", "event %s should have been selected out by the server", event.Name)
+			}
+		case <-time.After(500 * time.Millisecond):
+		}
+	})
+}
+
+func (s *IntegrationTestSuite) TestInvolvedObjectFiltersAreAppliedServerSide() {
+	s.Run("cluster-wide watch only receives events for the selected kind and namespace", func() {
+		ctx := context.Background()
+		other, err := s.clientset.CoreV1().Namespaces().Create(ctx, &v1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{GenerateName: "test-involved-"},
+		}, metav1.CreateOptions{})
+		s.Require().NoError(err, "failed to create namespace")
+
+		manager := &EventSubscriptionManager{}
+		currentRV, err := manager.getCurrentResourceVersion(s.clientset, metav1.NamespaceAll)
+		s.Require().NoError(err, "failed to get current resource version")
+
+		// Open the watch with the options of the shared watch serving the subscription,
+		// bypassing client-side filtering, so only the API server's selection is observed
+		key := newEventWatchKey("cluster1", metav1.NamespaceAll, SubscriptionFilters{InvolvedKind: "Pod", InvolvedNamespace: "default"}, "")
+		watchFilters := key.involvedObject.filters()
+		eventWatcher := NewEventWatcher(EventWatcherConfig{
+			Clientset:              s.clientset,
+			Filters:                &watchFilters,
+			InitialResourceVersion: currentRV,
+		})
+		opts := eventWatcher.watchOptions()
+		s.Equal("involvedObject.kind=Pod,involvedObject.namespace=default", opts.FieldSelector)
+		watchCtx, cancelWatch := context.WithCancel(ctx)
+		defer cancelWatch()
+		rawWatch, err := s.clientset.CoreV1().Events(metav1.NamespaceAll).Watch(watchCtx, opts)
+		s.Require().NoError(err, "failed to open watch")
+		defer rawWatch.Stop()
+
+		for name, involvedObject := range map[string]v1.ObjectReference{
+			"pod-event":        {Kind: "Pod", Name: "test-pod", Namespace: "default"},
+			"deployment-event": {Kind: "Deployment", APIVersion: "apps/v1", Name: "test-deployment", Namespace: "default"},
+			"node-event":       {Kind: "Node", Name: "test-node"},
+			"other-pod-event":  {Kind: "Pod", Name: "test-pod", Namespace: other.Name},
+		} {
+			// Events are recorded in their involved object's namespace, or default for cluster-scoped objects
+			namespace := involvedObject.Namespace
+			if namespace == "" {
+				namespace = "default"
+			}
+			_, err := s.clientset.CoreV1().Events(namespace).Create(ctx, &v1.Event{
+				ObjectMeta:     metav1.ObjectMeta{Name: name, Namespace: namespace},
+				InvolvedObject: involvedObject,
+				Type:           "Warning",
+				Reason:         "BackOff",
+				Message:        "Back-off restarting failed container",
+			}, metav1.CreateOptions{})
+			s.Require().NoError(err, "failed to create event %s", name)
+		}
+
+		select {
+		case watchEvent := <-rawWatch.ResultChan():
+			event, ok := watchEvent.Object.(*v1.Event)
+			s.Require().True(ok, "watch should deliver events")
+			s.Equal("pod-event", event.Name, "only the event for a pod in the default namespace should be sent by the server")
+		case <-time.After(3 * time.Second):
+			s.Fail("timeout waiting for event matching the involved object filters")
+		}
+
+		select {
+		case watchEvent := <-rawWatch.ResultChan():
+			if event, ok := watchEvent.Object.(*v1.Event); ok {
+				s.Fail("unexpected event sent", "event %s should have been selected out by the server", event.Name)
+			}
+		case <-time.After(500 * time.Millisecond):
+		}
+	})
+}
+
 // TestClusterWideSubscriptionFiltersHistoricalEvents tests resource version filtering for cluster-wide watches
 func (s *IntegrationTestSuite) TestClusterWideSubscriptionFiltersHistoricalEvents() {
 	s.Run("cluster-wide subscription filters historical events across all namespaces", func() {
@@ -390,7 +612,7 @@ func (s *IntegrationTestSuite) TestClusterWideSubscriptionFiltersHistoricalEvent
 			Filters:    &SubscriptionFilters{},
 			MaxRetries: 1,
 			DedupCache: dedupCache,
-			ProcessEvent: func(event *v1.Event) {
+			ProcessEvent: func(_ context.Context, event *v1.Event) {
 				receivedEvents <- event
 			},
 		}
@@ -465,7 +687,7 @@ func (s *IntegrationTestSuite) TestResourceVersionPreservedOnReconnection() {
 			Filters:    &SubscriptionFilters{},
 			MaxRetries: 3,
 			DedupCache: dedupCache,
-			ProcessEvent: func(event *v1.Event) {
+			ProcessEvent: func(_ context.Context, event *v1.Event) {
 				receivedEvents <- event
 			},
 		}
@@ -557,6 +779,85 @@ func (s *IntegrationTestSuite) TestResourceVersionPreservedOnReconnection() {
 	})
 }
 
+// TestResumeFromResourceVersion verifies that subscriptions resuming from a resource version receive the events since
+func (s *IntegrationTestSuite) TestResumeFromResourceVersion() {
+	s.Run("events created while disconnected are delivered exactly once", func() {
+		ctx := context.Background()
+		namespace := "default"
+		newEvent := func(name, message string) *v1.Event {
+			return &v1.Event{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      name,
+					Namespace: namespace,
+				},
+				InvolvedObject: v1.ObjectReference{
+					Kind:      "Pod",
+					Name:      "resume-pod",
+					Namespace: namespace,
+				},
+				Type:    "Warning",
+				Reason:  "BackOff",
+				Message: message,
+			}
+		}
+
+		// The last event the client received before disconnecting
+		last, err := s.clientset.CoreV1().Events(namespace).Create(ctx, newEvent("resume-seen", "seen"), metav1.CreateOptions{})
+		s.Require().NoError(err, "failed to create last seen event")
+		s.Require().NotEmpty(last.ResourceVersion)
+
+		// Events created while the client was disconnected
+		gap := []string{"gap-1", "gap-2", "gap-3"}
+		for _, message := range gap {
+			_, err := s.clientset.CoreV1().Events(namespace).Create(ctx, newEvent("resume-"+message, message), metav1.CreateOptions{})
+			s.Require().NoError(err, "failed to create gap event")
+		}
+
+		notifier := &MockNotifier{}
+		config := NewTestManagerConfig()
+		config.Notifier = notifier
+		getK8sClient := func(cluster string) (*pkgkubernetes.Kubernetes, error) {
+			return &pkgkubernetes.Kubernetes{Interface: s.clientset}, nil
+		}
+		manager := NewEventSubscriptionManager(NewMockMCPServer(), config, getK8sClient, nil)
+		defer manager.CancelAll()
+
+		sub, err := manager.Create("session1", "cluster1", "events", SubscriptionFilters{
+			Namespaces:   []string{namespace},
+			InvolvedName: "resume-pod",
+		}, last.ResourceVersion)
+		s.Require().NoError(err, "failed to resume subscription")
+		s.Equal(last.ResourceVersion, sub.ResumeFrom)
+
+		// Events after resuming follow the gap
+		_, err = s.clientset.CoreV1().Events(namespace).Create(ctx, newEvent("resume-after", "after"), metav1.CreateOptions{})
+		s.Require().NoError(err, "failed to create event after resuming")
+
+		received := func() map[string]int {
+			counts := make(map[string]int)
+			for _, notification := range notifier.GetNotifications() {
+				if payload, ok := notification.Payload.(*EventNotification); ok {
+					counts[payload.Event.Message]++
+				}
+			}
+			return counts
+		}
+		s.Require().Eventually(func() bool {
+			return received()["after"] > 0
+		}, 5*time.Second, 50*time.Millisecond, "event after resuming should be delivered")
+		// Give duplicates a chance to arrive
+		time.Sleep(500 * time.Millisecond)
+
+		counts := received()
+		for _, message := range gap {
+			s.Equal(1, counts[message], "gap event %s should be delivered exactly once", message)
+		}
+		s.Equal(1, counts["after"], "event after resuming should be delivered exactly once")
+		s.Zero(counts["seen"], "the last seen event should not be delivered again")
+		s.Len(counts, len(gap)+1, "no other events should be delivered")
+	})
+}
+
 // TestGetCurrentResourceVersionBehavior tests the getCurrentResourceVersion method
 func (s *IntegrationTestSuite) TestGetCurrentResourceVersionBehavior() {
 	s.Run("getCurrentResourceVersion returns valid resource version", func() {
@@ -658,7 +959,7 @@ func (s *IntegrationTestSuite) TestResourceFaultsSubscription() {
 		receivedSignals := make(chan FaultSignal, 10)
 
 		// Create ResourceWatcher with fault signal callback
-		watcher := NewResourceWatcher(ResourceWatcherConfig{
+		watcher, err := NewResourceWatcher(ResourceWatcherConfig{
 			Clientset:    s.clientset,
 			Cluster:      "test-cluster",
 			ResyncPeriod: 10 * time.Minute,
@@ -698,10 +999,11 @@ func (s *IntegrationTestSuite) TestResourceFaultsSubscription() {
 					},
 				},
 			},
-			SignalCallback: func(signal FaultSignal) {
+			SignalCallback: func(_ context.Context, signal FaultSignal) {
 				receivedSignals <- signal
 			},
 		})
+		s.Require().NoError(err, "failed to create resource watcher")
 
 		// Start the watcher
 		watcherCtx, cancelWatcher := context.WithCancel(ctx)
@@ -780,6 +1082,10 @@ type integrationTestMockDetector struct {
 	detectFunc func(oldObj, newObj interface{}) []FaultSignal
 }
 
+func (m *integrationTestMockDetector) Describe() DetectorInfo {
+	return DetectorInfo{}
+}
+
 func (m *integrationTestMockDetector) Detect(oldObj, newObj interface{}) []FaultSignal {
 	if m.detectFunc != nil {
 		return m.detectFunc(oldObj, newObj)
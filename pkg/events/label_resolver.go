@@ -0,0 +1,174 @@
+package events
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/klog/v2"
+)
+
+const (
+	// DefaultLabelCacheTTL is how long resolved involved-object labels are reused
+	// before the object is fetched again.
+	DefaultLabelCacheTTL = 5 * time.Minute
+
+	// labelCacheMaxEntries bounds the number of cached objects per cache.
+	labelCacheMaxEntries = 1000
+)
+
+// LabelResolver fetches the labels of an event's involved object.
+// Events don't carry their involved object's labels, so label selector filters
+// need a resolver to match against the object itself.
+type LabelResolver func(ctx context.Context, ref v1.ObjectReference) (map[string]string, error)
+
+// NewDynamicLabelResolver creates a LabelResolver that fetches involved objects of
+// any kind through the dynamic client, using mapper to find the object's resource.
+func NewDynamicLabelResolver(client dynamic.Interface, mapper meta.RESTMapper) LabelResolver {
+	return func(ctx context.Context, ref v1.ObjectReference) (map[string]string, error) {
+		obj, err := getReferencedObject(ctx, client, mapper, ref)
+		if err != nil {
+			return nil, err
+		}
+		return obj.GetLabels(), nil
+	}
+}
+
+// getReferencedObject fetches the object identified by ref through the dynamic
+// client, using mapper to find the object's resource.
+func getReferencedObject(ctx context.Context, client dynamic.Interface, mapper meta.RESTMapper, ref v1.ObjectReference) (*unstructured.Unstructured, error) {
+	gv, err := schema.ParseGroupVersion(ref.APIVersion)
+	if err != nil {
+		return nil, fmt.Errorf("invalid apiVersion %q: %w", ref.APIVersion, err)
+	}
+
+	mapping, err := mapper.RESTMapping(schema.GroupKind{Group: gv.Group, Kind: ref.Kind}, gv.Version)
+	if err != nil {
+		return nil, fmt.Errorf("failed to map %s %s: %w", ref.APIVersion, ref.Kind, err)
+	}
+
+	var resource dynamic.ResourceInterface = client.Resource(mapping.Resource)
+	if mapping.Scope.Name() == meta.RESTScopeNameNamespace {
+		resource = client.Resource(mapping.Resource).Namespace(ref.Namespace)
+	}
+
+	obj, err := resource.Get(ctx, ref.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get %s %s/%s: %w", ref.Kind, ref.Namespace, ref.Name, err)
+	}
+	return obj, nil
+}
+
+// objectLabelCache caches labels returned by a LabelResolver keyed by object UID,
+// so events for the same object only fetch it once per TTL.
+//
+// Thread-safe for concurrent use.
+type objectLabelCache struct {
+	mu      sync.Mutex
+	resolve LabelResolver
+	ttl     time.Duration
+	entries map[types.UID]labelCacheEntry
+}
+
+// labelCacheEntry holds the resolved labels of an object and when they expire.
+type labelCacheEntry struct {
+	labels    map[string]string
+	expiresAt time.Time
+}
+
+// newObjectLabelCache creates an objectLabelCache around resolve.
+func newObjectLabelCache(resolve LabelResolver, ttl time.Duration) *objectLabelCache {
+	return &objectLabelCache{
+		resolve: resolve,
+		ttl:     ttl,
+		entries: make(map[types.UID]labelCacheEntry),
+	}
+}
+
+// get returns the labels of the referenced object, resolving them on a cache miss.
+// References without a UID are always resolved, as they can't be keyed safely.
+// Failed resolutions are not cached.
+func (c *objectLabelCache) get(ctx context.Context, ref v1.ObjectReference) (map[string]string, error) {
+	now := time.Now()
+
+	if ref.UID != "" {
+		c.mu.Lock()
+		entry, exists := c.entries[ref.UID]
+		c.mu.Unlock()
+		if exists && now.Before(entry.expiresAt) {
+			return entry.labels, nil
+		}
+	}
+
+	objectLabels, err := c.resolve(ctx, ref)
+	if err != nil {
+		return nil, err
+	}
+
+	if ref.UID != "" {
+		c.mu.Lock()
+		if len(c.entries) >= labelCacheMaxEntries {
+			c.pruneLocked(now)
+		}
+		c.entries[ref.UID] = labelCacheEntry{labels: objectLabels, expiresAt: now.Add(c.ttl)}
+		c.mu.Unlock()
+	}
+	return objectLabels, nil
+}
+
+// pruneLocked removes expired entries, clearing the cache entirely if it is still
+// full afterwards. Must be called with lock held.
+func (c *objectLabelCache) pruneLocked(now time.Time) {
+	for uid, entry := range c.entries {
+		if !now.Before(entry.expiresAt) {
+			delete(c.entries, uid)
+		}
+	}
+	if len(c.entries) >= labelCacheMaxEntries {
+		c.entries = make(map[types.UID]labelCacheEntry)
+	}
+}
+
+// size returns the number of cached objects.
+func (c *objectLabelCache) size() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return len(c.entries)
+}
+
+// labelsFor returns the labels of an event's involved object. If they can't be
+// resolved, the event's own labels are returned instead.
+func (c *objectLabelCache) labelsFor(ctx context.Context, event *v1.Event) map[string]string {
+	objectLabels, err := c.get(ctx, event.InvolvedObject)
+	if err != nil {
+		klog.V(2).Infof("Failed to resolve labels for %s %s/%s, matching event labels instead: %v",
+			event.InvolvedObject.Kind, event.InvolvedObject.Namespace, event.InvolvedObject.Name, err)
+		return event.Labels
+	}
+	return objectLabels
+}
+
+// matches checks an event against filters, matching the label selector against the
+// involved object's labels. Non-label filters are checked first so objects are only
+// fetched for otherwise matching events. A nil cache falls back to Matches.
+func (c *objectLabelCache) matches(ctx context.Context, filters *SubscriptionFilters, event *v1.Event) bool {
+	if c == nil || filters.LabelSelector == "" {
+		return filters.Matches(event)
+	}
+
+	withoutSelector := *filters
+	withoutSelector.LabelSelector = ""
+	if !withoutSelector.Matches(event) {
+		return false
+	}
+	return filters.MatchesWithObjectLabels(event, c.labelsFor(ctx, event))
+}
@@ -0,0 +1,188 @@
+package events
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes/scheme"
+)
+
+type LabelResolverTestSuite struct {
+	suite.Suite
+	calls    atomic.Int32
+	resolver LabelResolver
+}
+
+func TestLabelResolverSuite(t *testing.T) {
+	suite.Run(t, new(LabelResolverTestSuite))
+}
+
+func (s *LabelResolverTestSuite) SetupSubTest() {
+	s.calls.Store(0)
+	s.resolver = func(_ context.Context, ref v1.ObjectReference) (map[string]string, error) {
+		s.calls.Add(1)
+		if ref.Name == "missing" {
+			return nil, errors.New("not found")
+		}
+		return map[string]string{"app": ref.Name}, nil
+	}
+}
+
+func makeLabelEvent(objectName string, uid types.UID, eventLabels map[string]string) *v1.Event {
+	return &v1.Event{
+		ObjectMeta: metav1.ObjectMeta{Name: "event-" + objectName, Namespace: "default", Labels: eventLabels},
+		InvolvedObject: v1.ObjectReference{
+			APIVersion: "v1",
+			Kind:       "Pod",
+			Name:       objectName,
+			Namespace:  "default",
+			UID:        uid,
+		},
+		Type: "Warning",
+	}
+}
+
+func (s *LabelResolverTestSuite) TestObjectLabelCache() {
+	s.Run("resolves each object once per TTL", func() {
+		cache := newObjectLabelCache(s.resolver, time.Minute)
+
+		for i := 0; i < 3; i++ {
+			objectLabels, err := cache.get(context.Background(), v1.ObjectReference{Name: "web", UID: "uid-web"})
+			s.Require().NoError(err)
+			s.Equal(map[string]string{"app": "web"}, objectLabels)
+		}
+
+		s.Equal(int32(1), s.calls.Load(), "labels should be fetched once and then served from cache")
+		s.Equal(1, cache.size())
+	})
+
+	s.Run("keys entries by UID", func() {
+		cache := newObjectLabelCache(s.resolver, time.Minute)
+
+		_, _ = cache.get(context.Background(), v1.ObjectReference{Name: "web", UID: "uid-1"})
+		_, _ = cache.get(context.Background(), v1.ObjectReference{Name: "web", UID: "uid-2"})
+
+		s.Equal(int32(2), s.calls.Load(), "a recreated object with a new UID should be fetched again")
+		s.Equal(2, cache.size())
+	})
+
+	s.Run("does not cache references without UID", func() {
+		cache := newObjectLabelCache(s.resolver, time.Minute)
+
+		_, _ = cache.get(context.Background(), v1.ObjectReference{Name: "web"})
+		_, _ = cache.get(context.Background(), v1.ObjectReference{Name: "web"})
+
+		s.Equal(int32(2), s.calls.Load())
+		s.Equal(0, cache.size())
+	})
+
+	s.Run("does not cache failures", func() {
+		cache := newObjectLabelCache(s.resolver, time.Minute)
+
+		_, err := cache.get(context.Background(), v1.ObjectReference{Name: "missing", UID: "uid-missing"})
+		s.Error(err)
+		_, err = cache.get(context.Background(), v1.ObjectReference{Name: "missing", UID: "uid-missing"})
+		s.Error(err)
+
+		s.Equal(int32(2), s.calls.Load())
+		s.Equal(0, cache.size())
+	})
+
+	s.Run("refetches expired entries", func() {
+		cache := newObjectLabelCache(s.resolver, time.Millisecond)
+
+		_, _ = cache.get(context.Background(), v1.ObjectReference{Name: "web", UID: "uid-web"})
+		time.Sleep(5 * time.Millisecond)
+		_, _ = cache.get(context.Background(), v1.ObjectReference{Name: "web", UID: "uid-web"})
+
+		s.Equal(int32(2), s.calls.Load())
+	})
+
+	s.Run("stays bounded when full", func() {
+		cache := newObjectLabelCache(s.resolver, time.Minute)
+
+		for i := 0; i <= labelCacheMaxEntries; i++ {
+			_, _ = cache.get(context.Background(), v1.ObjectReference{Name: "web", UID: types.UID(fmt.Sprintf("uid-%d", i))})
+		}
+
+		s.LessOrEqual(cache.size(), labelCacheMaxEntries)
+	})
+}
+
+func (s *LabelResolverTestSuite) TestMatches() {
+	s.Run("matches label selector against involved object labels", func() {
+		cache := newObjectLabelCache(s.resolver, time.Minute)
+		filters := &SubscriptionFilters{LabelSelector: "app=web"}
+
+		s.True(cache.matches(context.Background(), filters, makeLabelEvent("web", "uid-web", nil)))
+		s.False(cache.matches(context.Background(), filters, makeLabelEvent("db", "uid-db", nil)))
+	})
+
+	s.Run("skips fetching when other filters do not match", func() {
+		cache := newObjectLabelCache(s.resolver, time.Minute)
+		filters := &SubscriptionFilters{LabelSelector: "app=web", Type: "Normal"}
+
+		s.False(cache.matches(context.Background(), filters, makeLabelEvent("web", "uid-web", nil)))
+		s.Equal(int32(0), s.calls.Load(), "object should not be fetched for a non-matching event")
+	})
+
+	s.Run("falls back to event labels when resolution fails", func() {
+		cache := newObjectLabelCache(s.resolver, time.Minute)
+		filters := &SubscriptionFilters{LabelSelector: "app=web"}
+
+		s.True(cache.matches(context.Background(), filters, makeLabelEvent("missing", "uid-missing", map[string]string{"app": "web"})))
+		s.False(cache.matches(context.Background(), filters, makeLabelEvent("missing", "uid-missing", nil)))
+	})
+
+	s.Run("nil cache matches event labels", func() {
+		var cache *objectLabelCache
+		filters := &SubscriptionFilters{LabelSelector: "app=web"}
+
+		s.True(cache.matches(context.Background(), filters, makeLabelEvent("db", "uid-db", map[string]string{"app": "web"})))
+		s.False(cache.matches(context.Background(), filters, makeLabelEvent("web", "uid-web", nil)))
+	})
+}
+
+func (s *LabelResolverTestSuite) TestDynamicLabelResolver() {
+	pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default", Labels: map[string]string{"app": "web"}}}
+	node := &v1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node-1", Labels: map[string]string{"zone": "a"}}}
+	client := dynamicfake.NewSimpleDynamicClient(scheme.Scheme, pod, node)
+
+	mapper := meta.NewDefaultRESTMapper(nil)
+	mapper.Add(v1.SchemeGroupVersion.WithKind("Pod"), meta.RESTScopeNamespace)
+	mapper.Add(v1.SchemeGroupVersion.WithKind("Node"), meta.RESTScopeRoot)
+	resolver := NewDynamicLabelResolver(client, mapper)
+
+	s.Run("fetches labels of namespaced objects", func() {
+		objectLabels, err := resolver(context.Background(), v1.ObjectReference{APIVersion: "v1", Kind: "Pod", Name: "web", Namespace: "default"})
+		s.Require().NoError(err)
+		s.Equal(map[string]string{"app": "web"}, objectLabels)
+	})
+
+	s.Run("fetches labels of cluster-scoped objects", func() {
+		objectLabels, err := resolver(context.Background(), v1.ObjectReference{APIVersion: "v1", Kind: "Node", Name: "node-1"})
+		s.Require().NoError(err)
+		s.Equal(map[string]string{"zone": "a"}, objectLabels)
+	})
+
+	s.Run("returns error for missing objects", func() {
+		_, err := resolver(context.Background(), v1.ObjectReference{APIVersion: "v1", Kind: "Pod", Name: "gone", Namespace: "default"})
+		s.Error(err)
+	})
+
+	s.Run("returns error for unknown kinds", func() {
+		_, err := resolver(context.Background(), v1.ObjectReference{APIVersion: "example.com/v1", Kind: "Widget", Name: "w"})
+		s.Error(err)
+		s.Contains(err.Error(), "failed to map")
+	})
+}
@@ -2,14 +2,24 @@ package events
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"slices"
+	"sort"
+	"strconv"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"golang.org/x/time/rate"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/klog/v2"
 
@@ -37,10 +47,76 @@ type ServerSession interface {
 	// ID returns the session ID. Empty string for stdio sessions.
 	ID() string
 
-	// Log sends a log notification to the client.
-	Log(ctx context.Context, params *mcp.LoggingMessageParams) error
+	// Log sends a log notification to the client and reports whether it was sent.
+	// Like mcp.ServerSession.Log, the notification is dropped without error if the
+	// client hasn't set a log level at or below the notification's level.
+	Log(ctx context.Context, params *mcp.LoggingMessageParams) (bool, error)
 }
 
+// logLevelReporter is implemented by sessions that know the log level their client set,
+// so the manager can tell whether a subscription's notifications would be dropped.
+type logLevelReporter interface {
+	// LogLevel returns the log level the client set with logging/setLevel, or empty if
+	// it hasn't set one.
+	LogLevel() mcp.LoggingLevel
+}
+
+var (
+	// ErrSessionNotFound is returned when a notification targets a session that doesn't exist.
+	ErrSessionNotFound = errors.New("session not found")
+	// ErrNotificationDropped is returned by SendTestNotification when the notification
+	// was not sent to an existing session.
+	ErrNotificationDropped = errors.New("notification dropped")
+	// ErrSubscriptionNotFound is returned when cancelling a subscription that doesn't exist
+	// or belongs to another session.
+	ErrSubscriptionNotFound = errors.New("subscription not found")
+	// ErrSessionLimitExceeded is returned when creating subscriptions would exceed
+	// ManagerConfig.MaxSubscriptionsPerSession.
+	ErrSessionLimitExceeded = errors.New("session has reached maximum subscriptions")
+	// ErrGlobalLimitExceeded is returned when creating subscriptions would exceed
+	// ManagerConfig.MaxSubscriptionsGlobal.
+	ErrGlobalLimitExceeded = errors.New("server has reached maximum subscriptions")
+	// ErrCreationRateExceeded is returned when a session creates subscriptions faster
+	// than ManagerConfig.MaxSubscriptionsPerMinute allows.
+	ErrCreationRateExceeded = errors.New("subscription creation rate exceeded")
+	// ErrWatchConnectionLimitExceeded is returned when creating a subscription would open
+	// a watch beyond ManagerConfig.MaxWatchConnections.
+	ErrWatchConnectionLimitExceeded = errors.New("server has reached maximum watch connections")
+	// ErrResourceWatcherLimitExceeded is returned when creating a faults subscription
+	// would exceed ManagerConfig.MaxResourceWatchers.
+	ErrResourceWatcherLimitExceeded = errors.New("server has reached maximum resource watchers")
+	// ErrInvalidMode is returned when creating a subscription with a mode other than
+	// "events" or "faults".
+	ErrInvalidMode = errors.New("invalid mode")
+	// ErrInvalidFilters is returned, wrapping the validation error, when creating a
+	// subscription with filters that fail validation.
+	ErrInvalidFilters = errors.New("invalid filters")
+	// ErrDeliveryNotViable is returned when creating a subscription whose notifications
+	// the session's log level would drop, if ManagerConfig.RequireViableDelivery is set.
+	ErrDeliveryNotViable = errors.New("notifications would be dropped")
+)
+
+const (
+	// resourceVersionAttempts is how many times getCurrentResourceVersion lists events
+	// before giving up.
+	resourceVersionAttempts = 3
+	// resourceVersionRetryDelay is the delay before the first retry of
+	// getCurrentResourceVersion; it doubles after each failed attempt.
+	resourceVersionRetryDelay = 200 * time.Millisecond
+)
+
+// WatchHealth describes the connection state of the watch backing a subscription.
+type WatchHealth string
+
+const (
+	// WatchHealthHealthy indicates the watch is connected and receiving events
+	WatchHealthHealthy WatchHealth = "Healthy"
+	// WatchHealthReconnecting indicates the watch lost its connection and is retrying
+	WatchHealthReconnecting WatchHealth = "Reconnecting"
+	// WatchHealthDegraded indicates the watch gave up after exhausting its reconnection attempts
+	WatchHealthDegraded WatchHealth = "Degraded"
+)
+
 // Subscription represents an active event subscription.
 type Subscription struct {
 	ID        string
@@ -48,9 +124,42 @@ type Subscription struct {
 	Cluster   string
 	Mode      string // "events" or "faults"
 	Filters   SubscriptionFilters
-	Cancel    context.CancelFunc
-	CreatedAt time.Time
-	Degraded  bool
+	// ResumeFrom is the resource version the subscription's watch started from, or
+	// empty if it started from the time the subscription was created.
+	ResumeFrom string
+	Cancel     context.CancelFunc
+	CreatedAt  time.Time
+	Degraded   bool
+	Health     WatchHealth
+	// DeliveryViable reports whether the session's log level let the subscription's
+	// notifications through when it was created. When false, they are dropped until the
+	// client sets a low enough level with logging/setLevel.
+	DeliveryViable bool
+
+	notificationFailures atomic.Int32       // consecutive failed notification sends
+	lastEventAt          atomic.Int64       // unix nanoseconds of the last processed event, or of creation
+	deliveries           *notificationQueue // queued notifications; nil until the watcher starts
+	replay               *eventReplayBuffer // recently processed events; nil for faults mode or if disabled
+}
+
+// DroppedNotifications returns how many notifications were dropped because the
+// subscription's notification queue was full, typically because the client is slow.
+func (sub *Subscription) DroppedNotifications() int64 {
+	if sub.deliveries == nil {
+		return 0
+	}
+	return sub.deliveries.dropped.Load()
+}
+
+// LastEventAt returns when the subscription last processed an event, or when it was
+// created if it hasn't processed any yet.
+func (sub *Subscription) LastEventAt() time.Time {
+	return time.Unix(0, sub.lastEventAt.Load())
+}
+
+// isDegraded reports whether the subscription's watch has given up reconnecting.
+func (sub *Subscription) isDegraded() bool {
+	return sub.Degraded || sub.Health == WatchHealthDegraded
 }
 
 // KubernetesClientGetter is a function that returns a Kubernetes client for a given cluster.
@@ -60,73 +169,251 @@ type KubernetesClientGetter func(cluster string) (*pkgkubernetes.Kubernetes, err
 // EventSubscriptionManager manages event subscriptions and notification delivery.
 type EventSubscriptionManager struct {
 	mu            sync.RWMutex
-	subscriptions map[string]*Subscription // subscriptionID -> Subscription
-	bySession     map[string][]string      // sessionID -> []subscriptionID
-	byCluster     map[string][]string      // cluster -> []subscriptionID
-	server        MCPServer                // for accessing sessions
+	subscriptions map[string]*Subscription       // subscriptionID -> Subscription
+	bySession     map[string]map[string]struct{} // sessionID -> set of subscriptionIDs
+	byCluster     map[string]map[string]struct{} // cluster -> set of subscriptionIDs
+	server        MCPServer                      // for accessing sessions
+	notifier      Notifier                       // delivers notifications to sessions
+	resources     Notifier                       // delivers notifications of resource-delivery subscriptions; nil if unsupported
 	config        ManagerConfig
 	getK8sClient  KubernetesClientGetter // function to get Kubernetes client by cluster
-	detectors     []Detector             // fault detectors for resource-based fault detection
+	detectors     *DetectorRegistry      // fault detectors built for each faults subscription
+	faultHistory  *FaultHistory          // recent faults per cluster for late subscribers
+	tracer        trace.Tracer           // creates spans for event and fault processing
+	eventMux      *eventMultiplexer      // shared event watches for events-mode subscriptions
+	breakers      *clusterBreakers       // per-cluster circuit breakers shared by event watches
+	enrichments   *EnrichmentLimiter     // caps concurrent fault enrichments across faults subscriptions
+	mutes         *faultMutes            // resources whose faults are suppressed, e.g. during maintenance
+
+	resourceWatchers int // running ResourceWatchers of faults-mode subscriptions
+
+	creationLimiters map[string]*rate.Limiter // sessionID -> subscription creation rate limiter
+	clock            Clock                    // clock for creation rate limiting, expiry, and the session monitor
+
+	queuesClosed atomic.Bool    // set once Shutdown starts flushing the notification queues
+	drainMu      sync.Mutex     // guards draining and additions to inFlight
+	draining     bool           // set once the queues are flushed; new notifications are dropped
+	inFlight     sync.WaitGroup // tracks notifications currently being sent
+
+	monitorRunning   atomic.Bool  // set while StartSessionMonitor is running
+	lastNotification atomic.Int64 // UnixNano of the last delivered notification; zero if none
 }
 
 // NewEventSubscriptionManager creates a new EventSubscriptionManager.
 // The server parameter is used to iterate active sessions for cleanup.
 // The getK8sClient function is used to obtain Kubernetes clients for starting watchers.
-// The detectors parameter provides fault detectors for resource-based fault detection.
-func NewEventSubscriptionManager(server MCPServer, config ManagerConfig, getK8sClient KubernetesClientGetter, detectors []Detector) *EventSubscriptionManager {
+// The detectors parameter provides the fault detectors built for each faults subscription;
+// if nil, no detectors are registered and faults subscriptions can't be started.
+func NewEventSubscriptionManager(server MCPServer, config ManagerConfig, getK8sClient KubernetesClientGetter, detectors *DetectorRegistry) *EventSubscriptionManager {
+	if config.NotificationTimeout <= 0 {
+		klog.Warningf("Notification timeout %s is not positive, using %s", config.NotificationTimeout, DefaultNotificationTimeout)
+		config.NotificationTimeout = DefaultNotificationTimeout
+	}
+	if config.MaxNotificationFailures <= 0 {
+		klog.Warningf("Max notification failures %d is not positive, using %d", config.MaxNotificationFailures, DefaultMaxNotificationFailures)
+		config.MaxNotificationFailures = DefaultMaxNotificationFailures
+	}
+	if config.MaxNotificationRetries < 0 {
+		klog.Warningf("Max notification retries %d is negative, using %d", config.MaxNotificationRetries, DefaultMaxNotificationRetries)
+		config.MaxNotificationRetries = DefaultMaxNotificationRetries
+	}
+	if config.ShutdownDrainTimeout <= 0 {
+		klog.Warningf("Shutdown drain timeout %s is not positive, using %s", config.ShutdownDrainTimeout, DefaultShutdownDrainTimeout)
+		config.ShutdownDrainTimeout = DefaultShutdownDrainTimeout
+	}
+	if config.NotificationRetryBackoff <= 0 {
+		klog.Warningf("Notification retry backoff %s is not positive, using %s", config.NotificationRetryBackoff, DefaultNotificationRetryBackoff)
+		config.NotificationRetryBackoff = DefaultNotificationRetryBackoff
+	}
+	if config.FaultDeduplicationWindow <= 0 {
+		klog.Warningf("Fault deduplication window %s is not positive, using %s", config.FaultDeduplicationWindow, DeduplicationTTL)
+		config.FaultDeduplicationWindow = DeduplicationTTL
+	}
+	if config.NotificationQueueSize <= 0 {
+		klog.Warningf("Notification queue size %d is not positive, using %d", config.NotificationQueueSize, DefaultNotificationQueueSize)
+		config.NotificationQueueSize = DefaultNotificationQueueSize
+	}
+	if config.WatchBreakerThreshold <= 0 {
+		klog.Warningf("Watch breaker threshold %d is not positive, using %d", config.WatchBreakerThreshold, DefaultBreakerFailureThreshold)
+		config.WatchBreakerThreshold = DefaultBreakerFailureThreshold
+	}
+	if config.MaxConcurrentEnrichments <= 0 {
+		klog.Warningf("Max concurrent enrichments %d is not positive, using %d", config.MaxConcurrentEnrichments, DefaultMaxConcurrentEnrichments)
+		config.MaxConcurrentEnrichments = DefaultMaxConcurrentEnrichments
+	}
+	if config.MaxFaultContextBytes <= 0 {
+		klog.Warningf("Max fault context bytes %d is not positive, using %d", config.MaxFaultContextBytes, DefaultMaxContextBytes)
+		config.MaxFaultContextBytes = DefaultMaxContextBytes
+	}
+	if config.WatchBreakerCooldown <= 0 {
+		klog.Warningf("Watch breaker cooldown %s is not positive, using %s", config.WatchBreakerCooldown, DefaultBreakerCooldown)
+		config.WatchBreakerCooldown = DefaultBreakerCooldown
+	}
+
+	if detectors == nil {
+		detectors = NewDetectorRegistry()
+	}
+
+	notifier := config.Notifier
+	if notifier == nil {
+		notifier = NewMCPNotifier(server)
+	}
+
+	tracer := newTracer(config.TracerProvider)
 	return &EventSubscriptionManager{
 		subscriptions: make(map[string]*Subscription),
-		bySession:     make(map[string][]string),
-		byCluster:     make(map[string][]string),
+		bySession:     make(map[string]map[string]struct{}),
+		byCluster:     make(map[string]map[string]struct{}),
 		server:        server,
+		notifier:      notifier,
+		resources:     config.ResourceNotifier,
 		config:        config,
 		getK8sClient:  getK8sClient,
 		detectors:     detectors,
+		faultHistory:  NewFaultHistory(config.FaultHistorySize, config.FaultDeduplicationWindow),
+		tracer:        tracer,
+		eventMux:      newEventMultiplexer(tracer),
+		breakers:      newClusterBreakers(config.WatchBreakerThreshold, config.WatchBreakerCooldown),
+		enrichments:   NewEnrichmentLimiter(config.MaxConcurrentEnrichments),
+		mutes:         newFaultMutes(),
+
+		creationLimiters: make(map[string]*rate.Limiter),
+		clock:            clockOrDefault(config.Clock),
 	}
 }
 
 // Create creates a new subscription and returns it.
-// Returns an error if limits are exceeded or validation fails.
-func (m *EventSubscriptionManager) Create(sessionID, cluster, mode string, filters SubscriptionFilters) (*Subscription, error) {
+// Returns an error if the session's creation rate or limits are exceeded, or validation fails.
+//
+// Subscriptions start watching from the time they are created. An events-mode
+// subscription can instead resume from a resource version, e.g. the last one a client
+// received before reconnecting, so the events in between are delivered. If resumeFrom is
+// too old for the API server to resume from, the watch falls back to the current
+// resource version and the events in between are missed.
+func (m *EventSubscriptionManager) Create(sessionID, cluster, mode string, filters SubscriptionFilters, resumeFrom string) (*Subscription, error) {
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
+	if err := m.validateSubscription(mode, filters); err != nil {
+		return nil, err
+	}
+	if err := validateResumeFrom(mode, resumeFrom); err != nil {
+		return nil, err
+	}
+
+	// Check session creation rate before limits so rejected attempts are throttled too
+	if !m.allowCreationLocked(sessionID, 1) {
+		return nil, fmt.Errorf("%w (%d per minute)", ErrCreationRateExceeded, m.config.MaxSubscriptionsPerMinute)
+	}
+
+	if err := m.checkSubscriptionLimitsLocked(sessionID, 1); err != nil {
+		return nil, err
+	}
+
+	return m.startSubscriptionLocked(sessionID, cluster, mode, filters, resumeFrom)
+}
+
+// validateSubscription checks a subscription's mode and filters.
+func (m *EventSubscriptionManager) validateSubscription(mode string, filters SubscriptionFilters) error {
 	// Validate filters
 	if err := filters.ValidateForMode(mode); err != nil {
-		return nil, fmt.Errorf("invalid filters: %w", err)
+		return fmt.Errorf("%w: %w", ErrInvalidFilters, err)
 	}
 
 	// Validate mode
 	if mode != "events" && mode != "faults" {
-		return nil, fmt.Errorf("invalid mode: must be 'events' or 'faults'")
+		return fmt.Errorf("%w: must be 'events' or 'faults'", ErrInvalidMode)
 	}
 
+	// Resource delivery needs a notifier publishing to MCP resources
+	if filters.Delivery == DeliveryResource && m.resources == nil {
+		return fmt.Errorf("%w: delivery %q is not supported by this server", ErrInvalidFilters, DeliveryResource)
+	}
+
+	// Validate detector selection against the registered detectors
+	if err := m.detectors.Validate(filters.DetectorTypes); err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidFilters, err)
+	}
+
+	return nil
+}
+
+// validateResumeFrom checks the resource version a subscription resumes from, if any.
+// Resource versions are opaque to clients, but those of etcd-backed API servers are
+// positive integers, which catches clients passing something else.
+func validateResumeFrom(mode, resumeFrom string) error {
+	if resumeFrom == "" {
+		return nil
+	}
+	if mode != "events" {
+		return fmt.Errorf("invalid resumeFrom: only supported in events mode")
+	}
+	if version, err := strconv.ParseUint(resumeFrom, 10, 64); err != nil || version == 0 {
+		return fmt.Errorf("invalid resumeFrom: %q is not a resource version", resumeFrom)
+	}
+	return nil
+}
+
+// checkSubscriptionLimitsLocked returns an error if the session adding delta
+// subscriptions would exceed the session or global subscription limits.
+// Must be called with lock held.
+func (m *EventSubscriptionManager) checkSubscriptionLimitsLocked(sessionID string, delta int) error {
 	// Check session subscription limit
-	sessionSubs := m.bySession[sessionID]
-	if len(sessionSubs) >= m.config.MaxSubscriptionsPerSession {
-		return nil, fmt.Errorf("session has reached maximum subscriptions (%d)", m.config.MaxSubscriptionsPerSession)
+	if len(m.bySession[sessionID])+delta > m.config.MaxSubscriptionsPerSession {
+		return fmt.Errorf("%w (%d)", ErrSessionLimitExceeded, m.config.MaxSubscriptionsPerSession)
 	}
 
 	// Check global subscription limit
-	if len(m.subscriptions) >= m.config.MaxSubscriptionsGlobal {
-		return nil, fmt.Errorf("server has reached maximum subscriptions (%d)", m.config.MaxSubscriptionsGlobal)
+	if len(m.subscriptions)+delta > m.config.MaxSubscriptionsGlobal {
+		return fmt.Errorf("%w (%d)", ErrGlobalLimitExceeded, m.config.MaxSubscriptionsGlobal)
+	}
+
+	return nil
+}
+
+// startSubscriptionLocked tracks a new, validated subscription and starts its watcher.
+// Must be called with lock held.
+func (m *EventSubscriptionManager) startSubscriptionLocked(sessionID, cluster, mode string, filters SubscriptionFilters, resumeFrom string) (*Subscription, error) {
+	// Check watch connection limit; watchers are only started with a client getter
+	if m.getK8sClient != nil {
+		if err := m.checkWatchCapacityLocked(cluster, mode, filters, resumeFrom); err != nil {
+			return nil, err
+		}
+		if err := m.checkResourceWatcherCapacityLocked(mode); err != nil {
+			return nil, err
+		}
+	}
+
+	viable, level := m.deliveryViable(sessionID, mode, filters)
+	if !viable {
+		if m.config.RequireViableDelivery {
+			return nil, fmt.Errorf("%w: session %s must set a log level of %s or lower with logging/setLevel", ErrDeliveryNotViable, sessionID, level)
+		}
+		klog.Warningf("Notifications of the %s subscription for session %s are dropped until it sets a log level of %s or lower", mode, sessionID, level)
 	}
 
 	// Create subscription with unique ID
 	sub := &Subscription{
-		ID:        generateSubscriptionID(),
-		SessionID: sessionID,
-		Cluster:   cluster,
-		Mode:      mode,
-		Filters:   filters,
-		CreatedAt: time.Now(),
-		Degraded:  false,
+		ID:             generateSubscriptionID(),
+		SessionID:      sessionID,
+		Cluster:        cluster,
+		Mode:           mode,
+		Filters:        filters,
+		ResumeFrom:     resumeFrom,
+		CreatedAt:      m.clock.Now(),
+		Degraded:       false,
+		Health:         WatchHealthHealthy,
+		DeliveryViable: viable,
+	}
+	sub.lastEventAt.Store(sub.CreatedAt.UnixNano())
+	if mode == "events" {
+		sub.replay = newEventReplayBuffer(m.config.EventReplaySize)
 	}
 
 	// Track subscription
 	m.subscriptions[sub.ID] = sub
-	m.bySession[sessionID] = append(m.bySession[sessionID], sub.ID)
-	m.byCluster[cluster] = append(m.byCluster[cluster], sub.ID)
+	addToIndex(m.bySession, sessionID, sub.ID)
+	addToIndex(m.byCluster, cluster, sub.ID)
 
 	klog.V(1).Infof("Created subscription %s for session %s (cluster=%s, mode=%s)", sub.ID, sessionID, cluster, mode)
 
@@ -142,6 +429,134 @@ func (m *EventSubscriptionManager) Create(sessionID, cluster, mode string, filte
 	return sub, nil
 }
 
+// deliveryViable reports whether the session's log level lets the notifications of a
+// subscription with the given mode and filters through, and the level they need.
+// Subscriptions delivered through MCP resources or a custom Notifier, and sessions that
+// can't be found or don't report their log level, are assumed viable.
+func (m *EventSubscriptionManager) deliveryViable(sessionID, mode string, filters SubscriptionFilters) (bool, mcp.LoggingLevel) {
+	level := subscriptionLoggingLevel(mode)
+	if filters.Delivery == DeliveryResource || m.config.Notifier != nil || m.server == nil {
+		return true, level
+	}
+
+	var reporter logLevelReporter
+	m.server.Sessions().All(func(session ServerSession) bool {
+		if session.ID() != sessionID {
+			return true // continue iteration
+		}
+		reporter, _ = session.(logLevelReporter)
+		return false // stop iteration
+	})
+	if reporter == nil {
+		return true, level
+	}
+	return loggingLevelAllows(reporter.LogLevel(), level), level
+}
+
+// DesiredSubscription describes a subscription a session wants to have, as passed
+// to ReconcileSession.
+type DesiredSubscription struct {
+	Mode    string // "events" or "faults"
+	Filters SubscriptionFilters
+}
+
+// ReconcileSession makes a session's subscriptions on a cluster match desired, for
+// clients re-syncing their subscription set. Existing subscriptions with the same mode
+// and filters as a desired one keep running with their ID and watch position; degraded
+// ones are replaced. Other existing subscriptions are cancelled and desired ones without
+// a match are created. Returns the IDs of the created and cancelled subscriptions.
+//
+// The change is all or nothing: desired subscriptions are validated and checked against
+// the limits up front, and if one fails to start, those created so far are cancelled and
+// the existing subscriptions are left untouched. New subscriptions are started before
+// removed ones are cancelled, so there is no gap in coverage.
+func (m *EventSubscriptionManager) ReconcileSession(sessionID, cluster string, desired []DesiredSubscription) (added, removed []string, err error) {
+	for _, d := range desired {
+		if err := m.validateSubscription(d.Mode, d.Filters); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	// Index the session's running subscriptions on the cluster, oldest first, so the
+	// oldest of several identical subscriptions is the one kept
+	var existing []*Subscription
+	for subID := range m.bySession[sessionID] {
+		if sub, exists := m.subscriptions[subID]; exists && sub.Cluster == cluster {
+			existing = append(existing, sub)
+		}
+	}
+	sortSubscriptions(existing)
+	unmatched := make(map[string][]*Subscription) // subscription key -> existing subscriptions
+	for _, sub := range existing {
+		if !sub.isDegraded() {
+			key := subscriptionKey(sub.Mode, sub.Filters)
+			unmatched[key] = append(unmatched[key], sub)
+		}
+	}
+
+	// Each existing subscription satisfies at most one desired subscription
+	kept := make(map[string]bool) // subscriptionID -> matched a desired subscription
+	var toCreate []DesiredSubscription
+	for _, d := range desired {
+		key := subscriptionKey(d.Mode, d.Filters)
+		if subs := unmatched[key]; len(subs) > 0 {
+			kept[subs[0].ID] = true
+			unmatched[key] = subs[1:]
+			continue
+		}
+		toCreate = append(toCreate, d)
+	}
+	var toCancel []*Subscription
+	for _, sub := range existing {
+		if !kept[sub.ID] {
+			toCancel = append(toCancel, sub)
+		}
+	}
+
+	if len(toCreate) > 0 {
+		if !m.allowCreationLocked(sessionID, len(toCreate)) {
+			return nil, nil, fmt.Errorf("%w (%d per minute)", ErrCreationRateExceeded, m.config.MaxSubscriptionsPerMinute)
+		}
+		if err := m.checkSubscriptionLimitsLocked(sessionID, len(toCreate)-len(toCancel)); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	created := make([]*Subscription, 0, len(toCreate))
+	for _, d := range toCreate {
+		sub, err := m.startSubscriptionLocked(sessionID, cluster, d.Mode, d.Filters, "")
+		if err != nil {
+			for _, sub := range created {
+				m.cancelSubscriptionLocked(sub)
+			}
+			return nil, nil, err
+		}
+		created = append(created, sub)
+		added = append(added, sub.ID)
+	}
+
+	for _, sub := range toCancel {
+		m.cancelSubscriptionLocked(sub)
+		removed = append(removed, sub.ID)
+	}
+
+	klog.V(1).Infof("Reconciled subscriptions for session %s (cluster=%s): %d added, %d removed, %d unchanged",
+		sessionID, cluster, len(added), len(removed), len(kept))
+	return added, removed, nil
+}
+
+// subscriptionKey identifies what a subscription watches, so subscriptions with the
+// same mode and filters compare equal.
+func subscriptionKey(mode string, filters SubscriptionFilters) string {
+	// ToMap omits unset filters and json.Marshal sorts map keys, giving a canonical form.
+	// The map only holds strings, bools and string slices, so marshalling cannot fail.
+	data, _ := json.Marshal(filters.ToMap())
+	return mode + ":" + string(data)
+}
+
 // Cancel cancels a subscription by ID.
 // Returns an error if the subscription doesn't exist.
 func (m *EventSubscriptionManager) Cancel(subscriptionID string) error {
@@ -150,7 +565,7 @@ func (m *EventSubscriptionManager) Cancel(subscriptionID string) error {
 
 	sub, exists := m.subscriptions[subscriptionID]
 	if !exists {
-		return fmt.Errorf("subscription not found")
+		return ErrSubscriptionNotFound
 	}
 
 	m.cancelSubscriptionLocked(sub)
@@ -166,11 +581,11 @@ func (m *EventSubscriptionManager) CancelBySessionAndID(sessionID, subscriptionI
 
 	sub, exists := m.subscriptions[subscriptionID]
 	if !exists {
-		return fmt.Errorf("subscription not found")
+		return ErrSubscriptionNotFound
 	}
 
 	if sub.SessionID != sessionID {
-		return fmt.Errorf("subscription not found")
+		return ErrSubscriptionNotFound
 	}
 
 	m.cancelSubscriptionLocked(sub)
@@ -184,18 +599,54 @@ func (m *EventSubscriptionManager) CancelSession(sessionID string) {
 	m.cancelSessionLocked(sessionID)
 }
 
+// OnSessionClosed cancels all subscriptions of a session as soon as it disconnects.
+// The MCP server calls it when a session closes, so subscriptions stop immediately
+// instead of lingering until the next session monitor check.
+func (m *EventSubscriptionManager) OnSessionClosed(sessionID string) {
+	if sessionID == "" {
+		return
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if count := len(m.bySession[sessionID]); count > 0 {
+		klog.V(1).Infof("Session %s closed, cancelling %d subscriptions", sessionID, count)
+		m.cancelSessionLocked(sessionID)
+	}
+}
+
+// CancelSessionCluster cancels all subscriptions for a session on a specific cluster,
+// leaving the session's subscriptions on other clusters untouched.
+// Returns the number of subscriptions cancelled.
+func (m *EventSubscriptionManager) CancelSessionCluster(sessionID, cluster string) int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	cancelled := 0
+	for subID := range m.bySession[sessionID] {
+		if sub, exists := m.subscriptions[subID]; exists && sub.Cluster == cluster {
+			m.cancelSubscriptionLocked(sub)
+			cancelled++
+		}
+	}
+
+	klog.V(1).Infof("Cancelled %d subscriptions for session %s on cluster %s", cancelled, sessionID, cluster)
+	return cancelled
+}
+
 // CancelCluster cancels all subscriptions for a given cluster.
 // This is called when a cluster configuration is removed.
 func (m *EventSubscriptionManager) CancelCluster(cluster string) {
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
-	subIDs := m.byCluster[cluster]
-	for _, subID := range subIDs {
+	for subID := range m.byCluster[cluster] {
 		if sub, exists := m.subscriptions[subID]; exists {
 			m.cancelSubscriptionLocked(sub)
 		}
 	}
+	m.faultHistory.Clear(cluster)
 }
 
 // CancelAll cancels all active subscriptions.
@@ -209,6 +660,79 @@ func (m *EventSubscriptionManager) CancelAll() {
 	}
 }
 
+// Shutdown gracefully stops the manager. It stops queueing new notifications, sends
+// the notifications already queued for each subscription, then stops accepting new
+// notifications, waits for in-flight notifications to finish sending and cancels all
+// subscriptions. If ctx is done before the queues are flushed and in-flight
+// notifications complete, the rest is dropped, subscriptions are cancelled anyway
+// and the context error is returned.
+func (m *EventSubscriptionManager) Shutdown(ctx context.Context) error {
+	m.queuesClosed.Store(true)
+	err := m.flushQueues(ctx)
+
+	m.drainMu.Lock()
+	m.draining = true
+	m.drainMu.Unlock()
+
+	drained := make(chan struct{})
+	go func() {
+		m.inFlight.Wait()
+		close(drained)
+	}()
+
+	select {
+	case <-drained:
+		klog.V(1).Info("All in-flight notifications drained")
+	case <-ctx.Done():
+		err = ctx.Err()
+		klog.Warningf("Shutdown drain interrupted before in-flight notifications completed: %v", err)
+	}
+
+	m.CancelAll()
+	return err
+}
+
+// flushQueues waits for every subscription's queued notifications to be sent, or for
+// ctx to be done, in which case the context error is returned.
+func (m *EventSubscriptionManager) flushQueues(ctx context.Context) error {
+	m.mu.RLock()
+	queues := make([]*notificationQueue, 0, len(m.subscriptions))
+	for _, sub := range m.subscriptions {
+		if sub.deliveries != nil {
+			queues = append(queues, sub.deliveries)
+		}
+	}
+	m.mu.RUnlock()
+
+	errs := make(chan error, len(queues))
+	for _, queue := range queues {
+		go func() { errs <- queue.flush(ctx) }()
+	}
+	var err error
+	for range queues {
+		if flushErr := <-errs; flushErr != nil {
+			err = flushErr
+		}
+	}
+	if err != nil {
+		klog.Warningf("Shutdown drain interrupted before queued notifications were sent: %v", err)
+	}
+	return err
+}
+
+// beginNotification registers an in-flight notification.
+// Returns false if the manager is draining and the notification should be dropped.
+func (m *EventSubscriptionManager) beginNotification() bool {
+	m.drainMu.Lock()
+	defer m.drainMu.Unlock()
+
+	if m.draining {
+		return false
+	}
+	m.inFlight.Add(1)
+	return true
+}
+
 // GetSubscription returns a subscription by ID, or nil if not found.
 func (m *EventSubscriptionManager) GetSubscription(subscriptionID string) *Subscription {
 	m.mu.RLock()
@@ -225,40 +749,304 @@ func (m *EventSubscriptionManager) ListSubscriptionsForSession(sessionID string)
 	subIDs := m.bySession[sessionID]
 	subs := make([]*Subscription, 0, len(subIDs))
 
-	for _, subID := range subIDs {
+	for subID := range subIDs {
 		if sub, exists := m.subscriptions[subID]; exists {
 			subs = append(subs, sub)
 		}
 	}
 
+	sortSubscriptions(subs)
 	return subs
 }
 
+// SubscriptionQuery selects subscriptions listed by ListSubscriptions.
+// Empty fields match every subscription.
+type SubscriptionQuery struct {
+	Mode         string // "events" or "faults"
+	Cluster      string
+	SessionID    string
+	DegradedOnly bool
+}
+
+// matches reports whether sub satisfies every field of the query.
+func (q SubscriptionQuery) matches(sub *Subscription) bool {
+	if q.Mode != "" && sub.Mode != q.Mode {
+		return false
+	}
+	if q.Cluster != "" && sub.Cluster != q.Cluster {
+		return false
+	}
+	if q.SessionID != "" && sub.SessionID != q.SessionID {
+		return false
+	}
+	if q.DegradedOnly && !sub.isDegraded() {
+		return false
+	}
+	return true
+}
+
+// ListSubscriptions returns the subscriptions matching filter, in creation order.
+// Session and cluster filters are served from their indices, scanning the smaller
+// of the two when both are set, so only an unscoped query visits every subscription.
+func (m *EventSubscriptionManager) ListSubscriptions(filter SubscriptionQuery) []*Subscription {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	var subs []*Subscription
+	collect := func(sub *Subscription) {
+		if filter.matches(sub) {
+			subs = append(subs, sub)
+		}
+	}
+
+	var candidates map[string]struct{}
+	switch {
+	case filter.SessionID != "" && filter.Cluster != "":
+		candidates = m.bySession[filter.SessionID]
+		if len(m.byCluster[filter.Cluster]) < len(candidates) {
+			candidates = m.byCluster[filter.Cluster]
+		}
+	case filter.SessionID != "":
+		candidates = m.bySession[filter.SessionID]
+	case filter.Cluster != "":
+		candidates = m.byCluster[filter.Cluster]
+	default:
+		for _, sub := range m.subscriptions {
+			collect(sub)
+		}
+	}
+	for subID := range candidates {
+		if sub, exists := m.subscriptions[subID]; exists {
+			collect(sub)
+		}
+	}
+
+	sortSubscriptions(subs)
+	return subs
+}
+
+// sortSubscriptions sorts by creation time (then ID) for a stable ordering since the indices are sets.
+func sortSubscriptions(subs []*Subscription) {
+	sort.Slice(subs, func(i, j int) bool {
+		if !subs[i].CreatedAt.Equal(subs[j].CreatedAt) {
+			return subs[i].CreatedAt.Before(subs[j].CreatedAt)
+		}
+		return subs[i].ID < subs[j].ID
+	})
+}
+
+// SubscriptionExport is a serializable snapshot of a subscription's configuration, as
+// returned by ExportSubscriptions for backup and audit and accepted by ImportSubscriptions.
+// Filters use the argument format of the events_subscribe tool (see
+// SubscriptionFilters.ToMap). Runtime state, like the subscription ID, watch health and
+// resource version, isn't exported.
+type SubscriptionExport struct {
+	SessionID string                 `json:"sessionId"`
+	Cluster   string                 `json:"cluster"`
+	Mode      string                 `json:"mode"`
+	Filters   map[string]interface{} `json:"filters,omitempty"`
+	CreatedAt time.Time              `json:"createdAt"`
+}
+
+// ExportSubscriptions returns the configuration of every subscription, in creation order.
+func (m *EventSubscriptionManager) ExportSubscriptions() []SubscriptionExport {
+	subs := m.ListSubscriptions(SubscriptionQuery{})
+	exports := make([]SubscriptionExport, 0, len(subs))
+	for _, sub := range subs {
+		exports = append(exports, SubscriptionExport{
+			SessionID: sub.SessionID,
+			Cluster:   sub.Cluster,
+			Mode:      sub.Mode,
+			Filters:   sub.Filters.ToMap(),
+			CreatedAt: sub.CreatedAt,
+		})
+	}
+	return exports
+}
+
+// ImportSubscriptions recreates exported subscriptions, e.g. to migrate them to another
+// server or restore them after a restart, and returns the subscriptions created. Each gets
+// a new ID and starts watching from the current resource version, so events from before
+// the import aren't replayed; its CreatedAt is restored from the export, so
+// MaxSubscriptionTTL still counts from the original creation.
+//
+// Imports are validated and count toward the subscription limits like Create, but aren't
+// subject to the session creation rate. A subscription that can't be imported doesn't stop
+// the others; the returned error joins the errors of every one that failed.
+func (m *EventSubscriptionManager) ImportSubscriptions(exports []SubscriptionExport) ([]*Subscription, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var imported []*Subscription
+	var errs []error
+	for i, export := range exports {
+		sub, err := m.importSubscriptionLocked(export)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("subscription %d (session %s, cluster %s): %w", i, export.SessionID, export.Cluster, err))
+			continue
+		}
+		imported = append(imported, sub)
+	}
+
+	klog.V(1).Infof("Imported %d of %d subscriptions", len(imported), len(exports))
+	return imported, errors.Join(errs...)
+}
+
+// importSubscriptionLocked creates a subscription from its export.
+// Must be called with lock held.
+func (m *EventSubscriptionManager) importSubscriptionLocked(export SubscriptionExport) (*Subscription, error) {
+	filters, err := ParseFiltersFromMapStrict(export.Filters)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidFilters, err)
+	}
+	if err := m.validateSubscription(export.Mode, filters); err != nil {
+		return nil, err
+	}
+	if err := m.checkSubscriptionLimitsLocked(export.SessionID, 1); err != nil {
+		return nil, err
+	}
+
+	sub, err := m.startSubscriptionLocked(export.SessionID, export.Cluster, export.Mode, filters, "")
+	if err != nil {
+		return nil, err
+	}
+	if !export.CreatedAt.IsZero() {
+		sub.CreatedAt = export.CreatedAt
+	}
+	return sub, nil
+}
+
+// GetRecentFaults returns up to limit of the most recent faults detected on a cluster,
+// ordered newest-first. A limit of zero or less returns every buffered fault.
+// Faults are only recorded while at least one faults-mode subscription is watching the cluster.
+func (m *EventSubscriptionManager) GetRecentFaults(cluster string, limit int) []*FaultSignal {
+	return m.faultHistory.GetRecentFaults(cluster, limit)
+}
+
+// ReplayRecent returns up to limit of the events most recently processed for an
+// events-mode subscription, ordered newest-first, so a client reconnecting after a brief
+// disconnection can catch up on the events it may have missed. A limit of zero or less
+// returns every buffered event. At most ManagerConfig.EventReplaySize events are kept per
+// subscription; an unknown subscription, a faults-mode one, or a disabled replay returns
+// an empty slice.
+func (m *EventSubscriptionManager) ReplayRecent(subscriptionID string, limit int) []*EventDetails {
+	m.mu.RLock()
+	sub, exists := m.subscriptions[subscriptionID]
+	m.mu.RUnlock()
+	if !exists {
+		return []*EventDetails{}
+	}
+	return sub.replay.recent(limit)
+}
+
 // GetStats returns statistics about active subscriptions.
 func (m *EventSubscriptionManager) GetStats() SubscriptionStats {
 	m.mu.RLock()
 	defer m.mu.RUnlock()
 
-	return SubscriptionStats{
-		Total:    len(m.subscriptions),
-		Sessions: len(m.bySession),
-		Clusters: len(m.byCluster),
-		Degraded: m.countDegradedLocked(),
+	stats := SubscriptionStats{
+		Total:      len(m.subscriptions),
+		Sessions:   len(m.bySession),
+		Clusters:   len(m.byCluster),
+		PerCluster: make(map[string]int, len(m.byCluster)),
+		PerMode:    make(map[string]int),
+	}
+	for cluster, subIDs := range m.byCluster {
+		stats.PerCluster[cluster] = len(subIDs)
 	}
+	stats.WatchConnections = m.watchConnectionsLocked()
+	stats.ResourceWatchers = m.resourceWatchers
+	stats.Breakers = m.breakers.states()
+	m.countSubscriptionsLocked(&stats)
+	return stats
 }
 
 // SubscriptionStats holds statistics about subscriptions.
+// Healthy, Reconnecting and Degraded count subscriptions by watch health.
+// PerCluster and PerMode count subscriptions by cluster and by mode ("events" or "faults").
+// WatchConnections counts the open watches, as limited by MaxWatchConnections.
+// ResourceWatchers counts the running ResourceWatchers, as limited by MaxResourceWatchers.
+// Breakers holds the circuit breaker state of each cluster that has had an event watch.
 type SubscriptionStats struct {
-	Total    int
-	Sessions int
-	Clusters int
-	Degraded int
+	Total            int
+	Sessions         int
+	Clusters         int
+	Healthy          int
+	Reconnecting     int
+	Degraded         int
+	PerCluster       map[string]int
+	PerMode          map[string]int
+	WatchConnections int
+	ResourceWatchers int
+	Breakers         map[string]BreakerState
+}
+
+// Mute suppresses the faults of a resource on a cluster until the given time, e.g. while
+// it is under planned maintenance. Muted faults are not sent to any faults subscription,
+// forwarded to sinks, or kept in the fault history. Namespace is empty for cluster-scoped
+// resources like nodes. Muting a muted resource again replaces its expiry; the mute is
+// dropped once until has passed.
+func (m *EventSubscriptionManager) Mute(cluster, kind, namespace, name string, until time.Time) {
+	m.mutes.mute(faultMuteKey{cluster: cluster, kind: kind, namespace: namespace, name: name}, until)
+	klog.V(1).Infof("Muted faults for %s %s on cluster %s until %s", kind, resourceRef(namespace, name), cluster, until.Format(time.RFC3339))
+}
+
+// Unmute lifts the mute of a resource before it expires. Unmuting a resource that isn't
+// muted has no effect.
+func (m *EventSubscriptionManager) Unmute(cluster, kind, namespace, name string) {
+	m.mutes.unmute(faultMuteKey{cluster: cluster, kind: kind, namespace: namespace, name: name})
+	klog.V(1).Infof("Unmuted faults for %s %s on cluster %s", kind, resourceRef(namespace, name), cluster)
+}
+
+// ActiveMutes returns the mutes that haven't expired, ordered by cluster, kind, namespace, and name.
+func (m *EventSubscriptionManager) ActiveMutes() []FaultMute {
+	return m.mutes.active(m.clock.Now())
+}
+
+// SubsystemHealth reports the health of the events subsystem, e.g. to back a readiness probe.
+func (m *EventSubscriptionManager) SubsystemHealth() HealthReport {
+	m.mu.RLock()
+	report := HealthReport{
+		SessionMonitorRunning: m.monitorRunning.Load(),
+		Subscriptions:         len(m.subscriptions),
+	}
+	for _, sub := range m.subscriptions {
+		if sub.isDegraded() {
+			report.DegradedSubscriptions++
+		}
+	}
+	m.mu.RUnlock()
+
+	for _, state := range m.breakers.states() {
+		if state == BreakerOpen {
+			report.OpenBreakers++
+		}
+	}
+	if last := m.lastNotification.Load(); last != 0 {
+		report.LastNotification = time.Unix(0, last)
+	}
+	report.Ready = report.SessionMonitorRunning && report.OpenBreakers == 0
+	return report
+}
+
+// HealthReport summarizes the health of the events subsystem.
+// Ready is set when the session monitor is running and no cluster's circuit breaker is open;
+// degraded subscriptions don't affect it, as they usually stem from a single session's
+// permissions rather than the server. OpenBreakers counts the clusters whose event watches
+// are paused by their circuit breaker, and LastNotification is the time the last
+// notification was delivered to a session, zero if none was.
+type HealthReport struct {
+	Ready                 bool
+	SessionMonitorRunning bool
+	Subscriptions         int
+	DegradedSubscriptions int
+	OpenBreakers          int
+	LastNotification      time.Time
 }
 
 // cancelSessionLocked cancels all subscriptions for a session. Must be called with lock held.
 func (m *EventSubscriptionManager) cancelSessionLocked(sessionID string) {
-	subIDs := m.bySession[sessionID]
-	for _, subID := range subIDs {
+	for subID := range m.bySession[sessionID] {
 		if sub, exists := m.subscriptions[subID]; exists {
 			m.cancelSubscriptionLocked(sub)
 		}
@@ -276,57 +1064,141 @@ func (m *EventSubscriptionManager) cancelSubscriptionLocked(sub *Subscription) {
 	// Remove from indices
 	delete(m.subscriptions, sub.ID)
 
-	// Remove from session index
-	sessionSubs := m.bySession[sub.SessionID]
-	for i, id := range sessionSubs {
-		if id == sub.ID {
-			m.bySession[sub.SessionID] = append(sessionSubs[:i], sessionSubs[i+1:]...)
-			break
-		}
+	// Remove from session and cluster indices
+	removeFromIndex(m.bySession, sub.SessionID, sub.ID)
+	removeFromIndex(m.byCluster, sub.Cluster, sub.ID)
+
+	// Drop the notifications published to the subscription's resource
+	if releaser, ok := m.notifierFor(sub).(resourceReleaser); ok {
+		releaser.Release(SubscriptionResourceURI(sub.Cluster, sub.ID))
 	}
-	if len(m.bySession[sub.SessionID]) == 0 {
-		delete(m.bySession, sub.SessionID)
+
+	klog.V(1).Infof("Cancelled subscription %s", sub.ID)
+}
+
+// addToIndex adds a subscription ID to the set stored under key, creating the set if needed.
+func addToIndex(index map[string]map[string]struct{}, key, subscriptionID string) {
+	set, exists := index[key]
+	if !exists {
+		set = make(map[string]struct{})
+		index[key] = set
 	}
+	set[subscriptionID] = struct{}{}
+}
 
-	// Remove from cluster index
-	clusterSubs := m.byCluster[sub.Cluster]
-	for i, id := range clusterSubs {
-		if id == sub.ID {
-			m.byCluster[sub.Cluster] = append(clusterSubs[:i], clusterSubs[i+1:]...)
-			break
+// removeFromIndex removes a subscription ID from the set stored under key in O(1),
+// deleting the key entirely once its set is empty.
+func removeFromIndex(index map[string]map[string]struct{}, key, subscriptionID string) {
+	set, exists := index[key]
+	if !exists {
+		return
+	}
+	delete(set, subscriptionID)
+	if len(set) == 0 {
+		delete(index, key)
+	}
+}
+
+// allowCreationLocked reports whether a session may create n subscriptions now,
+// consuming n tokens from its creation rate limiter. Each session's token bucket
+// holds MaxSubscriptionsPerMinute tokens and refills over a minute.
+// Must be called with lock held.
+func (m *EventSubscriptionManager) allowCreationLocked(sessionID string, n int) bool {
+	if m.config.MaxSubscriptionsPerMinute <= 0 {
+		return true
+	}
+
+	limiter, exists := m.creationLimiters[sessionID]
+	if !exists {
+		limiter = rate.NewLimiter(rate.Every(time.Minute/time.Duration(m.config.MaxSubscriptionsPerMinute)), m.config.MaxSubscriptionsPerMinute)
+		m.creationLimiters[sessionID] = limiter
+	}
+	return limiter.AllowN(m.clock.Now(), n)
+}
+
+// checkWatchCapacityLocked returns an error if starting the watches for a new subscription
+// would exceed MaxWatchConnections. Events-mode subscriptions only need watches for the
+// namespace scopes not already shared by other subscriptions. Must be called with lock held.
+func (m *EventSubscriptionManager) checkWatchCapacityLocked(cluster, mode string, filters SubscriptionFilters, resumeFrom string) error {
+	if m.config.MaxWatchConnections <= 0 {
+		return nil
+	}
+
+	needed := 1
+	if mode == "events" {
+		needed = 0
+		for _, namespace := range watchNamespaces(filters.Namespaces) {
+			if !m.eventMux.hasWatch(newEventWatchKey(cluster, namespace, filters, resumeFrom)) {
+				needed++
+			}
 		}
 	}
-	if len(m.byCluster[sub.Cluster]) == 0 {
-		delete(m.byCluster, sub.Cluster)
+
+	if m.watchConnectionsLocked()+needed > m.config.MaxWatchConnections {
+		return fmt.Errorf("%w (%d)", ErrWatchConnectionLimitExceeded, m.config.MaxWatchConnections)
 	}
+	return nil
+}
 
-	klog.V(1).Infof("Cancelled subscription %s", sub.ID)
+// checkResourceWatcherCapacityLocked returns an error if starting the ResourceWatcher of
+// a new faults subscription would exceed MaxResourceWatchers. ResourceWatchers aren't
+// shared between subscriptions, so each faults subscription needs its own.
+// Must be called with lock held.
+func (m *EventSubscriptionManager) checkResourceWatcherCapacityLocked(mode string) error {
+	if mode != "faults" || m.config.MaxResourceWatchers <= 0 {
+		return nil
+	}
+	if m.resourceWatchers >= m.config.MaxResourceWatchers {
+		return fmt.Errorf("%w (%d)", ErrResourceWatcherLimitExceeded, m.config.MaxResourceWatchers)
+	}
+	return nil
+}
+
+// watchConnectionsLocked returns the number of open watches: one per shared event watch
+// and one per faults-mode ResourceWatcher. Must be called with lock held.
+func (m *EventSubscriptionManager) watchConnectionsLocked() int {
+	return m.eventMux.watchCount() + m.resourceWatchers
 }
 
-// countDegradedLocked counts degraded subscriptions. Must be called with lock held.
-func (m *EventSubscriptionManager) countDegradedLocked() int {
-	count := 0
+// countSubscriptionsLocked counts subscriptions by watch health and by mode into stats.
+// Must be called with lock held.
+func (m *EventSubscriptionManager) countSubscriptionsLocked(stats *SubscriptionStats) {
 	for _, sub := range m.subscriptions {
-		if sub.Degraded {
-			count++
+		stats.PerMode[sub.Mode]++
+		switch {
+		case sub.isDegraded():
+			stats.Degraded++
+		case sub.Health == WatchHealthReconnecting:
+			stats.Reconnecting++
+		default:
+			stats.Healthy++
 		}
 	}
-	return count
 }
 
 // StartSessionMonitor starts a background goroutine that periodically checks for stale sessions.
 func (m *EventSubscriptionManager) StartSessionMonitor(ctx context.Context) {
-	ticker := time.NewTicker(m.config.SessionMonitorInterval)
+	m.monitorRunning.Store(true)
+	defer m.monitorRunning.Store(false)
+
+	ticker := m.clock.NewTicker(m.config.SessionMonitorInterval)
 	defer ticker.Stop()
 
 	for {
 		select {
 		case <-ctx.Done():
-			klog.V(1).Info("Session monitor shutting down, cancelling all subscriptions")
-			m.CancelAll()
+			klog.V(1).Info("Session monitor shutting down, draining notifications and cancelling all subscriptions")
+			drainCtx, cancel := context.WithTimeout(context.Background(), m.config.ShutdownDrainTimeout)
+			_ = m.Shutdown(drainCtx)
+			cancel()
 			return
-		case <-ticker.C:
+		case <-ticker.C():
 			m.cleanupStaleSessions()
+			m.expireSubscriptions()
+			m.cancelIdleSubscriptions()
+			if m.getK8sClient != nil {
+				m.reconcileClusters(m.invalidClusters())
+			}
 		}
 	}
 }
@@ -364,47 +1236,231 @@ func (m *EventSubscriptionManager) cleanupStaleSessions() {
 			m.cancelSessionLocked(sessionID)
 		}
 	}
+
+	// Forget creation rate limiters of sessions that are gone
+	for sessionID := range m.creationLimiters {
+		if !activeSessions[sessionID] {
+			delete(m.creationLimiters, sessionID)
+		}
+	}
 }
 
-// sendNotification sends a notification to a specific session.
-// Uses a timeout context to detect dead connections.
-func (m *EventSubscriptionManager) sendNotification(sessionID string, logger string, level mcp.LoggingLevel, data any) error {
-	// Find the target session
-	var targetSession ServerSession
-	m.server.Sessions().All(func(session ServerSession) bool {
-		if session.ID() == sessionID {
-			targetSession = session
-			return false // stop iteration
+// expireSubscriptions cancels subscriptions older than MaxSubscriptionTTL. Each affected
+// session is sent a SubscriptionErrorNotification explaining the subscription expired.
+func (m *EventSubscriptionManager) expireSubscriptions() {
+	ttl := m.config.MaxSubscriptionTTL
+	if ttl <= 0 {
+		return
+	}
+
+	now := m.clock.Now()
+	m.mu.Lock()
+	var expired []*Subscription
+	for _, sub := range m.subscriptions {
+		if now.Sub(sub.CreatedAt) >= ttl {
+			expired = append(expired, sub)
+			m.cancelSubscriptionLocked(sub)
 		}
-		return true // continue iteration
-	})
+	}
+	m.mu.Unlock()
 
-	if targetSession == nil {
-		return fmt.Errorf("session %s not found", sessionID)
+	// Notify outside the lock; the subscriptions are already cancelled
+	for _, sub := range expired {
+		klog.Infof("Subscription %s expired after %s (session %s)", sub.ID, ttl, sub.SessionID)
+		notification := &SubscriptionErrorNotification{
+			SchemaVersion:  NotificationSchemaVersion,
+			SubscriptionID: sub.ID,
+			Cluster:        sub.Cluster,
+			Error:          fmt.Sprintf("Subscription expired after reaching the maximum lifetime of %s, subscribe again to keep receiving notifications", ttl),
+			Degraded:       false,
+		}
+		if err := m.sendNotification(sub.SessionID, LoggerSubscriptionError, notification); err != nil {
+			klog.V(1).Infof("Failed to notify session %s of expired subscription %s: %v", sub.SessionID, sub.ID, err)
+		}
 	}
+}
+
+// cancelIdleSubscriptions cancels events-mode subscriptions that processed no event within
+// MaxIdleTime. Each affected session is sent a SubscriptionErrorNotification explaining the
+// subscription was idle.
+func (m *EventSubscriptionManager) cancelIdleSubscriptions() {
+	maxIdle := m.config.MaxIdleTime
+	if maxIdle <= 0 {
+		return
+	}
+
+	now := m.clock.Now()
+	m.mu.Lock()
+	var idle []*Subscription
+	for _, sub := range m.subscriptions {
+		if sub.Mode == "events" && now.Sub(sub.LastEventAt()) >= maxIdle {
+			idle = append(idle, sub)
+			m.cancelSubscriptionLocked(sub)
+		}
+	}
+	m.mu.Unlock()
+
+	// Notify outside the lock; the subscriptions are already cancelled
+	for _, sub := range idle {
+		klog.Infof("Subscription %s cancelled after %s without events (session %s)", sub.ID, maxIdle, sub.SessionID)
+		notification := &SubscriptionErrorNotification{
+			SchemaVersion:  NotificationSchemaVersion,
+			SubscriptionID: sub.ID,
+			Cluster:        sub.Cluster,
+			Error:          fmt.Sprintf("Subscription cancelled after receiving no events for %s, check its filters and subscribe again", maxIdle),
+			Degraded:       false,
+		}
+		if err := m.sendNotification(sub.SessionID, LoggerSubscriptionError, notification); err != nil {
+			klog.V(1).Infof("Failed to notify session %s of idle subscription %s: %v", sub.SessionID, sub.ID, err)
+		}
+	}
+}
+
+// invalidClusters checks every cluster with subscriptions against getK8sClient and
+// returns the clusters a client can no longer be obtained for. Clusters gaining
+// subscriptions after the snapshot are not checked and so never reported.
+func (m *EventSubscriptionManager) invalidClusters() map[string]bool {
+	m.mu.RLock()
+	clusters := make([]string, 0, len(m.byCluster))
+	for cluster := range m.byCluster {
+		clusters = append(clusters, cluster)
+	}
+	m.mu.RUnlock()
+
+	invalid := make(map[string]bool)
+	for _, cluster := range clusters {
+		if _, err := m.getK8sClient(cluster); err != nil {
+			klog.V(1).Infof("Cluster %s is no longer valid: %v", cluster, err)
+			invalid[cluster] = true
+		}
+	}
+	return invalid
+}
+
+// reconcileClusters cancels subscriptions for the clusters in invalidClusters, covering
+// clusters that were removed without CancelCluster being called. Each affected session
+// is sent a SubscriptionErrorNotification explaining the cluster was removed.
+func (m *EventSubscriptionManager) reconcileClusters(invalidClusters map[string]bool) {
+	m.mu.Lock()
+	var removed []*Subscription
+	for cluster := range invalidClusters {
+		subIDs := m.byCluster[cluster]
+		klog.Infof("Cancelling %d subscriptions for removed cluster %s", len(subIDs), cluster)
+		for subID := range subIDs {
+			if sub, exists := m.subscriptions[subID]; exists {
+				removed = append(removed, sub)
+				m.cancelSubscriptionLocked(sub)
+			}
+		}
+		m.faultHistory.Clear(cluster)
+		m.breakers.remove(cluster)
+	}
+	m.mu.Unlock()
+
+	// Notify outside the lock; the subscriptions are already cancelled
+	for _, sub := range removed {
+		notification := &SubscriptionErrorNotification{
+			SchemaVersion:  NotificationSchemaVersion,
+			SubscriptionID: sub.ID,
+			Cluster:        sub.Cluster,
+			Error:          fmt.Sprintf("Cluster %s was removed, subscription cancelled", sub.Cluster),
+			Degraded:       false,
+		}
+		if err := m.sendNotification(sub.SessionID, LoggerSubscriptionError, notification); err != nil {
+			klog.V(1).Infof("Failed to notify session %s of removed cluster %s: %v", sub.SessionID, sub.Cluster, err)
+		}
+	}
+}
+
+// notifierFor returns the notifier delivering a subscription's notifications, as
+// selected by its delivery mode.
+func (m *EventSubscriptionManager) notifierFor(sub *Subscription) Notifier {
+	if sub.Filters.Delivery == DeliveryResource && m.resources != nil {
+		return m.resources
+	}
+	return m.notifier
+}
+
+// sendNotification sends a notification to a specific session as a log message, whatever
+// the delivery mode of the subscription it is about. Uses a timeout context to detect
+// dead connections.
+func (m *EventSubscriptionManager) sendNotification(sessionID string, channel string, data any) error {
+	_, err := m.deliverNotification(m.notifier, sessionID, channel, data)
+	return err
+}
+
+// SendTestNotification sends a synthetic notification to a session to verify it can
+// receive notifications. Returns an error wrapping ErrSessionNotFound if the session
+// doesn't exist, or ErrNotificationDropped if the notification wasn't sent, typically
+// because the client hasn't enabled logging at info level with logging/setLevel.
+func (m *EventSubscriptionManager) SendTestNotification(sessionID string) error {
+	notification := &TestNotification{
+		Message:   "Test notification: this session can receive event notifications",
+		Timestamp: formatTimestamp(m.clock.Now()),
+	}
+
+	delivered, err := m.deliverNotification(m.notifier, sessionID, LoggerTestNotification, notification)
+	if err != nil {
+		return err
+	}
+	if !delivered {
+		return fmt.Errorf("%w: session %s has not enabled logging at info level (logging/setLevel) or the server is shutting down", ErrNotificationDropped, sessionID)
+	}
+	return nil
+}
+
+// deliverNotification sends a notification to a specific session with notifier and reports
+// whether it was delivered, rather than dropped because of the session's log level or shutdown.
+// Uses a timeout context to detect dead connections.
+func (m *EventSubscriptionManager) deliverNotification(notifier Notifier, sessionID string, channel string, data any) (bool, error) {
+	// Drop new notifications once shutdown has started
+	if !m.beginNotification() {
+		klog.V(2).Infof("Dropping notification to session %s: manager is shutting down", sessionID)
+		return false, nil
+	}
+	defer m.inFlight.Done()
 
 	// Use a short timeout to detect dead connections
 	// If the SSE connection is dead, this should fail quickly
-	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	ctx, cancel := context.WithTimeout(context.Background(), m.config.NotificationTimeout)
 	defer cancel()
 
-	err := targetSession.Log(ctx, &mcp.LoggingMessageParams{
-		Level:  level,
-		Logger: logger,
-		Data:   data,
-	})
+	err := notifier.Notify(ctx, sessionID, channel, data)
+	if errors.Is(err, ErrNotificationDropped) {
+		klog.V(2).Infof("Notification to session %s dropped (channel=%s): %v", sessionID, channel, err)
+		return false, nil
+	}
+	if errors.Is(err, ErrSessionNotFound) {
+		return false, err
+	}
 	if err != nil {
 		klog.Warningf("Failed to send notification to session %s: %v", sessionID, err)
-		return err
+		return false, err
 	}
 
+	m.lastNotification.Store(m.clock.Now().UnixNano())
+
 	// Include faultId in log for fault notifications to aid debugging
 	if faultNotif, ok := data.(*ResourceFaultNotification); ok && faultNotif.FaultID != "" {
-		klog.V(1).Infof("Sent notification to session %s (logger=%s, level=%s, faultId=%s)", sessionID, logger, level, faultNotif.FaultID)
+		klog.V(1).Infof("Sent notification to session %s (channel=%s, faultId=%s)", sessionID, channel, faultNotif.FaultID)
 	} else {
-		klog.V(1).Infof("Sent notification to session %s (logger=%s, level=%s)", sessionID, logger, level)
+		klog.V(1).Infof("Sent notification to session %s (channel=%s)", sessionID, channel)
 	}
-	return nil
+	return true, nil
+}
+
+// sendTracedNotification sends a notification with notifier inside a child span of ctx,
+// recording the send error (if any) on the span.
+func (m *EventSubscriptionManager) sendTracedNotification(ctx context.Context, notifier Notifier, sessionID string, channel string, data any, attrs ...attribute.KeyValue) error {
+	attrs = append(attrs, AttrSessionID.String(sessionID), AttrLogger.String(channel))
+	_, span := startSpan(ctx, m.tracer, SpanSendNotification, attrs...)
+	defer span.End()
+
+	_, err := m.deliverNotification(notifier, sessionID, channel, data)
+	if err != nil {
+		recordSpanError(span, err)
+	}
+	return err
 }
 
 // generateSubscriptionID generates a unique subscription ID.
@@ -427,9 +1483,11 @@ func generateSubscriptionID() string {
 //
 // Returns:
 //   - The current resource version as a string (may be empty if no events exist)
-//   - An error if the List operation fails
+//   - An error if every List attempt fails
 //
-// The operation has a 5-second timeout to prevent hanging on unavailable API servers.
+// A failed List is retried up to resourceVersionAttempts times with a doubling delay, so a
+// momentary API server blip doesn't fail subscription creation. All attempts share a 5-second
+// timeout to prevent hanging on unavailable API servers.
 func (m *EventSubscriptionManager) getCurrentResourceVersion(clientset kubernetes.Interface, namespace string) (string, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
@@ -438,22 +1496,30 @@ func (m *EventSubscriptionManager) getCurrentResourceVersion(clientset kubernete
 		Limit: 1, // We only need the resource version, not the actual events
 	}
 
-	var list *v1.EventList
+	// An empty namespace lists cluster-wide events
 	var err error
-
-	if namespace == "" {
-		// Cluster-wide watch
-		list, err = clientset.CoreV1().Events(metav1.NamespaceAll).List(ctx, opts)
-	} else {
-		// Namespace-scoped watch
+	delay := resourceVersionRetryDelay
+	for attempt := 1; ; attempt++ {
+		var list *v1.EventList
 		list, err = clientset.CoreV1().Events(namespace).List(ctx, opts)
-	}
+		if err == nil {
+			return list.ResourceVersion, nil
+		}
+		if attempt == resourceVersionAttempts {
+			break
+		}
 
-	if err != nil {
-		return "", fmt.Errorf("failed to list events: %w", err)
+		klog.V(2).Infof("Listing events for resource version (namespace=%q) failed on attempt %d/%d, retrying in %s: %v",
+			namespace, attempt, resourceVersionAttempts, delay, err)
+		select {
+		case <-m.clock.After(delay):
+		case <-ctx.Done():
+			return "", fmt.Errorf("failed to list events: %w", err)
+		}
+		delay *= 2
 	}
 
-	return list.ResourceVersion, nil
+	return "", fmt.Errorf("failed to list events after %d attempts: %w", resourceVersionAttempts, err)
 }
 
 // startWatcher starts an EventWatcher or ResourceWatcher for the given subscription.
@@ -469,99 +1535,252 @@ func (m *EventSubscriptionManager) startWatcher(sub *Subscription) error {
 		return fmt.Errorf("failed to get kubernetes client: %w", err)
 	}
 
-	// The Kubernetes client embeds kubernetes.Interface directly
-	clientset := k8s
+	// Don't add load to a cluster whose watches keep failing; the caller can retry later
+	if m.breakers.forCluster(sub.Cluster).State() == BreakerOpen {
+		return fmt.Errorf("watch attempts on cluster %s are paused after repeated failures, retry in %s", sub.Cluster, m.config.WatchBreakerCooldown)
+	}
+
+	// Use the embedded kubernetes.Interface directly, so informers can detect
+	// client capabilities (e.g. watch-list support) of the underlying clientset
+	clientset := k8s.Interface
 
 	// Create context for the watcher
 	ctx, cancel := context.WithCancel(context.Background())
 	sub.Cancel = cancel
 
+	// Send notifications from a dedicated goroutine so a slow client can't stall the watcher
+	m.startDelivery(ctx, sub)
+
 	// Handle faults mode differently (uses ResourceWatcher)
 	if sub.Mode == "faults" {
-		return m.startResourceWatcher(ctx, sub, clientset)
+		if err := m.startResourceWatcher(ctx, sub, clientset, k8s.DynamicClient()); err != nil {
+			return err
+		}
+		m.resourceWatchers++
+		var once sync.Once
+		sub.Cancel = func() {
+			cancel()
+			// Cancel is only called with the lock held
+			once.Do(func() { m.resourceWatchers-- })
+		}
+		return nil
+	}
+
+	// Join the shared watch for each namespace scope; filters are applied per subscription
+	subscriber := &eventSubscriber{
+		sub:     sub,
+		process: m.makeProcessEventFunc(ctx, sub, m.newOwnerCache(k8s)),
+		onHealthChange: func(health WatchHealth, err error) {
+			m.setSubscriptionHealth(sub.ID, health, err)
+		},
+	}
+	// Events don't carry their involved object's labels, so fetch them for label selectors
+	if sub.Filters.LabelSelector != "" && k8s.DynamicClient() != nil {
+		subscriber.labels = newObjectLabelCache(NewDynamicLabelResolver(k8s.DynamicClient(), k8s.RESTMapper()), DefaultLabelCacheTTL)
+	}
+	if sub.Filters.FirstOccurrenceOnly {
+		subscriber.occurrences = newFirstOccurrenceCache(firstOccurrenceMaxEntries)
+	}
+	namespaces := watchNamespaces(sub.Filters.Namespaces)
+	unsubscribes := make([]func(), 0, len(namespaces))
+	unsubscribeAll := func() {
+		for _, unsubscribe := range unsubscribes {
+			unsubscribe()
+		}
+	}
+	for _, namespace := range namespaces {
+		key := newEventWatchKey(sub.Cluster, namespace, sub.Filters, sub.ResumeFrom)
+		unsubscribe, err := m.eventMux.subscribe(key, subscriber, func(watchCtx context.Context, dispatch func(context.Context, *v1.Event), onHealthChange func(WatchHealth, error)) error {
+			return m.startSharedEventWatch(watchCtx, key, clientset, dispatch, onHealthChange)
+		})
+		if err != nil {
+			cancel()
+			unsubscribeAll()
+			return err
+		}
+		unsubscribes = append(unsubscribes, unsubscribe)
 	}
+	sub.Cancel = func() {
+		cancel()
+		unsubscribeAll()
+	}
+
+	klog.V(1).Infof("Started watcher for subscription %s (cluster=%s, mode=%s, namespaces=%v)", sub.ID, sub.Cluster, sub.Mode, namespaces)
 
-	// Create deduplication cache for events mode
-	// Note: mode="faults" uses ResourceWatcher which has its own deduplication
-	var dedupCache *DeduplicationCache
-	if sub.Mode == "events" {
-		dedupCache = NewDeduplicationCache(m.config.EventDeduplicationWindow)
+	return nil
+}
+
+// watchNamespaces returns the namespace scopes to watch for a namespace filter.
+// Up to maxNamespaceScopedWatches namespaces are each watched with a namespace-scoped
+// watch, and their events merged; no namespaces, or more than that, use a single
+// cluster-wide watch (the empty namespace) with client-side filtering.
+func watchNamespaces(namespaces []string) []string {
+	var scoped []string
+	seen := make(map[string]bool, len(namespaces))
+	for _, namespace := range namespaces {
+		if !seen[namespace] {
+			seen[namespace] = true
+			scoped = append(scoped, namespace)
+		}
 	}
 
-	// Determine namespace for watcher
-	namespace := ""
-	if len(sub.Filters.Namespaces) == 1 {
-		// Single namespace: use namespace-scoped watch
-		namespace = sub.Filters.Namespaces[0]
+	if len(scoped) == 0 || len(scoped) > maxNamespaceScopedWatches {
+		return []string{metav1.NamespaceAll}
 	}
-	// Multiple namespaces or empty: use cluster-wide watch with client-side filtering
+	return scoped
+}
 
-	// Get current resource version to start from "now" and skip historical events
-	initialResourceVersion, err := m.getCurrentResourceVersion(clientset, namespace)
-	if err != nil {
-		return fmt.Errorf("failed to get current resource version: %w", err)
+// startSharedEventWatch starts the EventWatcher backing a shared event watch.
+// The watcher applies no filters of its own; the multiplexer filters per subscriber.
+func (m *EventSubscriptionManager) startSharedEventWatch(ctx context.Context, key eventWatchKey, clientset kubernetes.Interface, dispatch func(context.Context, *v1.Event), onHealthChange func(WatchHealth, error)) error {
+	// Get current resource version to start from "now" and skip historical events,
+	// unless the watch resumes from an earlier one
+	breaker := m.breakers.forCluster(key.cluster)
+	initialResourceVersion := key.resumeFrom
+	if initialResourceVersion == "" {
+		var err error
+		initialResourceVersion, err = m.getCurrentResourceVersion(clientset, key.namespace)
+		if err != nil {
+			breaker.RecordFailure()
+			return fmt.Errorf("failed to get current resource version: %w", err)
+		}
+	}
+	involvedObjectFilters := key.involvedObject.filters()
+	klog.V(1).Infof("Starting shared event watch (cluster=%s, namespace=%q, eventLabelSelector=%q, involvedObject=%q) from resource version %s (filtering historical events)",
+		key.cluster, key.namespace, key.eventLabelSelector, involvedObjectFilters.GetInvolvedObjectFieldSelector(), initialResourceVersion)
+
+	// Only selectors shared by every subscriber can be pushed to the server; each
+	// subscriber's own filters are applied when events are dispatched
+	var watchFilters *SubscriptionFilters
+	if key.eventLabelSelector != "" || key.involvedObject != (involvedObjectSelector{}) {
+		watchFilters = &involvedObjectFilters
+		watchFilters.EventLabelSelector = key.eventLabelSelector
 	}
-	klog.V(1).Infof("Starting subscription %s from resource version %s (filtering historical events)", sub.ID, initialResourceVersion)
 
-	// Create the event watcher
+	// The sweeper reclaiming expired keys stops with the shared watch
+	dedupCache := NewDeduplicationCache(m.config.EventDeduplicationWindow)
+	dedupCache.clock = m.clock
+	dedupCache.Start(ctx)
 	watcher := NewEventWatcher(EventWatcherConfig{
 		Clientset:              clientset,
-		Namespace:              namespace,
-		Filters:                &sub.Filters,
+		Namespace:              key.namespace,
+		Filters:                watchFilters,
 		MaxRetries:             m.config.WatchReconnectMaxRetries,
+		Breaker:                breaker,
 		InitialResourceVersion: initialResourceVersion,
+		// An expired resource version restarts from "now" too, not from every existing event
+		CurrentResourceVersion: func() (string, error) {
+			return m.getCurrentResourceVersion(clientset, key.namespace)
+		},
 		OnError: func(err error) {
-			klog.Warningf("Watch error for subscription %s: %v", sub.ID, err)
+			klog.Warningf("Watch error for shared event watch (cluster=%s, namespace=%q): %v", key.cluster, key.namespace, err)
+		},
+		OnReconnecting: func() {
+			onHealthChange(WatchHealthReconnecting, nil)
 		},
-		OnDegraded: func() {
-			m.markSubscriptionDegraded(sub.ID)
+		OnReconnected: func() {
+			onHealthChange(WatchHealthHealthy, nil)
 		},
-		DedupCache:   dedupCache,
-		ProcessEvent: m.makeProcessEventFunc(ctx, sub, k8s),
+		OnDegraded: func(err error) {
+			onHealthChange(WatchHealthDegraded, err)
+		},
+		DedupCache:           dedupCache,
+		Clock:                m.clock,
+		ProcessEvent:         dispatch,
+		IncludeModifications: &key.includeModifications,
+		Tracer:               m.tracer,
+		SpanAttributes:       []attribute.KeyValue{AttrCluster.String(key.cluster)},
 	})
 
 	// Start the watcher in the background
 	watcher.Start(ctx)
-
-	klog.V(1).Infof("Started watcher for subscription %s (cluster=%s, mode=%s, namespace=%s)", sub.ID, sub.Cluster, sub.Mode, namespace)
-
 	return nil
 }
 
 // startResourceWatcher starts a ResourceWatcher for resource-based fault detection.
 // This is called for subscriptions with mode="faults".
-func (m *EventSubscriptionManager) startResourceWatcher(ctx context.Context, sub *Subscription, clientset kubernetes.Interface) error {
-	// Use the detectors provided to the manager
-	// If no detectors are configured, return an error
-	if len(m.detectors) == 0 {
+func (m *EventSubscriptionManager) startResourceWatcher(ctx context.Context, sub *Subscription, clientset kubernetes.Interface, dynamicClient dynamic.Interface) error {
+	// Build fresh detectors from the registry, narrowed to the subscription's selection
+	// If no detectors are registered, return an error
+	detectors, err := m.detectors.Build(sub.Filters.DetectorTypes)
+	if err != nil {
+		return err
+	}
+	if len(detectors) == 0 {
 		return fmt.Errorf("no fault detectors configured for faults mode")
 	}
 
 	// Create the resource watcher with fault signal callback
-	watcher := NewResourceWatcher(ResourceWatcherConfig{
-		Clientset:      clientset,
-		Cluster:        sub.Cluster,
-		ResyncPeriod:   10 * time.Minute,
-		Detectors:      m.detectors,
-		SignalCallback: m.makeFaultSignalCallback(sub),
+	watcher, err := NewResourceWatcher(ResourceWatcherConfig{
+		Clientset:        clientset,
+		DynamicClient:    dynamicClient,
+		Cluster:          sub.Cluster,
+		ResyncPeriod:     DefaultResyncPeriod,
+		Detectors:        detectors,
+		Deduplicator:     NewFaultDeduplicatorWithTTL(m.config.FaultDeduplicationWindow),
+		Enricher:         NewFaultContextEnricher().WithRelatedEvents(m.config.MaxRelatedEventsPerFault).WithLimiter(m.enrichments),
+		SignalCallback:   m.makeFaultSignalCallback(sub),
+		Tracer:           m.tracer,
+		SpanAttributes:   subscriptionAttributes(sub),
+		MaxContextBytes:  m.config.MaxFaultContextBytes,
+		SendInitialState: sub.Filters.SendInitialState,
+		LabelSelector:    sub.Filters.LabelSelector,
+		NamespaceScope:   sub.Filters.GetNamespaceFilter(),
 	})
+	if err != nil {
+		return fmt.Errorf("failed to create resource watcher: %w", err)
+	}
 
 	// Start the watcher
-	err := watcher.Start(ctx)
+	err = watcher.Start(ctx)
 	if err != nil {
+		watcher.Stop()
 		return fmt.Errorf("failed to start resource watcher: %w", err)
 	}
 
+	// Tear down the informers once the subscription is cancelled. Stop waits for the
+	// informers to exit, so it runs outside the lock Cancel is called with.
+	go func() {
+		<-ctx.Done()
+		watcher.Stop()
+	}()
+
 	klog.V(1).Infof("Started resource watcher for subscription %s (cluster=%s)", sub.ID, sub.Cluster)
 	return nil
 }
 
 // makeFaultSignalCallback creates a callback function for processing fault signals.
-// This callback is invoked by ResourceWatcher when a fault is detected.
+// This callback is invoked by ResourceWatcher when a fault is detected. Faults of muted
+// resources are dropped, as are faults of namespaced resources outside the subscription's
+// namespaces, which the ResourceWatcher can only scope to a single one. Faults for the
+// same resource within FaultCoalescingWindow are sent as a single notification.
 func (m *EventSubscriptionManager) makeFaultSignalCallback(sub *Subscription) FaultSignalCallback {
-	return func(signal FaultSignal) {
-		// Determine APIVersion based on Kind
-		apiVersion := ""
+	coalescer := newFaultCoalescer(m.config.FaultCoalescingWindow, m.clock, func(ctx context.Context, signals []FaultSignal) {
+		m.notifyFaults(ctx, sub, signals)
+	})
+	return func(ctx context.Context, signal FaultSignal) {
+		if signal.Namespace != "" && len(sub.Filters.Namespaces) > 0 && !slices.Contains(sub.Filters.Namespaces, signal.Namespace) {
+			return
+		}
+		key := faultMuteKey{cluster: sub.Cluster, kind: signal.Kind, namespace: signal.Namespace, name: signal.Name}
+		if m.mutes.muted(key, m.clock.Now()) {
+			klog.V(2).Infof("Suppressed muted fault signal: %s for %s %s on cluster %s",
+				signal.FaultType, signal.Kind, resourceRef(signal.Namespace, signal.Name), sub.Cluster)
+			return
+		}
+		coalescer.add(ctx, signal)
+	}
+}
+
+// notifyFaults sends a single fault notification for one or more signals on the same
+// resource. The most severe signal determines the notification's fault type, ID, and
+// severity; when several signals are combined, all fault types are listed.
+func (m *EventSubscriptionManager) notifyFaults(ctx context.Context, sub *Subscription, signals []FaultSignal) {
+	signal := primaryFault(signals)
+
+	// Determine APIVersion based on Kind unless the detector reported it
+	apiVersion := signal.APIVersion
+	if apiVersion == "" {
 		switch signal.Kind {
 		case "Pod":
 			apiVersion = "v1"
@@ -571,96 +1790,177 @@ func (m *EventSubscriptionManager) makeFaultSignalCallback(sub *Subscription) Fa
 			apiVersion = "apps/v1"
 		case "Job":
 			apiVersion = "batch/v1"
+		case "EndpointSlice":
+			apiVersion = "discovery.k8s.io/v1"
+		case "Service", "PersistentVolume":
+			apiVersion = "v1"
 		}
+	}
 
-		// Build notification
-		notification := &ResourceFaultNotification{
-			SubscriptionID: sub.ID,
-			Cluster:        sub.Cluster,
-			FaultID:        GenerateFaultID(sub.Cluster, signal.FaultType, signal.ResourceUID, signal.ContainerName),
-			FaultType:      signal.FaultType,
-			Severity:       signal.Severity,
-			Resource: &ResourceReference{
-				APIVersion: apiVersion,
-				Kind:       signal.Kind,
-				Name:       signal.Name,
-				Namespace:  signal.Namespace,
-				UID:        string(signal.ResourceUID),
-			},
-			Context:   signal.Context,
-			Timestamp: formatTimestamp(signal.Timestamp),
-		}
-
-		// Send notification
-		err := m.sendNotification(sub.SessionID, LoggerFaults, mcp.LoggingLevel("warning"), notification)
-		if err != nil {
-			// Any error sending notification means the session is dead - cancel immediately
-			klog.V(1).Infof("Session %s unreachable (error: %v), cancelling subscription %s", sub.SessionID, err, sub.ID)
-			go func() {
-				if cancelErr := m.CancelBySessionAndID(sub.SessionID, sub.ID); cancelErr != nil {
-					klog.V(2).Infof("Failed to auto-cancel subscription %s: %v", sub.ID, cancelErr)
-				}
-			}()
-		}
+	// Keep the faults available for sessions that subscribe later
+	for _, faultSignal := range signals {
+		m.faultHistory.Record(sub.Cluster, faultSignal)
+	}
+
+	// Build notification
+	notification := &ResourceFaultNotification{
+		SchemaVersion:  NotificationSchemaVersion,
+		SubscriptionID: sub.ID,
+		Cluster:        sub.Cluster,
+		FaultID:        GenerateFaultID(sub.Cluster, signal.FaultType, signal.ResourceUID, signal.ContainerName),
+		FaultType:      signal.FaultType,
+		Severity:       signal.Severity,
+		Resource: &ResourceReference{
+			APIVersion: apiVersion,
+			Kind:       signal.Kind,
+			Name:       signal.Name,
+			Namespace:  signal.Namespace,
+			UID:        string(signal.ResourceUID),
+		},
+		Context:       coalescedContext(signals),
+		Details:       signal.Details,
+		RelatedEvents: signal.RelatedEvents,
+		Timestamp:     formatTimestamp(signal.Timestamp),
+	}
+	if len(signals) > 1 {
+		notification.FaultTypes = coalescedFaultTypes(signals)
+	}
+	if m.config.FaultContextYAML {
+		notification.ContextYAML = formatDetailsYAML(signal.Details)
+	}
+
+	// Forward every emitted fault to external sinks, whether or not history recorded it
+	m.forwardToSinks(notification)
+
+	// Send notification
+	m.queueNotification(ctx, sub, m.faultLogger(signal), notification,
+		AttrFaultType.String(string(signal.FaultType)), AttrFaultID.String(notification.FaultID))
+}
+
+// faultLogger returns the logger a fault notification is sent on: the logger configured
+// for its fault type, then the one configured for its severity, and LoggerFaults otherwise.
+func (m *EventSubscriptionManager) faultLogger(signal FaultSignal) string {
+	if logger, ok := m.config.FaultTypeLoggers[signal.FaultType]; ok && logger != "" {
+		return logger
+	}
+	if logger, ok := m.config.FaultLoggers[signal.Severity]; ok && logger != "" {
+		return logger
+	}
+	return LoggerFaults
+}
+
+// recordNotificationResult tracks consecutive notification failures for a subscription.
+// The subscription is cancelled once MaxNotificationFailures sends in a row have failed;
+// a successful send resets the count so a single transient failure is tolerated.
+func (m *EventSubscriptionManager) recordNotificationResult(sub *Subscription, err error) {
+	if err == nil {
+		sub.notificationFailures.Store(0)
+		return
+	}
+
+	failures := int(sub.notificationFailures.Add(1))
+	if failures < m.config.MaxNotificationFailures {
+		klog.V(1).Infof("Notification for subscription %s failed (%d/%d consecutive failures): %v", sub.ID, failures, m.config.MaxNotificationFailures, err)
+		return
 	}
+
+	// Repeated failures mean the session is dead - cancel the subscription
+	klog.V(1).Infof("Session %s unreachable after %d consecutive failures (error: %v), cancelling subscription %s", sub.SessionID, failures, err, sub.ID)
+	go func() {
+		if cancelErr := m.CancelBySessionAndID(sub.SessionID, sub.ID); cancelErr != nil {
+			klog.V(2).Infof("Failed to auto-cancel subscription %s: %v", sub.ID, cancelErr)
+		}
+	}()
 }
 
 // makeProcessEventFunc creates a callback function for processing events in events mode
-func (m *EventSubscriptionManager) makeProcessEventFunc(ctx context.Context, sub *Subscription, k8s *pkgkubernetes.Kubernetes) func(*v1.Event) {
+// A nil owners cache leaves the involved object's owner out of notifications.
+func (m *EventSubscriptionManager) makeProcessEventFunc(ctx context.Context, sub *Subscription, owners *objectOwnerCache) func(context.Context, *v1.Event) {
 	// Events mode: send event notification directly
-	return func(event *v1.Event) {
+	return func(eventCtx context.Context, event *v1.Event) {
+		sub.lastEventAt.Store(m.clock.Now().UnixNano())
+
+		details := SerializeEvent(event)
+		details.Owner = owners.ownerFor(eventCtx, event)
+		sub.replay.record(details)
 		notification := &EventNotification{
+			SchemaVersion:  NotificationSchemaVersion,
 			SubscriptionID: sub.ID,
 			Cluster:        sub.Cluster,
-			Event:          SerializeEvent(event),
+			Event:          details,
 		}
 
-		err := m.sendNotification(sub.SessionID, LoggerEvents, mcp.LoggingLevel("info"), notification)
-		if err != nil {
-			// Any error sending notification means the session is dead - cancel immediately
-			klog.V(1).Infof("Session %s unreachable (error: %v), cancelling subscription %s", sub.SessionID, err, sub.ID)
-			go func() {
-				if cancelErr := m.CancelBySessionAndID(sub.SessionID, sub.ID); cancelErr != nil {
-					klog.V(2).Infof("Failed to auto-cancel subscription %s: %v", sub.ID, cancelErr)
-				}
-			}()
-		}
+		m.queueNotification(eventCtx, sub, LoggerEvents, notification)
 	}
 }
 
-// markSubscriptionDegraded marks a subscription as degraded
-func (m *EventSubscriptionManager) markSubscriptionDegraded(subscriptionID string) {
+// newOwnerCache returns a cache resolving involved object owners, or nil if
+// ResolveEventOwners is disabled or the client has no dynamic client.
+func (m *EventSubscriptionManager) newOwnerCache(k8s *pkgkubernetes.Kubernetes) *objectOwnerCache {
+	if !m.config.ResolveEventOwners || k8s.DynamicClient() == nil {
+		return nil
+	}
+	return newObjectOwnerCache(NewDynamicOwnerResolver(k8s.DynamicClient(), k8s.RESTMapper()), DefaultOwnerCacheTTL)
+}
+
+// setSubscriptionHealth records the watch health of a subscription.
+// Degraded is terminal: once a subscription's watch gives up, later updates are ignored.
+// err is the error a degraded watch gave up on.
+func (m *EventSubscriptionManager) setSubscriptionHealth(subscriptionID string, health WatchHealth, err error) {
+	if health == WatchHealthDegraded {
+		m.markSubscriptionDegraded(subscriptionID, err)
+		return
+	}
+
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
 	sub, exists := m.subscriptions[subscriptionID]
-	if !exists {
+	if !exists || sub.Degraded || sub.Health == health {
 		return
 	}
 
-	if !sub.Degraded {
-		sub.Degraded = true
-		klog.Warningf("Subscription %s marked as degraded", subscriptionID)
+	klog.V(1).Infof("Subscription %s watch health changed from %s to %s", subscriptionID, sub.Health, health)
+	sub.Health = health
+}
 
-		// Send degraded notification to the session
-		notification := &SubscriptionErrorNotification{
-			SubscriptionID: subscriptionID,
-			Cluster:        sub.Cluster,
-			Error:          "Watch connection failed after maximum retry attempts",
-			Degraded:       true,
-		}
+// markSubscriptionDegraded marks a subscription as degraded because its watch gave up
+// with cause, which may be nil. The session is notified through the subscription's
+// notification queue, like its events and faults.
+func (m *EventSubscriptionManager) markSubscriptionDegraded(subscriptionID string, cause error) {
+	m.mu.Lock()
+	sub, exists := m.subscriptions[subscriptionID]
+	if !exists || sub.Degraded {
+		m.mu.Unlock()
+		return
+	}
+	sub.Degraded = true
+	sub.Health = WatchHealthDegraded
+	m.mu.Unlock()
+	klog.Warningf("Subscription %s marked as degraded", subscriptionID)
+
+	// Send degraded notification to the session
+	notification := &SubscriptionErrorNotification{
+		SchemaVersion:  NotificationSchemaVersion,
+		SubscriptionID: subscriptionID,
+		Cluster:        sub.Cluster,
+		Error:          degradedMessage(cause),
+		Degraded:       true,
+	}
+	m.queueNotification(context.Background(), sub, LoggerSubscriptionError, notification)
+}
 
-		err := m.sendNotification(sub.SessionID, LoggerSubscriptionError, mcp.LoggingLevel("warning"), notification)
-		if err != nil {
-			// Any error sending notification means the session is dead - cancel immediately
-			klog.V(1).Infof("Session %s unreachable (error: %v), cancelling subscription %s", sub.SessionID, err, subscriptionID)
-			go func() {
-				if cancelErr := m.CancelBySessionAndID(sub.SessionID, subscriptionID); cancelErr != nil {
-					klog.V(2).Infof("Failed to auto-cancel subscription %s: %v", subscriptionID, cancelErr)
-				}
-			}()
-		}
+// degradedMessage returns the error reported to a session when a subscription's watch
+// gives up with cause, the last watch error, so the session sees why (e.g. the
+// connection error) rather than only that retries ran out.
+func degradedMessage(cause error) string {
+	if errors.Is(cause, errWatchForbidden) {
+		return fmt.Sprintf("Watch is forbidden, check the RBAC permissions to watch events (retrying won't help until they are granted): %v", cause)
 	}
+	if cause != nil {
+		return fmt.Sprintf("Watch connection failed after maximum retry attempts: %v", cause)
+	}
+	return "Watch connection failed after maximum retry attempts"
 }
 
 // ManagerAdapter adapts EventSubscriptionManager to the api.EventSubscriptionManager interface.
@@ -670,15 +1970,20 @@ type ManagerAdapter struct {
 }
 
 // Create adapts the Create method to use interface{} types.
-func (a *ManagerAdapter) Create(sessionID, cluster, mode string, filters interface{}) (interface{}, error) {
+func (a *ManagerAdapter) Create(sessionID, cluster, mode string, filters interface{}, resumeFrom string) (interface{}, error) {
 	subscriptionFilters, ok := filters.(SubscriptionFilters)
 	if !ok {
 		return nil, fmt.Errorf("invalid filters type: expected SubscriptionFilters")
 	}
-	return a.EventSubscriptionManager.Create(sessionID, cluster, mode, subscriptionFilters)
+	return a.EventSubscriptionManager.Create(sessionID, cluster, mode, subscriptionFilters, resumeFrom)
 }
 
 // ListSubscriptionsForSession adapts the ListSubscriptionsForSession method to return interface{}.
 func (a *ManagerAdapter) ListSubscriptionsForSession(sessionID string) interface{} {
 	return a.EventSubscriptionManager.ListSubscriptionsForSession(sessionID)
 }
+
+// GetRecentFaults adapts the GetRecentFaults method to return interface{}.
+func (a *ManagerAdapter) GetRecentFaults(cluster string, limit int) interface{} {
+	return a.EventSubscriptionManager.GetRecentFaults(cluster, limit)
+}
@@ -2,13 +2,30 @@ package events
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
+	"github.com/modelcontextprotocol/go-sdk/mcp"
 	"github.com/stretchr/testify/suite"
 	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+	clocktesting "k8s.io/utils/clock/testing"
+	"sigs.k8s.io/yaml"
+
+	pkgkubernetes "github.com/containers/kubernetes-mcp-server/pkg/kubernetes"
 )
 
 type ManagerTestSuite struct {
@@ -38,11 +55,11 @@ func (s *ManagerTestSuite) TestCreate_ReturnsUniqueID() {
 	s.Run("generates unique IDs for each subscription", func() {
 		filters := SubscriptionFilters{}
 
-		sub1, err := s.manager.Create("session1", "cluster1", "events", filters)
+		sub1, err := s.manager.Create("session1", "cluster1", "events", filters, "")
 		s.Require().NoError(err)
 		s.NotEmpty(sub1.ID)
 
-		sub2, err := s.manager.Create("session1", "cluster1", "events", filters)
+		sub2, err := s.manager.Create("session1", "cluster1", "events", filters, "")
 		s.Require().NoError(err)
 		s.NotEmpty(sub2.ID)
 
@@ -55,7 +72,7 @@ func (s *ManagerTestSuite) TestCreate_TracksBySessionAndCluster() {
 	s.Run("tracks subscription by session", func() {
 		filters := SubscriptionFilters{}
 
-		sub, err := s.manager.Create("session1", "cluster1", "events", filters)
+		sub, err := s.manager.Create("session1", "cluster1", "events", filters, "")
 		s.Require().NoError(err)
 
 		// Verify tracking by session
@@ -67,10 +84,10 @@ func (s *ManagerTestSuite) TestCreate_TracksBySessionAndCluster() {
 	s.Run("tracks subscriptions by cluster", func() {
 		filters := SubscriptionFilters{}
 
-		sub1, err := s.manager.Create("session1", "cluster1", "events", filters)
+		sub1, err := s.manager.Create("session1", "cluster1", "events", filters, "")
 		s.Require().NoError(err)
 
-		sub2, err := s.manager.Create("session2", "cluster1", "events", filters)
+		sub2, err := s.manager.Create("session2", "cluster1", "events", filters, "")
 		s.Require().NoError(err)
 
 		// Both subscriptions should be tracked under cluster1
@@ -90,10 +107,10 @@ func (s *ManagerTestSuite) TestCreate_TracksBySessionAndCluster() {
 	s.Run("tracks subscriptions across multiple sessions and clusters", func() {
 		filters := SubscriptionFilters{}
 
-		sub1, err := s.manager.Create("session1", "cluster1", "events", filters)
+		sub1, err := s.manager.Create("session1", "cluster1", "events", filters, "")
 		s.Require().NoError(err)
 
-		sub2, err := s.manager.Create("session2", "cluster2", "events", filters)
+		sub2, err := s.manager.Create("session2", "cluster2", "events", filters, "")
 		s.Require().NoError(err)
 
 		// Verify session isolation
@@ -120,14 +137,14 @@ func (s *ManagerTestSuite) TestCreate_EnforcesSessionLimit() {
 
 		// Create up to the limit (config has MaxSubscriptionsPerSession = 3)
 		for i := 0; i < s.config.MaxSubscriptionsPerSession; i++ {
-			_, err := s.manager.Create("session1", "cluster1", "events", filters)
+			_, err := s.manager.Create("session1", "cluster1", "events", filters, "")
 			s.Require().NoError(err)
 		}
 
 		// Next subscription should fail
-		_, err := s.manager.Create("session1", "cluster1", "events", filters)
+		_, err := s.manager.Create("session1", "cluster1", "events", filters, "")
 		s.Error(err)
-		s.Contains(err.Error(), "maximum subscriptions")
+		s.ErrorIs(err, ErrSessionLimitExceeded)
 	})
 
 	s.Run("allows subscription for different session when one session at limit", func() {
@@ -135,12 +152,12 @@ func (s *ManagerTestSuite) TestCreate_EnforcesSessionLimit() {
 
 		// Fill session1 to limit
 		for i := 0; i < s.config.MaxSubscriptionsPerSession; i++ {
-			_, err := s.manager.Create("session1", "cluster1", "events", filters)
+			_, err := s.manager.Create("session1", "cluster1", "events", filters, "")
 			s.Require().NoError(err)
 		}
 
 		// session2 should still be able to create subscriptions
-		_, err := s.manager.Create("session2", "cluster1", "events", filters)
+		_, err := s.manager.Create("session2", "cluster1", "events", filters, "")
 		s.NoError(err)
 	})
 }
@@ -155,7 +172,7 @@ func (s *ManagerTestSuite) TestCreate_EnforcesGlobalLimit() {
 		sessionCount := 0
 		for i := 0; i < s.config.MaxSubscriptionsGlobal; i++ {
 			sessionID := "session" + string(rune('1'+sessionCount))
-			_, err := s.manager.Create(sessionID, "cluster1", "events", filters)
+			_, err := s.manager.Create(sessionID, "cluster1", "events", filters, "")
 			s.Require().NoError(err)
 
 			// Rotate to next session if we hit session limit
@@ -165,9 +182,136 @@ func (s *ManagerTestSuite) TestCreate_EnforcesGlobalLimit() {
 		}
 
 		// Next subscription should fail
-		_, err := s.manager.Create("session-extra", "cluster1", "events", filters)
+		_, err := s.manager.Create("session-extra", "cluster1", "events", filters, "")
 		s.Error(err)
-		s.Contains(err.Error(), "maximum subscriptions")
+		s.ErrorIs(err, ErrGlobalLimitExceeded)
+	})
+}
+
+// TestCreate_EnforcesCreationRate tests that Create() rate limits subscription creation per session
+func (s *ManagerTestSuite) TestCreate_EnforcesCreationRate() {
+	newRateLimitedManager := func() (*EventSubscriptionManager, *clocktesting.FakeClock) {
+		fakeClock := clocktesting.NewFakeClock(time.Now())
+		config := NewTestManagerConfig()
+		config.MaxSubscriptionsPerMinute = 2
+		config.Clock = fakeClock
+		return NewEventSubscriptionManager(s.server, config, nil, nil), fakeClock
+	}
+
+	s.Run("rejects rapid creation once the rate is exceeded", func() {
+		manager, _ := newRateLimitedManager()
+		filters := SubscriptionFilters{}
+
+		for i := 0; i < 2; i++ {
+			_, err := manager.Create("session1", "cluster1", "events", filters, "")
+			s.Require().NoError(err)
+		}
+
+		_, err := manager.Create("session1", "cluster1", "events", filters, "")
+		s.Require().Error(err)
+		s.ErrorIs(err, ErrCreationRateExceeded)
+		s.Contains(err.Error(), "subscription creation rate exceeded (2 per minute)")
+	})
+
+	s.Run("allows creation again after the window", func() {
+		manager, fakeClock := newRateLimitedManager()
+		filters := SubscriptionFilters{}
+
+		for i := 0; i < 2; i++ {
+			sub, err := manager.Create("session1", "cluster1", "events", filters, "")
+			s.Require().NoError(err)
+			s.Require().NoError(manager.Cancel(sub.ID))
+		}
+		_, err := manager.Create("session1", "cluster1", "events", filters, "")
+		s.Require().ErrorIs(err, ErrCreationRateExceeded, "cancelling subscriptions should not reset the creation rate")
+
+		fakeClock.Step(time.Minute)
+
+		for i := 0; i < 2; i++ {
+			_, err := manager.Create("session1", "cluster1", "events", filters, "")
+			s.NoError(err)
+		}
+	})
+
+	s.Run("invalid requests do not consume the rate", func() {
+		manager, _ := newRateLimitedManager()
+
+		for i := 0; i < 2; i++ {
+			_, err := manager.Create("session1", "cluster1", "invalid", SubscriptionFilters{}, "")
+			s.Require().Error(err)
+			s.ErrorIs(err, ErrInvalidMode)
+		}
+
+		_, err := manager.Create("session1", "cluster1", "events", SubscriptionFilters{}, "")
+		s.NoError(err)
+	})
+
+	s.Run("checks rate before subscription limits", func() {
+		manager, _ := newRateLimitedManager()
+		manager.config.MaxSubscriptionsPerSession = 1
+
+		_, err := manager.Create("session1", "cluster1", "events", SubscriptionFilters{}, "")
+		s.Require().NoError(err)
+
+		_, err = manager.Create("session1", "cluster1", "events", SubscriptionFilters{}, "")
+		s.Require().Error(err)
+		s.ErrorIs(err, ErrSessionLimitExceeded)
+
+		_, err = manager.Create("session1", "cluster1", "events", SubscriptionFilters{}, "")
+		s.Require().Error(err)
+		s.ErrorIs(err, ErrCreationRateExceeded, "attempts rejected by limits count against the rate")
+	})
+
+	s.Run("reconciling a session's subscriptions is rate limited", func() {
+		manager, _ := newRateLimitedManager()
+
+		_, _, err := manager.ReconcileSession("session1", "cluster1", []DesiredSubscription{
+			{Mode: "events", Filters: SubscriptionFilters{Namespaces: []string{"ns1"}}},
+			{Mode: "events", Filters: SubscriptionFilters{Namespaces: []string{"ns2"}}},
+			{Mode: "events", Filters: SubscriptionFilters{Namespaces: []string{"ns3"}}},
+		})
+		s.Require().Error(err)
+		s.ErrorIs(err, ErrCreationRateExceeded)
+		s.Empty(manager.ListSubscriptionsForSession("session1"))
+	})
+
+	s.Run("limits each session independently", func() {
+		manager, _ := newRateLimitedManager()
+		filters := SubscriptionFilters{}
+
+		for i := 0; i < 2; i++ {
+			_, err := manager.Create("session1", "cluster1", "events", filters, "")
+			s.Require().NoError(err)
+		}
+
+		_, err := manager.Create("session2", "cluster1", "events", filters, "")
+		s.NoError(err)
+	})
+
+	s.Run("zero disables rate limiting", func() {
+		filters := SubscriptionFilters{}
+		s.Zero(s.config.MaxSubscriptionsPerMinute)
+
+		for i := 0; i < 10; i++ {
+			sub, err := s.manager.Create("session1", "cluster1", "events", filters, "")
+			s.Require().NoError(err)
+			s.Require().NoError(s.manager.Cancel(sub.ID))
+		}
+	})
+
+	s.Run("forgets rate limiters of stale sessions", func() {
+		manager, _ := newRateLimitedManager()
+		s.server.AddSession(NewMockServerSession("session1"))
+
+		_, err := manager.Create("session1", "cluster1", "events", SubscriptionFilters{}, "")
+		s.Require().NoError(err)
+		_, err = manager.Create("session2", "cluster1", "events", SubscriptionFilters{}, "")
+		s.Require().NoError(err)
+
+		manager.cleanupStaleSessions()
+
+		s.Contains(manager.creationLimiters, "session1")
+		s.NotContains(manager.creationLimiters, "session2")
 	})
 }
 
@@ -175,21 +319,21 @@ func (s *ManagerTestSuite) TestCreate_EnforcesGlobalLimit() {
 func (s *ManagerTestSuite) TestCreate_ValidatesMode() {
 	s.Run("accepts events mode", func() {
 		filters := SubscriptionFilters{}
-		_, err := s.manager.Create("session1", "cluster1", "events", filters)
+		_, err := s.manager.Create("session1", "cluster1", "events", filters, "")
 		s.NoError(err)
 	})
 
 	s.Run("accepts faults mode", func() {
 		filters := SubscriptionFilters{}
-		_, err := s.manager.Create("session1", "cluster1", "faults", filters)
+		_, err := s.manager.Create("session1", "cluster1", "faults", filters, "")
 		s.NoError(err)
 	})
 
 	s.Run("rejects invalid mode", func() {
 		filters := SubscriptionFilters{}
-		_, err := s.manager.Create("session1", "cluster1", "invalid", filters)
+		_, err := s.manager.Create("session1", "cluster1", "invalid", filters, "")
 		s.Error(err)
-		s.Contains(err.Error(), "invalid mode")
+		s.ErrorIs(err, ErrInvalidMode)
 	})
 }
 
@@ -199,16 +343,16 @@ func (s *ManagerTestSuite) TestCreate_ValidatesFilters() {
 		filters := SubscriptionFilters{
 			LabelSelector: "invalid=label=selector",
 		}
-		_, err := s.manager.Create("session1", "cluster1", "events", filters)
+		_, err := s.manager.Create("session1", "cluster1", "events", filters, "")
 		s.Error(err)
-		s.Contains(err.Error(), "invalid filters")
+		s.ErrorIs(err, ErrInvalidFilters)
 	})
 
 	s.Run("rejects Normal type in faults mode", func() {
 		filters := SubscriptionFilters{
 			Type: "Normal",
 		}
-		_, err := s.manager.Create("session1", "cluster1", "faults", filters)
+		_, err := s.manager.Create("session1", "cluster1", "faults", filters, "")
 		s.Error(err)
 		s.Contains(err.Error(), "faults mode cannot filter for Normal events")
 	})
@@ -219,17 +363,84 @@ func (s *ManagerTestSuite) TestCreate_ValidatesFilters() {
 			LabelSelector: "app=nginx",
 			Type:          "Warning",
 		}
-		_, err := s.manager.Create("session1", "cluster1", "events", filters)
+		_, err := s.manager.Create("session1", "cluster1", "events", filters, "")
 		s.NoError(err)
 	})
 }
 
+// TestCreate_ResumeFrom tests that Create() resumes subscriptions from a resource version
+func (s *ManagerTestSuite) TestCreate_ResumeFrom() {
+	// recordingClientset returns a clientset whose current resource version is 500, and
+	// the resource versions its event watches were started from
+	recordingClientset := func() (*fake.Clientset, func() []string, *atomic.Int32) {
+		clientset := fake.NewClientset()
+		var lists atomic.Int32
+		clientset.PrependReactor("list", "events", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			lists.Add(1)
+			return true, &v1.EventList{ListMeta: metav1.ListMeta{ResourceVersion: "500"}}, nil
+		})
+		versions := make(chan string, 10)
+		clientset.PrependWatchReactor("events", func(action k8stesting.Action) (bool, watch.Interface, error) {
+			versions <- action.(k8stesting.WatchActionImpl).WatchRestrictions.ResourceVersion
+			return true, watch.NewFake(), nil
+		})
+		var watched []string
+		return clientset, func() []string {
+			for {
+				select {
+				case version := <-versions:
+					watched = append(watched, version)
+				default:
+					return watched
+				}
+			}
+		}, &lists
+	}
+
+	s.Run("rejects values that aren't resource versions", func() {
+		for _, resumeFrom := range []string{"abc", "0", "-1", "12a"} {
+			_, err := s.manager.Create("session1", "cluster1", "events", SubscriptionFilters{}, resumeFrom)
+			s.Require().Error(err, "resumeFrom %q", resumeFrom)
+			s.Contains(err.Error(), "invalid resumeFrom")
+		}
+		s.Empty(s.manager.ListSubscriptionsForSession("session1"))
+	})
+
+	s.Run("rejects resuming faults mode subscriptions", func() {
+		_, err := s.manager.Create("session1", "cluster1", "faults", SubscriptionFilters{}, "42")
+		s.Require().Error(err)
+		s.Contains(err.Error(), "only supported in events mode")
+	})
+
+	s.Run("resuming subscriptions watch from the resource version in a watch of their own", func() {
+		clientset, watched, lists := recordingClientset()
+		getK8sClient := func(cluster string) (*pkgkubernetes.Kubernetes, error) {
+			return &pkgkubernetes.Kubernetes{Interface: clientset}, nil
+		}
+		manager := NewEventSubscriptionManager(s.server, NewTestManagerConfig(), getK8sClient, nil)
+		defer manager.CancelAll()
+
+		_, err := manager.Create("session1", "cluster1", "events", SubscriptionFilters{}, "")
+		s.Require().NoError(err)
+		resumed, err := manager.Create("session2", "cluster1", "events", SubscriptionFilters{}, "42")
+		s.Require().NoError(err)
+
+		s.Equal("42", resumed.ResumeFrom)
+		s.Equal(2, manager.GetStats().WatchConnections, "the resuming subscription shouldn't join the watch started from now")
+		s.Equal(int32(1), lists.Load(), "the current resource version is only needed for the watch started from now")
+		s.Eventually(func() bool {
+			return len(watched()) == 2
+		}, time.Second, 5*time.Millisecond, "both watches should be started")
+		s.ElementsMatch([]string{"500", "42"}, watched())
+	})
+}
+
 // TestCancel_RemovesSubscription tests that Cancel() removes subscriptions
 func (s *ManagerTestSuite) TestCancel_RemovesSubscription() {
 	s.Run("removes subscription from all indices", func() {
 		filters := SubscriptionFilters{}
 
-		sub, err := s.manager.Create("session1", "cluster1", "events", filters)
+		sub, err := s.manager.Create("session1", "cluster1", "events", filters, "")
 		s.Require().NoError(err)
 
 		// Verify subscription exists
@@ -256,13 +467,13 @@ func (s *ManagerTestSuite) TestCancel_RemovesSubscription() {
 	s.Run("returns error for non-existent subscription", func() {
 		err := s.manager.Cancel("non-existent-id")
 		s.Error(err)
-		s.Contains(err.Error(), "not found")
+		s.ErrorIs(err, ErrSubscriptionNotFound)
 	})
 
 	s.Run("calls cancel function when set", func() {
 		filters := SubscriptionFilters{}
 
-		sub, err := s.manager.Create("session1", "cluster1", "events", filters)
+		sub, err := s.manager.Create("session1", "cluster1", "events", filters, "")
 		s.Require().NoError(err)
 
 		// Set a cancel function
@@ -283,7 +494,7 @@ func (s *ManagerTestSuite) TestCancelBySessionAndID_ValidatesOwnership() {
 	s.Run("cancels subscription when owned by session", func() {
 		filters := SubscriptionFilters{}
 
-		sub, err := s.manager.Create("session1", "cluster1", "events", filters)
+		sub, err := s.manager.Create("session1", "cluster1", "events", filters, "")
 		s.Require().NoError(err)
 
 		// Cancel with correct session ID
@@ -298,13 +509,13 @@ func (s *ManagerTestSuite) TestCancelBySessionAndID_ValidatesOwnership() {
 	s.Run("rejects cancellation when not owned by session", func() {
 		filters := SubscriptionFilters{}
 
-		sub, err := s.manager.Create("session1", "cluster1", "events", filters)
+		sub, err := s.manager.Create("session1", "cluster1", "events", filters, "")
 		s.Require().NoError(err)
 
 		// Attempt to cancel with different session ID
 		err = s.manager.CancelBySessionAndID("session2", sub.ID)
 		s.Error(err)
-		s.Contains(err.Error(), "not found")
+		s.ErrorIs(err, ErrSubscriptionNotFound)
 
 		// Verify subscription still exists
 		retrieved := s.manager.GetSubscription(sub.ID)
@@ -314,7 +525,7 @@ func (s *ManagerTestSuite) TestCancelBySessionAndID_ValidatesOwnership() {
 	s.Run("returns error for non-existent subscription", func() {
 		err := s.manager.CancelBySessionAndID("session1", "non-existent-id")
 		s.Error(err)
-		s.Contains(err.Error(), "not found")
+		s.ErrorIs(err, ErrSubscriptionNotFound)
 	})
 }
 
@@ -324,14 +535,14 @@ func (s *ManagerTestSuite) TestCancelSession_RemovesAllForSession() {
 		filters := SubscriptionFilters{}
 
 		// Create multiple subscriptions for session1
-		sub1, err := s.manager.Create("session1", "cluster1", "events", filters)
+		sub1, err := s.manager.Create("session1", "cluster1", "events", filters, "")
 		s.Require().NoError(err)
 
-		sub2, err := s.manager.Create("session1", "cluster2", "faults", filters)
+		sub2, err := s.manager.Create("session1", "cluster2", "faults", filters, "")
 		s.Require().NoError(err)
 
 		// Create subscription for session2
-		sub3, err := s.manager.Create("session2", "cluster1", "events", filters)
+		sub3, err := s.manager.Create("session2", "cluster1", "events", filters, "")
 		s.Require().NoError(err)
 
 		// Cancel all subscriptions for session1
@@ -356,10 +567,10 @@ func (s *ManagerTestSuite) TestCancelSession_RemovesAllForSession() {
 	s.Run("calls cancel functions for all subscriptions", func() {
 		filters := SubscriptionFilters{}
 
-		sub1, err := s.manager.Create("session1", "cluster1", "events", filters)
+		sub1, err := s.manager.Create("session1", "cluster1", "events", filters, "")
 		s.Require().NoError(err)
 
-		sub2, err := s.manager.Create("session1", "cluster2", "faults", filters)
+		sub2, err := s.manager.Create("session1", "cluster2", "faults", filters, "")
 		s.Require().NoError(err)
 
 		// Set cancel functions
@@ -375,6 +586,25 @@ func (s *ManagerTestSuite) TestCancelSession_RemovesAllForSession() {
 		s.True(cancel2Called, "cancel function 2 should be called")
 	})
 
+	s.Run("removes every subscription when session holds many", func() {
+		config := NewTestManagerConfig()
+		config.MaxSubscriptionsPerSession = 50
+		config.MaxSubscriptionsGlobal = 50
+		manager := NewEventSubscriptionManager(s.server, config, nil, nil)
+
+		for i := 0; i < 50; i++ {
+			_, err := manager.Create("session1", "cluster1", "events", SubscriptionFilters{}, "")
+			s.Require().NoError(err)
+		}
+
+		manager.CancelSession("session1")
+
+		stats := manager.GetStats()
+		s.Equal(0, stats.Total)
+		s.Equal(0, stats.Sessions)
+		s.Equal(0, stats.Clusters)
+	})
+
 	s.Run("handles non-existent session gracefully", func() {
 		// Should not panic or error
 		s.manager.CancelSession("non-existent-session")
@@ -387,14 +617,14 @@ func (s *ManagerTestSuite) TestCancelCluster_RemovesAllForCluster() {
 		filters := SubscriptionFilters{}
 
 		// Create subscriptions for cluster1
-		sub1, err := s.manager.Create("session1", "cluster1", "events", filters)
+		sub1, err := s.manager.Create("session1", "cluster1", "events", filters, "")
 		s.Require().NoError(err)
 
-		sub2, err := s.manager.Create("session2", "cluster1", "faults", filters)
+		sub2, err := s.manager.Create("session2", "cluster1", "faults", filters, "")
 		s.Require().NoError(err)
 
 		// Create subscription for cluster2
-		sub3, err := s.manager.Create("session1", "cluster2", "events", filters)
+		sub3, err := s.manager.Create("session1", "cluster2", "events", filters, "")
 		s.Require().NoError(err)
 
 		// Cancel all subscriptions for cluster1
@@ -416,10 +646,10 @@ func (s *ManagerTestSuite) TestCancelCluster_RemovesAllForCluster() {
 	s.Run("calls cancel functions for all cluster subscriptions", func() {
 		filters := SubscriptionFilters{}
 
-		sub1, err := s.manager.Create("session1", "cluster1", "events", filters)
+		sub1, err := s.manager.Create("session1", "cluster1", "events", filters, "")
 		s.Require().NoError(err)
 
-		sub2, err := s.manager.Create("session2", "cluster1", "faults", filters)
+		sub2, err := s.manager.Create("session2", "cluster1", "faults", filters, "")
 		s.Require().NoError(err)
 
 		// Set cancel functions
@@ -441,215 +671,2050 @@ func (s *ManagerTestSuite) TestCancelCluster_RemovesAllForCluster() {
 	})
 }
 
-// TestCancelAll tests that CancelAll() removes all subscriptions
-func (s *ManagerTestSuite) TestCancelAll() {
-	s.Run("removes all subscriptions", func() {
+// TestOnSessionClosed tests that a closed session's subscriptions are torn down immediately
+func (s *ManagerTestSuite) TestOnSessionClosed() {
+	s.Run("cancels the session's subscriptions without waiting for the session monitor", func() {
+		config := NewTestManagerConfig()
+		config.SessionMonitorInterval = time.Hour
+		manager := NewEventSubscriptionManager(s.server, config, nil, nil)
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		go manager.StartSessionMonitor(ctx)
+
+		s.server.AddSession(NewMockServerSession("session1"))
+		s.server.AddSession(NewMockServerSession("session2"))
+		sub1, err := manager.Create("session1", "cluster1", "events", SubscriptionFilters{}, "")
+		s.Require().NoError(err)
+		sub2, err := manager.Create("session1", "cluster2", "faults", SubscriptionFilters{}, "")
+		s.Require().NoError(err)
+		sub3, err := manager.Create("session2", "cluster1", "events", SubscriptionFilters{}, "")
+		s.Require().NoError(err)
+		cancelled := 0
+		sub1.Cancel = func() { cancelled++ }
+		sub2.Cancel = func() { cancelled++ }
+
+		s.server.RemoveSession("session1")
+		manager.OnSessionClosed("session1")
+
+		s.Equal(2, cancelled, "watchers should be stopped synchronously")
+		s.Nil(manager.GetSubscription(sub1.ID))
+		s.Nil(manager.GetSubscription(sub2.ID))
+		s.Empty(manager.ListSubscriptionsForSession("session1"))
+		s.NotNil(manager.GetSubscription(sub3.ID), "other sessions should be unaffected")
+	})
+
+	s.Run("ignores sessions without subscriptions", func() {
+		sub, err := s.manager.Create("session1", "cluster1", "events", SubscriptionFilters{}, "")
+		s.Require().NoError(err)
+
+		s.manager.OnSessionClosed("session2")
+		s.manager.OnSessionClosed("")
+
+		s.NotNil(s.manager.GetSubscription(sub.ID))
+	})
+}
+
+// TestCancelSessionCluster_RemovesOnlyTargetedCluster tests that CancelSessionCluster() only removes
+// subscriptions matching both the session and the cluster
+func (s *ManagerTestSuite) TestCancelSessionCluster_RemovesOnlyTargetedCluster() {
+	s.Run("removes only the targeted cluster's subscriptions for the session", func() {
 		filters := SubscriptionFilters{}
 
-		// Create multiple subscriptions
-		sub1, err := s.manager.Create("session1", "cluster1", "events", filters)
+		// session1 subscribes to two clusters
+		sub1, err := s.manager.Create("session1", "cluster1", "events", filters, "")
 		s.Require().NoError(err)
 
-		sub2, err := s.manager.Create("session2", "cluster2", "faults", filters)
+		sub2, err := s.manager.Create("session1", "cluster1", "faults", filters, "")
 		s.Require().NoError(err)
 
-		// Cancel all
-		s.manager.CancelAll()
+		sub3, err := s.manager.Create("session1", "cluster2", "events", filters, "")
+		s.Require().NoError(err)
 
-		// Verify all removed
+		// session2 also subscribes to cluster1
+		sub4, err := s.manager.Create("session2", "cluster1", "events", filters, "")
+		s.Require().NoError(err)
+
+		cancelled := s.manager.CancelSessionCluster("session1", "cluster1")
+		s.Equal(2, cancelled)
+
+		// Verify session1's cluster1 subscriptions removed
 		s.Nil(s.manager.GetSubscription(sub1.ID))
 		s.Nil(s.manager.GetSubscription(sub2.ID))
 
-		// Verify stats
+		// Verify session1's cluster2 subscription and session2's subscription remain
+		s.NotNil(s.manager.GetSubscription(sub3.ID))
+		s.NotNil(s.manager.GetSubscription(sub4.ID))
+
+		// Verify session index
+		sessionSubs := s.manager.ListSubscriptionsForSession("session1")
+		s.Require().Len(sessionSubs, 1)
+		s.Equal(sub3.ID, sessionSubs[0].ID)
+
+		// Verify cluster index
+		s.Equal(map[string]struct{}{sub4.ID: {}}, s.manager.byCluster["cluster1"])
+		s.Equal(map[string]struct{}{sub3.ID: {}}, s.manager.byCluster["cluster2"])
+
 		stats := s.manager.GetStats()
-		s.Equal(0, stats.Total)
-		s.Equal(0, stats.Sessions)
-		s.Equal(0, stats.Clusters)
+		s.Equal(2, stats.Total)
+		s.Equal(2, stats.Sessions)
+		s.Equal(2, stats.Clusters)
 	})
-}
 
-// TestGetSubscription tests the GetSubscription method
-func (s *ManagerTestSuite) TestGetSubscription() {
-	s.Run("returns subscription when exists", func() {
-		filters := SubscriptionFilters{
-			Namespaces: []string{"default"},
-		}
+	s.Run("removes indices when last subscription is cancelled", func() {
+		filters := SubscriptionFilters{}
 
-		sub, err := s.manager.Create("session1", "cluster1", "events", filters)
+		_, err := s.manager.Create("session1", "cluster1", "events", filters, "")
 		s.Require().NoError(err)
 
-		retrieved := s.manager.GetSubscription(sub.ID)
-		s.NotNil(retrieved)
-		s.Equal(sub.ID, retrieved.ID)
-		s.Equal("session1", retrieved.SessionID)
-		s.Equal("cluster1", retrieved.Cluster)
-		s.Equal("events", retrieved.Mode)
-		s.Equal(filters.Namespaces, retrieved.Filters.Namespaces)
-	})
+		cancelled := s.manager.CancelSessionCluster("session1", "cluster1")
+		s.Equal(1, cancelled)
 
-	s.Run("returns nil when subscription does not exist", func() {
-		retrieved := s.manager.GetSubscription("non-existent-id")
-		s.Nil(retrieved)
+		_, sessionExists := s.manager.bySession["session1"]
+		s.False(sessionExists, "session index should be removed")
+		_, clusterExists := s.manager.byCluster["cluster1"]
+		s.False(clusterExists, "cluster index should be removed")
 	})
-}
 
-// TestListSubscriptionsForSession tests the ListSubscriptionsForSession method
-func (s *ManagerTestSuite) TestListSubscriptionsForSession() {
-	s.Run("returns all subscriptions for session", func() {
+	s.Run("calls cancel functions for targeted subscriptions only", func() {
 		filters := SubscriptionFilters{}
 
-		sub1, err := s.manager.Create("session1", "cluster1", "events", filters)
+		sub1, err := s.manager.Create("session1", "cluster1", "events", filters, "")
 		s.Require().NoError(err)
 
-		sub2, err := s.manager.Create("session1", "cluster2", "faults", filters)
+		sub2, err := s.manager.Create("session1", "cluster2", "events", filters, "")
 		s.Require().NoError(err)
 
-		// Create subscription for different session
-		_, err = s.manager.Create("session2", "cluster1", "events", filters)
-		s.Require().NoError(err)
+		cancel1Called := false
+		cancel2Called := false
+		sub1.Cancel = func() { cancel1Called = true }
+		sub2.Cancel = func() { cancel2Called = true }
 
-		// List session1 subscriptions
-		subs := s.manager.ListSubscriptionsForSession("session1")
-		s.Len(subs, 2)
+		s.manager.CancelSessionCluster("session1", "cluster1")
 
-		// Verify both subscriptions are in the list
-		ids := make(map[string]bool)
-		for _, sub := range subs {
-			ids[sub.ID] = true
-		}
-		s.True(ids[sub1.ID])
-		s.True(ids[sub2.ID])
+		s.True(cancel1Called, "cancel function for targeted cluster should be called")
+		s.False(cancel2Called, "cancel function for other cluster should not be called")
 	})
 
-	s.Run("returns empty list for non-existent session", func() {
-		subs := s.manager.ListSubscriptionsForSession("non-existent-session")
-		s.Len(subs, 0)
+	s.Run("returns zero for non-existent session or cluster", func() {
+		filters := SubscriptionFilters{}
+
+		_, err := s.manager.Create("session1", "cluster1", "events", filters, "")
+		s.Require().NoError(err)
+
+		s.Equal(0, s.manager.CancelSessionCluster("non-existent-session", "cluster1"))
+		s.Equal(0, s.manager.CancelSessionCluster("session1", "non-existent-cluster"))
+		s.Equal(1, s.manager.GetStats().Total)
 	})
 }
 
-// TestGetStats tests the GetStats method
-func (s *ManagerTestSuite) TestGetStats() {
-	s.Run("returns correct statistics", func() {
+// TestCancelAll tests that CancelAll() removes all subscriptions
+func (s *ManagerTestSuite) TestCancelAll() {
+	s.Run("removes all subscriptions", func() {
 		filters := SubscriptionFilters{}
 
-		// Initially empty
+		// Create multiple subscriptions
+		sub1, err := s.manager.Create("session1", "cluster1", "events", filters, "")
+		s.Require().NoError(err)
+
+		sub2, err := s.manager.Create("session2", "cluster2", "faults", filters, "")
+		s.Require().NoError(err)
+
+		// Cancel all
+		s.manager.CancelAll()
+
+		// Verify all removed
+		s.Nil(s.manager.GetSubscription(sub1.ID))
+		s.Nil(s.manager.GetSubscription(sub2.ID))
+
+		// Verify stats
 		stats := s.manager.GetStats()
 		s.Equal(0, stats.Total)
 		s.Equal(0, stats.Sessions)
 		s.Equal(0, stats.Clusters)
-		s.Equal(0, stats.Degraded)
-
-		// Add subscriptions
-		sub1, err := s.manager.Create("session1", "cluster1", "events", filters)
-		s.Require().NoError(err)
+	})
+}
 
-		sub2, err := s.manager.Create("session1", "cluster2", "faults", filters)
-		s.Require().NoError(err)
+// TestShutdown tests that Shutdown() drains in-flight notifications before cancelling
+func (s *ManagerTestSuite) TestShutdown() {
+	s.Run("waits for in-flight notification to complete", func() {
+		session := NewMockServerSession("session1")
+		session.SetLogLevel(mcp.LoggingLevel("info"))
+		session.SetLogDelay(300 * time.Millisecond)
+		s.server.AddSession(session)
 
-		_, err = s.manager.Create("session2", "cluster1", "events", filters)
+		sub, err := s.manager.Create("session1", "cluster1", "events", SubscriptionFilters{}, "")
 		s.Require().NoError(err)
 
-		// Check stats
-		stats = s.manager.GetStats()
-		s.Equal(3, stats.Total)
-		s.Equal(2, stats.Sessions)
-		s.Equal(2, stats.Clusters)
-		s.Equal(0, stats.Degraded)
+		sendDone := make(chan error, 1)
+		go func() {
+			sendDone <- s.manager.sendNotification("session1", LoggerEvents, &EventNotification{SubscriptionID: sub.ID})
+		}()
 
-		// Mark one as degraded
-		sub1.Degraded = true
+		// Give the notification time to start sending
+		time.Sleep(50 * time.Millisecond)
 
-		stats = s.manager.GetStats()
-		s.Equal(1, stats.Degraded)
+		ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+		defer cancel()
 
-		// Remove one subscription
-		err = s.manager.Cancel(sub2.ID)
-		s.Require().NoError(err)
+		start := time.Now()
+		err = s.manager.Shutdown(ctx)
+		elapsed := time.Since(start)
 
-		stats = s.manager.GetStats()
-		s.Equal(2, stats.Total)
-		s.Equal(2, stats.Sessions)
-		s.Equal(1, stats.Clusters)
+		s.NoError(err)
+		s.GreaterOrEqual(elapsed, 200*time.Millisecond, "Shutdown should wait for the slow notification")
+		s.NoError(<-sendDone)
+		s.Len(session.GetLogCalls(), 1, "in-flight notification should be delivered")
+		s.Nil(s.manager.GetSubscription(sub.ID), "subscriptions should be cancelled after drain")
 	})
-}
-
-// TestSessionMonitor tests the session monitoring functionality
-func (s *ManagerTestSuite) TestSessionMonitor() {
-	s.Run("removes subscriptions for stale sessions", func() {
-		filters := SubscriptionFilters{}
-
-		// Create a session and add it to the server
-		session1 := NewMockServerSession("session1")
-		s.server.AddSession(session1)
 
-		// Create subscriptions
-		sub1, err := s.manager.Create("session1", "cluster1", "events", filters)
-		s.Require().NoError(err)
+	s.Run("returns context error when drain times out", func() {
+		session := NewMockServerSession("session1")
+		session.SetLogLevel(mcp.LoggingLevel("info"))
+		session.SetLogDelay(1 * time.Second)
+		s.server.AddSession(session)
 
-		sub2, err := s.manager.Create("session2", "cluster1", "events", filters)
+		sub, err := s.manager.Create("session1", "cluster1", "events", SubscriptionFilters{}, "")
 		s.Require().NoError(err)
 
-		// Verify both exist
-		s.NotNil(s.manager.GetSubscription(sub1.ID))
-		s.NotNil(s.manager.GetSubscription(sub2.ID))
-
-		// Trigger cleanup (session2 is not in the server, so it's stale)
-		s.manager.cleanupStaleSessions()
+		go func() {
+			_ = s.manager.sendNotification("session1", LoggerEvents, &EventNotification{SubscriptionID: sub.ID})
+		}()
+		time.Sleep(50 * time.Millisecond)
 
-		// session1 subscription should remain (it's in the server)
-		s.NotNil(s.manager.GetSubscription(sub1.ID))
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
 
-		// session2 subscription should be removed (it's stale)
-		s.Nil(s.manager.GetSubscription(sub2.ID))
+		err = s.manager.Shutdown(ctx)
+		s.ErrorIs(err, context.DeadlineExceeded)
+		s.Nil(s.manager.GetSubscription(sub.ID), "subscriptions should be cancelled even when drain times out")
 	})
 
-	s.Run("keeps subscriptions for active sessions", func() {
-		filters := SubscriptionFilters{}
-
-		// Create sessions and add them to the server
-		session1 := NewMockServerSession("session1")
-		session2 := NewMockServerSession("session2")
-		s.server.AddSession(session1)
-		s.server.AddSession(session2)
+	s.Run("drops notifications sent after shutdown starts", func() {
+		session := NewMockServerSession("session1")
+		session.SetLogLevel(mcp.LoggingLevel("info"))
+		s.server.AddSession(session)
 
-		// Create subscriptions
-		sub1, err := s.manager.Create("session1", "cluster1", "events", filters)
+		err := s.manager.Shutdown(context.Background())
 		s.Require().NoError(err)
 
-		sub2, err := s.manager.Create("session2", "cluster1", "events", filters)
+		err = s.manager.sendNotification("session1", LoggerEvents, &EventNotification{SubscriptionID: "sub-1"})
+		s.NoError(err)
+		s.Empty(session.GetLogCalls(), "notifications should be dropped once draining")
+	})
+
+	s.Run("sends notifications queued before shutdown", func() {
+		session := NewMockServerSession("session1")
+		session.SetLogLevel(mcp.LoggingLevel("info"))
+		session.SetLogDelay(50 * time.Millisecond)
+		s.server.AddSession(session)
+
+		sub, err := s.manager.Create("session1", "cluster1", "events", SubscriptionFilters{}, "")
 		s.Require().NoError(err)
+		s.manager.startDelivery(context.Background(), sub)
+		for range 3 {
+			s.manager.queueNotification(context.Background(), sub, LoggerEvents, &EventNotification{SubscriptionID: sub.ID})
+		}
 
-		// Trigger cleanup
-		s.manager.cleanupStaleSessions()
+		ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+		defer cancel()
+		s.Require().NoError(s.manager.Shutdown(ctx))
 
-		// Both subscriptions should remain (both sessions are active)
-		s.NotNil(s.manager.GetSubscription(sub1.ID))
-		s.NotNil(s.manager.GetSubscription(sub2.ID))
+		s.Len(session.GetLogCalls(), 3, "queued notifications should be sent before subscriptions are cancelled")
+		s.Nil(s.manager.GetSubscription(sub.ID))
 	})
-}
 
-// TestSubscriptionCreatedAt tests that subscriptions have timestamps
-func (s *ManagerTestSuite) TestSubscriptionCreatedAt() {
-	s.Run("sets CreatedAt timestamp", func() {
-		filters := SubscriptionFilters{}
+	s.Run("returns context error when queued notifications aren't sent in time", func() {
+		session := NewMockServerSession("session1")
+		session.SetLogLevel(mcp.LoggingLevel("info"))
+		session.SetLogDelay(time.Second)
+		s.server.AddSession(session)
 
-		before := time.Now()
-		sub, err := s.manager.Create("session1", "cluster1", "events", filters)
+		sub, err := s.manager.Create("session1", "cluster1", "events", SubscriptionFilters{}, "")
 		s.Require().NoError(err)
-		after := time.Now()
+		s.manager.startDelivery(context.Background(), sub)
+		for range 3 {
+			s.manager.queueNotification(context.Background(), sub, LoggerEvents, &EventNotification{SubscriptionID: sub.ID})
+		}
 
-		s.False(sub.CreatedAt.IsZero())
-		s.True(sub.CreatedAt.After(before) || sub.CreatedAt.Equal(before))
-		s.True(sub.CreatedAt.Before(after) || sub.CreatedAt.Equal(after))
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+		s.ErrorIs(s.manager.Shutdown(ctx), context.DeadlineExceeded)
+		s.Nil(s.manager.GetSubscription(sub.ID), "subscriptions should be cancelled even when flushing times out")
 	})
-}
+
+	s.Run("non-positive drain timeout falls back to the default", func() {
+		for _, timeout := range []time.Duration{0, -time.Second} {
+			config := NewTestManagerConfig()
+			config.ShutdownDrainTimeout = timeout
+			manager := NewEventSubscriptionManager(s.server, config, nil, nil)
+			s.Equal(DefaultShutdownDrainTimeout, manager.config.ShutdownDrainTimeout, "timeout %s should fall back to the default", timeout)
+		}
+	})
+}
+
+// TestNotificationTimeout tests that the configured timeout bounds a blocked notification send
+func (s *ManagerTestSuite) TestNotificationTimeout() {
+	s.Run("send fails once the configured timeout elapses", func() {
+		config := NewTestManagerConfig()
+		config.NotificationTimeout = 100 * time.Millisecond
+		manager := NewEventSubscriptionManager(s.server, config, nil, nil)
+
+		session := NewMockServerSession("session1")
+		session.SetLogLevel(mcp.LoggingLevel("info"))
+		session.SetLogDelay(5 * time.Second)
+		s.server.AddSession(session)
+
+		start := time.Now()
+		err := manager.sendNotification("session1", LoggerEvents, &EventNotification{SubscriptionID: "sub-1"})
+		elapsed := time.Since(start)
+
+		s.ErrorIs(err, context.DeadlineExceeded)
+		s.GreaterOrEqual(elapsed, 100*time.Millisecond, "send should wait for the configured timeout")
+		s.Less(elapsed, time.Second, "send should not wait past the configured timeout")
+		s.Empty(session.GetLogCalls())
+	})
+
+	s.Run("slow send within a longer timeout succeeds", func() {
+		config := NewTestManagerConfig()
+		config.NotificationTimeout = time.Second
+		manager := NewEventSubscriptionManager(s.server, config, nil, nil)
+
+		session := NewMockServerSession("session1")
+		session.SetLogLevel(mcp.LoggingLevel("info"))
+		session.SetLogDelay(200 * time.Millisecond)
+		s.server.AddSession(session)
+
+		err := manager.sendNotification("session1", LoggerEvents, &EventNotification{SubscriptionID: "sub-1"})
+		s.NoError(err)
+		s.Len(session.GetLogCalls(), 1)
+	})
+
+	s.Run("non-positive timeout falls back to the default", func() {
+		for _, timeout := range []time.Duration{0, -time.Second} {
+			config := NewTestManagerConfig()
+			config.NotificationTimeout = timeout
+			manager := NewEventSubscriptionManager(s.server, config, nil, nil)
+			s.Equal(DefaultNotificationTimeout, manager.config.NotificationTimeout, "timeout %s should fall back to the default", timeout)
+		}
+	})
+}
+
+// TestNotificationFailureThreshold tests that subscriptions are only cancelled after consecutive send failures
+func (s *ManagerTestSuite) TestNotificationFailureThreshold() {
+	s.Run("single failure followed by a success keeps the subscription", func() {
+		session := NewMockServerSession("session1")
+		session.SetLogLevel(mcp.LoggingLevel("info"))
+		s.server.AddSession(session)
+
+		sub, err := s.manager.Create("session1", "cluster1", "events", SubscriptionFilters{}, "")
+		s.Require().NoError(err)
+		process := s.manager.makeProcessEventFunc(context.Background(), sub, nil)
+
+		session.SetLogError(errors.New("connection reset"))
+		process(context.Background(), &v1.Event{Reason: "BackOff"})
+		session.SetLogError(nil)
+		process(context.Background(), &v1.Event{Reason: "BackOff"})
+
+		// Two more failures must not reach the threshold since the success reset the count
+		session.SetLogError(errors.New("connection reset"))
+		process(context.Background(), &v1.Event{Reason: "BackOff"})
+		process(context.Background(), &v1.Event{Reason: "BackOff"})
+
+		s.Never(func() bool {
+			return s.manager.GetSubscription(sub.ID) == nil
+		}, 200*time.Millisecond, 10*time.Millisecond, "subscription should survive non-consecutive failures")
+		s.Len(session.GetLogCalls(), 1)
+	})
+
+	s.Run("consecutive failures up to the threshold cancel the subscription", func() {
+		session := NewMockServerSession("session1")
+		session.SetLogLevel(mcp.LoggingLevel("info"))
+		session.SetLogError(errors.New("connection reset"))
+		s.server.AddSession(session)
+
+		sub, err := s.manager.Create("session1", "cluster1", "events", SubscriptionFilters{}, "")
+		s.Require().NoError(err)
+		process := s.manager.makeProcessEventFunc(context.Background(), sub, nil)
+
+		for i := 0; i < s.config.MaxNotificationFailures-1; i++ {
+			process(context.Background(), &v1.Event{Reason: "BackOff"})
+		}
+		s.Never(func() bool {
+			return s.manager.GetSubscription(sub.ID) == nil
+		}, 100*time.Millisecond, 10*time.Millisecond, "subscription should survive failures below the threshold")
+
+		process(context.Background(), &v1.Event{Reason: "BackOff"})
+		s.Eventually(func() bool {
+			return s.manager.GetSubscription(sub.ID) == nil
+		}, time.Second, 10*time.Millisecond, "subscription should be cancelled at the threshold")
+	})
+
+	s.Run("fault notification failures count toward the threshold", func() {
+		session := NewMockServerSession("session1")
+		session.SetLogLevel(mcp.LoggingLevel("info"))
+		session.SetLogError(errors.New("connection reset"))
+		s.server.AddSession(session)
+
+		sub, err := s.manager.Create("session1", "cluster1", "faults", SubscriptionFilters{}, "")
+		s.Require().NoError(err)
+		callback := s.manager.makeFaultSignalCallback(sub)
+
+		for i := 0; i < s.config.MaxNotificationFailures; i++ {
+			callback(context.Background(), FaultSignal{
+				FaultType:   FaultTypePodCrash,
+				ResourceUID: types.UID(fmt.Sprintf("pod-uid-%d", i)),
+				Kind:        "Pod",
+				Name:        "test-pod",
+				Namespace:   "default",
+				Severity:    SeverityCritical,
+				Timestamp:   time.Now(),
+			})
+		}
+		s.Eventually(func() bool {
+			return s.manager.GetSubscription(sub.ID) == nil
+		}, time.Second, 10*time.Millisecond, "subscription should be cancelled at the threshold")
+	})
+
+	s.Run("non-positive threshold falls back to the default", func() {
+		config := NewTestManagerConfig()
+		config.MaxNotificationFailures = 0
+		manager := NewEventSubscriptionManager(s.server, config, nil, nil)
+		s.Equal(DefaultMaxNotificationFailures, manager.config.MaxNotificationFailures)
+	})
+}
+
+// TestNotificationRetries tests that sends failing with transient errors are retried with backoff
+func (s *ManagerTestSuite) TestNotificationRetries() {
+	s.Run("transient failure is retried and delivered", func() {
+		session := NewMockServerSession("session1")
+		session.SetLogLevel(mcp.LoggingLevel("info"))
+		session.SetLogErrors(1, errors.New("stream error: temporarily unavailable"))
+		s.server.AddSession(session)
+
+		sub, err := s.manager.Create("session1", "cluster1", "events", SubscriptionFilters{}, "")
+		s.Require().NoError(err)
+		process := s.manager.makeProcessEventFunc(context.Background(), sub, nil)
+
+		process(context.Background(), &v1.Event{Reason: "BackOff"})
+
+		s.Eventually(func() bool {
+			return len(session.GetLogCalls()) == 1
+		}, time.Second, 10*time.Millisecond, "notification should be delivered on retry")
+		s.Equal(2, session.GetLogAttempts())
+		s.Equal(0, int(sub.notificationFailures.Load()), "a delivered retry is not a failure")
+	})
+
+	s.Run("persistent failure gives up after the maximum retries", func() {
+		session := NewMockServerSession("session1")
+		session.SetLogLevel(mcp.LoggingLevel("info"))
+		session.SetLogError(errors.New("stream error: temporarily unavailable"))
+		s.server.AddSession(session)
+
+		sub, err := s.manager.Create("session1", "cluster1", "events", SubscriptionFilters{}, "")
+		s.Require().NoError(err)
+		process := s.manager.makeProcessEventFunc(context.Background(), sub, nil)
+
+		process(context.Background(), &v1.Event{Reason: "BackOff"})
+
+		s.Equal(1+s.config.MaxNotificationRetries, session.GetLogAttempts())
+		s.Empty(session.GetLogCalls())
+		s.Equal(1, int(sub.notificationFailures.Load()), "retries of a notification count as a single failure")
+	})
+
+	s.Run("dead connection is not retried", func() {
+		for i, deadErr := range []error{mcp.ErrConnectionClosed, context.DeadlineExceeded, io.EOF} {
+			sessionID := fmt.Sprintf("dead-session-%d", i)
+			session := NewMockServerSession(sessionID)
+			session.SetLogLevel(mcp.LoggingLevel("info"))
+			session.SetLogError(deadErr)
+			s.server.AddSession(session)
+
+			sub, err := s.manager.Create(sessionID, "cluster1", "events", SubscriptionFilters{}, "")
+			s.Require().NoError(err)
+			process := s.manager.makeProcessEventFunc(context.Background(), sub, nil)
+
+			process(context.Background(), &v1.Event{Reason: "BackOff"})
+
+			s.Equal(1, session.GetLogAttempts(), "%v should not be retried", deadErr)
+			s.Require().NoError(s.manager.CancelBySessionAndID(sessionID, sub.ID))
+		}
+	})
+
+	s.Run("zero retries disables retrying", func() {
+		config := NewTestManagerConfig()
+		config.MaxNotificationRetries = 0
+		manager := NewEventSubscriptionManager(s.server, config, nil, nil)
+		defer manager.CancelAll()
+
+		session := NewMockServerSession("session2")
+		session.SetLogLevel(mcp.LoggingLevel("info"))
+		session.SetLogErrors(1, errors.New("stream error: temporarily unavailable"))
+		s.server.AddSession(session)
+
+		sub, err := manager.Create("session2", "cluster1", "events", SubscriptionFilters{}, "")
+		s.Require().NoError(err)
+		manager.makeProcessEventFunc(context.Background(), sub, nil)(context.Background(), &v1.Event{Reason: "BackOff"})
+
+		s.Equal(1, session.GetLogAttempts())
+		s.Empty(session.GetLogCalls())
+	})
+
+	s.Run("invalid retry settings fall back to the defaults", func() {
+		config := NewTestManagerConfig()
+		config.MaxNotificationRetries = -1
+		config.NotificationRetryBackoff = 0
+		manager := NewEventSubscriptionManager(s.server, config, nil, nil)
+		s.Equal(DefaultMaxNotificationRetries, manager.config.MaxNotificationRetries)
+		s.Equal(DefaultNotificationRetryBackoff, manager.config.NotificationRetryBackoff)
+	})
+}
+
+// TestNotificationBackpressure tests that a slow session doesn't block event processing
+func (s *ManagerTestSuite) TestNotificationBackpressure() {
+	newWatchingManager := func(queueSize int) *EventSubscriptionManager {
+		getK8sClient := func(cluster string) (*pkgkubernetes.Kubernetes, error) {
+			return &pkgkubernetes.Kubernetes{Interface: fake.NewClientset()}, nil
+		}
+		config := NewTestManagerConfig()
+		config.NotificationQueueSize = queueSize
+		return NewEventSubscriptionManager(s.server, config, getK8sClient, nil)
+	}
+
+	s.Run("slow session does not block processing and drops are counted", func() {
+		session := NewMockServerSession("session1")
+		session.SetLogLevel(mcp.LoggingLevel("info"))
+		session.SetLogDelay(200 * time.Millisecond)
+		s.server.AddSession(session)
+
+		manager := newWatchingManager(2)
+		defer manager.CancelAll()
+		sub, err := manager.Create("session1", "cluster1", "events", SubscriptionFilters{}, "")
+		s.Require().NoError(err)
+		process := manager.makeProcessEventFunc(context.Background(), sub, nil)
+
+		const total = 10
+		start := time.Now()
+		for i := 0; i < total; i++ {
+			process(context.Background(), &v1.Event{Reason: "BackOff", Message: fmt.Sprintf("event-%d", i)})
+		}
+		s.Less(time.Since(start), 200*time.Millisecond, "processing should not wait for the slow session")
+
+		s.Eventually(func() bool {
+			return int64(len(session.GetLogCalls()))+sub.DroppedNotifications() == total
+		}, 2*time.Second, 10*time.Millisecond, "every notification should be delivered or counted as dropped")
+		s.GreaterOrEqual(sub.DroppedNotifications(), int64(total-3), "at most one in-flight and two queued notifications are kept")
+
+		calls := session.GetLogCalls()
+		last, ok := calls[len(calls)-1].Data.(*EventNotification)
+		s.Require().True(ok)
+		s.Equal("event-9", last.Event.Message, "the oldest notifications should be dropped")
+		s.NotNil(manager.GetSubscription(sub.ID), "dropping notifications should not cancel the subscription")
+	})
+
+	s.Run("fast session receives every notification", func() {
+		session := NewMockServerSession("session1")
+		session.SetLogLevel(mcp.LoggingLevel("info"))
+		s.server.AddSession(session)
+
+		manager := newWatchingManager(DefaultNotificationQueueSize)
+		defer manager.CancelAll()
+		sub, err := manager.Create("session1", "cluster1", "events", SubscriptionFilters{}, "")
+		s.Require().NoError(err)
+		process := manager.makeProcessEventFunc(context.Background(), sub, nil)
+
+		for i := 0; i < 10; i++ {
+			process(context.Background(), &v1.Event{Reason: "BackOff"})
+		}
+
+		s.Eventually(func() bool {
+			return len(session.GetLogCalls()) == 10
+		}, time.Second, 10*time.Millisecond)
+		s.Zero(sub.DroppedNotifications())
+	})
+
+	s.Run("non-positive queue size falls back to the default", func() {
+		config := NewTestManagerConfig()
+		config.NotificationQueueSize = 0
+		manager := NewEventSubscriptionManager(s.server, config, nil, nil)
+		s.Equal(DefaultNotificationQueueSize, manager.config.NotificationQueueSize)
+	})
+}
+
+// TestGetRecentFaults tests that detected faults are buffered for late subscribers
+func (s *ManagerTestSuite) TestGetRecentFaults() {
+	s.Run("records faults delivered through the fault callback", func() {
+		session := NewMockServerSession("session1")
+		session.SetLogLevel(mcp.LoggingLevel("warning"))
+		s.server.AddSession(session)
+
+		sub, err := s.manager.Create("session1", "cluster1", "faults", SubscriptionFilters{}, "")
+		s.Require().NoError(err)
+
+		callback := s.manager.makeFaultSignalCallback(sub)
+		for i := 0; i < s.config.FaultHistorySize+5; i++ {
+			callback(context.Background(), FaultSignal{
+				FaultType:   FaultTypeCrashLoop,
+				ResourceUID: types.UID(fmt.Sprintf("pod-uid-%d", i)),
+				Kind:        "Pod",
+				Name:        fmt.Sprintf("pod-%d", i),
+				Namespace:   "default",
+				Severity:    SeverityCritical,
+				Timestamp:   time.Now(),
+			})
+		}
+
+		faults := s.manager.GetRecentFaults("cluster1", 0)
+		s.Require().Len(faults, s.config.FaultHistorySize, "history should keep only the most recent faults")
+		s.Equal(fmt.Sprintf("pod-%d", s.config.FaultHistorySize+4), faults[0].Name, "newest fault should be first")
+		s.Empty(s.manager.GetRecentFaults("cluster2", 0), "faults should not leak across clusters")
+	})
+
+	s.Run("cancelling a cluster clears its history", func() {
+		sub, err := s.manager.Create("session1", "cluster1", "faults", SubscriptionFilters{}, "")
+		s.Require().NoError(err)
+
+		s.manager.makeFaultSignalCallback(sub)(context.Background(), FaultSignal{
+			FaultType:   FaultTypePodCrash,
+			ResourceUID: types.UID("pod-uid"),
+			Kind:        "Pod",
+			Name:        "pod",
+			Namespace:   "default",
+			Severity:    SeverityCritical,
+			Timestamp:   time.Now(),
+		})
+		s.Require().Len(s.manager.GetRecentFaults("cluster1", 0), 1)
+
+		s.manager.CancelCluster("cluster1")
+		s.Empty(s.manager.GetRecentFaults("cluster1", 0))
+	})
+}
+
+// TestReplayRecent tests that recently processed events can be replayed after a reconnection
+func (s *ManagerTestSuite) TestReplayRecent() {
+	newReplayManager := func(size int) *EventSubscriptionManager {
+		config := NewTestManagerConfig()
+		config.EventReplaySize = size
+		return NewEventSubscriptionManager(s.server, config, nil, nil)
+	}
+	// processEvents feeds count events with distinct messages through a subscription
+	processEvents := func(manager *EventSubscriptionManager, sub *Subscription, count int) {
+		process := manager.makeProcessEventFunc(context.Background(), sub, nil)
+		for i := 0; i < count; i++ {
+			process(context.Background(), &v1.Event{Reason: "BackOff", Message: fmt.Sprintf("event %d", i)})
+		}
+	}
+
+	s.Run("replays recent events newest-first", func() {
+		manager := newReplayManager(5)
+		sub, err := manager.Create("session1", "cluster1", "events", SubscriptionFilters{}, "")
+		s.Require().NoError(err)
+		processEvents(manager, sub, 3)
+
+		events := manager.ReplayRecent(sub.ID, 0)
+
+		s.Require().Len(events, 3)
+		s.Equal("event 2", events[0].Message)
+		s.Equal("event 1", events[1].Message)
+		s.Equal("event 0", events[2].Message)
+	})
+
+	s.Run("respects the limit", func() {
+		manager := newReplayManager(5)
+		sub, err := manager.Create("session1", "cluster1", "events", SubscriptionFilters{}, "")
+		s.Require().NoError(err)
+		processEvents(manager, sub, 3)
+
+		events := manager.ReplayRecent(sub.ID, 2)
+
+		s.Require().Len(events, 2)
+		s.Equal("event 2", events[0].Message)
+		s.Equal("event 1", events[1].Message)
+	})
+
+	s.Run("keeps at most EventReplaySize events per subscription", func() {
+		manager := newReplayManager(2)
+		sub, err := manager.Create("session1", "cluster1", "events", SubscriptionFilters{}, "")
+		s.Require().NoError(err)
+		processEvents(manager, sub, 5)
+
+		events := manager.ReplayRecent(sub.ID, 0)
+
+		s.Require().Len(events, 2)
+		s.Equal("event 4", events[0].Message)
+		s.Equal("event 3", events[1].Message)
+	})
+
+	s.Run("subscriptions are replayed separately", func() {
+		manager := newReplayManager(5)
+		sub1, err := manager.Create("session1", "cluster1", "events", SubscriptionFilters{}, "")
+		s.Require().NoError(err)
+		sub2, err := manager.Create("session1", "cluster1", "events", SubscriptionFilters{Type: "Warning"}, "")
+		s.Require().NoError(err)
+		processEvents(manager, sub1, 2)
+
+		s.Len(manager.ReplayRecent(sub1.ID, 0), 2)
+		s.Empty(manager.ReplayRecent(sub2.ID, 0))
+	})
+
+	s.Run("returns empty slice without replayable events", func() {
+		manager := newReplayManager(5)
+		faults, err := manager.Create("session1", "cluster1", "faults", SubscriptionFilters{}, "")
+		s.Require().NoError(err)
+
+		s.Empty(manager.ReplayRecent(faults.ID, 0), "faults subscriptions don't buffer events")
+		s.NotNil(manager.ReplayRecent("unknown", 0))
+		s.Empty(manager.ReplayRecent("unknown", 0))
+
+		disabled := newReplayManager(0)
+		sub, err := disabled.Create("session1", "cluster1", "events", SubscriptionFilters{}, "")
+		s.Require().NoError(err)
+		processEvents(disabled, sub, 2)
+		s.Empty(disabled.ReplayRecent(sub.ID, 0), "zero disables replay")
+	})
+
+	s.Run("cancelled subscriptions can't be replayed", func() {
+		manager := newReplayManager(5)
+		sub, err := manager.Create("session1", "cluster1", "events", SubscriptionFilters{}, "")
+		s.Require().NoError(err)
+		processEvents(manager, sub, 2)
+
+		s.Require().NoError(manager.Cancel(sub.ID))
+		s.Empty(manager.ReplayRecent(sub.ID, 0))
+	})
+}
+
+// TestFaultNotificationLevel tests that fault severities map to MCP logging levels
+func (s *ManagerTestSuite) TestFaultNotificationLevel() {
+	cases := []struct {
+		severity Severity
+		level    mcp.LoggingLevel
+	}{
+		{SeverityInfo, mcp.LoggingLevel("info")},
+		{SeverityWarning, mcp.LoggingLevel("warning")},
+		{SeverityCritical, mcp.LoggingLevel("warning")},
+	}
+
+	for _, tc := range cases {
+		s.Run(fmt.Sprintf("%s severity is sent at %s level", tc.severity, tc.level), func() {
+			session := NewMockServerSession("session1")
+			session.SetLogLevel(mcp.LoggingLevel("info"))
+			s.server.AddSession(session)
+
+			sub, err := s.manager.Create("session1", "cluster1", "faults", SubscriptionFilters{}, "")
+			s.Require().NoError(err)
+
+			s.manager.makeFaultSignalCallback(sub)(context.Background(), FaultSignal{
+				FaultType:   FaultTypeNodeUnhealthy,
+				ResourceUID: types.UID("node-uid"),
+				Kind:        "Node",
+				Name:        "worker-1",
+				Severity:    tc.severity,
+				Timestamp:   time.Now(),
+			})
+
+			calls := session.GetLogCalls()
+			s.Require().Len(calls, 1)
+			s.Equal(tc.level, calls[0].Level)
+			s.Equal(LoggerFaults, calls[0].Logger)
+
+			notification, ok := calls[0].Data.(*ResourceFaultNotification)
+			s.Require().True(ok)
+			s.Equal(tc.severity, notification.Severity)
+			s.Equal(NotificationSchemaVersion, notification.SchemaVersion)
+		})
+	}
+}
+
+// TestFaultCoalescing tests that correlated faults for the same resource are sent as one notification
+func (s *ManagerTestSuite) TestFaultCoalescing() {
+	newCoalescingManager := func(window time.Duration) (*EventSubscriptionManager, *MockServerSession, *Subscription) {
+		config := NewTestManagerConfig()
+		config.FaultCoalescingWindow = window
+		manager := NewEventSubscriptionManager(s.server, config, nil, nil)
+
+		session := NewMockServerSession("session1")
+		session.SetLogLevel(mcp.LoggingLevel("info"))
+		s.server.AddSession(session)
+
+		sub, err := manager.Create("session1", "cluster1", "faults", SubscriptionFilters{}, "")
+		s.Require().NoError(err)
+		return manager, session, sub
+	}
+	crash := FaultSignal{
+		FaultType:     FaultTypePodCrash,
+		ResourceUID:   types.UID("pod-uid"),
+		Kind:          "Pod",
+		Name:          "test-pod",
+		Namespace:     "default",
+		ContainerName: "app",
+		Severity:      SeverityWarning,
+		Context:       "Container crashed with exit code 1",
+		Details:       map[string]string{"exitCode": "1"},
+		Timestamp:     time.Now(),
+	}
+	crashLoop := FaultSignal{
+		FaultType:     FaultTypeCrashLoop,
+		ResourceUID:   types.UID("pod-uid"),
+		Kind:          "Pod",
+		Name:          "test-pod",
+		Namespace:     "default",
+		ContainerName: "app",
+		Severity:      SeverityCritical,
+		Context:       "Container is in CrashLoopBackOff",
+		Details:       map[string]string{"restartCount": "3"},
+		RelatedEvents: []*EventDetails{{Type: "Warning", Reason: "BackOff"}},
+		Timestamp:     time.Now(),
+	}
+
+	s.Run("crash and crashloop within the window are sent as one notification", func() {
+		manager, session, sub := newCoalescingManager(100 * time.Millisecond)
+		callback := manager.makeFaultSignalCallback(sub)
+
+		callback(context.Background(), crash)
+		callback(context.Background(), crashLoop)
+		s.Empty(session.GetLogCalls(), "faults should be held until the window elapses")
+
+		s.Eventually(func() bool {
+			return len(session.GetLogCalls()) == 1
+		}, time.Second, 10*time.Millisecond)
+		s.Never(func() bool {
+			return len(session.GetLogCalls()) > 1
+		}, 200*time.Millisecond, 10*time.Millisecond, "faults should be coalesced into a single notification")
+
+		call := session.GetLogCalls()[0]
+		notification, ok := call.Data.(*ResourceFaultNotification)
+		s.Require().True(ok)
+		s.Equal(FaultTypeCrashLoop, notification.FaultType, "the most severe fault leads the notification")
+		s.Equal(SeverityCritical, notification.Severity)
+		s.Equal(GenerateFaultID("cluster1", FaultTypeCrashLoop, "pod-uid", "app"), notification.FaultID)
+		s.Equal([]FaultType{FaultTypePodCrash, FaultTypeCrashLoop}, notification.FaultTypes)
+		s.Equal("PodCrash: Container crashed with exit code 1; CrashLoop: Container is in CrashLoopBackOff", notification.Context)
+		s.Equal(map[string]string{"restartCount": "3"}, notification.Details, "details are those of the leading fault")
+		s.Equal(crashLoop.RelatedEvents, notification.RelatedEvents, "related events are those of the leading fault")
+		s.Equal(mcp.LoggingLevel("warning"), call.Level)
+		s.Len(manager.GetRecentFaults("cluster1", 0), 2, "each coalesced fault is kept in history")
+	})
+
+	s.Run("faults for different resources are sent separately", func() {
+		manager, session, sub := newCoalescingManager(50 * time.Millisecond)
+		callback := manager.makeFaultSignalCallback(sub)
+
+		otherPod := crashLoop
+		otherPod.ResourceUID = types.UID("other-pod-uid")
+		otherPod.Name = "other-pod"
+		callback(context.Background(), crash)
+		callback(context.Background(), otherPod)
+
+		s.Eventually(func() bool {
+			return len(session.GetLogCalls()) == 2
+		}, time.Second, 10*time.Millisecond)
+		for _, call := range session.GetLogCalls() {
+			notification, ok := call.Data.(*ResourceFaultNotification)
+			s.Require().True(ok)
+			s.Empty(notification.FaultTypes, "single faults are not listed as coalesced")
+		}
+	})
+
+	s.Run("faults after the window are sent separately", func() {
+		manager, session, sub := newCoalescingManager(20 * time.Millisecond)
+		callback := manager.makeFaultSignalCallback(sub)
+
+		callback(context.Background(), crash)
+		s.Eventually(func() bool {
+			return len(session.GetLogCalls()) == 1
+		}, time.Second, 5*time.Millisecond)
+
+		callback(context.Background(), crashLoop)
+		s.Eventually(func() bool {
+			return len(session.GetLogCalls()) == 2
+		}, time.Second, 5*time.Millisecond)
+	})
+
+	s.Run("zero window sends each fault immediately", func() {
+		manager, session, sub := newCoalescingManager(0)
+		callback := manager.makeFaultSignalCallback(sub)
+
+		callback(context.Background(), crash)
+		callback(context.Background(), crashLoop)
+
+		s.Len(session.GetLogCalls(), 2)
+	})
+
+	s.Run("pending faults are dropped when the subscription is cancelled", func() {
+		manager, session, sub := newCoalescingManager(20 * time.Millisecond)
+		callback := manager.makeFaultSignalCallback(sub)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		callback(ctx, crash)
+		cancel()
+
+		s.Never(func() bool {
+			return len(session.GetLogCalls()) > 0
+		}, 100*time.Millisecond, 10*time.Millisecond)
+	})
+}
+
+// TestFaultMutes tests that faults of muted resources are suppressed until the mute expires
+func (s *ManagerTestSuite) TestFaultMutes() {
+	newMutingManager := func() (*EventSubscriptionManager, *clocktesting.FakeClock, *MockServerSession, FaultSignalCallback) {
+		fakeClock := clocktesting.NewFakeClock(time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC))
+		config := NewTestManagerConfig()
+		config.Clock = fakeClock
+		manager := NewEventSubscriptionManager(s.server, config, nil, nil)
+
+		session := NewMockServerSession("session1")
+		session.SetLogLevel(mcp.LoggingLevel("info"))
+		s.server.AddSession(session)
+
+		sub, err := manager.Create("session1", "cluster1", "faults", SubscriptionFilters{}, "")
+		s.Require().NoError(err)
+		return manager, fakeClock, session, manager.makeFaultSignalCallback(sub)
+	}
+	podFault := FaultSignal{
+		FaultType:   FaultTypePodCrash,
+		ResourceUID: types.UID("pod-uid"),
+		Kind:        "Pod",
+		Name:        "test-pod",
+		Namespace:   "default",
+		Severity:    SeverityWarning,
+		Context:     "Container crashed with exit code 1",
+	}
+	nodeFault := FaultSignal{
+		FaultType:   FaultTypeNodeUnhealthy,
+		ResourceUID: types.UID("node-uid"),
+		Kind:        "Node",
+		Name:        "worker-1",
+		Severity:    SeverityCritical,
+		Context:     "Node became unhealthy: Ready=False",
+	}
+
+	s.Run("muted resources produce no faults until the mute expires", func() {
+		manager, fakeClock, session, callback := newMutingManager()
+		manager.Mute("cluster1", "Pod", "default", "test-pod", fakeClock.Now().Add(time.Hour))
+
+		callback(context.Background(), podFault)
+		s.Empty(session.GetLogCalls(), "muted fault should not be sent")
+		s.Empty(manager.GetRecentFaults("cluster1", 0), "muted fault should not be kept in history")
+
+		fakeClock.Step(time.Hour)
+		callback(context.Background(), podFault)
+		s.Len(session.GetLogCalls(), 1, "faults should resume once the mute expires")
+	})
+
+	s.Run("other resources are not muted", func() {
+		manager, fakeClock, session, callback := newMutingManager()
+		manager.Mute("cluster1", "Pod", "default", "test-pod", fakeClock.Now().Add(time.Hour))
+
+		otherPod := podFault
+		otherPod.Name = "other-pod"
+		otherNamespace := podFault
+		otherNamespace.Namespace = "staging"
+		callback(context.Background(), otherPod)
+		callback(context.Background(), otherNamespace)
+		callback(context.Background(), nodeFault)
+
+		s.Len(session.GetLogCalls(), 3)
+	})
+
+	s.Run("mutes apply to their cluster only", func() {
+		manager, fakeClock, session, callback := newMutingManager()
+		manager.Mute("cluster2", "Pod", "default", "test-pod", fakeClock.Now().Add(time.Hour))
+
+		callback(context.Background(), podFault)
+
+		s.Len(session.GetLogCalls(), 1)
+	})
+
+	s.Run("cluster-scoped resources are muted without a namespace", func() {
+		manager, fakeClock, session, callback := newMutingManager()
+		manager.Mute("cluster1", "Node", "", "worker-1", fakeClock.Now().Add(time.Hour))
+
+		callback(context.Background(), nodeFault)
+
+		s.Empty(session.GetLogCalls())
+	})
+
+	s.Run("unmuted resources produce faults again", func() {
+		manager, fakeClock, session, callback := newMutingManager()
+		manager.Mute("cluster1", "Pod", "default", "test-pod", fakeClock.Now().Add(time.Hour))
+
+		manager.Unmute("cluster1", "Pod", "default", "test-pod")
+		callback(context.Background(), podFault)
+
+		s.Len(session.GetLogCalls(), 1)
+		s.Empty(manager.ActiveMutes())
+	})
+
+	s.Run("ActiveMutes lists unexpired mutes in order", func() {
+		manager, fakeClock, _, _ := newMutingManager()
+		now := fakeClock.Now()
+		manager.Mute("cluster1", "Pod", "default", "test-pod", now.Add(time.Hour))
+		manager.Mute("cluster1", "Node", "", "worker-1", now.Add(2*time.Hour))
+		manager.Mute("cluster1", "Pod", "default", "short-lived", now.Add(time.Minute))
+
+		s.Equal([]FaultMute{
+			{Cluster: "cluster1", Kind: "Node", Name: "worker-1", Until: now.Add(2 * time.Hour)},
+			{Cluster: "cluster1", Kind: "Pod", Namespace: "default", Name: "short-lived", Until: now.Add(time.Minute)},
+			{Cluster: "cluster1", Kind: "Pod", Namespace: "default", Name: "test-pod", Until: now.Add(time.Hour)},
+		}, manager.ActiveMutes())
+
+		fakeClock.Step(time.Hour)
+		s.Equal([]FaultMute{
+			{Cluster: "cluster1", Kind: "Node", Name: "worker-1", Until: now.Add(2 * time.Hour)},
+		}, manager.ActiveMutes(), "expired mutes should be dropped")
+	})
+
+	s.Run("muting again replaces the expiry", func() {
+		manager, fakeClock, session, callback := newMutingManager()
+		manager.Mute("cluster1", "Pod", "default", "test-pod", fakeClock.Now().Add(time.Hour))
+		manager.Mute("cluster1", "Pod", "default", "test-pod", fakeClock.Now().Add(time.Minute))
+
+		fakeClock.Step(time.Minute)
+		callback(context.Background(), podFault)
+
+		s.Len(session.GetLogCalls(), 1)
+	})
+}
+
+// TestFaultSinks tests that every emitted fault is forwarded to configured sinks
+func (s *ManagerTestSuite) TestFaultSinks() {
+	signal := FaultSignal{
+		FaultType:   FaultTypeCrashLoop,
+		ResourceUID: "pod-uid",
+		Kind:        "Pod",
+		Name:        "test-pod",
+		Namespace:   "default",
+		Severity:    SeverityCritical,
+		Timestamp:   time.Now(),
+	}
+
+	for _, historySize := range []int{0, 100} {
+		s.Run(fmt.Sprintf("fault reported by several subscriptions is forwarded for each with history size %d", historySize), func() {
+			sink := &MockNotificationSink{}
+			config := NewTestManagerConfig()
+			config.Sinks = []NotificationSink{sink}
+			config.FaultHistorySize = historySize
+			manager := NewEventSubscriptionManager(s.server, config, nil, nil)
+
+			session := NewMockServerSession("session1")
+			session.SetLogLevel(mcp.LoggingLevel("info"))
+			s.server.AddSession(session)
+
+			sub1, err := manager.Create("session1", "cluster1", "faults", SubscriptionFilters{}, "")
+			s.Require().NoError(err)
+			sub2, err := manager.Create("session1", "cluster1", "faults", SubscriptionFilters{}, "")
+			s.Require().NoError(err)
+
+			manager.makeFaultSignalCallback(sub1)(context.Background(), signal)
+			manager.makeFaultSignalCallback(sub2)(context.Background(), signal)
+
+			s.Eventually(func() bool {
+				return len(sink.GetNotifications()) == 2
+			}, time.Second, 10*time.Millisecond, "sink should receive every emitted fault")
+
+			for _, notification := range sink.GetNotifications() {
+				s.Equal(FaultTypeCrashLoop, notification.FaultType)
+				s.Equal("test-pod", notification.Resource.Name)
+			}
+			s.Len(session.GetLogCalls(), 2, "each subscription still receives its notification")
+		})
+	}
+}
+
+// TestFaultContextYAML tests that fault notifications carry their details as YAML when configured
+func (s *ManagerTestSuite) TestFaultContextYAML() {
+	signal := FaultSignal{
+		FaultType:   FaultTypePodCrash,
+		ResourceUID: "pod-uid",
+		Kind:        "Pod",
+		Name:        "test-pod",
+		Namespace:   "default",
+		Severity:    SeverityWarning,
+		Context:     "Container app crashed with exit code 2, reason: Error",
+		Details:     map[string]string{"container": "app", "exitCode": "2", "reason": "Error"},
+		Timestamp:   time.Now(),
+	}
+
+	notify := func(config ManagerConfig) *ResourceFaultNotification {
+		manager := NewEventSubscriptionManager(s.server, config, nil, nil)
+
+		session := NewMockServerSession("session1")
+		session.SetLogLevel(mcp.LoggingLevel("info"))
+		s.server.AddSession(session)
+
+		sub, err := manager.Create("session1", "cluster1", "faults", SubscriptionFilters{}, "")
+		s.Require().NoError(err)
+		manager.makeFaultSignalCallback(sub)(context.Background(), signal)
+
+		calls := session.GetLogCalls()
+		s.Require().Len(calls, 1)
+		notification, ok := calls[0].Data.(*ResourceFaultNotification)
+		s.Require().True(ok)
+		return notification
+	}
+
+	s.Run("contextYAML round-trips to the details when enabled", func() {
+		config := NewTestManagerConfig()
+		config.FaultContextYAML = true
+
+		notification := notify(config)
+
+		s.Require().NotEmpty(notification.ContextYAML)
+		var details map[string]string
+		s.Require().NoError(yaml.Unmarshal([]byte(notification.ContextYAML), &details))
+		s.Equal(signal.Details, details)
+		s.Equal(signal.Context, notification.Context, "flat context is kept")
+		s.Equal(signal.Details, notification.Details)
+	})
+
+	s.Run("contextYAML is omitted by default", func() {
+		notification := notify(NewTestManagerConfig())
+
+		s.Empty(notification.ContextYAML)
+		s.Equal(signal.Context, notification.Context)
+	})
+}
+
+// TestGetSubscription tests the GetSubscription method
+func (s *ManagerTestSuite) TestGetSubscription() {
+	s.Run("returns subscription when exists", func() {
+		filters := SubscriptionFilters{
+			Namespaces: []string{"default"},
+		}
+
+		sub, err := s.manager.Create("session1", "cluster1", "events", filters, "")
+		s.Require().NoError(err)
+
+		retrieved := s.manager.GetSubscription(sub.ID)
+		s.NotNil(retrieved)
+		s.Equal(sub.ID, retrieved.ID)
+		s.Equal("session1", retrieved.SessionID)
+		s.Equal("cluster1", retrieved.Cluster)
+		s.Equal("events", retrieved.Mode)
+		s.Equal(filters.Namespaces, retrieved.Filters.Namespaces)
+	})
+
+	s.Run("returns nil when subscription does not exist", func() {
+		retrieved := s.manager.GetSubscription("non-existent-id")
+		s.Nil(retrieved)
+	})
+}
+
+// TestListSubscriptionsForSession tests the ListSubscriptionsForSession method
+func (s *ManagerTestSuite) TestListSubscriptionsForSession() {
+	s.Run("returns all subscriptions for session", func() {
+		filters := SubscriptionFilters{}
+
+		sub1, err := s.manager.Create("session1", "cluster1", "events", filters, "")
+		s.Require().NoError(err)
+
+		sub2, err := s.manager.Create("session1", "cluster2", "faults", filters, "")
+		s.Require().NoError(err)
+
+		// Create subscription for different session
+		_, err = s.manager.Create("session2", "cluster1", "events", filters, "")
+		s.Require().NoError(err)
+
+		// List session1 subscriptions
+		subs := s.manager.ListSubscriptionsForSession("session1")
+		s.Len(subs, 2)
+
+		// Verify both subscriptions are in the list
+		ids := make(map[string]bool)
+		for _, sub := range subs {
+			ids[sub.ID] = true
+		}
+		s.True(ids[sub1.ID])
+		s.True(ids[sub2.ID])
+	})
+
+	s.Run("returns subscriptions in creation order", func() {
+		filters := SubscriptionFilters{}
+
+		sub1, err := s.manager.Create("session1", "cluster1", "events", filters, "")
+		s.Require().NoError(err)
+
+		sub2, err := s.manager.Create("session1", "cluster2", "events", filters, "")
+		s.Require().NoError(err)
+
+		sub3, err := s.manager.Create("session1", "cluster3", "faults", filters, "")
+		s.Require().NoError(err)
+
+		for i := 0; i < 5; i++ {
+			subs := s.manager.ListSubscriptionsForSession("session1")
+			s.Require().Len(subs, 3)
+			s.Equal(sub1.ID, subs[0].ID)
+			s.Equal(sub2.ID, subs[1].ID)
+			s.Equal(sub3.ID, subs[2].ID)
+		}
+	})
+
+	s.Run("returns empty list for non-existent session", func() {
+		subs := s.manager.ListSubscriptionsForSession("non-existent-session")
+		s.Len(subs, 0)
+	})
+}
+
+// TestListSubscriptions tests listing subscriptions with a SubscriptionQuery
+func (s *ManagerTestSuite) TestListSubscriptions() {
+	// createSubs creates subscriptions across sessions, clusters and modes,
+	// with the faults subscription on cluster1 marked as degraded.
+	createSubs := func() map[string]*Subscription {
+		subs := make(map[string]*Subscription)
+		for _, spec := range []struct{ name, session, cluster, mode string }{
+			{"s1-c1-events", "session1", "cluster1", "events"},
+			{"s1-c1-faults", "session1", "cluster1", "faults"},
+			{"s1-c2-events", "session1", "cluster2", "events"},
+			{"s2-c1-events", "session2", "cluster1", "events"},
+			{"s2-c2-faults", "session2", "cluster2", "faults"},
+		} {
+			sub, err := s.manager.Create(spec.session, spec.cluster, spec.mode, SubscriptionFilters{}, "")
+			s.Require().NoError(err)
+			subs[spec.name] = sub
+		}
+		subs["s1-c1-faults"].Degraded = true
+		subs["s2-c2-faults"].Health = WatchHealthDegraded
+		return subs
+	}
+
+	ids := func(subs []*Subscription) []string {
+		result := make([]string, 0, len(subs))
+		for _, sub := range subs {
+			result = append(result, sub.ID)
+		}
+		return result
+	}
+
+	s.Run("empty query returns every subscription in creation order", func() {
+		subs := createSubs()
+		s.Equal([]string{
+			subs["s1-c1-events"].ID, subs["s1-c1-faults"].ID, subs["s1-c2-events"].ID,
+			subs["s2-c1-events"].ID, subs["s2-c2-faults"].ID,
+		}, ids(s.manager.ListSubscriptions(SubscriptionQuery{})))
+	})
+
+	s.Run("filters match every combination", func() {
+		subs := createSubs()
+		for _, tc := range []struct {
+			query    SubscriptionQuery
+			expected []string
+		}{
+			{SubscriptionQuery{Mode: "events"}, []string{"s1-c1-events", "s1-c2-events", "s2-c1-events"}},
+			{SubscriptionQuery{Mode: "faults"}, []string{"s1-c1-faults", "s2-c2-faults"}},
+			{SubscriptionQuery{Cluster: "cluster1"}, []string{"s1-c1-events", "s1-c1-faults", "s2-c1-events"}},
+			{SubscriptionQuery{SessionID: "session2"}, []string{"s2-c1-events", "s2-c2-faults"}},
+			{SubscriptionQuery{DegradedOnly: true}, []string{"s1-c1-faults", "s2-c2-faults"}},
+			{SubscriptionQuery{SessionID: "session1", Cluster: "cluster1"}, []string{"s1-c1-events", "s1-c1-faults"}},
+			{SubscriptionQuery{SessionID: "session1", Mode: "events"}, []string{"s1-c1-events", "s1-c2-events"}},
+			{SubscriptionQuery{Cluster: "cluster2", Mode: "faults"}, []string{"s2-c2-faults"}},
+			{SubscriptionQuery{Cluster: "cluster1", DegradedOnly: true}, []string{"s1-c1-faults"}},
+			{SubscriptionQuery{SessionID: "session2", DegradedOnly: true}, []string{"s2-c2-faults"}},
+			{SubscriptionQuery{Mode: "events", DegradedOnly: true}, []string{}},
+			{SubscriptionQuery{SessionID: "session1", Cluster: "cluster1", Mode: "faults", DegradedOnly: true}, []string{"s1-c1-faults"}},
+			{SubscriptionQuery{SessionID: "session2", Cluster: "cluster1", Mode: "faults"}, []string{}},
+		} {
+			expected := make([]string, 0, len(tc.expected))
+			for _, name := range tc.expected {
+				expected = append(expected, subs[name].ID)
+			}
+			s.Equal(expected, ids(s.manager.ListSubscriptions(tc.query)), "query %+v", tc.query)
+		}
+	})
+
+	s.Run("unknown session or cluster returns nothing", func() {
+		createSubs()
+		s.Empty(s.manager.ListSubscriptions(SubscriptionQuery{SessionID: "non-existent-session"}))
+		s.Empty(s.manager.ListSubscriptions(SubscriptionQuery{Cluster: "non-existent-cluster"}))
+		s.Empty(s.manager.ListSubscriptions(SubscriptionQuery{SessionID: "session1", Cluster: "non-existent-cluster"}))
+	})
+
+	s.Run("cancelled subscriptions are not listed", func() {
+		subs := createSubs()
+		s.Require().NoError(s.manager.Cancel(subs["s1-c1-faults"].ID))
+		s.Equal([]string{subs["s2-c2-faults"].ID}, ids(s.manager.ListSubscriptions(SubscriptionQuery{DegradedOnly: true})))
+	})
+}
+
+// TestGetStats tests the GetStats method
+func (s *ManagerTestSuite) TestGetStats() {
+	s.Run("returns correct statistics", func() {
+		filters := SubscriptionFilters{}
+
+		// Initially empty
+		stats := s.manager.GetStats()
+		s.Equal(0, stats.Total)
+		s.Equal(0, stats.Sessions)
+		s.Equal(0, stats.Clusters)
+		s.Equal(0, stats.Degraded)
+
+		// Add subscriptions
+		sub1, err := s.manager.Create("session1", "cluster1", "events", filters, "")
+		s.Require().NoError(err)
+
+		sub2, err := s.manager.Create("session1", "cluster2", "faults", filters, "")
+		s.Require().NoError(err)
+
+		_, err = s.manager.Create("session2", "cluster1", "events", filters, "")
+		s.Require().NoError(err)
+
+		// Check stats
+		stats = s.manager.GetStats()
+		s.Equal(3, stats.Total)
+		s.Equal(2, stats.Sessions)
+		s.Equal(2, stats.Clusters)
+		s.Equal(0, stats.Degraded)
+
+		// Mark one as degraded
+		sub1.Degraded = true
+
+		stats = s.manager.GetStats()
+		s.Equal(1, stats.Degraded)
+
+		// Remove one subscription
+		err = s.manager.Cancel(sub2.ID)
+		s.Require().NoError(err)
+
+		stats = s.manager.GetStats()
+		s.Equal(2, stats.Total)
+		s.Equal(2, stats.Sessions)
+		s.Equal(1, stats.Clusters)
+	})
+
+	s.Run("breaks subscriptions down by cluster and mode", func() {
+		stats := s.manager.GetStats()
+		s.Empty(stats.PerCluster)
+		s.Empty(stats.PerMode)
+
+		_, err := s.manager.Create("session1", "cluster1", "events", SubscriptionFilters{}, "")
+		s.Require().NoError(err)
+		_, err = s.manager.Create("session1", "cluster1", "faults", SubscriptionFilters{}, "")
+		s.Require().NoError(err)
+		_, err = s.manager.Create("session2", "cluster1", "events", SubscriptionFilters{Type: "Warning"}, "")
+		s.Require().NoError(err)
+		sub, err := s.manager.Create("session2", "cluster2", "events", SubscriptionFilters{}, "")
+		s.Require().NoError(err)
+
+		stats = s.manager.GetStats()
+		s.Equal(map[string]int{"cluster1": 3, "cluster2": 1}, stats.PerCluster)
+		s.Equal(map[string]int{"events": 3, "faults": 1}, stats.PerMode)
+
+		s.Require().NoError(s.manager.Cancel(sub.ID))
+
+		stats = s.manager.GetStats()
+		s.Equal(map[string]int{"cluster1": 3}, stats.PerCluster)
+		s.Equal(map[string]int{"events": 2, "faults": 1}, stats.PerMode)
+	})
+}
+
+// TestSubsystemHealth tests that the health report reflects the session monitor, subscriptions and breakers
+func (s *ManagerTestSuite) TestSubsystemHealth() {
+	s.Run("is not ready until the session monitor runs", func() {
+		report := s.manager.SubsystemHealth()
+		s.False(report.Ready)
+		s.False(report.SessionMonitorRunning)
+		s.Zero(report.Subscriptions)
+		s.True(report.LastNotification.IsZero(), "no notification has been delivered yet")
+
+		ctx, cancel := context.WithCancel(context.Background())
+		done := make(chan struct{})
+		go func() {
+			s.manager.StartSessionMonitor(ctx)
+			close(done)
+		}()
+		s.Eventually(func() bool {
+			return s.manager.SubsystemHealth().Ready
+		}, time.Second, 10*time.Millisecond, "running session monitor should make the subsystem ready")
+
+		cancel()
+		<-done
+		report = s.manager.SubsystemHealth()
+		s.False(report.SessionMonitorRunning)
+		s.False(report.Ready)
+	})
+
+	s.Run("counts degraded subscriptions without affecting readiness", func() {
+		s.manager.monitorRunning.Store(true)
+		sub1, err := s.manager.Create("session1", "cluster1", "events", SubscriptionFilters{}, "")
+		s.Require().NoError(err)
+		_, err = s.manager.Create("session1", "cluster1", "faults", SubscriptionFilters{}, "")
+		s.Require().NoError(err)
+		sub3, err := s.manager.Create("session2", "cluster2", "events", SubscriptionFilters{}, "")
+		s.Require().NoError(err)
+
+		s.manager.setSubscriptionHealth(sub1.ID, WatchHealthDegraded, errors.New("events is forbidden"))
+		sub3.Degraded = true
+
+		report := s.manager.SubsystemHealth()
+		s.Equal(3, report.Subscriptions)
+		s.Equal(2, report.DegradedSubscriptions)
+		s.True(report.Ready)
+	})
+
+	s.Run("open circuit breakers make the subsystem not ready", func() {
+		config := NewTestManagerConfig()
+		config.WatchBreakerThreshold = 1
+		config.WatchBreakerCooldown = time.Hour
+		manager := NewEventSubscriptionManager(s.server, config, nil, nil)
+		manager.monitorRunning.Store(true)
+		manager.breakers.forCluster("cluster1").RecordSuccess()
+		manager.breakers.forCluster("cluster2").RecordFailure()
+		manager.breakers.forCluster("cluster3").RecordFailure()
+
+		report := manager.SubsystemHealth()
+		s.Equal(2, report.OpenBreakers)
+		s.False(report.Ready)
+
+		manager.breakers.remove("cluster2")
+		manager.breakers.remove("cluster3")
+		report = manager.SubsystemHealth()
+		s.Zero(report.OpenBreakers)
+		s.True(report.Ready)
+	})
+
+	s.Run("reports the time of the last delivered notification", func() {
+		fakeClock := clocktesting.NewFakeClock(time.Date(2025, 1, 15, 12, 0, 0, 0, time.UTC))
+		config := NewTestManagerConfig()
+		config.Clock = fakeClock
+		manager := NewEventSubscriptionManager(s.server, config, nil, nil)
+		session := NewMockServerSession("session1")
+		session.SetLogLevel(mcp.LoggingLevel("info"))
+		s.server.AddSession(session)
+
+		s.Require().NoError(manager.SendTestNotification("session1"))
+		s.WithinDuration(fakeClock.Now(), manager.SubsystemHealth().LastNotification, 0)
+
+		// Failed sends leave the last delivery time alone
+		fakeClock.Step(time.Minute)
+		s.Require().Error(manager.SendTestNotification("missing"))
+		s.WithinDuration(fakeClock.Now().Add(-time.Minute), manager.SubsystemHealth().LastNotification, 0)
+	})
+}
+
+// TestReconcileSession tests that ReconcileSession applies only the differences to a session's subscriptions
+func (s *ManagerTestSuite) TestReconcileSession() {
+	warnings := SubscriptionFilters{Type: "Warning"}
+	defaultNamespace := SubscriptionFilters{Namespaces: []string{"default"}}
+
+	// subscriptionIDs returns the IDs of the session's subscriptions
+	subscriptionIDs := func(manager *EventSubscriptionManager, sessionID string) []string {
+		var ids []string
+		for _, sub := range manager.ListSubscriptionsForSession(sessionID) {
+			ids = append(ids, sub.ID)
+		}
+		return ids
+	}
+
+	s.Run("creates desired subscriptions for a new session", func() {
+		added, removed, err := s.manager.ReconcileSession("session1", "cluster1", []DesiredSubscription{
+			{Mode: "events", Filters: warnings},
+			{Mode: "faults"},
+		})
+		s.Require().NoError(err)
+		s.Len(added, 2)
+		s.Empty(removed)
+		s.ElementsMatch(added, subscriptionIDs(s.manager, "session1"))
+	})
+
+	s.Run("keeps unchanged subscriptions and applies only the differences", func() {
+		unchanged, err := s.manager.Create("session1", "cluster1", "events", warnings, "")
+		s.Require().NoError(err)
+		stale, err := s.manager.Create("session1", "cluster1", "events", defaultNamespace, "")
+		s.Require().NoError(err)
+
+		added, removed, err := s.manager.ReconcileSession("session1", "cluster1", []DesiredSubscription{
+			{Mode: "events", Filters: SubscriptionFilters{Type: "Warning"}},
+			{Mode: "faults"},
+		})
+		s.Require().NoError(err)
+		s.Len(added, 1)
+		s.Equal([]string{stale.ID}, removed)
+		s.ElementsMatch([]string{unchanged.ID, added[0]}, subscriptionIDs(s.manager, "session1"))
+		s.Same(unchanged, s.manager.GetSubscription(unchanged.ID), "unchanged subscription should keep running")
+		s.Equal("faults", s.manager.GetSubscription(added[0]).Mode)
+	})
+
+	s.Run("is a no-op when the desired set is already in place", func() {
+		existing, err := s.manager.Create("session1", "cluster1", "events", warnings, "")
+		s.Require().NoError(err)
+
+		added, removed, err := s.manager.ReconcileSession("session1", "cluster1", []DesiredSubscription{{Mode: "events", Filters: warnings}})
+		s.Require().NoError(err)
+		s.Empty(added)
+		s.Empty(removed)
+		s.Equal([]string{existing.ID}, subscriptionIDs(s.manager, "session1"))
+	})
+
+	s.Run("distinguishes modes with the same filters", func() {
+		events, err := s.manager.Create("session1", "cluster1", "events", SubscriptionFilters{}, "")
+		s.Require().NoError(err)
+
+		added, removed, err := s.manager.ReconcileSession("session1", "cluster1", []DesiredSubscription{{Mode: "faults"}})
+		s.Require().NoError(err)
+		s.Len(added, 1)
+		s.Equal([]string{events.ID}, removed)
+	})
+
+	s.Run("repeated desired subscriptions each get a subscription", func() {
+		existing, err := s.manager.Create("session1", "cluster1", "events", warnings, "")
+		s.Require().NoError(err)
+
+		added, removed, err := s.manager.ReconcileSession("session1", "cluster1", []DesiredSubscription{
+			{Mode: "events", Filters: warnings},
+			{Mode: "events", Filters: warnings},
+		})
+		s.Require().NoError(err)
+		s.Len(added, 1)
+		s.Empty(removed)
+		s.Contains(subscriptionIDs(s.manager, "session1"), existing.ID)
+	})
+
+	s.Run("replaces degraded subscriptions", func() {
+		degraded, err := s.manager.Create("session1", "cluster1", "events", warnings, "")
+		s.Require().NoError(err)
+		degraded.Degraded = true
+
+		added, removed, err := s.manager.ReconcileSession("session1", "cluster1", []DesiredSubscription{{Mode: "events", Filters: warnings}})
+		s.Require().NoError(err)
+		s.Len(added, 1)
+		s.Equal([]string{degraded.ID}, removed)
+	})
+
+	s.Run("leaves other clusters and sessions alone", func() {
+		otherCluster, err := s.manager.Create("session1", "cluster2", "events", warnings, "")
+		s.Require().NoError(err)
+		otherSession, err := s.manager.Create("session2", "cluster1", "events", warnings, "")
+		s.Require().NoError(err)
+
+		added, removed, err := s.manager.ReconcileSession("session1", "cluster1", nil)
+		s.Require().NoError(err)
+		s.Empty(added)
+		s.Empty(removed)
+		s.NotNil(s.manager.GetSubscription(otherCluster.ID))
+		s.NotNil(s.manager.GetSubscription(otherSession.ID))
+	})
+
+	s.Run("makes no changes when a desired subscription is invalid", func() {
+		existing, err := s.manager.Create("session1", "cluster1", "events", warnings, "")
+		s.Require().NoError(err)
+
+		_, _, err = s.manager.ReconcileSession("session1", "cluster1", []DesiredSubscription{
+			{Mode: "faults"},
+			{Mode: "events", Filters: SubscriptionFilters{Type: "Invalid"}},
+		})
+		s.Require().Error(err)
+		s.ErrorIs(err, ErrInvalidFilters)
+		s.Equal([]string{existing.ID}, subscriptionIDs(s.manager, "session1"))
+	})
+
+	s.Run("counts removed subscriptions towards the session limit", func() {
+		for i := 0; i < s.config.MaxSubscriptionsPerSession; i++ {
+			_, err := s.manager.Create("session1", "cluster1", "events", SubscriptionFilters{Namespaces: []string{fmt.Sprintf("ns%d", i)}}, "")
+			s.Require().NoError(err)
+		}
+
+		added, removed, err := s.manager.ReconcileSession("session1", "cluster1", []DesiredSubscription{
+			{Mode: "events", Filters: SubscriptionFilters{Namespaces: []string{"ns0"}}},
+			{Mode: "faults"},
+		})
+		s.Require().NoError(err, "the limit applies to the reconciled set, not the existing one")
+		s.Len(added, 1)
+		s.Len(removed, 2)
+	})
+
+	s.Run("makes no changes when the limit would be exceeded", func() {
+		existing, err := s.manager.Create("session1", "cluster1", "events", warnings, "")
+		s.Require().NoError(err)
+
+		desired := make([]DesiredSubscription, s.config.MaxSubscriptionsPerSession+1)
+		for i := range desired {
+			desired[i] = DesiredSubscription{Mode: "events", Filters: SubscriptionFilters{Namespaces: []string{fmt.Sprintf("ns%d", i)}}}
+		}
+		_, _, err = s.manager.ReconcileSession("session1", "cluster1", desired)
+		s.Require().Error(err)
+		s.ErrorIs(err, ErrSessionLimitExceeded)
+		s.Equal([]string{existing.ID}, subscriptionIDs(s.manager, "session1"))
+	})
+
+	s.Run("unchanged subscriptions keep their watch", func() {
+		clientset := fake.NewClientset()
+		var watches atomic.Int32
+		clientset.PrependWatchReactor("events", func(action k8stesting.Action) (bool, watch.Interface, error) {
+			watches.Add(1)
+			return true, watch.NewFake(), nil
+		})
+		var clusterDown atomic.Bool
+		getK8sClient := func(cluster string) (*pkgkubernetes.Kubernetes, error) {
+			if clusterDown.Load() {
+				return nil, errors.New("cluster unavailable")
+			}
+			return &pkgkubernetes.Kubernetes{Interface: clientset}, nil
+		}
+		manager := NewEventSubscriptionManager(s.server, s.config, getK8sClient, nil)
+		defer manager.CancelAll()
+
+		unchanged, err := manager.Create("session1", "cluster1", "events", defaultNamespace, "")
+		s.Require().NoError(err)
+		s.Require().Eventually(func() bool { return watches.Load() == 1 }, time.Second, 10*time.Millisecond)
+
+		added, removed, err := manager.ReconcileSession("session1", "cluster1", []DesiredSubscription{
+			{Mode: "events", Filters: defaultNamespace},
+			{Mode: "events", Filters: SubscriptionFilters{Namespaces: []string{"kube-system"}}},
+		})
+		s.Require().NoError(err)
+		s.Len(added, 1)
+		s.Empty(removed)
+		s.Require().Eventually(func() bool { return watches.Load() == 2 }, time.Second, 10*time.Millisecond)
+		s.Never(func() bool { return watches.Load() > 2 }, 100*time.Millisecond, 10*time.Millisecond,
+			"the unchanged subscription's watch should not be restarted")
+		s.Equal(unchanged.ID, manager.GetSubscription(unchanged.ID).ID)
+
+		// A subscription that fails to start rolls back the whole reconciliation
+		clusterDown.Store(true)
+		_, _, err = manager.ReconcileSession("session1", "cluster1", []DesiredSubscription{{Mode: "faults"}})
+		s.Require().Error(err)
+		s.Contains(err.Error(), "failed to start watcher")
+		s.ElementsMatch([]string{unchanged.ID, added[0]}, subscriptionIDs(manager, "session1"))
+	})
+}
+
+// TestSessionMonitor tests the session monitoring functionality
+func (s *ManagerTestSuite) TestSessionMonitor() {
+	s.Run("removes subscriptions for stale sessions", func() {
+		filters := SubscriptionFilters{}
+
+		// Create a session and add it to the server
+		session1 := NewMockServerSession("session1")
+		s.server.AddSession(session1)
+
+		// Create subscriptions
+		sub1, err := s.manager.Create("session1", "cluster1", "events", filters, "")
+		s.Require().NoError(err)
+
+		sub2, err := s.manager.Create("session2", "cluster1", "events", filters, "")
+		s.Require().NoError(err)
+
+		// Verify both exist
+		s.NotNil(s.manager.GetSubscription(sub1.ID))
+		s.NotNil(s.manager.GetSubscription(sub2.ID))
+
+		// Trigger cleanup (session2 is not in the server, so it's stale)
+		s.manager.cleanupStaleSessions()
+
+		// session1 subscription should remain (it's in the server)
+		s.NotNil(s.manager.GetSubscription(sub1.ID))
+
+		// session2 subscription should be removed (it's stale)
+		s.Nil(s.manager.GetSubscription(sub2.ID))
+	})
+
+	s.Run("keeps subscriptions for active sessions", func() {
+		filters := SubscriptionFilters{}
+
+		// Create sessions and add them to the server
+		session1 := NewMockServerSession("session1")
+		session2 := NewMockServerSession("session2")
+		s.server.AddSession(session1)
+		s.server.AddSession(session2)
+
+		// Create subscriptions
+		sub1, err := s.manager.Create("session1", "cluster1", "events", filters, "")
+		s.Require().NoError(err)
+
+		sub2, err := s.manager.Create("session2", "cluster1", "events", filters, "")
+		s.Require().NoError(err)
+
+		// Trigger cleanup
+		s.manager.cleanupStaleSessions()
+
+		// Both subscriptions should remain (both sessions are active)
+		s.NotNil(s.manager.GetSubscription(sub1.ID))
+		s.NotNil(s.manager.GetSubscription(sub2.ID))
+	})
+}
+
+// TestReconcileClusters tests that subscriptions for removed clusters are cancelled
+func (s *ManagerTestSuite) TestReconcileClusters() {
+	s.Run("cancels subscriptions for removed clusters and notifies clients", func() {
+		session := NewMockServerSession("session1")
+		session.SetLogLevel(mcp.LoggingLevel("info"))
+		s.server.AddSession(session)
+
+		removed, err := s.manager.Create("session1", "removed-cluster", "events", SubscriptionFilters{}, "")
+		s.Require().NoError(err)
+		kept, err := s.manager.Create("session1", "cluster1", "faults", SubscriptionFilters{}, "")
+		s.Require().NoError(err)
+
+		s.manager.reconcileClusters(map[string]bool{"removed-cluster": true})
+
+		s.Nil(s.manager.GetSubscription(removed.ID), "subscription for removed cluster should be cancelled")
+		s.NotNil(s.manager.GetSubscription(kept.ID), "subscription for valid cluster should remain")
+
+		calls := session.GetLogCalls()
+		s.Require().Len(calls, 1)
+		s.Equal(LoggerSubscriptionError, calls[0].Logger)
+		notification, ok := calls[0].Data.(*SubscriptionErrorNotification)
+		s.Require().True(ok)
+		s.Equal(NotificationSchemaVersion, notification.SchemaVersion)
+		s.Equal(removed.ID, notification.SubscriptionID)
+		s.Equal("removed-cluster", notification.Cluster)
+		s.Contains(notification.Error, "removed")
+	})
+
+	s.Run("clears fault history of removed clusters", func() {
+		_, err := s.manager.Create("session1", "removed-cluster", "faults", SubscriptionFilters{}, "")
+		s.Require().NoError(err)
+		s.manager.faultHistory.Record("removed-cluster", FaultSignal{FaultType: FaultTypePodCrash, ResourceUID: "pod-uid", Timestamp: time.Now()})
+
+		s.manager.reconcileClusters(map[string]bool{"removed-cluster": true})
+
+		s.Empty(s.manager.GetRecentFaults("removed-cluster", 0))
+	})
+
+	s.Run("keeps subscriptions of clusters that were not found invalid", func() {
+		// Created after the validity snapshot, so the cluster was never checked
+		added, err := s.manager.Create("session1", "new-cluster", "events", SubscriptionFilters{}, "")
+		s.Require().NoError(err)
+		s.manager.faultHistory.Record("new-cluster", FaultSignal{FaultType: FaultTypePodCrash, ResourceUID: "pod-uid", Timestamp: time.Now()})
+
+		s.manager.reconcileClusters(map[string]bool{"removed-cluster": true})
+
+		s.NotNil(s.manager.GetSubscription(added.ID))
+		s.NotEmpty(s.manager.GetRecentFaults("new-cluster", 0))
+	})
+
+	s.Run("invalid clusters are those a client cannot be obtained for", func() {
+		_, err := s.manager.Create("session1", "removed-cluster", "events", SubscriptionFilters{}, "")
+		s.Require().NoError(err)
+		_, err = s.manager.Create("session1", "cluster1", "events", SubscriptionFilters{}, "")
+		s.Require().NoError(err)
+
+		s.manager.getK8sClient = func(cluster string) (*pkgkubernetes.Kubernetes, error) {
+			if cluster == "removed-cluster" {
+				return nil, errors.New("cluster not found")
+			}
+			return nil, nil
+		}
+
+		s.Equal(map[string]bool{"removed-cluster": true}, s.manager.invalidClusters())
+	})
+
+	s.Run("session monitor cancels subscriptions for removed clusters", func() {
+		s.server.AddSession(NewMockServerSession("session1"))
+		sub, err := s.manager.Create("session1", "removed-cluster", "events", SubscriptionFilters{}, "")
+		s.Require().NoError(err)
+
+		s.manager.getK8sClient = func(cluster string) (*pkgkubernetes.Kubernetes, error) {
+			return nil, errors.New("cluster not found")
+		}
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		go s.manager.StartSessionMonitor(ctx)
+
+		s.Eventually(func() bool {
+			return s.manager.GetSubscription(sub.ID) == nil
+		}, time.Second, 10*time.Millisecond)
+	})
+}
+
+// TestSubscriptionExpiry tests that subscriptions older than MaxSubscriptionTTL are cancelled
+func (s *ManagerTestSuite) TestSubscriptionExpiry() {
+	newExpiringManager := func(ttl time.Duration) (*EventSubscriptionManager, *clocktesting.FakeClock) {
+		fakeClock := clocktesting.NewFakeClock(time.Now())
+		config := NewTestManagerConfig()
+		config.MaxSubscriptionTTL = ttl
+		config.Clock = fakeClock
+		return NewEventSubscriptionManager(s.server, config, nil, nil), fakeClock
+	}
+
+	s.Run("expires old subscriptions while newer ones survive", func() {
+		session := NewMockServerSession("session1")
+		session.SetLogLevel(mcp.LoggingLevel("info"))
+		s.server.AddSession(session)
+		ttl := 100 * time.Millisecond
+		manager, fakeClock := newExpiringManager(ttl)
+
+		old, err := manager.Create("session1", "cluster1", "events", SubscriptionFilters{}, "")
+		s.Require().NoError(err)
+		fakeClock.Step(60 * time.Millisecond)
+		newer, err := manager.Create("session1", "cluster1", "faults", SubscriptionFilters{}, "")
+		s.Require().NoError(err)
+
+		// Halfway through the newer subscription's lifetime, the older one is past its TTL
+		fakeClock.Step(ttl / 2)
+		manager.expireSubscriptions()
+
+		s.Nil(manager.GetSubscription(old.ID), "subscription older than the TTL should be expired")
+		s.NotNil(manager.GetSubscription(newer.ID), "subscription younger than the TTL should survive")
+		s.Len(manager.ListSubscriptionsForSession("session1"), 1, "expired subscription should be untracked")
+	})
+
+	s.Run("notifies the session of the expiry", func() {
+		session := NewMockServerSession("session1")
+		session.SetLogLevel(mcp.LoggingLevel("info"))
+		s.server.AddSession(session)
+		manager, fakeClock := newExpiringManager(time.Minute)
+
+		sub, err := manager.Create("session1", "cluster1", "events", SubscriptionFilters{}, "")
+		s.Require().NoError(err)
+		fakeClock.Step(time.Minute)
+		manager.expireSubscriptions()
+
+		calls := session.GetLogCalls()
+		s.Require().Len(calls, 1)
+		s.Equal(LoggerSubscriptionError, calls[0].Logger)
+		notification, ok := calls[0].Data.(*SubscriptionErrorNotification)
+		s.Require().True(ok)
+		s.Equal(NotificationSchemaVersion, notification.SchemaVersion)
+		s.Equal(sub.ID, notification.SubscriptionID)
+		s.Equal("cluster1", notification.Cluster)
+		s.Contains(notification.Error, "expired after reaching the maximum lifetime of 1m0s")
+		s.False(notification.Degraded)
+	})
+
+	s.Run("zero TTL disables expiry", func() {
+		manager, fakeClock := newExpiringManager(0)
+
+		sub, err := manager.Create("session1", "cluster1", "events", SubscriptionFilters{}, "")
+		s.Require().NoError(err)
+		fakeClock.Step(24 * time.Hour)
+		manager.expireSubscriptions()
+
+		s.NotNil(manager.GetSubscription(sub.ID))
+	})
+
+	s.Run("session monitor expires subscriptions", func() {
+		s.server.AddSession(NewMockServerSession("session1"))
+		manager, fakeClock := newExpiringManager(50 * time.Millisecond)
+
+		sub, err := manager.Create("session1", "cluster1", "events", SubscriptionFilters{}, "")
+		s.Require().NoError(err)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		go manager.StartSessionMonitor(ctx)
+
+		// The subscription is past its TTL on the monitor's first check
+		s.Eventually(fakeClock.HasWaiters, time.Second, time.Millisecond, "session monitor should be started")
+		fakeClock.Step(manager.config.SessionMonitorInterval)
+		s.Eventually(func() bool {
+			return manager.GetSubscription(sub.ID) == nil
+		}, time.Second, 10*time.Millisecond, "session monitor should expire the subscription")
+	})
+}
+
+// TestIdleSubscriptions tests that events subscriptions without events within MaxIdleTime are cancelled
+func (s *ManagerTestSuite) TestIdleSubscriptions() {
+	newIdleManager := func(maxIdle time.Duration) (*EventSubscriptionManager, *clocktesting.FakeClock) {
+		fakeClock := clocktesting.NewFakeClock(time.Now())
+		config := NewTestManagerConfig()
+		config.MaxIdleTime = maxIdle
+		config.Clock = fakeClock
+		return NewEventSubscriptionManager(s.server, config, nil, nil), fakeClock
+	}
+
+	s.Run("cancels idle subscriptions while active ones survive", func() {
+		session := NewMockServerSession("session1")
+		session.SetLogLevel(mcp.LoggingLevel("info"))
+		s.server.AddSession(session)
+		manager, fakeClock := newIdleManager(time.Minute)
+
+		idle, err := manager.Create("session1", "cluster1", "events", SubscriptionFilters{}, "")
+		s.Require().NoError(err)
+		active, err := manager.Create("session1", "cluster1", "events", SubscriptionFilters{}, "")
+		s.Require().NoError(err)
+		process := manager.makeProcessEventFunc(context.Background(), active, nil)
+
+		// The active subscription receives an event halfway through the window
+		fakeClock.Step(30 * time.Second)
+		process(context.Background(), &v1.Event{Reason: "BackOff"})
+		s.True(fakeClock.Now().Equal(active.LastEventAt()))
+
+		fakeClock.Step(30 * time.Second)
+		manager.cancelIdleSubscriptions()
+
+		s.Nil(manager.GetSubscription(idle.ID), "subscription without events within the window should be cancelled")
+		s.NotNil(manager.GetSubscription(active.ID), "subscription with a recent event should survive")
+		s.Len(manager.ListSubscriptionsForSession("session1"), 1, "idle subscription should be untracked")
+	})
+
+	s.Run("notifies the session of the idle cancellation", func() {
+		session := NewMockServerSession("session1")
+		session.SetLogLevel(mcp.LoggingLevel("info"))
+		s.server.AddSession(session)
+		manager, fakeClock := newIdleManager(time.Minute)
+
+		sub, err := manager.Create("session1", "cluster1", "events", SubscriptionFilters{}, "")
+		s.Require().NoError(err)
+		s.True(sub.CreatedAt.Equal(sub.LastEventAt()), "idleness is measured from creation until the first event")
+		fakeClock.Step(time.Minute)
+		manager.cancelIdleSubscriptions()
+
+		calls := session.GetLogCalls()
+		s.Require().Len(calls, 1)
+		s.Equal(LoggerSubscriptionError, calls[0].Logger)
+		notification, ok := calls[0].Data.(*SubscriptionErrorNotification)
+		s.Require().True(ok)
+		s.Equal(sub.ID, notification.SubscriptionID)
+		s.Equal("cluster1", notification.Cluster)
+		s.Contains(notification.Error, "receiving no events for 1m0s")
+		s.False(notification.Degraded)
+	})
+
+	s.Run("faults subscriptions are not idle-cancelled", func() {
+		manager, fakeClock := newIdleManager(time.Minute)
+
+		sub, err := manager.Create("session1", "cluster1", "faults", SubscriptionFilters{}, "")
+		s.Require().NoError(err)
+		fakeClock.Step(time.Hour)
+		manager.cancelIdleSubscriptions()
+
+		s.NotNil(manager.GetSubscription(sub.ID))
+	})
+
+	s.Run("zero MaxIdleTime disables idle cancellation", func() {
+		manager, fakeClock := newIdleManager(0)
+
+		sub, err := manager.Create("session1", "cluster1", "events", SubscriptionFilters{}, "")
+		s.Require().NoError(err)
+		fakeClock.Step(24 * time.Hour)
+		manager.cancelIdleSubscriptions()
+
+		s.NotNil(manager.GetSubscription(sub.ID))
+	})
+
+	s.Run("session monitor cancels idle subscriptions", func() {
+		s.server.AddSession(NewMockServerSession("session1"))
+		manager, fakeClock := newIdleManager(50 * time.Millisecond)
+
+		sub, err := manager.Create("session1", "cluster1", "events", SubscriptionFilters{}, "")
+		s.Require().NoError(err)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		go manager.StartSessionMonitor(ctx)
+
+		// The subscription is idle past the window on the monitor's first check
+		s.Eventually(fakeClock.HasWaiters, time.Second, time.Millisecond, "session monitor should be started")
+		fakeClock.Step(manager.config.SessionMonitorInterval)
+		s.Eventually(func() bool {
+			return manager.GetSubscription(sub.ID) == nil
+		}, time.Second, 10*time.Millisecond, "session monitor should cancel the idle subscription")
+	})
+}
+
+// TestExportImportSubscriptions tests that exported subscriptions are recreated by importing them
+func (s *ManagerTestSuite) TestExportImportSubscriptions() {
+	includeModifications := true
+	create := func() {
+		_, err := s.manager.Create("session1", "cluster1", "events", SubscriptionFilters{
+			Namespaces:           []string{"default", "kube-system"},
+			Type:                 "Warning",
+			IncludeReasons:       []string{"BackOff", "Failed"},
+			IncludeModifications: &includeModifications,
+		}, "")
+		s.Require().NoError(err)
+		_, err = s.manager.Create("session1", "cluster2", "faults", SubscriptionFilters{LabelSelector: "app=web"}, "")
+		s.Require().NoError(err)
+		_, err = s.manager.Create("session2", "cluster1", "events", SubscriptionFilters{}, "")
+		s.Require().NoError(err)
+	}
+
+	s.Run("round-trips through JSON, clearing, and importing", func() {
+		create()
+		exported := s.manager.ExportSubscriptions()
+		s.Require().Len(exported, 3)
+		s.Equal("session1", exported[0].SessionID)
+		s.Equal("cluster1", exported[0].Cluster)
+		s.Equal("events", exported[0].Mode)
+		s.Equal("Warning", exported[0].Filters["type"])
+
+		backup, err := json.Marshal(exported)
+		s.Require().NoError(err)
+		s.NotContains(string(backup), "Cancel", "runtime-only fields should not be exported")
+
+		s.manager.CancelAll()
+		s.Empty(s.manager.ExportSubscriptions())
+
+		var restored []SubscriptionExport
+		s.Require().NoError(json.Unmarshal(backup, &restored))
+		imported, err := s.manager.ImportSubscriptions(restored)
+		s.Require().NoError(err)
+		s.Len(imported, 3)
+
+		reexported, err := json.Marshal(s.manager.ExportSubscriptions())
+		s.Require().NoError(err)
+		s.JSONEq(string(backup), string(reexported), "imported subscriptions should be equivalent to the exported ones")
+		s.Len(s.manager.ListSubscriptionsForSession("session1"), 2)
+		s.Len(s.manager.ListSubscriptionsForSession("session2"), 1)
+	})
+
+	s.Run("imported subscriptions get new IDs and start from the current resource version", func() {
+		s.manager.CancelAll()
+		original, err := s.manager.Create("session1", "cluster1", "events", SubscriptionFilters{}, "12345")
+		s.Require().NoError(err)
+		exported := s.manager.ExportSubscriptions()
+		s.manager.CancelAll()
+
+		imported, err := s.manager.ImportSubscriptions(exported)
+		s.Require().NoError(err)
+		s.Require().Len(imported, 1)
+		s.NotEqual(original.ID, imported[0].ID)
+		s.Empty(imported[0].ResumeFrom)
+		s.True(original.CreatedAt.Equal(imported[0].CreatedAt))
+	})
+
+	s.Run("invalid subscriptions are reported without stopping the others", func() {
+		s.manager.CancelAll()
+
+		imported, err := s.manager.ImportSubscriptions([]SubscriptionExport{
+			{SessionID: "session1", Cluster: "cluster1", Mode: "invalid"},
+			{SessionID: "session1", Cluster: "cluster1", Mode: "events", Filters: map[string]interface{}{"namespace": "default"}},
+			{SessionID: "session1", Cluster: "cluster1", Mode: "faults"},
+		})
+		s.Require().Error(err)
+		s.ErrorIs(err, ErrInvalidMode)
+		s.ErrorIs(err, ErrInvalidFilters)
+		s.Contains(err.Error(), "subscription 0 (session session1, cluster cluster1)")
+		s.Require().Len(imported, 1)
+		s.Equal("faults", imported[0].Mode)
+	})
+
+	s.Run("imports count toward the subscription limits", func() {
+		s.manager.CancelAll()
+		exports := make([]SubscriptionExport, s.config.MaxSubscriptionsPerSession+1)
+		for i := range exports {
+			exports[i] = SubscriptionExport{SessionID: "session1", Cluster: "cluster1", Mode: "events"}
+		}
+
+		imported, err := s.manager.ImportSubscriptions(exports)
+		s.ErrorIs(err, ErrSessionLimitExceeded)
+		s.Len(imported, s.config.MaxSubscriptionsPerSession)
+	})
+}
+
+// TestSubscriptionCreatedAt tests that subscriptions have timestamps
+func (s *ManagerTestSuite) TestSubscriptionCreatedAt() {
+	s.Run("sets CreatedAt timestamp", func() {
+		filters := SubscriptionFilters{}
+
+		before := time.Now()
+		sub, err := s.manager.Create("session1", "cluster1", "events", filters, "")
+		s.Require().NoError(err)
+		after := time.Now()
+
+		s.False(sub.CreatedAt.IsZero())
+		s.True(sub.CreatedAt.After(before) || sub.CreatedAt.Equal(before))
+		s.True(sub.CreatedAt.Before(after) || sub.CreatedAt.Equal(after))
+	})
+}
 
 // TestSubscriptionDegraded tests the Degraded flag
 func (s *ManagerTestSuite) TestSubscriptionDegraded() {
 	s.Run("starts as not degraded", func() {
 		filters := SubscriptionFilters{}
 
-		sub, err := s.manager.Create("session1", "cluster1", "events", filters)
+		sub, err := s.manager.Create("session1", "cluster1", "events", filters, "")
 		s.Require().NoError(err)
 
 		s.False(sub.Degraded)
@@ -658,7 +2723,7 @@ func (s *ManagerTestSuite) TestSubscriptionDegraded() {
 	s.Run("can be marked as degraded", func() {
 		filters := SubscriptionFilters{}
 
-		sub, err := s.manager.Create("session1", "cluster1", "events", filters)
+		sub, err := s.manager.Create("session1", "cluster1", "events", filters, "")
 		s.Require().NoError(err)
 
 		sub.Degraded = true
@@ -673,6 +2738,177 @@ func (s *ManagerTestSuite) TestSubscriptionDegraded() {
 	})
 }
 
+// TestDegradedNotification tests that the degraded notification carries the watch error causing it
+func (s *ManagerTestSuite) TestDegradedNotification() {
+	newFailingManager := func(watchErr error) (*EventSubscriptionManager, *clocktesting.FakeClock, *MockServerSession) {
+		clientset := fake.NewClientset()
+		clientset.PrependWatchReactor("events", func(action k8stesting.Action) (bool, watch.Interface, error) {
+			return true, nil, watchErr
+		})
+		getK8sClient := func(cluster string) (*pkgkubernetes.Kubernetes, error) {
+			return &pkgkubernetes.Kubernetes{Interface: clientset}, nil
+		}
+		fakeClock := clocktesting.NewFakeClock(time.Now())
+		config := NewTestManagerConfig()
+		config.Clock = fakeClock
+		manager := NewEventSubscriptionManager(s.server, config, getK8sClient, nil)
+
+		session := NewMockServerSession("session1")
+		session.SetLogLevel(mcp.LoggingLevel("info"))
+		s.server.AddSession(session)
+		return manager, fakeClock, session
+	}
+	// degradedError waits for the subscription error notification, stepping the clock
+	// through the watch's reconnection backoffs, and returns its error
+	degradedError := func(fakeClock *clocktesting.FakeClock, session *MockServerSession) string {
+		var notification *SubscriptionErrorNotification
+		s.Require().Eventually(func() bool {
+			for _, call := range session.GetLogCalls() {
+				if call.Logger == LoggerSubscriptionError {
+					notification = call.Data.(*SubscriptionErrorNotification)
+					return true
+				}
+			}
+			fakeClock.Step(time.Minute)
+			return false
+		}, 2*time.Second, 10*time.Millisecond, "subscription should be degraded")
+		s.True(notification.Degraded)
+		return notification.Error
+	}
+
+	s.Run("403 Forbidden surfaces the forbidden message", func() {
+		manager, fakeClock, session := newFailingManager(apierrors.NewForbidden(v1.Resource("events"), "",
+			errors.New(`User "system:serviceaccount:default:mcp" cannot watch resource "events"`)))
+		defer manager.CancelAll()
+
+		_, err := manager.Create("session1", "cluster1", "events", SubscriptionFilters{}, "")
+		s.Require().NoError(err)
+
+		message := degradedError(fakeClock, session)
+		s.Contains(message, "Watch is forbidden, check the RBAC permissions to watch events")
+		s.Contains(message, "events is forbidden")
+		s.Contains(message, `cannot watch resource "events"`)
+	})
+
+	s.Run("network errors surface the connection error", func() {
+		manager, fakeClock, session := newFailingManager(&net.OpError{
+			Op:  "dial",
+			Net: "tcp",
+			Err: errors.New("connect: connection refused"),
+		})
+		defer manager.CancelAll()
+
+		_, err := manager.Create("session1", "cluster1", "events", SubscriptionFilters{}, "")
+		s.Require().NoError(err)
+
+		message := degradedError(fakeClock, session)
+		s.Contains(message, "Watch connection failed after maximum retry attempts")
+		s.Contains(message, "dial tcp: connect: connection refused")
+	})
+
+	s.Run("a degrade without a cause keeps the generic message", func() {
+		session := NewMockServerSession("session1")
+		session.SetLogLevel(mcp.LoggingLevel("info"))
+		s.server.AddSession(session)
+		sub, err := s.manager.Create("session1", "cluster1", "events", SubscriptionFilters{}, "")
+		s.Require().NoError(err)
+
+		s.manager.setSubscriptionHealth(sub.ID, WatchHealthDegraded, nil)
+
+		calls := session.GetLogCalls()
+		s.Require().Len(calls, 1)
+		s.Equal("Watch connection failed after maximum retry attempts", calls[0].Data.(*SubscriptionErrorNotification).Error)
+	})
+
+	s.Run("is queued behind the subscription's pending notifications", func() {
+		session := NewMockServerSession("session1")
+		session.SetLogLevel(mcp.LoggingLevel("info"))
+		session.SetLogDelay(200 * time.Millisecond)
+		s.server.AddSession(session)
+
+		sub, err := s.manager.Create("session1", "cluster1", "events", SubscriptionFilters{}, "")
+		s.Require().NoError(err)
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		s.manager.startDelivery(ctx, sub)
+		s.manager.queueNotification(ctx, sub, LoggerEvents, &EventNotification{SubscriptionID: sub.ID})
+
+		start := time.Now()
+		s.manager.markSubscriptionDegraded(sub.ID, nil)
+		s.Less(time.Since(start), 100*time.Millisecond, "marking degraded should not wait for the session")
+		s.True(s.manager.GetSubscription(sub.ID).Degraded)
+
+		s.Eventually(func() bool { return len(session.GetLogCalls()) == 2 }, 2*time.Second, 10*time.Millisecond)
+		calls := session.GetLogCalls()
+		s.Equal(LoggerEvents, calls[0].Logger)
+		s.Equal(LoggerSubscriptionError, calls[1].Logger)
+	})
+}
+
+// TestSubscriptionWatchHealth tests watch health transitions and their stats
+func (s *ManagerTestSuite) TestSubscriptionWatchHealth() {
+	s.Run("starts as healthy", func() {
+		sub, err := s.manager.Create("session1", "cluster1", "events", SubscriptionFilters{}, "")
+		s.Require().NoError(err)
+
+		s.Equal(WatchHealthHealthy, sub.Health)
+		s.Equal(1, s.manager.GetStats().Healthy)
+	})
+
+	s.Run("tracks reconnection and recovery", func() {
+		sub, err := s.manager.Create("session1", "cluster2", "events", SubscriptionFilters{}, "")
+		s.Require().NoError(err)
+
+		s.manager.setSubscriptionHealth(sub.ID, WatchHealthReconnecting, nil)
+		s.Equal(WatchHealthReconnecting, s.manager.GetSubscription(sub.ID).Health)
+		s.False(sub.Degraded, "reconnecting should not mark the subscription degraded")
+		stats := s.manager.GetStats()
+		s.Equal(1, stats.Reconnecting)
+		s.Equal(0, stats.Degraded)
+
+		s.manager.setSubscriptionHealth(sub.ID, WatchHealthHealthy, nil)
+		s.Equal(WatchHealthHealthy, s.manager.GetSubscription(sub.ID).Health)
+		s.Equal(0, s.manager.GetStats().Reconnecting)
+	})
+
+	s.Run("degraded is terminal", func() {
+		sub, err := s.manager.Create("session1", "cluster3", "events", SubscriptionFilters{}, "")
+		s.Require().NoError(err)
+
+		s.manager.setSubscriptionHealth(sub.ID, WatchHealthReconnecting, nil)
+		s.manager.setSubscriptionHealth(sub.ID, WatchHealthDegraded, nil)
+		s.Equal(WatchHealthDegraded, sub.Health)
+		s.True(sub.Degraded)
+
+		s.manager.setSubscriptionHealth(sub.ID, WatchHealthHealthy, nil)
+		s.Equal(WatchHealthDegraded, sub.Health, "degraded subscriptions should not recover")
+	})
+
+	s.Run("stats count each health state", func() {
+		_, err := s.manager.Create("session1", "cluster1", "events", SubscriptionFilters{}, "")
+		s.Require().NoError(err)
+		reconnecting, err := s.manager.Create("session1", "cluster2", "events", SubscriptionFilters{}, "")
+		s.Require().NoError(err)
+		degraded, err := s.manager.Create("session1", "cluster3", "events", SubscriptionFilters{}, "")
+		s.Require().NoError(err)
+
+		s.manager.setSubscriptionHealth(reconnecting.ID, WatchHealthReconnecting, nil)
+		s.manager.setSubscriptionHealth(degraded.ID, WatchHealthDegraded, nil)
+
+		stats := s.manager.GetStats()
+		s.Equal(3, stats.Total)
+		s.Equal(1, stats.Healthy)
+		s.Equal(1, stats.Reconnecting)
+		s.Equal(1, stats.Degraded)
+	})
+
+	s.Run("ignores unknown subscriptions", func() {
+		s.NotPanics(func() {
+			s.manager.setSubscriptionHealth("sub-unknown", WatchHealthReconnecting, nil)
+		})
+	})
+}
+
 // TestGetCurrentResourceVersion tests the getCurrentResourceVersion method
 func (s *ManagerTestSuite) TestGetCurrentResourceVersion() {
 	s.Run("retrieves resource version from cluster-wide list", func() {
@@ -734,4 +2970,610 @@ func (s *ManagerTestSuite) TestGetCurrentResourceVersion() {
 		s.NoError(err)
 		s.NotNil(rv)
 	})
+
+	// failingLists makes the first failures event lists fail, and counts all list calls
+	failingLists := func(clientset *fake.Clientset, failures int) *int {
+		calls := 0
+		clientset.PrependReactor("list", "events", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			calls++
+			if calls <= failures {
+				return true, nil, errors.New("connection refused")
+			}
+			return false, nil, nil
+		})
+		return &calls
+	}
+
+	s.Run("retries transient list failures", func() {
+		clientset := fake.NewClientset()
+		calls := failingLists(clientset, 2)
+
+		_, err := s.manager.getCurrentResourceVersion(clientset, "")
+		s.NoError(err)
+		s.Equal(3, *calls)
+	})
+
+	s.Run("gives up after the maximum attempts", func() {
+		clientset := fake.NewClientset()
+		calls := failingLists(clientset, 10)
+
+		_, err := s.manager.getCurrentResourceVersion(clientset, "")
+		s.Require().Error(err)
+		s.Contains(err.Error(), "failed to list events after 3 attempts")
+		s.Contains(err.Error(), "connection refused")
+		s.Equal(resourceVersionAttempts, *calls)
+	})
+
+	s.Run("subscription is created despite transient list failures", func() {
+		clientset := fake.NewClientset()
+		calls := failingLists(clientset, 2)
+		getK8sClient := func(cluster string) (*pkgkubernetes.Kubernetes, error) {
+			return &pkgkubernetes.Kubernetes{Interface: clientset}, nil
+		}
+		manager := NewEventSubscriptionManager(s.server, NewTestManagerConfig(), getK8sClient, nil)
+		defer manager.CancelAll()
+
+		sub, err := manager.Create("session1", "cluster1", "events", SubscriptionFilters{}, "")
+		s.Require().NoError(err)
+		s.NotNil(manager.GetSubscription(sub.ID))
+		s.GreaterOrEqual(*calls, 3)
+	})
+
+	s.Run("subscription creation fails when the list keeps failing", func() {
+		clientset := fake.NewClientset()
+		failingLists(clientset, 10)
+		getK8sClient := func(cluster string) (*pkgkubernetes.Kubernetes, error) {
+			return &pkgkubernetes.Kubernetes{Interface: clientset}, nil
+		}
+		manager := NewEventSubscriptionManager(s.server, NewTestManagerConfig(), getK8sClient, nil)
+		defer manager.CancelAll()
+
+		_, err := manager.Create("session1", "cluster1", "events", SubscriptionFilters{}, "")
+		s.Require().Error(err)
+		s.Contains(err.Error(), "failed to get current resource version")
+		s.Contains(err.Error(), "connection refused")
+		s.Empty(manager.ListSubscriptionsForSession("session1"))
+	})
+}
+
+// TestWatchCircuitBreaker tests that persistent failures on a cluster pause new watches until the cooldown passes
+func (s *ManagerTestSuite) TestWatchCircuitBreaker() {
+	s.Run("repeated failures pause subscription creation on the cluster", func() {
+		clientset := fake.NewClientset()
+		var clusterUp atomic.Bool
+		var lists atomic.Int32
+		clientset.PrependReactor("list", "events", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			lists.Add(1)
+			if !clusterUp.Load() {
+				return true, nil, errors.New("connection refused")
+			}
+			return false, nil, nil
+		})
+		getK8sClient := func(cluster string) (*pkgkubernetes.Kubernetes, error) {
+			return &pkgkubernetes.Kubernetes{Interface: clientset}, nil
+		}
+		config := NewTestManagerConfig()
+		config.WatchBreakerThreshold = 1
+		config.WatchBreakerCooldown = 300 * time.Millisecond
+		manager := NewEventSubscriptionManager(s.server, config, getK8sClient, nil)
+		defer manager.CancelAll()
+
+		_, err := manager.Create("session1", "cluster1", "events", SubscriptionFilters{}, "")
+		s.Require().Error(err)
+		s.Equal(BreakerOpen, manager.GetStats().Breakers["cluster1"])
+
+		listed := lists.Load()
+		_, err = manager.Create("session1", "cluster1", "events", SubscriptionFilters{Namespaces: []string{"default"}}, "")
+		s.Require().Error(err)
+		s.Contains(err.Error(), "watch attempts on cluster cluster1 are paused")
+		s.Equal(listed, lists.Load(), "no request should reach a cluster with an open breaker")
+
+		// Once the cooldown passes and the cluster is back, subscriptions resume
+		clusterUp.Store(true)
+		s.Require().Eventually(func() bool {
+			return manager.GetStats().Breakers["cluster1"] == BreakerHalfOpen
+		}, time.Second, 10*time.Millisecond, "breaker should half-open after the cooldown")
+		sub, err := manager.Create("session1", "cluster1", "events", SubscriptionFilters{}, "")
+		s.Require().NoError(err)
+		s.NotNil(manager.GetSubscription(sub.ID))
+		s.Eventually(func() bool {
+			return manager.GetStats().Breakers["cluster1"] == BreakerClosed
+		}, time.Second, 10*time.Millisecond, "successful watch should close the breaker")
+	})
+}
+
+// BenchmarkCancelSession measures tearing down a session holding many subscriptions.
+// Index removal is O(1) per subscription, so this scales linearly with the session size.
+func BenchmarkCancelSession(b *testing.B) {
+	for _, n := range []int{10, 100, 1000} {
+		b.Run(fmt.Sprintf("subscriptions=%d", n), func(b *testing.B) {
+			config := NewTestManagerConfig()
+			config.MaxSubscriptionsPerSession = n
+			config.MaxSubscriptionsGlobal = n
+			for i := 0; i < b.N; i++ {
+				b.StopTimer()
+				manager := NewEventSubscriptionManager(nil, config, nil, nil)
+				for j := 0; j < n; j++ {
+					if _, err := manager.Create("session1", "cluster1", "events", SubscriptionFilters{}, ""); err != nil {
+						b.Fatal(err)
+					}
+				}
+				b.StartTimer()
+				manager.CancelSession("session1")
+			}
+		})
+	}
+}
+
+// TestMaxWatchConnections tests that subscriptions are rejected once the watch connection limit is reached
+func (s *ManagerTestSuite) TestMaxWatchConnections() {
+	registered := NewDetectorRegistry()
+	registered.Register(string(FaultTypePodCrash), func() Detector {
+		return &MockTypedDetector{faultType: FaultTypePodCrash, kind: "Pod"}
+	})
+	newLimitedManager := func(maxWatchConnections int) *EventSubscriptionManager {
+		clientset := fake.NewClientset()
+		getK8sClient := func(cluster string) (*pkgkubernetes.Kubernetes, error) {
+			return &pkgkubernetes.Kubernetes{Interface: clientset}, nil
+		}
+		config := NewTestManagerConfig()
+		config.MaxWatchConnections = maxWatchConnections
+		return NewEventSubscriptionManager(s.server, config, getK8sClient, registered)
+	}
+
+	s.Run("rejects subscriptions needing a watch beyond the limit", func() {
+		manager := newLimitedManager(2)
+		defer manager.CancelAll()
+
+		_, err := manager.Create("session1", "cluster1", "events", SubscriptionFilters{}, "")
+		s.Require().NoError(err)
+		_, err = manager.Create("session2", "cluster1", "faults", SubscriptionFilters{}, "")
+		s.Require().NoError(err)
+		s.Equal(2, manager.GetStats().WatchConnections)
+
+		_, err = manager.Create("session3", "cluster1", "faults", SubscriptionFilters{}, "")
+		s.Require().ErrorIs(err, ErrWatchConnectionLimitExceeded)
+		s.Contains(err.Error(), "server has reached maximum watch connections (2)")
+
+		_, err = manager.Create("session3", "cluster1", "events", SubscriptionFilters{Namespaces: []string{"ns1"}}, "")
+		s.Require().ErrorIs(err, ErrWatchConnectionLimitExceeded)
+		s.Contains(err.Error(), "server has reached maximum watch connections (2)")
+
+		_, err = manager.Create("session3", "cluster2", "events", SubscriptionFilters{}, "")
+		s.Require().ErrorIs(err, ErrWatchConnectionLimitExceeded, "the same scope on another cluster needs its own watch")
+
+		s.Len(manager.ListSubscriptions(SubscriptionQuery{}), 2, "rejected subscriptions are not tracked")
+	})
+
+	s.Run("subscriptions sharing an open watch are not counted again", func() {
+		manager := newLimitedManager(1)
+		defer manager.CancelAll()
+
+		_, err := manager.Create("session1", "cluster1", "events", SubscriptionFilters{}, "")
+		s.Require().NoError(err)
+		_, err = manager.Create("session2", "cluster1", "events", SubscriptionFilters{Type: "Warning"}, "")
+		s.Require().NoError(err, "subscription joins the existing cluster-wide watch")
+		s.Equal(1, manager.GetStats().WatchConnections)
+	})
+
+	s.Run("subscriptions sharing an event label selector watch are not counted again", func() {
+		manager := newLimitedManager(1)
+		defer manager.CancelAll()
+
+		_, err := manager.Create("session1", "cluster1", "events", SubscriptionFilters{EventLabelSelector: "team=payments"}, "")
+		s.Require().NoError(err)
+		_, err = manager.Create("session2", "cluster1", "events", SubscriptionFilters{EventLabelSelector: "team=payments", Type: "Warning"}, "")
+		s.Require().NoError(err, "subscription joins the existing watch for its label selector")
+		s.Equal(1, manager.GetStats().WatchConnections)
+
+		_, err = manager.Create("session3", "cluster1", "events", SubscriptionFilters{EventLabelSelector: "team=search"}, "")
+		s.Require().ErrorIs(err, ErrWatchConnectionLimitExceeded, "another label selector needs its own watch")
+	})
+
+	s.Run("freeing a watch allows a new subscription", func() {
+		manager := newLimitedManager(2)
+		defer manager.CancelAll()
+
+		shared1, err := manager.Create("session1", "cluster1", "events", SubscriptionFilters{}, "")
+		s.Require().NoError(err)
+		shared2, err := manager.Create("session2", "cluster1", "events", SubscriptionFilters{Type: "Warning"}, "")
+		s.Require().NoError(err)
+		faults, err := manager.Create("session3", "cluster1", "faults", SubscriptionFilters{}, "")
+		s.Require().NoError(err)
+
+		_, err = manager.Create("session4", "cluster1", "faults", SubscriptionFilters{}, "")
+		s.Require().ErrorIs(err, ErrWatchConnectionLimitExceeded)
+
+		s.Require().NoError(manager.Cancel(faults.ID))
+		s.Equal(1, manager.GetStats().WatchConnections)
+		_, err = manager.Create("session4", "cluster1", "faults", SubscriptionFilters{}, "")
+		s.Require().NoError(err, "cancelling the faults subscription frees its watch")
+
+		// The shared watch stays open until its last subscriber leaves
+		s.Require().NoError(manager.Cancel(shared1.ID))
+		_, err = manager.Create("session5", "cluster1", "events", SubscriptionFilters{Namespaces: []string{"ns1"}}, "")
+		s.Require().ErrorIs(err, ErrWatchConnectionLimitExceeded)
+
+		s.Require().NoError(manager.Cancel(shared2.ID))
+		_, err = manager.Create("session5", "cluster1", "events", SubscriptionFilters{Namespaces: []string{"ns1"}}, "")
+		s.Require().NoError(err)
+		s.Equal(2, manager.GetStats().WatchConnections)
+	})
+
+	s.Run("zero disables the limit", func() {
+		manager := newLimitedManager(0)
+		defer manager.CancelAll()
+
+		for i := 0; i < 3; i++ {
+			_, err := manager.Create(fmt.Sprintf("session%d", i), "cluster1", "faults", SubscriptionFilters{}, "")
+			s.Require().NoError(err)
+		}
+		s.Equal(3, manager.GetStats().WatchConnections)
+	})
+}
+
+// TestMaxResourceWatchers tests that faults subscriptions are rejected once the resource watcher limit is reached
+func (s *ManagerTestSuite) TestMaxResourceWatchers() {
+	registered := NewDetectorRegistry()
+	registered.Register(string(FaultTypePodCrash), func() Detector {
+		return &MockTypedDetector{faultType: FaultTypePodCrash, kind: "Pod"}
+	})
+	newLimitedManager := func(maxResourceWatchers int) *EventSubscriptionManager {
+		clientset := fake.NewClientset()
+		getK8sClient := func(cluster string) (*pkgkubernetes.Kubernetes, error) {
+			return &pkgkubernetes.Kubernetes{Interface: clientset}, nil
+		}
+		config := NewTestManagerConfig()
+		config.MaxResourceWatchers = maxResourceWatchers
+		return NewEventSubscriptionManager(s.server, config, getK8sClient, registered)
+	}
+
+	s.Run("rejects faults subscriptions beyond the limit", func() {
+		manager := newLimitedManager(2)
+		defer manager.CancelAll()
+
+		_, err := manager.Create("session1", "cluster1", "faults", SubscriptionFilters{}, "")
+		s.Require().NoError(err)
+		_, err = manager.Create("session2", "cluster2", "faults", SubscriptionFilters{}, "")
+		s.Require().NoError(err)
+		s.Equal(2, manager.GetStats().ResourceWatchers)
+
+		_, err = manager.Create("session3", "cluster3", "faults", SubscriptionFilters{}, "")
+		s.Require().Error(err)
+		s.ErrorIs(err, ErrResourceWatcherLimitExceeded)
+		s.Contains(err.Error(), "server has reached maximum resource watchers (2)")
+
+		s.Len(manager.ListSubscriptions(SubscriptionQuery{}), 2, "rejected subscriptions are not tracked")
+		s.Equal(2, manager.GetStats().ResourceWatchers)
+	})
+
+	s.Run("events subscriptions are not limited", func() {
+		manager := newLimitedManager(1)
+		defer manager.CancelAll()
+
+		_, err := manager.Create("session1", "cluster1", "faults", SubscriptionFilters{}, "")
+		s.Require().NoError(err)
+		_, err = manager.Create("session2", "cluster1", "events", SubscriptionFilters{}, "")
+		s.Require().NoError(err, "events subscriptions don't start a ResourceWatcher")
+	})
+
+	s.Run("cancelling a faults subscription frees capacity", func() {
+		manager := newLimitedManager(1)
+		defer manager.CancelAll()
+
+		faults, err := manager.Create("session1", "cluster1", "faults", SubscriptionFilters{}, "")
+		s.Require().NoError(err)
+		_, err = manager.Create("session2", "cluster1", "faults", SubscriptionFilters{}, "")
+		s.Require().ErrorIs(err, ErrResourceWatcherLimitExceeded)
+
+		s.Require().NoError(manager.Cancel(faults.ID))
+		s.Equal(0, manager.GetStats().ResourceWatchers)
+		_, err = manager.Create("session2", "cluster1", "faults", SubscriptionFilters{}, "")
+		s.Require().NoError(err, "cancelling the faults subscription frees its ResourceWatcher")
+		s.Equal(1, manager.GetStats().ResourceWatchers)
+	})
+
+	s.Run("zero disables the limit", func() {
+		manager := newLimitedManager(0)
+		defer manager.CancelAll()
+
+		for i := 0; i < 3; i++ {
+			_, err := manager.Create(fmt.Sprintf("session%d", i), "cluster1", "faults", SubscriptionFilters{}, "")
+			s.Require().NoError(err)
+		}
+		s.Equal(3, manager.GetStats().ResourceWatchers)
+	})
+}
+
+// stopRecordingWatch is a watch that records whether it was stopped.
+type stopRecordingWatch struct {
+	watch.Interface
+	stopped atomic.Bool
+}
+
+// Stop stops the underlying watch and records it was stopped.
+func (w *stopRecordingWatch) Stop() {
+	w.stopped.Store(true)
+	w.Interface.Stop()
+}
+
+// TestCancelStopsResourceWatcher tests that cancelling a faults subscription shuts its ResourceWatcher down
+func (s *ManagerTestSuite) TestCancelStopsResourceWatcher() {
+	s.Run("informers stop watching and no more faults are sent", func() {
+		clientset := fake.NewClientset(
+			&v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "pod-a", Namespace: "default", UID: "pod-a-uid"}},
+			&v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "pod-b", Namespace: "default", UID: "pod-b-uid"}},
+		)
+		var mu sync.Mutex
+		var watches []*stopRecordingWatch
+		clientset.PrependWatchReactor("pods", func(action k8stesting.Action) (bool, watch.Interface, error) {
+			w, err := clientset.Tracker().Watch(action.GetResource(), action.GetNamespace())
+			if err != nil {
+				return true, nil, err
+			}
+			recording := &stopRecordingWatch{Interface: w}
+			mu.Lock()
+			watches = append(watches, recording)
+			mu.Unlock()
+			return true, recording, nil
+		})
+		allStopped := func() bool {
+			mu.Lock()
+			defer mu.Unlock()
+			for _, w := range watches {
+				if !w.stopped.Load() {
+					return false
+				}
+			}
+			return len(watches) > 0
+		}
+
+		registered := NewDetectorRegistry()
+		registered.Register(string(FaultTypePodCrash), func() Detector {
+			return &MockTypedDetector{faultType: FaultTypePodCrash, kind: "Pod"}
+		})
+		getK8sClient := func(cluster string) (*pkgkubernetes.Kubernetes, error) {
+			return &pkgkubernetes.Kubernetes{Interface: clientset}, nil
+		}
+		manager := NewEventSubscriptionManager(s.server, NewTestManagerConfig(), getK8sClient, registered)
+		defer manager.CancelAll()
+
+		session := NewMockServerSession("session1")
+		session.SetLogLevel(mcp.LoggingLevel("info"))
+		s.server.AddSession(session)
+
+		sub, err := manager.Create("session1", "cluster1", "faults", SubscriptionFilters{}, "")
+		s.Require().NoError(err)
+
+		updatePod := func(name string) {
+			pod, err := clientset.CoreV1().Pods("default").Get(context.Background(), name, metav1.GetOptions{})
+			s.Require().NoError(err)
+			pod.Labels = map[string]string{"updated": "true"}
+			_, err = clientset.CoreV1().Pods("default").Update(context.Background(), pod, metav1.UpdateOptions{})
+			s.Require().NoError(err)
+		}
+		updatePod("pod-a")
+		s.Eventually(func() bool {
+			return len(session.GetLogCalls()) == 1
+		}, time.Second, 10*time.Millisecond, "fault should be sent while subscribed")
+
+		s.Require().NoError(manager.Cancel(sub.ID))
+		s.Eventually(allStopped, time.Second, 10*time.Millisecond, "informer watches should be stopped")
+
+		updatePod("pod-b")
+		s.Never(func() bool {
+			return len(session.GetLogCalls()) > 1
+		}, 200*time.Millisecond, 10*time.Millisecond, "no fault should be sent after cancel")
+	})
+}
+
+// TestResourceWatcherScope tests that faults subscriptions only watch resources their filters select
+func (s *ManagerTestSuite) TestResourceWatcherScope() {
+	newPod := func(namespace, name string, labels map[string]string) *v1.Pod {
+		return &v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace, UID: types.UID(namespace + "-" + name), Labels: labels}}
+	}
+	web := map[string]string{"app": "web"}
+	newScopedManager := func(clientset *fake.Clientset) *EventSubscriptionManager {
+		registered := NewDetectorRegistry()
+		registered.Register(string(FaultTypePodCrash), func() Detector {
+			return &MockTypedDetector{faultType: FaultTypePodCrash, kind: "Pod"}
+		})
+		getK8sClient := func(cluster string) (*pkgkubernetes.Kubernetes, error) {
+			return &pkgkubernetes.Kubernetes{Interface: clientset}, nil
+		}
+		return NewEventSubscriptionManager(s.server, NewTestManagerConfig(), getK8sClient, registered)
+	}
+	// updatePods changes the labels of the given pods so the detector reports each of them
+	updatePods := func(clientset *fake.Clientset, pods ...*v1.Pod) {
+		for _, pod := range pods {
+			updated := pod.DeepCopy()
+			updated.Labels = map[string]string{"app": pod.Labels["app"], "updated": "true"}
+			_, err := clientset.CoreV1().Pods(pod.Namespace).Update(context.Background(), updated, metav1.UpdateOptions{})
+			s.Require().NoError(err)
+		}
+	}
+	// faultedPods returns the namespace/name of the pods faults were sent for
+	faultedPods := func(session *MockServerSession) []string {
+		var pods []string
+		for _, call := range session.GetLogCalls() {
+			if notification, ok := call.Data.(*ResourceFaultNotification); ok {
+				pods = append(pods, notification.Resource.Namespace+"/"+notification.Resource.Name)
+			}
+		}
+		return pods
+	}
+
+	s.Run("passes the label selector and namespace to the informers", func() {
+		webPod, otherApp, otherNamespace := newPod("default", "web", web), newPod("default", "db", map[string]string{"app": "db"}), newPod("staging", "web", web)
+		clientset := fake.NewClientset(webPod, otherApp, otherNamespace)
+		manager := newScopedManager(clientset)
+		defer manager.CancelAll()
+
+		session := NewMockServerSession("session1")
+		session.SetLogLevel(mcp.LoggingLevel("info"))
+		s.server.AddSession(session)
+
+		_, err := manager.Create("session1", "cluster1", "faults", SubscriptionFilters{Namespaces: []string{"default"}, LabelSelector: "app=web"}, "")
+		s.Require().NoError(err)
+
+		listed := 0
+		for _, action := range clientset.Actions() {
+			list, ok := action.(k8stesting.ListAction)
+			if !ok || action.GetResource().Resource != "pods" {
+				continue
+			}
+			listed++
+			s.Equal("default", list.GetNamespace())
+			s.Equal("app=web", list.GetListRestrictions().Labels.String())
+		}
+		s.Positive(listed, "pods should be listed")
+
+		updatePods(clientset, webPod, otherApp, otherNamespace)
+		s.Eventually(func() bool { return len(faultedPods(session)) == 1 }, time.Second, 10*time.Millisecond)
+		s.Never(func() bool { return len(faultedPods(session)) > 1 }, 200*time.Millisecond, 10*time.Millisecond,
+			"pods outside the selector should not be reported")
+		s.Equal([]string{"default/web"}, faultedPods(session))
+	})
+
+	s.Run("drops faults outside several namespaces", func() {
+		pods := []*v1.Pod{newPod("default", "web", web), newPod("staging", "web", web), newPod("other", "web", web)}
+		clientset := fake.NewClientset(pods[0], pods[1], pods[2])
+		manager := newScopedManager(clientset)
+		defer manager.CancelAll()
+
+		session := NewMockServerSession("session1")
+		session.SetLogLevel(mcp.LoggingLevel("info"))
+		s.server.AddSession(session)
+
+		_, err := manager.Create("session1", "cluster1", "faults", SubscriptionFilters{Namespaces: []string{"default", "staging"}}, "")
+		s.Require().NoError(err)
+
+		updatePods(clientset, pods...)
+		s.Eventually(func() bool { return len(faultedPods(session)) == 2 }, time.Second, 10*time.Millisecond)
+		s.Never(func() bool { return len(faultedPods(session)) > 2 }, 200*time.Millisecond, 10*time.Millisecond,
+			"pods outside the namespaces should not be reported")
+		s.ElementsMatch([]string{"default/web", "staging/web"}, faultedPods(session))
+	})
+}
+
+// TestDetectorTypes tests that faults subscriptions only run their selected detectors
+func (s *ManagerTestSuite) TestDetectorTypes() {
+	registered := NewDetectorRegistry()
+	for _, detector := range []*MockTypedDetector{
+		{faultType: FaultTypePodCrash, kind: "Pod"},
+		{faultType: FaultTypeOOMKilled, kind: "Pod"},
+		{faultType: FaultTypeNodeUnhealthy, kind: "Node"},
+	} {
+		registered.Register(string(detector.faultType), func() Detector { return detector })
+	}
+	newDetectorManager := func(clientset *fake.Clientset) *EventSubscriptionManager {
+		getK8sClient := func(cluster string) (*pkgkubernetes.Kubernetes, error) {
+			return &pkgkubernetes.Kubernetes{Interface: clientset}, nil
+		}
+		return NewEventSubscriptionManager(s.server, NewTestManagerConfig(), getK8sClient, registered)
+	}
+
+	s.Run("rejects unknown detector types", func() {
+		manager := NewEventSubscriptionManager(s.server, NewTestManagerConfig(), nil, registered)
+
+		_, err := manager.Create("session1", "cluster1", "faults", SubscriptionFilters{DetectorTypes: []string{"PodCrash", "DiskFull"}}, "")
+		s.Require().Error(err)
+		s.Contains(err.Error(), `unknown detector type "DiskFull"`)
+		s.Contains(err.Error(), "available: PodCrash, OOMKilled, NodeUnhealthy")
+		s.Empty(manager.ListSubscriptionsForSession("session1"))
+	})
+
+	s.Run("subscription with a subset only emits the selected fault types", func() {
+		clientset := fake.NewClientset()
+		manager := newDetectorManager(clientset)
+		defer manager.CancelAll()
+
+		session := NewMockServerSession("session1")
+		session.SetLogLevel(mcp.LoggingLevel("info"))
+		s.server.AddSession(session)
+
+		_, err := manager.Create("session1", "cluster1", "faults", SubscriptionFilters{DetectorTypes: []string{"OOMKilled"}}, "")
+		s.Require().NoError(err)
+
+		// Detectors only run on updates, so create the pod before updating it
+		pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "test-pod", Namespace: "default", UID: "pod-uid"}}
+		_, err = clientset.CoreV1().Pods("default").Create(context.Background(), pod, metav1.CreateOptions{})
+		s.Require().NoError(err)
+		s.Require().Eventually(func() bool {
+			updated := pod.DeepCopy()
+			updated.Labels = map[string]string{"restarted": "true"}
+			_, err := clientset.CoreV1().Pods("default").Update(context.Background(), updated, metav1.UpdateOptions{})
+			return err == nil && len(session.GetLogCalls()) > 0
+		}, 2*time.Second, 50*time.Millisecond, "selected fault should be delivered")
+
+		for _, call := range session.GetLogCalls() {
+			notification, ok := call.Data.(*ResourceFaultNotification)
+			s.Require().True(ok)
+			s.Equal(FaultTypeOOMKilled, notification.FaultType, "unselected detectors should not emit faults")
+		}
+
+		for _, action := range clientset.Actions() {
+			s.NotEqual("nodes", action.GetResource().Resource, "no node informer is needed for pod detectors")
+		}
+	})
+}
+
+// TestFaultDeduplicationWindow tests that the configured window governs fault deduplication
+func (s *ManagerTestSuite) TestFaultDeduplicationWindow() {
+	registered := NewDetectorRegistry()
+	registered.Register(string(FaultTypePodCrash), func() Detector {
+		return &MockTypedDetector{faultType: FaultTypePodCrash, kind: "Pod"}
+	})
+
+	s.Run("repeated faults are suppressed until the window expires", func() {
+		clientset := fake.NewClientset()
+		getK8sClient := func(cluster string) (*pkgkubernetes.Kubernetes, error) {
+			return &pkgkubernetes.Kubernetes{Interface: clientset}, nil
+		}
+		config := NewTestManagerConfig()
+		config.FaultDeduplicationWindow = time.Second
+		manager := NewEventSubscriptionManager(s.server, config, getK8sClient, registered)
+		defer manager.CancelAll()
+
+		session := NewMockServerSession("session1")
+		session.SetLogLevel(mcp.LoggingLevel("info"))
+		s.server.AddSession(session)
+
+		_, err := manager.Create("session1", "cluster1", "faults", SubscriptionFilters{}, "")
+		s.Require().NoError(err)
+
+		pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "test-pod", Namespace: "default", UID: "pod-uid"}}
+		_, err = clientset.CoreV1().Pods("default").Create(context.Background(), pod, metav1.CreateOptions{})
+		s.Require().NoError(err)
+		updatePod := func() bool {
+			_, err := clientset.CoreV1().Pods("default").Update(context.Background(), pod.DeepCopy(), metav1.UpdateOptions{})
+			return err == nil
+		}
+
+		s.Require().Eventually(func() bool {
+			return updatePod() && len(session.GetLogCalls()) > 0
+		}, 2*time.Second, 50*time.Millisecond, "first fault should be delivered")
+		first := time.Now()
+
+		for i := 0; i < 3; i++ {
+			s.Require().True(updatePod())
+		}
+		s.Never(func() bool {
+			return len(session.GetLogCalls()) > 1
+		}, 300*time.Millisecond, 20*time.Millisecond, "repeated faults within the window should be suppressed")
+
+		s.Require().Eventually(func() bool {
+			return updatePod() && len(session.GetLogCalls()) > 1
+		}, 3*time.Second, 50*time.Millisecond, "fault should be delivered again after the window")
+		s.GreaterOrEqual(time.Since(first), 900*time.Millisecond, "fault should not be delivered again before the window expires")
+	})
+
+	s.Run("non-positive window falls back to the default", func() {
+		for _, window := range []time.Duration{0, -time.Second} {
+			config := NewTestManagerConfig()
+			config.FaultDeduplicationWindow = window
+			manager := NewEventSubscriptionManager(s.server, config, nil, nil)
+			s.Equal(DeduplicationTTL, manager.config.FaultDeduplicationWindow, "window %s should fall back to the default", window)
+		}
+	})
 }
@@ -6,6 +6,8 @@ import (
 	"time"
 
 	"github.com/modelcontextprotocol/go-sdk/mcp"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/watch"
 )
 
@@ -72,6 +74,10 @@ type MockServerSession struct {
 	id       string
 	logLevel mcp.LoggingLevel
 	logCalls []LogCall
+	logDelay time.Duration
+	logErr   error
+	logErrs  int // remaining calls failing with logErr, zero fails every call
+	attempts int
 	mu       sync.Mutex
 }
 
@@ -104,15 +110,79 @@ func (m *MockServerSession) SetLogLevel(level mcp.LoggingLevel) {
 	m.logLevel = level
 }
 
-// Log captures a log call for testing assertions.
+// LogLevel returns the log level set with SetLogLevel, or empty if none was set.
+func (m *MockServerSession) LogLevel() mcp.LoggingLevel {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.logLevel
+}
+
+// SetLogDelay makes Log() block for the given duration before recording the call,
+// simulating a slow client. The delay is abandoned if the context is done first.
+func (m *MockServerSession) SetLogDelay(delay time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.logDelay = delay
+}
+
+// SetLogError makes Log() fail with the given error without recording the call,
+// simulating a broken connection. Pass nil to restore normal behavior.
+func (m *MockServerSession) SetLogError(err error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.logErr = err
+	m.logErrs = 0
+}
+
+// SetLogErrors makes the next n Log() calls fail with the given error, simulating a
+// transient failure. Later calls behave normally.
+func (m *MockServerSession) SetLogErrors(n int, err error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.logErr = err
+	m.logErrs = n
+}
+
+// GetLogAttempts returns the number of Log() calls, including failed ones.
+func (m *MockServerSession) GetLogAttempts() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.attempts
+}
+
+// Log captures a log call for testing assertions and reports whether it was delivered.
 // Mimics SDK behavior: drops logs if no level is set.
-func (m *MockServerSession) Log(ctx context.Context, params *mcp.LoggingMessageParams) error {
+func (m *MockServerSession) Log(ctx context.Context, params *mcp.LoggingMessageParams) (bool, error) {
+	m.mu.Lock()
+	delay := m.logDelay
+	m.mu.Unlock()
+
+	if delay > 0 {
+		select {
+		case <-time.After(delay):
+		case <-ctx.Done():
+			return false, ctx.Err()
+		}
+	}
+
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
+	m.attempts++
+	if m.logErr != nil {
+		err := m.logErr
+		if m.logErrs > 0 {
+			m.logErrs--
+			if m.logErrs == 0 {
+				m.logErr = nil
+			}
+		}
+		return false, err
+	}
+
 	// Mimic SDK behavior: drop if no log level set
 	if m.logLevel == "" {
-		return nil
+		return false, nil
 	}
 
 	m.logCalls = append(m.logCalls, LogCall{
@@ -122,7 +192,7 @@ func (m *MockServerSession) Log(ctx context.Context, params *mcp.LoggingMessageP
 		Data:   params.Data,
 	})
 
-	return nil
+	return true, nil
 }
 
 // GetLogCalls returns all captured log calls.
@@ -223,9 +293,135 @@ func NewTestManagerConfig() ManagerConfig {
 		MaxLogCapturesGlobal:         4,
 		MaxLogBytesPerContainer:      1024, // 1KB for testing
 		MaxContainersPerNotification: 2,
+		MaxConcurrentEnrichments:     DefaultMaxConcurrentEnrichments,
+		MaxFaultContextBytes:         DefaultMaxContextBytes,
 		EventDeduplicationWindow:     100 * time.Millisecond, // 100ms for fast tests
 		FaultDeduplicationWindow:     200 * time.Millisecond, // 200ms for fast tests
 		SessionMonitorInterval:       100 * time.Millisecond, // 100ms for fast cleanup tests
 		WatchReconnectMaxRetries:     3,                      // Fewer retries for faster tests
+		WatchBreakerThreshold:        DefaultBreakerFailureThreshold,
+		WatchBreakerCooldown:         DefaultBreakerCooldown,
+		ShutdownDrainTimeout:         500 * time.Millisecond, // 500ms for fast shutdown tests
+		FaultHistorySize:             10,                     // small buffer to exercise wraparound
+		NotificationTimeout:          DefaultNotificationTimeout,
+		MaxNotificationFailures:      DefaultMaxNotificationFailures,
+		MaxNotificationRetries:       DefaultMaxNotificationRetries,
+		NotificationRetryBackoff:     10 * time.Millisecond, // 10ms for fast retry tests
+		NotificationQueueSize:        DefaultNotificationQueueSize,
+	}
+}
+
+// MockNotificationSink implements NotificationSink for testing.
+type MockNotificationSink struct {
+	mu            sync.Mutex
+	notifications []*ResourceFaultNotification
+}
+
+// Name identifies the sink in logs.
+func (m *MockNotificationSink) Name() string {
+	return "mock"
+}
+
+// Send records the notification for assertions.
+func (m *MockNotificationSink) Send(_ context.Context, notification *ResourceFaultNotification) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.notifications = append(m.notifications, notification)
+	return nil
+}
+
+// GetNotifications returns all notifications sent to the sink.
+func (m *MockNotificationSink) GetNotifications() []*ResourceFaultNotification {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]*ResourceFaultNotification(nil), m.notifications...)
+}
+
+// MockNotification is a notification recorded by MockNotifier.
+type MockNotification struct {
+	SessionID string
+	Channel   string
+	Payload   any
+}
+
+// MockNotifier implements Notifier for testing, recording notifications instead of
+// sending them.
+type MockNotifier struct {
+	mu            sync.Mutex
+	notifications []MockNotification
+	err           error
+}
+
+// Notify records the notification for assertions and returns the configured error.
+func (m *MockNotifier) Notify(_ context.Context, sessionID, channel string, payload any) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.err != nil {
+		return m.err
+	}
+	m.notifications = append(m.notifications, MockNotification{SessionID: sessionID, Channel: channel, Payload: payload})
+	return nil
+}
+
+// SetError configures an error for Notify to return; nil records notifications again.
+func (m *MockNotifier) SetError(err error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.err = err
+}
+
+// GetNotifications returns all notifications recorded by the notifier.
+func (m *MockNotifier) GetNotifications() []MockNotification {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]MockNotification(nil), m.notifications...)
+}
+
+// MockTypedDetector implements TypedDetector for testing.
+// It emits one signal of its fault type for every update of a resource of its kind.
+type MockTypedDetector struct {
+	faultType FaultType
+	kind      string
+}
+
+// FaultType returns the fault type emitted by the detector.
+func (d *MockTypedDetector) FaultType() FaultType {
+	return d.faultType
+}
+
+// ResourceKind returns the kind of resource the detector inspects.
+func (d *MockTypedDetector) ResourceKind() string {
+	return d.kind
+}
+
+// Describe returns the fault type and kind of the detector.
+func (d *MockTypedDetector) Describe() DetectorInfo {
+	return DetectorInfo{FaultTypes: []FaultType{d.faultType}, ResourceKinds: []string{d.kind}, Description: "mock detector"}
+}
+
+// Detect emits a signal if newObj is a Pod or Node of the detector's kind.
+func (d *MockTypedDetector) Detect(_, newObj interface{}) []FaultSignal {
+	var obj metav1.Object
+	switch o := newObj.(type) {
+	case *v1.Pod:
+		if d.kind == "Pod" {
+			obj = o
+		}
+	case *v1.Node:
+		if d.kind == "Node" {
+			obj = o
+		}
+	}
+	if obj == nil {
+		return []FaultSignal{}
 	}
+	return []FaultSignal{{
+		FaultType:   d.faultType,
+		ResourceUID: obj.GetUID(),
+		Kind:        d.kind,
+		Name:        obj.GetName(),
+		Namespace:   obj.GetNamespace(),
+		Severity:    SeverityWarning,
+		Timestamp:   time.Now(),
+	}}
 }
@@ -0,0 +1,299 @@
+package events
+
+import (
+	"context"
+	"runtime/debug"
+	"sync"
+
+	"go.opentelemetry.io/otel/trace"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/klog/v2"
+)
+
+// maxNamespaceScopedWatches is the largest namespace filter that is watched with one
+// namespace-scoped watch per namespace rather than a single cluster-wide watch.
+const maxNamespaceScopedWatches = 5
+
+// eventWatchKey identifies a shared event watch by cluster and namespace scope.
+// An empty namespace denotes a cluster-wide watch. Subscriptions that exclude
+// event modifications use a separate watch that only delivers added events, and
+// subscriptions with an event label selector or involved object filters share a watch
+// selecting on them server-side.
+// Subscriptions resuming from a resource version share a watch starting from it, as
+// the watches of the others started from when they were created.
+type eventWatchKey struct {
+	cluster              string
+	namespace            string
+	includeModifications bool
+	eventLabelSelector   string
+	involvedObject       involvedObjectSelector
+	resumeFrom           string
+}
+
+// involvedObjectSelector holds the involved object filters a shared watch selects on
+// with a field selector. The API version is only set together with a kind, as it only
+// narrows a kind down.
+type involvedObjectSelector struct {
+	kind       string
+	apiVersion string
+	name       string
+	namespace  string
+}
+
+// newInvolvedObjectSelector returns the involved object selector for a subscription's filters.
+func newInvolvedObjectSelector(filters SubscriptionFilters) involvedObjectSelector {
+	selector := involvedObjectSelector{
+		kind:      filters.InvolvedKind,
+		name:      filters.InvolvedName,
+		namespace: filters.InvolvedNamespace,
+	}
+	if filters.InvolvedKind != "" {
+		selector.apiVersion = filters.InvolvedAPIVersion
+	}
+	return selector
+}
+
+// filters returns the subscription filters selecting the involved object, which
+// GetInvolvedObjectFieldSelector turns into the watch's field selector.
+func (i involvedObjectSelector) filters() SubscriptionFilters {
+	return SubscriptionFilters{
+		InvolvedKind:       i.kind,
+		InvolvedAPIVersion: i.apiVersion,
+		InvolvedName:       i.name,
+		InvolvedNamespace:  i.namespace,
+	}
+}
+
+// newEventWatchKey returns the key of the shared watch on a cluster's namespace scope
+// that serves subscriptions with the given filters resuming from resumeFrom, which is
+// empty for subscriptions starting from when they were created.
+func newEventWatchKey(cluster, namespace string, filters SubscriptionFilters, resumeFrom string) eventWatchKey {
+	return eventWatchKey{
+		cluster:              cluster,
+		namespace:            namespace,
+		includeModifications: filters.IncludesModifications(),
+		eventLabelSelector:   filters.EventLabelSelector,
+		involvedObject:       newInvolvedObjectSelector(filters),
+		resumeFrom:           resumeFrom,
+	}
+}
+
+// eventSubscriber is a subscription receiving events from a shared watch.
+// labels resolves involved object labels for the subscription's label selector;
+// if nil, the selector is matched against event labels. occurrences suppresses
+// repeated events for first-occurrence-only subscriptions; if nil, all are delivered.
+type eventSubscriber struct {
+	sub            *Subscription
+	process        func(ctx context.Context, event *v1.Event)
+	onHealthChange func(health WatchHealth, err error)
+	labels         *objectLabelCache
+	occurrences    *firstOccurrenceCache
+}
+
+// sharedEventWatch is a single event watch whose events are fanned out to
+// every subscriber on the same key.
+type sharedEventWatch struct {
+	key         eventWatchKey
+	cancel      context.CancelFunc
+	subscribers map[string]*eventSubscriber // subscriptionID -> subscriber
+	started     chan struct{}               // closed once the watch started or failed to
+	startErr    error                       // why the watch failed to start; set before started is closed
+}
+
+// startEventWatchFunc starts the underlying watch for a shared event watch.
+// The watch must call dispatch for every received event and onHealthChange when it
+// loses or regains its connection and when it gives up reconnecting, passing the error
+// it gave up on. It must stop when ctx is cancelled.
+type startEventWatchFunc func(ctx context.Context, dispatch func(context.Context, *v1.Event), onHealthChange func(WatchHealth, error)) error
+
+// eventMultiplexer maintains one event watch per (cluster, namespace scope) and
+// fans events out to every events-mode subscription on that scope, applying each
+// subscription's filters independently. Watches are reference-counted by their
+// subscribers and stopped when the last subscriber leaves.
+//
+// Thread-safe for concurrent use.
+type eventMultiplexer struct {
+	mu      sync.Mutex
+	watches map[eventWatchKey]*sharedEventWatch
+	tracer  trace.Tracer
+}
+
+// newEventMultiplexer creates an empty eventMultiplexer.
+func newEventMultiplexer(tracer trace.Tracer) *eventMultiplexer {
+	return &eventMultiplexer{
+		watches: make(map[eventWatchKey]*sharedEventWatch),
+		tracer:  tracer,
+	}
+}
+
+// subscribe adds a subscriber to the shared watch for key, calling start to open
+// the watch if no subscriber currently holds it. Returns a function that removes
+// the subscriber again; it is safe to call more than once.
+//
+// start runs without the lock held, as it may list events for a while, so the other
+// shared watches keep dispatching meanwhile. Subscribers joining a watch that is
+// still starting wait for it, and fail with the same error if it fails to start.
+func (x *eventMultiplexer) subscribe(key eventWatchKey, subscriber *eventSubscriber, start startEventWatchFunc) (func(), error) {
+	x.mu.Lock()
+	shared, exists := x.watches[key]
+	for exists {
+		select {
+		case <-shared.started:
+		default:
+			x.mu.Unlock()
+			<-shared.started
+			x.mu.Lock()
+		}
+		if shared.startErr != nil {
+			x.mu.Unlock()
+			return nil, shared.startErr
+		}
+		// The watch may have closed while waiting, in which case a new one is needed
+		if x.watches[key] == shared {
+			break
+		}
+		shared, exists = x.watches[key]
+	}
+	if !exists {
+		ctx, cancel := context.WithCancel(context.Background())
+		shared = &sharedEventWatch{
+			key:         key,
+			cancel:      cancel,
+			subscribers: map[string]*eventSubscriber{subscriber.sub.ID: subscriber},
+			started:     make(chan struct{}),
+		}
+		// Hold the key while starting, so concurrent subscribers join this watch
+		x.watches[key] = shared
+		x.mu.Unlock()
+
+		dispatch := func(ctx context.Context, event *v1.Event) {
+			x.dispatch(ctx, shared, event)
+		}
+		onHealthChange := func(health WatchHealth, err error) {
+			x.setHealth(shared, health, err)
+		}
+		err := start(ctx, dispatch, onHealthChange)
+
+		x.mu.Lock()
+		if err != nil {
+			cancel()
+			if x.watches[key] == shared {
+				delete(x.watches, key)
+			}
+			shared.startErr = err
+			close(shared.started)
+			x.mu.Unlock()
+			return nil, err
+		}
+		close(shared.started)
+		klog.V(1).Infof("Opened shared event watch (cluster=%s, namespace=%q)", key.cluster, key.namespace)
+	}
+
+	shared.subscribers[subscriber.sub.ID] = subscriber
+	klog.V(2).Infof("Subscription %s joined shared event watch (cluster=%s, namespace=%q, subscribers=%d)",
+		subscriber.sub.ID, key.cluster, key.namespace, len(shared.subscribers))
+	x.mu.Unlock()
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			x.unsubscribe(shared, subscriber.sub.ID)
+		})
+	}, nil
+}
+
+// unsubscribe removes a subscriber from a shared watch, stopping the watch once
+// no subscribers remain.
+func (x *eventMultiplexer) unsubscribe(shared *sharedEventWatch, subscriptionID string) {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+
+	delete(shared.subscribers, subscriptionID)
+	if len(shared.subscribers) > 0 {
+		return
+	}
+
+	shared.cancel()
+	if x.watches[shared.key] == shared {
+		delete(x.watches, shared.key)
+	}
+	klog.V(1).Infof("Closed shared event watch (cluster=%s, namespace=%q): no subscribers remain", shared.key.cluster, shared.key.namespace)
+}
+
+// dispatch delivers an event to every subscriber of a shared watch whose filters match,
+// skipping events already delivered to first-occurrence-only subscribers.
+func (x *eventMultiplexer) dispatch(ctx context.Context, shared *sharedEventWatch, event *v1.Event) {
+	for _, subscriber := range x.snapshot(shared) {
+		x.dispatchTo(ctx, subscriber, event)
+	}
+}
+
+// dispatchTo delivers an event to a single subscriber if its filters match. A panic
+// while doing so is recovered and logged, so the other subscribers still get the event
+// and one subscriber's bug doesn't count against the shared watch.
+func (x *eventMultiplexer) dispatchTo(ctx context.Context, subscriber *eventSubscriber, event *v1.Event) {
+	subCtx, span := startSpan(ctx, x.tracer, SpanDispatch, subscriptionAttributes(subscriber.sub)...)
+	defer span.End()
+	defer func() {
+		if r := recover(); r != nil {
+			klog.Errorf("Recovered from panic dispatching event %s/%s to subscription %s: %v\n%s",
+				event.Namespace, event.Name, subscriber.sub.ID, r, debug.Stack())
+		}
+	}()
+
+	_, filterSpan := startSpan(subCtx, x.tracer, SpanFilterMatch)
+	matched := subscriber.labels.matches(subCtx, &subscriber.sub.Filters, event)
+	filterSpan.SetAttributes(AttrMatched.Bool(matched))
+	filterSpan.End()
+
+	if matched && subscriber.occurrences.isFirst(event) {
+		subscriber.process(subCtx, event)
+	}
+}
+
+// setHealth notifies the current subscribers of a shared watch of a health change.
+// A watch that gave up reconnecting is detached first so later subscriptions open
+// a fresh watch. err is the error a degraded watch gave up on.
+func (x *eventMultiplexer) setHealth(shared *sharedEventWatch, health WatchHealth, err error) {
+	if health == WatchHealthDegraded {
+		x.mu.Lock()
+		if x.watches[shared.key] == shared {
+			delete(x.watches, shared.key)
+		}
+		x.mu.Unlock()
+	}
+
+	for _, subscriber := range x.snapshot(shared) {
+		subscriber.onHealthChange(health, err)
+	}
+}
+
+// snapshot returns the current subscribers of a shared watch so they can be
+// notified without holding the lock.
+func (x *eventMultiplexer) snapshot(shared *sharedEventWatch) []*eventSubscriber {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+
+	subscribers := make([]*eventSubscriber, 0, len(shared.subscribers))
+	for _, subscriber := range shared.subscribers {
+		subscribers = append(subscribers, subscriber)
+	}
+	return subscribers
+}
+
+// hasWatch reports whether a shared watch is open for key.
+func (x *eventMultiplexer) hasWatch(key eventWatchKey) bool {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+
+	_, exists := x.watches[key]
+	return exists
+}
+
+// watchCount returns the number of open shared watches.
+func (x *eventMultiplexer) watchCount() int {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+
+	return len(x.watches)
+}
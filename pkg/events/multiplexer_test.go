@@ -0,0 +1,567 @@
+package events
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/suite"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+	"k8s.io/utils/ptr"
+
+	pkgkubernetes "github.com/containers/kubernetes-mcp-server/pkg/kubernetes"
+)
+
+type MultiplexerTestSuite struct {
+	suite.Suite
+	mu             sync.Mutex
+	watchers       []*watch.FakeWatcher
+	labelSelectors []string // label selector of each opened watch
+	fieldSelectors []string // field selector of each opened watch
+	session        *MockServerSession
+	manager        *EventSubscriptionManager
+}
+
+func TestMultiplexerSuite(t *testing.T) {
+	suite.Run(t, new(MultiplexerTestSuite))
+}
+
+func (s *MultiplexerTestSuite) SetupTest() {
+	s.watchers = nil
+	s.labelSelectors = nil
+	s.fieldSelectors = nil
+
+	clientset := fake.NewClientset()
+	clientset.PrependWatchReactor("events", func(action k8stesting.Action) (bool, watch.Interface, error) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		fakeWatcher := watch.NewFake()
+		s.watchers = append(s.watchers, fakeWatcher)
+		s.labelSelectors = append(s.labelSelectors, action.(k8stesting.WatchActionImpl).ListOptions.LabelSelector)
+		s.fieldSelectors = append(s.fieldSelectors, action.(k8stesting.WatchActionImpl).ListOptions.FieldSelector)
+		return true, fakeWatcher, nil
+	})
+
+	server := NewMockMCPServer()
+	s.session = NewMockServerSession("session1")
+	s.session.SetLogLevel(mcp.LoggingLevel("info"))
+	server.AddSession(s.session)
+
+	getK8sClient := func(cluster string) (*pkgkubernetes.Kubernetes, error) {
+		return &pkgkubernetes.Kubernetes{Interface: clientset}, nil
+	}
+	s.manager = NewEventSubscriptionManager(server, NewTestManagerConfig(), getK8sClient, nil)
+}
+
+func (s *MultiplexerTestSuite) TearDownTest() {
+	s.manager.CancelAll()
+}
+
+// openWatches returns the fake watches opened against the API server so far.
+func (s *MultiplexerTestSuite) openWatches() []*watch.FakeWatcher {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]*watch.FakeWatcher(nil), s.watchers...)
+}
+
+// waitForWatches waits until n watches have been opened and returns them.
+func (s *MultiplexerTestSuite) waitForWatches(n int) []*watch.FakeWatcher {
+	s.Require().Eventually(func() bool {
+		return len(s.openWatches()) >= n
+	}, 2*time.Second, 10*time.Millisecond, "expected %d watches to be opened", n)
+	return s.openWatches()
+}
+
+// receivedEvents returns the event reasons delivered to each subscription ID.
+func (s *MultiplexerTestSuite) receivedEvents() map[string][]string {
+	received := map[string][]string{}
+	for _, call := range s.session.GetLogCalls() {
+		if call.Logger != LoggerEvents {
+			continue
+		}
+		notification, ok := call.Data.(*EventNotification)
+		s.Require().True(ok, "events logger should carry an EventNotification")
+		s.Equal(NotificationSchemaVersion, notification.SchemaVersion)
+		received[notification.SubscriptionID] = append(received[notification.SubscriptionID], notification.Event.Reason)
+	}
+	return received
+}
+
+func makeMultiplexerEvent(name, eventType, reason string) *v1.Event {
+	return &v1.Event{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            name,
+			Namespace:       "default",
+			UID:             types.UID("uid-" + name),
+			ResourceVersion: "1",
+		},
+		InvolvedObject: v1.ObjectReference{Kind: "Pod", Name: "test-pod", Namespace: "default"},
+		Reason:         reason,
+		Type:           eventType,
+	}
+}
+
+func (s *MultiplexerTestSuite) TestSharedClusterWideWatch() {
+	s.Run("two cluster-wide subscriptions share a single watch", func() {
+		warnings, err := s.manager.Create("session1", "cluster1", "events", SubscriptionFilters{Type: "Warning"}, "")
+		s.Require().NoError(err)
+		pulls, err := s.manager.Create("session1", "cluster1", "events", SubscriptionFilters{Reason: "Pull"}, "")
+		s.Require().NoError(err)
+
+		watches := s.waitForWatches(1)
+		s.Equal(1, s.manager.eventMux.watchCount())
+
+		watches[0].Add(makeMultiplexerEvent("backoff", "Warning", "BackOff"))
+		watches[0].Add(makeMultiplexerEvent("pulled", "Normal", "Pulled"))
+		watches[0].Add(makeMultiplexerEvent("pull-failed", "Warning", "PullFailed"))
+		watches[0].Add(makeMultiplexerEvent("scheduled", "Normal", "Scheduled"))
+
+		s.Require().Eventually(func() bool {
+			return len(s.session.GetLogCalls()) >= 4
+		}, 2*time.Second, 10*time.Millisecond, "matching events should be delivered")
+
+		received := s.receivedEvents()
+		s.ElementsMatch([]string{"BackOff", "PullFailed"}, received[warnings.ID], "warning subscription should only receive warnings")
+		s.ElementsMatch([]string{"Pulled", "PullFailed"}, received[pulls.ID], "reason subscription should only receive Pull* events")
+		s.Len(s.openWatches(), 1, "no additional watch should be opened")
+	})
+}
+
+func (s *MultiplexerTestSuite) TestReferenceCounting() {
+	first, err := s.manager.Create("session1", "cluster1", "events", SubscriptionFilters{}, "")
+	s.Require().NoError(err)
+	second, err := s.manager.Create("session1", "cluster1", "events", SubscriptionFilters{}, "")
+	s.Require().NoError(err)
+	watches := s.waitForWatches(1)
+
+	s.Run("watch stays open while a subscriber remains", func() {
+		s.Require().NoError(s.manager.CancelBySessionAndID("session1", first.ID))
+
+		s.Equal(1, s.manager.eventMux.watchCount())
+		s.False(watches[0].IsStopped())
+	})
+
+	s.Run("watch closes when the last subscriber leaves", func() {
+		s.Require().NoError(s.manager.CancelBySessionAndID("session1", second.ID))
+
+		s.Equal(0, s.manager.eventMux.watchCount())
+		s.Eventually(watches[0].IsStopped, 2*time.Second, 10*time.Millisecond, "underlying watch should be stopped")
+	})
+
+	s.Run("new subscription after close opens a fresh watch", func() {
+		_, err := s.manager.Create("session1", "cluster1", "events", SubscriptionFilters{}, "")
+		s.Require().NoError(err)
+
+		s.waitForWatches(2)
+		s.Equal(1, s.manager.eventMux.watchCount())
+	})
+}
+
+func (s *MultiplexerTestSuite) TestNamespaceScopes() {
+	s.Run("different namespace scopes use separate watches", func() {
+		_, err := s.manager.Create("session1", "cluster1", "events", SubscriptionFilters{}, "")
+		s.Require().NoError(err)
+		_, err = s.manager.Create("session1", "cluster1", "events", SubscriptionFilters{Namespaces: []string{"default"}}, "")
+		s.Require().NoError(err)
+		_, err = s.manager.Create("session1", "cluster1", "events", SubscriptionFilters{Namespaces: []string{"default"}, Type: "Warning"}, "")
+		s.Require().NoError(err)
+
+		s.waitForWatches(2)
+		s.Equal(2, s.manager.eventMux.watchCount())
+	})
+}
+
+func (s *MultiplexerTestSuite) TestModificationScopes() {
+	s.Run("subscriptions excluding modifications use a separate watch", func() {
+		_, err := s.manager.Create("session1", "cluster1", "events", SubscriptionFilters{}, "")
+		s.Require().NoError(err)
+		_, err = s.manager.Create("session1", "cluster1", "events", SubscriptionFilters{IncludeModifications: ptr.To(true)}, "")
+		s.Require().NoError(err)
+		_, err = s.manager.Create("session1", "cluster1", "events", SubscriptionFilters{IncludeModifications: ptr.To(false)}, "")
+		s.Require().NoError(err)
+
+		s.waitForWatches(2)
+		s.Equal(2, s.manager.eventMux.watchCount())
+	})
+}
+
+func (s *MultiplexerTestSuite) TestEventLabelSelectorScopes() {
+	s.Run("event label selectors are pushed down to a separate watch", func() {
+		all, err := s.manager.Create("session1", "cluster1", "events", SubscriptionFilters{Namespaces: []string{"default"}}, "")
+		s.Require().NoError(err)
+		selected, err := s.manager.Create("session1", "cluster1", "events", SubscriptionFilters{Namespaces: []string{"default"}, EventLabelSelector: "team=payments"}, "")
+		s.Require().NoError(err)
+		_, err = s.manager.Create("session1", "cluster1", "events", SubscriptionFilters{Namespaces: []string{"default"}, EventLabelSelector: "team=payments", Type: "Warning"}, "")
+		s.Require().NoError(err)
+
+		watches := s.waitForWatches(2)
+		s.Equal(2, s.manager.eventMux.watchCount())
+		s.mu.Lock()
+		s.ElementsMatch([]string{"", "team=payments"}, s.labelSelectors)
+		s.mu.Unlock()
+
+		// The fake API server doesn't apply selectors, so send a non-matching event down the
+		// selected watch to check subscriptions still filter it out
+		for i, watcher := range watches {
+			s.mu.Lock()
+			labelSelector := s.labelSelectors[i]
+			s.mu.Unlock()
+			if labelSelector == "" {
+				watcher.Add(makeMultiplexerEvent("unselected", "Normal", "Unselected"))
+				continue
+			}
+			labeled := makeMultiplexerEvent("labeled", "Normal", "Labeled")
+			labeled.Labels = map[string]string{"team": "payments"}
+			watcher.Add(labeled)
+			unlabeled := makeMultiplexerEvent("unlabeled", "Normal", "Unlabeled")
+			unlabeled.Labels = map[string]string{"team": "search"}
+			watcher.Add(unlabeled)
+		}
+
+		s.Require().Eventually(func() bool {
+			received := s.receivedEvents()
+			return len(received[all.ID]) == 1 && len(received[selected.ID]) == 1
+		}, 2*time.Second, 10*time.Millisecond, "each subscription should receive its events")
+		s.Equal([]string{"Unselected"}, s.receivedEvents()[all.ID])
+		s.Equal([]string{"Labeled"}, s.receivedEvents()[selected.ID])
+	})
+}
+
+func (s *MultiplexerTestSuite) TestInvolvedObjectScopes() {
+	s.Run("involved object filters are pushed down to a separate watch", func() {
+		all, err := s.manager.Create("session1", "cluster1", "events", SubscriptionFilters{Namespaces: []string{"default"}}, "")
+		s.Require().NoError(err)
+		selected, err := s.manager.Create("session1", "cluster1", "events", SubscriptionFilters{Namespaces: []string{"default"}, InvolvedKind: "Pod", InvolvedNamespace: "default"}, "")
+		s.Require().NoError(err)
+		_, err = s.manager.Create("session1", "cluster1", "events", SubscriptionFilters{Namespaces: []string{"default"}, InvolvedKind: "Pod", InvolvedNamespace: "default", Type: "Warning"}, "")
+		s.Require().NoError(err)
+
+		watches := s.waitForWatches(2)
+		s.Equal(2, s.manager.eventMux.watchCount(), "subscriptions selecting the same involved objects share a watch")
+		s.mu.Lock()
+		s.ElementsMatch([]string{"", "involvedObject.kind=Pod,involvedObject.namespace=default"}, s.fieldSelectors)
+		s.mu.Unlock()
+
+		// The fake API server doesn't apply selectors, so send a non-matching event down the
+		// selected watch to check subscriptions still filter it out
+		for i, watcher := range watches {
+			s.mu.Lock()
+			fieldSelector := s.fieldSelectors[i]
+			s.mu.Unlock()
+			if fieldSelector == "" {
+				watcher.Add(makeMultiplexerEvent("unselected", "Normal", "Unselected"))
+				continue
+			}
+			watcher.Add(makeMultiplexerEvent("pod", "Normal", "Pod"))
+			node := makeMultiplexerEvent("node", "Normal", "Node")
+			node.InvolvedObject = v1.ObjectReference{Kind: "Node", Name: "worker-1"}
+			watcher.Add(node)
+		}
+
+		s.Require().Eventually(func() bool {
+			received := s.receivedEvents()
+			return len(received[all.ID]) == 1 && len(received[selected.ID]) == 1
+		}, 2*time.Second, 10*time.Millisecond, "each subscription should receive its events")
+		s.Equal([]string{"Unselected"}, s.receivedEvents()[all.ID])
+		s.Equal([]string{"Pod"}, s.receivedEvents()[selected.ID])
+	})
+}
+
+func (s *MultiplexerTestSuite) TestInvolvedAPIVersionScope() {
+	s.Run("an API version without a kind is not pushed down", func() {
+		_, err := s.manager.Create("session1", "cluster1", "events", SubscriptionFilters{}, "")
+		s.Require().NoError(err)
+		_, err = s.manager.Create("session1", "cluster1", "events", SubscriptionFilters{InvolvedAPIVersion: "apps/v1"}, "")
+		s.Require().NoError(err)
+
+		s.waitForWatches(1)
+		s.Equal(1, s.manager.eventMux.watchCount(), "the subscription joins the unfiltered watch")
+	})
+}
+
+func (s *MultiplexerTestSuite) TestDispatchPanics() {
+	s.Run("a panicking subscriber doesn't keep the event from the others", func() {
+		x := newEventMultiplexer(nil)
+		var dispatch func(context.Context, *v1.Event)
+		start := func(ctx context.Context, d func(context.Context, *v1.Event), onHealthChange func(WatchHealth, error)) error {
+			dispatch = d
+			return nil
+		}
+
+		var mu sync.Mutex
+		counts := map[string]int{}
+		newSubscriber := func(id string, process func()) *eventSubscriber {
+			return &eventSubscriber{
+				sub: &Subscription{ID: id},
+				process: func(context.Context, *v1.Event) {
+					mu.Lock()
+					counts[id]++
+					mu.Unlock()
+					process()
+				},
+				onHealthChange: func(WatchHealth, error) {},
+			}
+		}
+
+		key := eventWatchKey{cluster: "cluster1"}
+		for _, id := range []string{"a", "b", "c"} {
+			_, err := x.subscribe(key, newSubscriber(id, func() {}), start)
+			s.Require().NoError(err)
+		}
+		_, err := x.subscribe(key, newSubscriber("panics", func() { panic("sink failed") }), start)
+		s.Require().NoError(err)
+
+		s.NotPanics(func() {
+			dispatch(context.Background(), makeMultiplexerEvent("one", "Normal", "Started"))
+			dispatch(context.Background(), makeMultiplexerEvent("two", "Normal", "Started"))
+		})
+		s.Equal(map[string]int{"a": 2, "b": 2, "c": 2, "panics": 2}, counts)
+	})
+}
+
+func (s *MultiplexerTestSuite) TestDispatchAfterUnsubscribe() {
+	s.Run("unsubscribed subscribers stop receiving events", func() {
+		x := newEventMultiplexer(nil)
+		var mu sync.Mutex
+		var dispatch func(context.Context, *v1.Event)
+		start := func(ctx context.Context, d func(context.Context, *v1.Event), onHealthChange func(WatchHealth, error)) error {
+			dispatch = d
+			return nil
+		}
+
+		counts := map[string]int{}
+		newSubscriber := func(id string) *eventSubscriber {
+			return &eventSubscriber{
+				sub: &Subscription{ID: id},
+				process: func(_ context.Context, event *v1.Event) {
+					mu.Lock()
+					defer mu.Unlock()
+					counts[id]++
+				},
+				onHealthChange: func(WatchHealth, error) {},
+			}
+		}
+
+		key := eventWatchKey{cluster: "cluster1"}
+		cancelA, err := x.subscribe(key, newSubscriber("a"), start)
+		s.Require().NoError(err)
+		cancelB, err := x.subscribe(key, newSubscriber("b"), start)
+		s.Require().NoError(err)
+
+		dispatch(context.Background(), makeMultiplexerEvent("one", "Normal", "Started"))
+		cancelA()
+		cancelA() // idempotent
+		dispatch(context.Background(), makeMultiplexerEvent("two", "Normal", "Started"))
+
+		s.Equal(1, counts["a"])
+		s.Equal(2, counts["b"])
+		s.Equal(1, x.watchCount())
+
+		cancelB()
+		s.Equal(0, x.watchCount())
+	})
+}
+
+func (s *MultiplexerTestSuite) TestSlowWatchStart() {
+	newSubscriber := func(id string, received chan<- string) *eventSubscriber {
+		return &eventSubscriber{
+			sub: &Subscription{ID: id},
+			process: func(_ context.Context, event *v1.Event) {
+				received <- id + ":" + event.Reason
+			},
+			onHealthChange: func(WatchHealth, error) {},
+		}
+	}
+
+	s.Run("other watches keep dispatching while a watch starts", func() {
+		x := newEventMultiplexer(nil)
+		received := make(chan string, 10)
+		var dispatchFast func(context.Context, *v1.Event)
+		_, err := x.subscribe(eventWatchKey{cluster: "fast"}, newSubscriber("a", received), func(_ context.Context, d func(context.Context, *v1.Event), _ func(WatchHealth, error)) error {
+			dispatchFast = d
+			return nil
+		})
+		s.Require().NoError(err)
+
+		release := make(chan struct{})
+		started := make(chan struct{})
+		go func() {
+			defer close(started)
+			_, _ = x.subscribe(eventWatchKey{cluster: "slow"}, newSubscriber("b", received), func(context.Context, func(context.Context, *v1.Event), func(WatchHealth, error)) error {
+				<-release
+				return nil
+			})
+		}()
+		s.Eventually(func() bool { return x.hasWatch(eventWatchKey{cluster: "slow"}) }, time.Second, 10*time.Millisecond)
+
+		dispatchFast(context.Background(), makeMultiplexerEvent("one", "Normal", "Started"))
+		s.Equal("a:Started", <-received)
+		s.Equal(2, x.watchCount(), "the starting watch counts as open")
+
+		close(release)
+		<-started
+	})
+
+	s.Run("subscribers joining a starting watch wait for it", func() {
+		x := newEventMultiplexer(nil)
+		received := make(chan string, 10)
+		key := eventWatchKey{cluster: "cluster1"}
+		release := make(chan struct{})
+		starts := 0
+		var dispatch func(context.Context, *v1.Event)
+		start := func(_ context.Context, d func(context.Context, *v1.Event), _ func(WatchHealth, error)) error {
+			starts++
+			dispatch = d
+			<-release
+			return nil
+		}
+		go func() {
+			_, _ = x.subscribe(key, newSubscriber("a", received), start)
+		}()
+		s.Eventually(func() bool { return x.hasWatch(key) }, time.Second, 10*time.Millisecond)
+
+		joined := make(chan error)
+		go func() {
+			_, err := x.subscribe(key, newSubscriber("b", received), start)
+			joined <- err
+		}()
+		s.Never(func() bool { return len(joined) > 0 }, 50*time.Millisecond, 10*time.Millisecond)
+
+		close(release)
+		s.Require().NoError(<-joined)
+		s.Equal(1, starts, "the joining subscriber shares the watch")
+		dispatch(context.Background(), makeMultiplexerEvent("one", "Normal", "Started"))
+		s.ElementsMatch([]string{"a:Started", "b:Started"}, []string{<-received, <-received})
+	})
+
+	s.Run("subscribers joining a watch failing to start get its error", func() {
+		x := newEventMultiplexer(nil)
+		key := eventWatchKey{cluster: "cluster1"}
+		release := make(chan struct{})
+		failed := make(chan error)
+		go func() {
+			_, err := x.subscribe(key, newSubscriber("a", nil), func(context.Context, func(context.Context, *v1.Event), func(WatchHealth, error)) error {
+				<-release
+				return fmt.Errorf("list failed")
+			})
+			failed <- err
+		}()
+		s.Eventually(func() bool { return x.hasWatch(key) }, time.Second, 10*time.Millisecond)
+
+		joined := make(chan error)
+		go func() {
+			_, err := x.subscribe(key, newSubscriber("b", nil), func(context.Context, func(context.Context, *v1.Event), func(WatchHealth, error)) error {
+				return nil
+			})
+			joined <- err
+		}()
+		s.Never(func() bool { return len(joined) > 0 }, 50*time.Millisecond, 10*time.Millisecond)
+		close(release)
+
+		s.EqualError(<-failed, "list failed")
+		s.EqualError(<-joined, "list failed")
+		s.Zero(x.watchCount())
+	})
+}
+
+// watchKeys returns the keys of the open shared watches.
+func (s *MultiplexerTestSuite) watchKeys() []eventWatchKey {
+	s.manager.eventMux.mu.Lock()
+	defer s.manager.eventMux.mu.Unlock()
+
+	keys := make([]eventWatchKey, 0, len(s.manager.eventMux.watches))
+	for key := range s.manager.eventMux.watches {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+func (s *MultiplexerTestSuite) TestMultipleNamespaceScopes() {
+	s.Run("a few namespaces use one namespace-scoped watch each", func() {
+		defer s.manager.CancelAll()
+		sub, err := s.manager.Create("session1", "cluster1", "events", SubscriptionFilters{Namespaces: []string{"team-a", "team-b", "team-a"}}, "")
+		s.Require().NoError(err)
+
+		watches := s.waitForWatches(2)
+		s.ElementsMatch([]eventWatchKey{
+			{cluster: "cluster1", namespace: "team-a", includeModifications: true},
+			{cluster: "cluster1", namespace: "team-b", includeModifications: true},
+		}, s.watchKeys())
+
+		for i, watcher := range watches {
+			event := makeMultiplexerEvent(fmt.Sprintf("event-%d", i), "Warning", fmt.Sprintf("Reason%d", i))
+			event.Namespace = []string{"team-a", "team-b"}[i]
+			watcher.Add(event)
+		}
+
+		s.Require().Eventually(func() bool {
+			return len(s.receivedEvents()[sub.ID]) == 2
+		}, 2*time.Second, 10*time.Millisecond, "events from each namespace should be delivered")
+		s.ElementsMatch([]string{"Reason0", "Reason1"}, s.receivedEvents()[sub.ID])
+
+		s.Require().NoError(s.manager.CancelBySessionAndID("session1", sub.ID))
+		s.Equal(0, s.manager.eventMux.watchCount(), "all namespace watches should be released")
+	})
+
+	s.Run("namespace-scoped watches are shared with single-namespace subscriptions", func() {
+		defer s.manager.CancelAll()
+		_, err := s.manager.Create("session1", "cluster1", "events", SubscriptionFilters{Namespaces: []string{"team-a", "team-b"}}, "")
+		s.Require().NoError(err)
+		_, err = s.manager.Create("session1", "cluster1", "events", SubscriptionFilters{Namespaces: []string{"team-b"}}, "")
+		s.Require().NoError(err)
+
+		s.Equal(2, s.manager.eventMux.watchCount())
+	})
+
+	s.Run("many namespaces fall back to a cluster-wide watch", func() {
+		defer s.manager.CancelAll()
+		namespaces := make([]string, maxNamespaceScopedWatches+1)
+		for i := range namespaces {
+			namespaces[i] = fmt.Sprintf("team-%d", i)
+		}
+		_, err := s.manager.Create("session1", "cluster1", "events", SubscriptionFilters{Namespaces: namespaces}, "")
+		s.Require().NoError(err)
+
+		s.Equal([]eventWatchKey{{cluster: "cluster1", includeModifications: true}}, s.watchKeys())
+	})
+}
+
+func (s *MultiplexerTestSuite) TestFirstOccurrenceOnly() {
+	s.Run("repeats of the same involved object and reason are suppressed", func() {
+		defer s.manager.CancelAll()
+		first, err := s.manager.Create("session1", "cluster1", "events", SubscriptionFilters{FirstOccurrenceOnly: true}, "")
+		s.Require().NoError(err)
+		all, err := s.manager.Create("session1", "cluster1", "events", SubscriptionFilters{}, "")
+		s.Require().NoError(err)
+
+		watches := s.waitForWatches(1)
+
+		backoff := makeMultiplexerEvent("backoff", "Warning", "BackOff")
+		backoff.LastTimestamp = metav1.NewTime(time.Now())
+		watches[0].Add(backoff)
+
+		// A recurrence well past any deduplication TTL, as a new event object
+		repeat := makeMultiplexerEvent("backoff-2", "Warning", "BackOff")
+		repeat.Count = 5
+		repeat.LastTimestamp = metav1.NewTime(time.Now().Add(time.Hour))
+		watches[0].Add(repeat)
+
+		watches[0].Add(makeMultiplexerEvent("failed", "Warning", "Failed"))
+
+		s.Require().Eventually(func() bool {
+			return len(s.session.GetLogCalls()) >= 5
+		}, 2*time.Second, 10*time.Millisecond, "events should be delivered")
+
+		received := s.receivedEvents()
+		s.Equal([]string{"BackOff", "Failed"}, received[first.ID], "only first occurrences should be delivered")
+		s.Equal([]string{"BackOff", "BackOff", "Failed"}, received[all.ID], "other subscriptions should receive every event")
+	})
+}
@@ -5,10 +5,18 @@ import (
 	"time"
 
 	v1 "k8s.io/api/core/v1"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/yaml"
 )
 
+// NotificationSchemaVersion identifies the shape of notification payloads. It is bumped
+// when a field is renamed or removed, or its meaning changes; adding optional fields
+// keeps the version, so clients should ignore fields they don't know.
+const NotificationSchemaVersion = "v1"
+
 // EventNotification represents the notification payload for kubernetes/events
 type EventNotification struct {
+	SchemaVersion  string        `json:"schemaVersion"`
 	SubscriptionID string        `json:"subscriptionId"`
 	Cluster        string        `json:"cluster"`
 	Event          *EventDetails `json:"event"`
@@ -16,16 +24,18 @@ type EventNotification struct {
 
 // EventDetails contains the serialized event information
 type EventDetails struct {
-	Namespace      string            `json:"namespace"`
-	Timestamp      string            `json:"timestamp"`
-	Type           string            `json:"type"`
-	Reason         string            `json:"reason"`
-	Message        string            `json:"message"`
-	Labels         map[string]string `json:"labels,omitempty"`
-	InvolvedObject *InvolvedObject   `json:"involvedObject"`
-	Count          int32             `json:"count,omitempty"`
-	FirstTimestamp string            `json:"firstTimestamp,omitempty"`
-	LastTimestamp  string            `json:"lastTimestamp,omitempty"`
+	Namespace       string            `json:"namespace"`
+	Timestamp       string            `json:"timestamp"`
+	Type            string            `json:"type"`
+	Reason          string            `json:"reason"`
+	Message         string            `json:"message"`
+	Labels          map[string]string `json:"labels,omitempty"`
+	InvolvedObject  *InvolvedObject   `json:"involvedObject"`
+	Owner           *ObjectOwner      `json:"owner,omitempty"`
+	Count           int32             `json:"count,omitempty"`
+	FirstTimestamp  string            `json:"firstTimestamp,omitempty"`
+	LastTimestamp   string            `json:"lastTimestamp,omitempty"`
+	ResourceVersion string            `json:"resourceVersion,omitempty"`
 }
 
 // InvolvedObject represents the object involved in the event
@@ -37,8 +47,17 @@ type InvolvedObject struct {
 	UID        string `json:"uid,omitempty"`
 }
 
+// ObjectOwner identifies the top-level owner of an involved object (e.g. the
+// Deployment of a pod). Only set when ManagerConfig.ResolveEventOwners is enabled.
+type ObjectOwner struct {
+	APIVersion string `json:"apiVersion"`
+	Kind       string `json:"kind"`
+	Name       string `json:"name"`
+}
+
 // SubscriptionErrorNotification represents the notification payload for subscription errors
 type SubscriptionErrorNotification struct {
+	SchemaVersion  string `json:"schemaVersion"`
 	SubscriptionID string `json:"subscriptionId"`
 	Cluster        string `json:"cluster"`
 	Error          string `json:"error"`
@@ -47,19 +66,9 @@ type SubscriptionErrorNotification struct {
 
 // SerializeEvent converts a Kubernetes Event to EventDetails
 func SerializeEvent(event *v1.Event) *EventDetails {
-	// Determine the best timestamp to use
-	timestamp := event.EventTime.Time
-	if timestamp.IsZero() && event.Series != nil {
-		timestamp = event.Series.LastObservedTime.Time
-	} else if timestamp.IsZero() && event.Count > 1 {
-		timestamp = event.LastTimestamp.Time
-	} else if timestamp.IsZero() {
-		timestamp = event.FirstTimestamp.Time
-	}
-
 	details := &EventDetails{
 		Namespace: event.Namespace,
-		Timestamp: formatTimestamp(timestamp),
+		Timestamp: formatTimestamp(eventTimestamp(event)),
 		Type:      event.Type,
 		Reason:    event.Reason,
 		Message:   strings.TrimSpace(event.Message),
@@ -70,20 +79,16 @@ func SerializeEvent(event *v1.Event) *EventDetails {
 			Namespace:  event.InvolvedObject.Namespace,
 			UID:        string(event.InvolvedObject.UID),
 		},
+		ResourceVersion: event.ResourceVersion,
 	}
 
-	// Add optional fields
-	if event.Count > 0 {
-		details.Count = event.Count
+	// Add optional fields, falling back to EventTime and Series for newer events
+	if count := eventCount(event); count > 0 {
+		details.Count = count
 	}
 
-	if !event.FirstTimestamp.IsZero() {
-		details.FirstTimestamp = formatTimestamp(event.FirstTimestamp.Time)
-	}
-
-	if !event.LastTimestamp.IsZero() {
-		details.LastTimestamp = formatTimestamp(event.LastTimestamp.Time)
-	}
+	details.FirstTimestamp = formatTimestamp(eventFirstTimestamp(event))
+	details.LastTimestamp = formatTimestamp(eventLastTimestamp(event))
 
 	// Add labels from the involved object if available
 	// Note: We don't have direct access to the object here,
@@ -93,6 +98,56 @@ func SerializeEvent(event *v1.Event) *EventDetails {
 	return details
 }
 
+// eventTimestamp returns the best available time of an event's latest occurrence.
+// events.k8s.io-style events record their first occurrence in EventTime and later
+// ones in Series, while legacy events use FirstTimestamp and LastTimestamp.
+func eventTimestamp(event *v1.Event) time.Time {
+	if event.Series != nil && !event.Series.LastObservedTime.IsZero() {
+		return event.Series.LastObservedTime.Time
+	}
+	if !event.EventTime.IsZero() {
+		return event.EventTime.Time
+	}
+	if event.Count > 1 && !event.LastTimestamp.IsZero() {
+		return event.LastTimestamp.Time
+	}
+	return event.FirstTimestamp.Time
+}
+
+// eventFirstTimestamp returns when an event first occurred, from FirstTimestamp or,
+// for events that don't set the legacy field, EventTime.
+func eventFirstTimestamp(event *v1.Event) time.Time {
+	if !event.FirstTimestamp.IsZero() {
+		return event.FirstTimestamp.Time
+	}
+	return event.EventTime.Time
+}
+
+// eventLastTimestamp returns when an event last occurred, from LastTimestamp or,
+// for events that don't set the legacy field, the series' last observed time.
+// Returns the zero time for a single occurrence without LastTimestamp.
+func eventLastTimestamp(event *v1.Event) time.Time {
+	if !event.LastTimestamp.IsZero() {
+		return event.LastTimestamp.Time
+	}
+	if event.Series != nil {
+		return event.Series.LastObservedTime.Time
+	}
+	return time.Time{}
+}
+
+// eventCount returns how many times an event occurred, from Count or, for events
+// that don't set the legacy field, the series count. Returns zero if neither is set.
+func eventCount(event *v1.Event) int32 {
+	if event.Count > 0 {
+		return event.Count
+	}
+	if event.Series != nil {
+		return event.Series.Count
+	}
+	return 0
+}
+
 // formatTimestamp formats a time.Time to RFC3339 string
 func formatTimestamp(t time.Time) string {
 	if t.IsZero() {
@@ -103,16 +158,36 @@ func formatTimestamp(t time.Time) string {
 
 // ResourceFaultNotification represents the notification payload for kubernetes/faults
 type ResourceFaultNotification struct {
+	SchemaVersion  string             `json:"schemaVersion"`
 	SubscriptionID string             `json:"subscriptionId"`
 	Cluster        string             `json:"cluster"`
 	FaultID        string             `json:"faultId"`
 	FaultType      FaultType          `json:"faultType"`
+	FaultTypes     []FaultType        `json:"faultTypes,omitempty"`
 	Severity       Severity           `json:"severity"`
 	Resource       *ResourceReference `json:"resource"`
 	Context        string             `json:"context,omitempty"`
+	ContextYAML    string             `json:"contextYAML,omitempty"`
+	Details        map[string]string  `json:"details,omitempty"`
+	RelatedEvents  []*EventDetails    `json:"relatedEvents,omitempty"`
 	Timestamp      string             `json:"timestamp"`
 }
 
+// formatDetailsYAML renders fault details as a YAML document with one key per line,
+// sorted by key, with multi-line values such as panic traces kept readable.
+// Returns an empty string if there are no details.
+func formatDetailsYAML(details map[string]string) string {
+	if len(details) == 0 {
+		return ""
+	}
+	data, err := yaml.Marshal(details)
+	if err != nil {
+		klog.V(2).Infof("Failed to render fault details as YAML: %v", err)
+		return ""
+	}
+	return string(data)
+}
+
 // ResourceReference contains information about the affected resource
 type ResourceReference struct {
 	APIVersion string `json:"apiVersion"`
@@ -122,9 +197,17 @@ type ResourceReference struct {
 	UID        string `json:"uid"`
 }
 
+// TestNotification is the payload sent by SendTestNotification to verify a session
+// receives notifications.
+type TestNotification struct {
+	Message   string `json:"message"`
+	Timestamp string `json:"timestamp"`
+}
+
 // Logger name constants for notification delivery
 const (
 	LoggerEvents            = "kubernetes/events"
 	LoggerFaults            = "kubernetes/faults"
 	LoggerSubscriptionError = "kubernetes/subscription_error"
+	LoggerTestNotification  = "kubernetes/test_notification"
 )
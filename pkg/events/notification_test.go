@@ -1,10 +1,18 @@
 package events
 
 import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 	"github.com/stretchr/testify/suite"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
 )
 
 type NotificationTestSuite struct {
@@ -47,7 +55,7 @@ func (s *NotificationTestSuite) TestSendNotification_DeliversToCorrectSession()
 			Cluster:        "test-cluster",
 		}
 
-		err := s.manager.sendNotification("session1", LoggerEvents, mcp.LoggingLevel("info"), notification)
+		err := s.manager.sendNotification("session1", LoggerEvents, notification)
 		s.NoError(err)
 
 		// Verify session1 received the notification
@@ -80,7 +88,7 @@ func (s *NotificationTestSuite) TestSendNotification_DeliversToCorrectSession()
 			Cluster:        "test-cluster",
 		}
 
-		err := s.manager.sendNotification("session2", LoggerEvents, mcp.LoggingLevel("info"), notification)
+		err := s.manager.sendNotification("session2", LoggerEvents, notification)
 		s.NoError(err)
 
 		// Verify only session2 received the notification
@@ -104,9 +112,9 @@ func (s *NotificationTestSuite) TestSendNotification_ReturnsErrorForMissingSessi
 			Cluster:        "test-cluster",
 		}
 
-		err := s.manager.sendNotification("non-existent-session", LoggerEvents, mcp.LoggingLevel("info"), notification)
+		err := s.manager.sendNotification("non-existent-session", LoggerEvents, notification)
 		s.Error(err)
-		s.Contains(err.Error(), "not found")
+		s.ErrorIs(err, ErrSessionNotFound)
 	})
 
 	s.Run("returns error when no sessions exist", func() {
@@ -117,9 +125,9 @@ func (s *NotificationTestSuite) TestSendNotification_ReturnsErrorForMissingSessi
 			Cluster:        "test-cluster",
 		}
 
-		err := s.manager.sendNotification("session1", LoggerEvents, mcp.LoggingLevel("info"), notification)
+		err := s.manager.sendNotification("session1", LoggerEvents, notification)
 		s.Error(err)
-		s.Contains(err.Error(), "not found")
+		s.ErrorIs(err, ErrSessionNotFound)
 	})
 }
 
@@ -136,7 +144,7 @@ func (s *NotificationTestSuite) TestNotificationDroppedWhenNoLogLevel() {
 			Cluster:        "test-cluster",
 		}
 
-		err := s.manager.sendNotification("session1", LoggerEvents, mcp.LoggingLevel("info"), notification)
+		err := s.manager.sendNotification("session1", LoggerEvents, notification)
 		s.NoError(err) // No error, but notification is dropped
 
 		// Verify no log calls were captured
@@ -155,7 +163,7 @@ func (s *NotificationTestSuite) TestNotificationDroppedWhenNoLogLevel() {
 			Cluster:        "test-cluster",
 		}
 
-		err := s.manager.sendNotification("session1", LoggerEvents, mcp.LoggingLevel("info"), notification)
+		err := s.manager.sendNotification("session1", LoggerEvents, notification)
 		s.NoError(err)
 
 		// Verify log call was captured
@@ -164,6 +172,58 @@ func (s *NotificationTestSuite) TestNotificationDroppedWhenNoLogLevel() {
 	})
 }
 
+// TestSendTestNotification tests that test notifications report whether they were delivered
+func (s *NotificationTestSuite) TestSendTestNotification() {
+	s.Run("delivered to session with a log level", func() {
+		session := NewMockServerSession("session1")
+		session.SetLogLevel(mcp.LoggingLevel("info"))
+		s.server.AddSession(session)
+
+		s.NoError(s.manager.SendTestNotification("session1"))
+
+		calls := session.GetLogCalls()
+		s.Require().Len(calls, 1)
+		s.Equal(LoggerTestNotification, calls[0].Logger)
+		s.Equal(mcp.LoggingLevel("info"), calls[0].Level)
+		notification, ok := calls[0].Data.(*TestNotification)
+		s.Require().True(ok, "data should be *TestNotification type")
+		s.NotEmpty(notification.Message)
+		s.NotEmpty(notification.Timestamp)
+	})
+
+	s.Run("dropped due to log level", func() {
+		session := NewMockServerSession("session1")
+		s.server.AddSession(session)
+
+		err := s.manager.SendTestNotification("session1")
+		s.Require().Error(err)
+		s.True(errors.Is(err, ErrNotificationDropped))
+		s.False(errors.Is(err, ErrSessionNotFound))
+		s.Contains(err.Error(), "logging/setLevel")
+		s.Empty(session.GetLogCalls())
+	})
+
+	s.Run("missing session", func() {
+		err := s.manager.SendTestNotification("non-existent")
+		s.Require().Error(err)
+		s.True(errors.Is(err, ErrSessionNotFound))
+		s.False(errors.Is(err, ErrNotificationDropped))
+		s.Contains(err.Error(), "non-existent")
+	})
+
+	s.Run("send failure is returned", func() {
+		session := NewMockServerSession("session1")
+		session.SetLogLevel(mcp.LoggingLevel("info"))
+		session.SetLogError(errors.New("connection reset"))
+		s.server.AddSession(session)
+
+		err := s.manager.SendTestNotification("session1")
+		s.Require().Error(err)
+		s.Contains(err.Error(), "connection reset")
+		s.False(errors.Is(err, ErrNotificationDropped))
+	})
+}
+
 // TestCorrectLoggerNames tests that correct logger names are used for different notification types
 func (s *NotificationTestSuite) TestCorrectLoggerNames() {
 	s.Run("uses correct logger name for events", func() {
@@ -176,7 +236,7 @@ func (s *NotificationTestSuite) TestCorrectLoggerNames() {
 			Cluster:        "test-cluster",
 		}
 
-		err := s.manager.sendNotification("session1", LoggerEvents, mcp.LoggingLevel("info"), notification)
+		err := s.manager.sendNotification("session1", LoggerEvents, notification)
 		s.NoError(err)
 
 		calls := session.GetLogCalls()
@@ -195,7 +255,7 @@ func (s *NotificationTestSuite) TestCorrectLoggerNames() {
 			Cluster:        "test-cluster",
 		}
 
-		err := s.manager.sendNotification("session1", LoggerFaults, mcp.LoggingLevel("warning"), notification)
+		err := s.manager.sendNotification("session1", LoggerFaults, notification)
 		s.NoError(err)
 
 		calls := session.GetLogCalls()
@@ -206,7 +266,7 @@ func (s *NotificationTestSuite) TestCorrectLoggerNames() {
 
 	s.Run("uses correct logger name for subscription errors", func() {
 		session := NewMockServerSession("session1")
-		session.SetLogLevel(mcp.LoggingLevel("error"))
+		session.SetLogLevel(mcp.LoggingLevel("warning"))
 		s.server.AddSession(session)
 
 		notification := SubscriptionErrorNotification{
@@ -216,7 +276,7 @@ func (s *NotificationTestSuite) TestCorrectLoggerNames() {
 			Degraded:       true,
 		}
 
-		err := s.manager.sendNotification("session1", LoggerSubscriptionError, mcp.LoggingLevel("error"), notification)
+		err := s.manager.sendNotification("session1", LoggerSubscriptionError, notification)
 		s.NoError(err)
 
 		calls := session.GetLogCalls()
@@ -244,7 +304,7 @@ func (s *NotificationTestSuite) TestNotificationData() {
 			},
 		}
 
-		err := s.manager.sendNotification("session1", LoggerEvents, mcp.LoggingLevel("info"), notification)
+		err := s.manager.sendNotification("session1", LoggerEvents, notification)
 		s.NoError(err)
 
 		calls := session.GetLogCalls()
@@ -262,7 +322,7 @@ func (s *NotificationTestSuite) TestNotificationData() {
 
 	s.Run("passes error notification data correctly", func() {
 		session := NewMockServerSession("session1")
-		session.SetLogLevel(mcp.LoggingLevel("error"))
+		session.SetLogLevel(mcp.LoggingLevel("warning"))
 		s.server.AddSession(session)
 
 		notification := SubscriptionErrorNotification{
@@ -272,7 +332,7 @@ func (s *NotificationTestSuite) TestNotificationData() {
 			Degraded:       true,
 		}
 
-		err := s.manager.sendNotification("session1", LoggerSubscriptionError, mcp.LoggingLevel("error"), notification)
+		err := s.manager.sendNotification("session1", LoggerSubscriptionError, notification)
 		s.NoError(err)
 
 		calls := session.GetLogCalls()
@@ -300,7 +360,7 @@ func (s *NotificationTestSuite) TestNotificationLogLevel() {
 			Cluster:        "test-cluster",
 		}
 
-		err := s.manager.sendNotification("session1", LoggerEvents, mcp.LoggingLevel("info"), notification)
+		err := s.manager.sendNotification("session1", LoggerEvents, notification)
 		s.NoError(err)
 
 		calls := session.GetLogCalls()
@@ -308,9 +368,9 @@ func (s *NotificationTestSuite) TestNotificationLogLevel() {
 		s.Equal(mcp.LoggingLevel("info"), calls[0].Level)
 	})
 
-	s.Run("uses Error level for error notifications", func() {
+	s.Run("uses Warning level for subscription errors", func() {
 		session := NewMockServerSession("session1")
-		session.SetLogLevel(mcp.LoggingLevel("error"))
+		session.SetLogLevel(mcp.LoggingLevel("warning"))
 		s.server.AddSession(session)
 
 		notification := SubscriptionErrorNotification{
@@ -319,12 +379,12 @@ func (s *NotificationTestSuite) TestNotificationLogLevel() {
 			Error:          "connection lost",
 		}
 
-		err := s.manager.sendNotification("session1", LoggerSubscriptionError, mcp.LoggingLevel("error"), notification)
+		err := s.manager.sendNotification("session1", LoggerSubscriptionError, notification)
 		s.NoError(err)
 
 		calls := session.GetLogCalls()
 		s.Require().Len(calls, 1)
-		s.Equal(mcp.LoggingLevel("error"), calls[0].Level)
+		s.Equal(mcp.LoggingLevel("warning"), calls[0].Level)
 	})
 
 	s.Run("uses Warning level for fault events", func() {
@@ -337,7 +397,7 @@ func (s *NotificationTestSuite) TestNotificationLogLevel() {
 			Cluster:        "test-cluster",
 		}
 
-		err := s.manager.sendNotification("session1", LoggerFaults, mcp.LoggingLevel("warning"), notification)
+		err := s.manager.sendNotification("session1", LoggerFaults, notification)
 		s.NoError(err)
 
 		calls := session.GetLogCalls()
@@ -358,7 +418,7 @@ func (s *NotificationTestSuite) TestNotificationContext() {
 			Cluster:        "test-cluster",
 		}
 
-		err := s.manager.sendNotification("session1", LoggerEvents, mcp.LoggingLevel("info"), notification)
+		err := s.manager.sendNotification("session1", LoggerEvents, notification)
 		s.NoError(err)
 
 		calls := session.GetLogCalls()
@@ -383,7 +443,7 @@ func (s *NotificationTestSuite) TestMultipleNotifications() {
 				Cluster:        "test-cluster",
 			}
 
-			err := s.manager.sendNotification("session1", LoggerEvents, mcp.LoggingLevel("info"), notification)
+			err := s.manager.sendNotification("session1", LoggerEvents, notification)
 			s.NoError(err)
 		}
 
@@ -412,7 +472,7 @@ func (s *NotificationTestSuite) TestMultipleNotifications() {
 				SubscriptionID: "sub-1",
 				Cluster:        "cluster1",
 			}
-			err := s.manager.sendNotification("session1", LoggerEvents, mcp.LoggingLevel("info"), notification)
+			err := s.manager.sendNotification("session1", LoggerEvents, notification)
 			s.NoError(err)
 		}
 
@@ -421,7 +481,7 @@ func (s *NotificationTestSuite) TestMultipleNotifications() {
 				SubscriptionID: "sub-2",
 				Cluster:        "cluster2",
 			}
-			err := s.manager.sendNotification("session2", LoggerFaults, mcp.LoggingLevel("warning"), notification)
+			err := s.manager.sendNotification("session2", LoggerFaults, notification)
 			s.NoError(err)
 		}
 
@@ -445,6 +505,7 @@ func (s *NotificationTestSuite) TestLoggerConstants() {
 		s.Equal("kubernetes/events", LoggerEvents)
 		s.Equal("kubernetes/faults", LoggerFaults)
 		s.Equal("kubernetes/subscription_error", LoggerSubscriptionError)
+		s.Equal("kubernetes/test_notification", LoggerTestNotification)
 	})
 }
 
@@ -456,7 +517,7 @@ func (s *NotificationTestSuite) TestNotificationWithNilData() {
 		s.server.AddSession(session)
 
 		// Send notification with nil data
-		err := s.manager.sendNotification("session1", LoggerEvents, mcp.LoggingLevel("info"), nil)
+		err := s.manager.sendNotification("session1", LoggerEvents, nil)
 		s.NoError(err)
 
 		calls := session.GetLogCalls()
@@ -475,7 +536,7 @@ func (s *NotificationTestSuite) TestSessionRemoval() {
 
 		// Create subscription
 		filters := SubscriptionFilters{}
-		sub, err := s.manager.Create("session1", "cluster1", "events", filters)
+		sub, err := s.manager.Create("session1", "cluster1", "events", filters, "")
 		s.Require().NoError(err)
 
 		// Remove session from server
@@ -487,9 +548,85 @@ func (s *NotificationTestSuite) TestSessionRemoval() {
 			Cluster:        "cluster1",
 		}
 
-		err = s.manager.sendNotification("session1", LoggerEvents, mcp.LoggingLevel("info"), notification)
+		err = s.manager.sendNotification("session1", LoggerEvents, notification)
 		s.Error(err)
-		s.Contains(err.Error(), "not found")
+		s.ErrorIs(err, ErrSessionNotFound)
+	})
+}
+
+// TestSerializeEvent tests that event timing and counts are read from legacy and series fields
+func (s *NotificationTestSuite) TestSerializeEvent() {
+	first := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	last := first.Add(10 * time.Minute)
+
+	s.Run("legacy event uses first and last timestamps and count", func() {
+		details := SerializeEvent(&v1.Event{
+			ObjectMeta:     metav1.ObjectMeta{Name: "legacy", Namespace: "default"},
+			Reason:         "BackOff",
+			FirstTimestamp: metav1.NewTime(first),
+			LastTimestamp:  metav1.NewTime(last),
+			Count:          4,
+		})
+
+		s.Equal("2025-01-01T12:10:00Z", details.Timestamp, "repeated event is timestamped at its last occurrence")
+		s.Equal("2025-01-01T12:00:00Z", details.FirstTimestamp)
+		s.Equal("2025-01-01T12:10:00Z", details.LastTimestamp)
+		s.Equal(int32(4), details.Count)
+	})
+
+	s.Run("legacy single occurrence uses first timestamp", func() {
+		details := SerializeEvent(&v1.Event{
+			ObjectMeta:     metav1.ObjectMeta{Name: "legacy", Namespace: "default"},
+			FirstTimestamp: metav1.NewTime(first),
+			LastTimestamp:  metav1.NewTime(first),
+			Count:          1,
+		})
+
+		s.Equal("2025-01-01T12:00:00Z", details.Timestamp)
+		s.Equal(int32(1), details.Count)
+	})
+
+	s.Run("series event uses event time and series fields", func() {
+		details := SerializeEvent(&v1.Event{
+			ObjectMeta: metav1.ObjectMeta{Name: "series", Namespace: "default"},
+			Reason:     "BackOff",
+			EventTime:  metav1.NewMicroTime(first),
+			Series: &v1.EventSeries{
+				Count:            12,
+				LastObservedTime: metav1.NewMicroTime(last),
+			},
+		})
+
+		s.Equal("2025-01-01T12:10:00Z", details.Timestamp, "repeated event is timestamped at its last observation")
+		s.Equal("2025-01-01T12:00:00Z", details.FirstTimestamp)
+		s.Equal("2025-01-01T12:10:00Z", details.LastTimestamp)
+		s.Equal(int32(12), details.Count)
+	})
+
+	s.Run("single event with only event time", func() {
+		details := SerializeEvent(&v1.Event{
+			ObjectMeta: metav1.ObjectMeta{Name: "single", Namespace: "default"},
+			EventTime:  metav1.NewMicroTime(first),
+		})
+
+		s.Equal("2025-01-01T12:00:00Z", details.Timestamp)
+		s.Equal("2025-01-01T12:00:00Z", details.FirstTimestamp)
+		s.Empty(details.LastTimestamp)
+		s.Zero(details.Count)
+	})
+
+	s.Run("legacy fields take precedence when both are set", func() {
+		details := SerializeEvent(&v1.Event{
+			ObjectMeta:     metav1.ObjectMeta{Name: "both", Namespace: "default"},
+			FirstTimestamp: metav1.NewTime(first),
+			LastTimestamp:  metav1.NewTime(last),
+			Count:          3,
+			EventTime:      metav1.NewMicroTime(first.Add(time.Minute)),
+			Series:         &v1.EventSeries{Count: 9, LastObservedTime: metav1.NewMicroTime(last)},
+		})
+
+		s.Equal("2025-01-01T12:00:00Z", details.FirstTimestamp)
+		s.Equal(int32(3), details.Count)
 	})
 }
 
@@ -516,7 +653,7 @@ func (s *NotificationTestSuite) TestResourceFaultNotification_FaultID() {
 			Timestamp: "2025-01-01T00:00:00Z",
 		}
 
-		err := s.manager.sendNotification("session1", LoggerFaults, mcp.LoggingLevel("warning"), notification)
+		err := s.manager.sendNotification("session1", LoggerFaults, notification)
 		s.NoError(err)
 
 		calls := session.GetLogCalls()
@@ -561,10 +698,10 @@ func (s *NotificationTestSuite) TestResourceFaultNotification_FaultID() {
 			Timestamp: "2025-01-01T00:01:00Z",
 		}
 
-		err := s.manager.sendNotification("session1", LoggerFaults, mcp.LoggingLevel("warning"), notification1)
+		err := s.manager.sendNotification("session1", LoggerFaults, notification1)
 		s.NoError(err)
 
-		err = s.manager.sendNotification("session1", LoggerFaults, mcp.LoggingLevel("warning"), notification2)
+		err = s.manager.sendNotification("session1", LoggerFaults, notification2)
 		s.NoError(err)
 
 		calls := session.GetLogCalls()
@@ -581,3 +718,231 @@ func (s *NotificationTestSuite) TestResourceFaultNotification_FaultID() {
 		s.NotEqual(data1.FaultID, data2.FaultID, "different faults should have different FaultIDs")
 	})
 }
+
+// TestFormatDetailsYAML tests rendering fault details as YAML for contextYAML
+func (s *NotificationTestSuite) TestFormatDetailsYAML() {
+	s.Run("round-trips to the same details", func() {
+		details := map[string]string{
+			"exitCode": "1",
+			"reason":   "Error: exit status 1",
+			"message":  "panic: runtime error\ngoroutine 1 [running]:\nmain.main()",
+			"empty":    "",
+			"quoted":   `"value" with 'quotes'`,
+		}
+
+		rendered := formatDetailsYAML(details)
+
+		var parsed map[string]string
+		s.Require().NoError(yaml.Unmarshal([]byte(rendered), &parsed))
+		s.Equal(details, parsed)
+	})
+
+	s.Run("renders one key per line with multi-line values indented", func() {
+		rendered := formatDetailsYAML(map[string]string{
+			"restartCount": "5",
+			"container":    "nginx",
+			"message":      "line one\nline two",
+		})
+
+		s.Equal("container: nginx\nmessage: |-\n  line one\n  line two\nrestartCount: \"5\"\n", rendered)
+	})
+
+	s.Run("returns empty string without details", func() {
+		s.Empty(formatDetailsYAML(nil))
+		s.Empty(formatDetailsYAML(map[string]string{}))
+	})
+}
+
+// updateNotificationJsonEnvVar regenerates the notification snapshot files when set.
+// Example: UPDATE_NOTIFICATION_JSON=1 go test ./pkg/events -run TestNotificationSuite
+const updateNotificationJsonEnvVar = "UPDATE_NOTIFICATION_JSON"
+
+// assertJsonSnapshot checks that actual serializes to the JSON stored in testdata/snapshotFile.
+func (s *NotificationTestSuite) assertJsonSnapshot(snapshotFile string, actual any) {
+	snapshotPath := filepath.Join("testdata", snapshotFile)
+	actualJson, err := json.MarshalIndent(actual, "", "  ")
+	s.Require().NoErrorf(err, "failed to marshal actual data: %v", err)
+	if os.Getenv(updateNotificationJsonEnvVar) != "" {
+		err := os.WriteFile(snapshotPath, append(actualJson, '\n'), 0644)
+		s.Require().NoErrorf(err, "failed to write snapshot file %s: %v", snapshotFile, err)
+		s.T().Logf("Updated snapshot: %s", snapshotFile)
+		return
+	}
+	expectedJson, err := os.ReadFile(snapshotPath)
+	s.Require().NoErrorf(err, "failed to read snapshot file %s: %v", snapshotFile, err)
+	s.JSONEq(
+		string(expectedJson),
+		string(actualJson),
+		"snapshot %s does not match - field names and omitempty behavior are part of the notification schema; "+
+			"bump NotificationSchemaVersion for breaking changes and re-run the tests with %s=1",
+		snapshotFile,
+		updateNotificationJsonEnvVar,
+	)
+}
+
+// TestNotificationSchema tests that the serialized notification payloads keep their shape,
+// so accidental field renames or tag changes are caught before they reach clients
+func (s *NotificationTestSuite) TestNotificationSchema() {
+	timestamp := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	event := &v1.Event{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "nginx-7d8b9c.17a",
+			Namespace: "default",
+		},
+		InvolvedObject: v1.ObjectReference{
+			APIVersion: "v1",
+			Kind:       "Pod",
+			Name:       "nginx-7d8b9c",
+			Namespace:  "default",
+			UID:        "pod-uid-123",
+		},
+		Type:           "Warning",
+		Reason:         "BackOff",
+		Message:        "Back-off restarting failed container",
+		Count:          3,
+		FirstTimestamp: metav1.NewTime(timestamp.Add(-2 * time.Minute)),
+		LastTimestamp:  metav1.NewTime(timestamp),
+	}
+
+	s.Run("event notification", func() {
+		details := SerializeEvent(event)
+		details.Labels = map[string]string{"app": "nginx"}
+		details.Owner = &ObjectOwner{APIVersion: "apps/v1", Kind: "Deployment", Name: "nginx"}
+		s.assertJsonSnapshot("event-notification.json", &EventNotification{
+			SchemaVersion:  NotificationSchemaVersion,
+			SubscriptionID: "sub-123",
+			Cluster:        "cluster-1",
+			Event:          details,
+		})
+	})
+
+	s.Run("event notification without optional fields", func() {
+		s.assertJsonSnapshot("event-notification-minimal.json", &EventNotification{
+			SchemaVersion:  NotificationSchemaVersion,
+			SubscriptionID: "sub-123",
+			Cluster:        "cluster-1",
+			Event: &EventDetails{
+				Namespace:      "default",
+				Timestamp:      "2025-01-01T12:00:00Z",
+				Type:           "Normal",
+				Reason:         "Scheduled",
+				Message:        "Successfully assigned default/nginx to node-1",
+				InvolvedObject: &InvolvedObject{APIVersion: "v1", Kind: "Pod", Name: "nginx"},
+			},
+		})
+	})
+
+	s.Run("fault notification", func() {
+		s.assertJsonSnapshot("fault-notification.json", &ResourceFaultNotification{
+			SchemaVersion:  NotificationSchemaVersion,
+			SubscriptionID: "sub-123",
+			Cluster:        "cluster-1",
+			FaultID:        GenerateFaultID("cluster-1", FaultTypeCrashLoop, "pod-uid-123", "nginx"),
+			FaultType:      FaultTypeCrashLoop,
+			FaultTypes:     []FaultType{FaultTypeCrashLoop, FaultTypePodCrash},
+			Severity:       SeverityCritical,
+			Resource: &ResourceReference{
+				APIVersion: "v1",
+				Kind:       "Pod",
+				Name:       "nginx-7d8b9c",
+				Namespace:  "default",
+				UID:        "pod-uid-123",
+			},
+			Context:       "Container nginx is in CrashLoopBackOff",
+			ContextYAML:   formatDetailsYAML(map[string]string{"container": "nginx", "restartCount": "3"}),
+			Details:       map[string]string{"container": "nginx", "restartCount": "3"},
+			RelatedEvents: []*EventDetails{SerializeEvent(event)},
+			Timestamp:     formatTimestamp(timestamp),
+		})
+	})
+
+	s.Run("subscription error notification", func() {
+		s.assertJsonSnapshot("subscription-error-notification.json", &SubscriptionErrorNotification{
+			SchemaVersion:  NotificationSchemaVersion,
+			SubscriptionID: "sub-123",
+			Cluster:        "cluster-1",
+			Error:          "Watch connection failed after maximum retry attempts",
+			Degraded:       true,
+		})
+	})
+}
+
+// TestDeliveryViable tests that subscriptions report whether the session's log level lets their notifications through
+func (s *NotificationTestSuite) TestDeliveryViable() {
+	s.Run("viable when the session's log level allows the mode's notifications", func() {
+		for mode, level := range map[string]mcp.LoggingLevel{"events": "info", "faults": "warning"} {
+			session := NewMockServerSession("session-" + mode)
+			session.SetLogLevel(level)
+			s.server.AddSession(session)
+
+			sub, err := s.manager.Create(session.ID(), "cluster1", mode, SubscriptionFilters{}, "")
+			s.Require().NoError(err)
+			s.True(sub.DeliveryViable, "%s subscription with log level %s", mode, level)
+		}
+	})
+
+	s.Run("not viable when the session hasn't set a log level", func() {
+		session := NewMockServerSession("session1")
+		s.server.AddSession(session)
+
+		sub, err := s.manager.Create("session1", "cluster1", "events", SubscriptionFilters{}, "")
+		s.Require().NoError(err, "subscription is still created")
+		s.False(sub.DeliveryViable)
+	})
+
+	s.Run("not viable when the session's log level is above the mode's notifications", func() {
+		session := NewMockServerSession("session1")
+		session.SetLogLevel("warning")
+		s.server.AddSession(session)
+
+		eventsSub, err := s.manager.Create("session1", "cluster1", "events", SubscriptionFilters{}, "")
+		s.Require().NoError(err)
+		s.False(eventsSub.DeliveryViable, "events are sent at info")
+
+		faultsSub, err := s.manager.Create("session1", "cluster1", "faults", SubscriptionFilters{}, "")
+		s.Require().NoError(err)
+		s.True(faultsSub.DeliveryViable, "faults are sent at warning")
+	})
+
+	s.Run("rejected when viable delivery is required", func() {
+		config := NewTestManagerConfig()
+		config.RequireViableDelivery = true
+		manager := NewEventSubscriptionManager(s.server, config, nil, nil)
+		session := NewMockServerSession("session1")
+		session.SetLogLevel("error")
+		s.server.AddSession(session)
+
+		sub, err := manager.Create("session1", "cluster1", "faults", SubscriptionFilters{}, "")
+		s.Require().ErrorIs(err, ErrDeliveryNotViable)
+		s.Nil(sub)
+		s.Contains(err.Error(), `log level of warning or lower`)
+		s.Empty(manager.ListSubscriptions(SubscriptionQuery{}))
+
+		session.SetLogLevel("debug")
+		sub, err = manager.Create("session1", "cluster1", "faults", SubscriptionFilters{}, "")
+		s.Require().NoError(err)
+		s.True(sub.DeliveryViable)
+	})
+
+	s.Run("assumed viable when the log level doesn't apply or is unknown", func() {
+		s.server.AddSession(NewMockServerSession("session1"))
+
+		sub, err := s.manager.Create("unknown-session", "cluster1", "events", SubscriptionFilters{}, "")
+		s.Require().NoError(err)
+		s.True(sub.DeliveryViable, "sessions that can't be found are not judged")
+
+		config := NewTestManagerConfig()
+		config.ResourceNotifier = &MockNotifier{}
+		manager := NewEventSubscriptionManager(s.server, config, nil, nil)
+		sub, err = manager.Create("session1", "cluster1", "events", SubscriptionFilters{Delivery: DeliveryResource}, "")
+		s.Require().NoError(err)
+		s.True(sub.DeliveryViable, "resource delivery doesn't use log messages")
+
+		config = NewTestManagerConfig()
+		config.Notifier = &MockNotifier{}
+		manager = NewEventSubscriptionManager(s.server, config, nil, nil)
+		sub, err = manager.Create("session1", "cluster1", "events", SubscriptionFilters{}, "")
+		s.Require().NoError(err)
+		s.True(sub.DeliveryViable, "custom notifiers don't use log messages")
+	})
+}
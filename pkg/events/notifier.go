@@ -0,0 +1,112 @@
+package events
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// Notifier delivers notification payloads to sessions, decoupling the manager from the
+// transport carrying them. The channel identifies the kind of notification and is one
+// of the Logger constants, e.g. LoggerEvents.
+type Notifier interface {
+	// Notify sends payload on channel to the session. Returns an error wrapping
+	// ErrSessionNotFound if the session doesn't exist, or ErrNotificationDropped if the
+	// transport accepted but deliberately didn't send the notification.
+	Notify(ctx context.Context, sessionID, channel string, payload any) error
+}
+
+// mcpNotifier delivers notifications as MCP log messages on the server's sessions, using
+// the channel as the logger name.
+type mcpNotifier struct {
+	server MCPServer
+}
+
+// NewMCPNotifier creates a Notifier sending notifications to the sessions of server
+// with ServerSession.Log.
+func NewMCPNotifier(server MCPServer) Notifier {
+	return &mcpNotifier{server: server}
+}
+
+// Notify sends payload as a log message from the channel's logger. Like
+// mcp.ServerSession.Log, the message is dropped if the client hasn't set a log level at
+// or below the notification's level, which is reported as ErrNotificationDropped.
+func (n *mcpNotifier) Notify(ctx context.Context, sessionID, channel string, payload any) error {
+	var target ServerSession
+	n.server.Sessions().All(func(session ServerSession) bool {
+		if session.ID() == sessionID {
+			target = session
+			return false // stop iteration
+		}
+		return true // continue iteration
+	})
+	if target == nil {
+		return fmt.Errorf("%w: %s", ErrSessionNotFound, sessionID)
+	}
+
+	level := notificationLevel(channel, payload)
+	delivered, err := target.Log(ctx, &mcp.LoggingMessageParams{
+		Level:  level,
+		Logger: channel,
+		Data:   payload,
+	})
+	if err != nil {
+		return err
+	}
+	if !delivered {
+		return fmt.Errorf("%w: session %s log level is above %s", ErrNotificationDropped, sessionID, level)
+	}
+	return nil
+}
+
+// notificationLevel returns the MCP logging level of a notification: warning for
+// subscription errors, the severity's level for faults, and info otherwise. Faults get
+// their severity's level whatever logger they are routed to (see ManagerConfig.FaultLoggers).
+func notificationLevel(channel string, payload any) mcp.LoggingLevel {
+	if fault, ok := payload.(*ResourceFaultNotification); ok {
+		return severityLoggingLevel(fault.Severity)
+	}
+	switch channel {
+	case LoggerSubscriptionError:
+		return mcp.LoggingLevel("warning")
+	case LoggerFaults:
+		return mcp.LoggingLevel("warning")
+	default:
+		return mcp.LoggingLevel("info")
+	}
+}
+
+// severityLoggingLevel maps a fault severity to the MCP logging level used for its notification.
+// Informational faults are sent at "info" so clients can leave them out of their log level,
+// while warning and critical faults are sent at "warning".
+func severityLoggingLevel(severity Severity) mcp.LoggingLevel {
+	if severity == SeverityInfo {
+		return mcp.LoggingLevel("info")
+	}
+	return mcp.LoggingLevel("warning")
+}
+
+// subscriptionLoggingLevel returns the MCP logging level a client must have set for the
+// notifications of a subscription with the given mode to be sent: info for events, and
+// warning for faults, as warning and critical faults are sent at warning.
+func subscriptionLoggingLevel(mode string) mcp.LoggingLevel {
+	if mode == "faults" {
+		return mcp.LoggingLevel("warning")
+	}
+	return mcp.LoggingLevel("info")
+}
+
+// loggingLevels are the MCP logging levels, from the least to the most severe.
+var loggingLevels = []mcp.LoggingLevel{"debug", "info", "notice", "warning", "error", "critical", "alert", "emergency"}
+
+// loggingLevelAllows reports whether messages at level are sent to a client that set the
+// log level set, like mcp.ServerSession.Log: nothing is sent before the client sets a
+// level, and unknown levels are treated as debug.
+func loggingLevelAllows(set, level mcp.LoggingLevel) bool {
+	if set == "" {
+		return false
+	}
+	return max(slices.Index(loggingLevels, set), 0) <= max(slices.Index(loggingLevels, level), 0)
+}
@@ -0,0 +1,305 @@
+package events
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/suite"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+type NotifierTestSuite struct {
+	suite.Suite
+	notifier *MockNotifier
+	manager  *EventSubscriptionManager
+}
+
+func (s *NotifierTestSuite) SetupTest() {
+	s.notifier = &MockNotifier{}
+	config := NewTestManagerConfig()
+	config.Notifier = s.notifier
+	// The server has no sessions: every notification must go through the notifier
+	s.manager = NewEventSubscriptionManager(NewMockMCPServer(), config, nil, nil)
+}
+
+func (s *NotifierTestSuite) SetupSubTest() {
+	s.SetupTest()
+}
+
+func TestNotifierSuite(t *testing.T) {
+	suite.Run(t, new(NotifierTestSuite))
+}
+
+// TestManagerRouting tests that the manager sends each kind of notification on its channel
+func (s *NotifierTestSuite) TestManagerRouting() {
+	s.Run("events are sent on the events channel", func() {
+		sub, err := s.manager.Create("session1", "cluster1", "events", SubscriptionFilters{}, "")
+		s.Require().NoError(err)
+
+		s.manager.makeProcessEventFunc(context.Background(), sub, nil)(context.Background(), &v1.Event{Reason: "BackOff"})
+
+		notifications := s.notifier.GetNotifications()
+		s.Require().Len(notifications, 1)
+		s.Equal("session1", notifications[0].SessionID)
+		s.Equal(LoggerEvents, notifications[0].Channel)
+		payload, ok := notifications[0].Payload.(*EventNotification)
+		s.Require().True(ok, "payload should be an EventNotification")
+		s.Equal(sub.ID, payload.SubscriptionID)
+		s.Equal("cluster1", payload.Cluster)
+		s.Equal("BackOff", payload.Event.Reason)
+	})
+
+	s.Run("faults are sent on the faults channel", func() {
+		sub, err := s.manager.Create("session1", "cluster1", "faults", SubscriptionFilters{}, "")
+		s.Require().NoError(err)
+
+		s.manager.makeFaultSignalCallback(sub)(context.Background(), FaultSignal{
+			FaultType:   FaultTypePodCrash,
+			ResourceUID: "pod-uid",
+			Kind:        "Pod",
+			Name:        "test-pod",
+			Namespace:   "default",
+			Severity:    SeverityCritical,
+			Timestamp:   time.Now(),
+		})
+
+		notifications := s.notifier.GetNotifications()
+		s.Require().Len(notifications, 1)
+		s.Equal("session1", notifications[0].SessionID)
+		s.Equal(LoggerFaults, notifications[0].Channel)
+		payload, ok := notifications[0].Payload.(*ResourceFaultNotification)
+		s.Require().True(ok, "payload should be a ResourceFaultNotification")
+		s.Equal(sub.ID, payload.SubscriptionID)
+		s.Equal(FaultTypePodCrash, payload.FaultType)
+	})
+
+	s.Run("faults are sent on the loggers configured for their severity and fault type", func() {
+		config := NewTestManagerConfig()
+		config.Notifier = s.notifier
+		config.FaultLoggers = map[Severity]string{SeverityCritical: "kubernetes/faults_critical"}
+		config.FaultTypeLoggers = map[FaultType]string{FaultTypeNodeUnhealthy: "kubernetes/faults_nodes"}
+		s.manager = NewEventSubscriptionManager(NewMockMCPServer(), config, nil, nil)
+		sub, err := s.manager.Create("session1", "cluster1", "faults", SubscriptionFilters{}, "")
+		s.Require().NoError(err)
+
+		callback := s.manager.makeFaultSignalCallback(sub)
+		faults := []struct {
+			faultType FaultType
+			uid       string
+			severity  Severity
+			logger    string
+		}{
+			{FaultTypePodCrash, "critical-uid", SeverityCritical, "kubernetes/faults_critical"},
+			{FaultTypeCrashLoop, "warning-uid", SeverityWarning, LoggerFaults},
+			{FaultTypeNodeUnhealthy, "node-uid", SeverityCritical, "kubernetes/faults_nodes"},
+		}
+		for _, fault := range faults {
+			callback(context.Background(), FaultSignal{
+				FaultType:   fault.faultType,
+				ResourceUID: types.UID(fault.uid),
+				Kind:        "Pod",
+				Name:        fault.uid,
+				Namespace:   "default",
+				Severity:    fault.severity,
+				Timestamp:   time.Now(),
+			})
+		}
+
+		notifications := s.notifier.GetNotifications()
+		s.Require().Len(notifications, len(faults))
+		for i, fault := range faults {
+			s.Equal(fault.logger, notifications[i].Channel, "logger for %s %s fault", fault.severity, fault.faultType)
+		}
+	})
+
+	s.Run("degraded subscriptions are reported on the subscription error channel", func() {
+		sub, err := s.manager.Create("session1", "cluster1", "events", SubscriptionFilters{}, "")
+		s.Require().NoError(err)
+
+		s.manager.markSubscriptionDegraded(sub.ID, nil)
+
+		notifications := s.notifier.GetNotifications()
+		s.Require().Len(notifications, 1)
+		s.Equal("session1", notifications[0].SessionID)
+		s.Equal(LoggerSubscriptionError, notifications[0].Channel)
+		payload, ok := notifications[0].Payload.(*SubscriptionErrorNotification)
+		s.Require().True(ok, "payload should be a SubscriptionErrorNotification")
+		s.Equal(sub.ID, payload.SubscriptionID)
+		s.True(payload.Degraded)
+	})
+
+	s.Run("degraded notifications explain forbidden watches", func() {
+		sub, err := s.manager.Create("session1", "cluster1", "events", SubscriptionFilters{}, "")
+		s.Require().NoError(err)
+
+		s.manager.setSubscriptionHealth(sub.ID, WatchHealthDegraded, fmt.Errorf("%w: events is forbidden", errWatchForbidden))
+
+		notifications := s.notifier.GetNotifications()
+		s.Require().Len(notifications, 1)
+		payload, ok := notifications[0].Payload.(*SubscriptionErrorNotification)
+		s.Require().True(ok, "payload should be a SubscriptionErrorNotification")
+		s.True(payload.Degraded)
+		s.Contains(payload.Error, "RBAC permissions")
+		s.Contains(payload.Error, "events is forbidden")
+	})
+
+	s.Run("resource delivery subscriptions are sent through the resource notifier", func() {
+		resources := &MockNotifier{}
+		config := NewTestManagerConfig()
+		config.Notifier = s.notifier
+		config.ResourceNotifier = resources
+		s.manager = NewEventSubscriptionManager(NewMockMCPServer(), config, nil, nil)
+		eventsSub, err := s.manager.Create("session1", "cluster1", "events", SubscriptionFilters{Delivery: DeliveryResource}, "")
+		s.Require().NoError(err)
+		faultsSub, err := s.manager.Create("session1", "cluster1", "faults", SubscriptionFilters{Delivery: DeliveryResource}, "")
+		s.Require().NoError(err)
+		logSub, err := s.manager.Create("session1", "cluster1", "events", SubscriptionFilters{Delivery: DeliveryLog}, "")
+		s.Require().NoError(err)
+
+		s.manager.makeProcessEventFunc(context.Background(), eventsSub, nil)(context.Background(), &v1.Event{Reason: "BackOff"})
+		s.manager.makeFaultSignalCallback(faultsSub)(context.Background(), FaultSignal{
+			FaultType:   FaultTypePodCrash,
+			ResourceUID: "pod-uid",
+			Kind:        "Pod",
+			Name:        "test-pod",
+			Namespace:   "default",
+			Severity:    SeverityCritical,
+			Timestamp:   time.Now(),
+		})
+		s.manager.markSubscriptionDegraded(eventsSub.ID, nil)
+		s.manager.makeProcessEventFunc(context.Background(), logSub, nil)(context.Background(), &v1.Event{Reason: "Pulled"})
+
+		published := resources.GetNotifications()
+		s.Require().Len(published, 3)
+		s.Equal(LoggerEvents, published[0].Channel)
+		s.Equal(eventsSub.ID, published[0].Payload.(*EventNotification).SubscriptionID)
+		s.Equal(LoggerFaults, published[1].Channel)
+		s.Equal(faultsSub.ID, published[1].Payload.(*ResourceFaultNotification).SubscriptionID)
+		s.Equal(LoggerSubscriptionError, published[2].Channel)
+
+		logged := s.notifier.GetNotifications()
+		s.Require().Len(logged, 1, "only the log delivery subscription should send log messages")
+		s.Equal("Pulled", logged[0].Payload.(*EventNotification).Event.Reason)
+	})
+
+	s.Run("resource delivery is rejected without a resource notifier", func() {
+		_, err := s.manager.Create("session1", "cluster1", "events", SubscriptionFilters{Delivery: DeliveryResource}, "")
+		s.ErrorIs(err, ErrInvalidFilters)
+		s.Contains(err.Error(), `delivery "resource" is not supported`)
+	})
+
+	s.Run("cancelling a resource delivery subscription releases its resource", func() {
+		resources := NewResourceNotifier(&recordingResourceUpdater{}, 0)
+		config := NewTestManagerConfig()
+		config.ResourceNotifier = resources
+		s.manager = NewEventSubscriptionManager(NewMockMCPServer(), config, nil, nil)
+		sub, err := s.manager.Create("session1", "cluster1", "events", SubscriptionFilters{Delivery: DeliveryResource}, "")
+		s.Require().NoError(err)
+		s.manager.makeProcessEventFunc(context.Background(), sub, nil)(context.Background(), &v1.Event{Reason: "BackOff"})
+		read := func() string {
+			result, err := resources.ReadResource(context.Background(), &mcp.ReadResourceRequest{
+				Params: &mcp.ReadResourceParams{URI: SubscriptionResourceURI("cluster1", sub.ID)},
+			})
+			s.Require().NoError(err)
+			return result.Contents[0].Text
+		}
+		s.Contains(read(), "BackOff")
+
+		s.Require().NoError(s.manager.Cancel(sub.ID))
+
+		s.Equal("[]", read())
+	})
+
+	s.Run("test notifications are sent on the test channel", func() {
+		s.Require().NoError(s.manager.SendTestNotification("session1"))
+
+		notifications := s.notifier.GetNotifications()
+		s.Require().Len(notifications, 1)
+		s.Equal("session1", notifications[0].SessionID)
+		s.Equal(LoggerTestNotification, notifications[0].Channel)
+		s.IsType(&TestNotification{}, notifications[0].Payload)
+	})
+
+	s.Run("notifications dropped by the notifier are reported by SendTestNotification", func() {
+		s.notifier.SetError(ErrNotificationDropped)
+
+		err := s.manager.SendTestNotification("session1")
+		s.ErrorIs(err, ErrNotificationDropped)
+	})
+
+	s.Run("notifier errors count toward the failure threshold", func() {
+		sub, err := s.manager.Create("session1", "cluster1", "events", SubscriptionFilters{}, "")
+		s.Require().NoError(err)
+		s.notifier.SetError(errors.New("connection reset"))
+
+		process := s.manager.makeProcessEventFunc(context.Background(), sub, nil)
+		for i := 0; i < DefaultMaxNotificationFailures; i++ {
+			process(context.Background(), &v1.Event{Reason: "BackOff"})
+		}
+		s.Eventually(func() bool {
+			return s.manager.GetSubscription(sub.ID) == nil
+		}, time.Second, 10*time.Millisecond, "subscription should be cancelled at the threshold")
+	})
+}
+
+// TestMCPNotifier tests that the MCP notifier sends notifications as log messages
+func (s *NotifierTestSuite) TestMCPNotifier() {
+	s.Run("uses the channel as logger and derives the level", func() {
+		levels := []struct {
+			channel string
+			payload any
+			level   mcp.LoggingLevel
+		}{
+			{LoggerEvents, &EventNotification{}, "info"},
+			{LoggerTestNotification, &TestNotification{}, "info"},
+			{LoggerSubscriptionError, &SubscriptionErrorNotification{}, "warning"},
+			{LoggerFaults, &ResourceFaultNotification{Severity: SeverityCritical}, "warning"},
+			{LoggerFaults, &ResourceFaultNotification{Severity: SeverityInfo}, "info"},
+			{"kubernetes/faults_critical", &ResourceFaultNotification{Severity: SeverityCritical}, "warning"},
+			{"kubernetes/faults_info", &ResourceFaultNotification{Severity: SeverityInfo}, "info"},
+		}
+		for _, tc := range levels {
+			server := NewMockMCPServer()
+			session := NewMockServerSession("session1")
+			session.SetLogLevel(mcp.LoggingLevel("debug"))
+			server.AddSession(session)
+
+			err := NewMCPNotifier(server).Notify(context.Background(), "session1", tc.channel, tc.payload)
+			s.Require().NoError(err)
+
+			calls := session.GetLogCalls()
+			s.Require().Len(calls, 1)
+			s.Equal(tc.channel, calls[0].Logger)
+			s.Equal(tc.level, calls[0].Level, "level for %s", tc.channel)
+			s.Same(tc.payload, calls[0].Data)
+		}
+	})
+
+	s.Run("returns ErrSessionNotFound for a missing session", func() {
+		err := NewMCPNotifier(NewMockMCPServer()).Notify(context.Background(), "missing", LoggerEvents, &EventNotification{})
+		s.ErrorIs(err, ErrSessionNotFound)
+	})
+
+	s.Run("log levels allow the messages at or above them", func() {
+		s.True(loggingLevelAllows("info", "info"))
+		s.True(loggingLevelAllows("debug", "warning"))
+		s.False(loggingLevelAllows("warning", "info"))
+		s.False(loggingLevelAllows("", "emergency"), "nothing is sent before a level is set")
+		s.True(loggingLevelAllows("verbose", "debug"), "unknown levels are treated as debug")
+	})
+
+	s.Run("returns ErrNotificationDropped when the session hasn't set a log level", func() {
+		server := NewMockMCPServer()
+		session := NewMockServerSession("session1")
+		server.AddSession(session)
+
+		err := NewMCPNotifier(server).Notify(context.Background(), "session1", LoggerEvents, &EventNotification{})
+		s.ErrorIs(err, ErrNotificationDropped)
+		s.Empty(session.GetLogCalls())
+	})
+}
@@ -0,0 +1,144 @@
+package events
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/lru"
+)
+
+const (
+	// DefaultOwnerCacheTTL is how long a resolved top-level owner is reused before
+	// the owner chain is walked again.
+	DefaultOwnerCacheTTL = 5 * time.Minute
+
+	// ownerCacheMaxEntries bounds the number of cached objects per cache.
+	ownerCacheMaxEntries = 1000
+
+	// maxOwnerDepth bounds how many owner references are followed, guarding
+	// against reference cycles.
+	maxOwnerDepth = 10
+)
+
+// OwnerResolver finds the top-level owner of an event's involved object, e.g. the
+// Deployment owning a pod through its ReplicaSet. Returns nil if the object has no owner.
+type OwnerResolver func(ctx context.Context, ref v1.ObjectReference) (*ObjectOwner, error)
+
+// NewDynamicOwnerResolver creates an OwnerResolver that follows owner references
+// of any kind through the dynamic client, using mapper to find each object's resource.
+// The controller reference is followed when present, otherwise the first owner.
+func NewDynamicOwnerResolver(client dynamic.Interface, mapper meta.RESTMapper) OwnerResolver {
+	return func(ctx context.Context, ref v1.ObjectReference) (*ObjectOwner, error) {
+		var owner *ObjectOwner
+		for depth := 0; depth < maxOwnerDepth; depth++ {
+			obj, err := getReferencedObject(ctx, client, mapper, ref)
+			if err != nil {
+				return nil, err
+			}
+
+			next := primaryOwnerReference(obj.GetOwnerReferences())
+			if next == nil {
+				return owner, nil
+			}
+
+			owner = &ObjectOwner{APIVersion: next.APIVersion, Kind: next.Kind, Name: next.Name}
+			// Owners are either in the object's namespace or cluster-scoped
+			ref = v1.ObjectReference{APIVersion: next.APIVersion, Kind: next.Kind, Name: next.Name, Namespace: ref.Namespace}
+		}
+		return nil, fmt.Errorf("owner chain of %s %s/%s exceeds %d levels", ref.Kind, ref.Namespace, ref.Name, maxOwnerDepth)
+	}
+}
+
+// primaryOwnerReference returns the controller reference, or the first owner
+// reference if none is the controller. Returns nil if there are no owners.
+func primaryOwnerReference(refs []metav1.OwnerReference) *metav1.OwnerReference {
+	for i := range refs {
+		if refs[i].Controller != nil && *refs[i].Controller {
+			return &refs[i]
+		}
+	}
+	if len(refs) > 0 {
+		return &refs[0]
+	}
+	return nil
+}
+
+// objectOwnerCache caches owners returned by an OwnerResolver keyed by object UID,
+// so events for the same object only walk its owner chain once per TTL.
+// The least recently used entries are evicted once the cache is full.
+//
+// Thread-safe for concurrent use.
+type objectOwnerCache struct {
+	resolve OwnerResolver
+	ttl     time.Duration
+	entries *lru.Cache
+}
+
+// ownerCacheEntry holds the resolved owner of an object and when it expires.
+// A nil owner records that the object has no owner.
+type ownerCacheEntry struct {
+	owner     *ObjectOwner
+	expiresAt time.Time
+}
+
+// newObjectOwnerCache creates an objectOwnerCache around resolve.
+func newObjectOwnerCache(resolve OwnerResolver, ttl time.Duration) *objectOwnerCache {
+	return &objectOwnerCache{
+		resolve: resolve,
+		ttl:     ttl,
+		entries: lru.New(ownerCacheMaxEntries),
+	}
+}
+
+// get returns the top-level owner of the referenced object, resolving it on a cache miss.
+// References without a UID are always resolved, as they can't be keyed safely.
+// Failed resolutions are not cached.
+func (c *objectOwnerCache) get(ctx context.Context, ref v1.ObjectReference) (*ObjectOwner, error) {
+	now := time.Now()
+
+	if ref.UID != "" {
+		if cached, exists := c.entries.Get(ref.UID); exists {
+			entry := cached.(ownerCacheEntry)
+			if now.Before(entry.expiresAt) {
+				return entry.owner, nil
+			}
+		}
+	}
+
+	owner, err := c.resolve(ctx, ref)
+	if err != nil {
+		return nil, err
+	}
+
+	if ref.UID != "" {
+		c.entries.Add(ref.UID, ownerCacheEntry{owner: owner, expiresAt: now.Add(c.ttl)})
+	}
+	return owner, nil
+}
+
+// size returns the number of cached objects.
+func (c *objectOwnerCache) size() int {
+	return c.entries.Len()
+}
+
+// ownerFor returns the top-level owner of an event's involved object, or nil if
+// it has none or can't be resolved. A nil cache resolves nothing.
+func (c *objectOwnerCache) ownerFor(ctx context.Context, event *v1.Event) *ObjectOwner {
+	if c == nil {
+		return nil
+	}
+
+	owner, err := c.get(ctx, event.InvolvedObject)
+	if err != nil {
+		klog.V(2).Infof("Failed to resolve owner of %s %s/%s: %v",
+			event.InvolvedObject.Kind, event.InvolvedObject.Namespace, event.InvolvedObject.Name, err)
+		return nil
+	}
+	return owner
+}
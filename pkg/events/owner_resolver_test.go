@@ -0,0 +1,218 @@
+package events
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/suite"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/ptr"
+)
+
+type OwnerResolverTestSuite struct {
+	suite.Suite
+	calls    atomic.Int32
+	resolver OwnerResolver
+}
+
+func TestOwnerResolverSuite(t *testing.T) {
+	suite.Run(t, new(OwnerResolverTestSuite))
+}
+
+func (s *OwnerResolverTestSuite) SetupSubTest() {
+	s.calls.Store(0)
+	s.resolver = func(_ context.Context, ref v1.ObjectReference) (*ObjectOwner, error) {
+		s.calls.Add(1)
+		switch ref.Name {
+		case "missing":
+			return nil, errors.New("not found")
+		case "standalone":
+			return nil, nil
+		}
+		return &ObjectOwner{APIVersion: "apps/v1", Kind: "Deployment", Name: ref.Name}, nil
+	}
+}
+
+// newOwnerChainClient returns a dynamic client holding a pod owned by a ReplicaSet
+// owned by a Deployment, plus a pod without owners, and a matching REST mapper.
+func newOwnerChainClient() (*dynamicfake.FakeDynamicClient, meta.RESTMapper) {
+	deployment := &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default", UID: "deploy-uid"}}
+	replicaSet := &appsv1.ReplicaSet{ObjectMeta: metav1.ObjectMeta{
+		Name:      "web-5d8f",
+		Namespace: "default",
+		UID:       "rs-uid",
+		OwnerReferences: []metav1.OwnerReference{
+			{APIVersion: "apps/v1", Kind: "Deployment", Name: "web", UID: "deploy-uid", Controller: ptr.To(true)},
+		},
+	}}
+	pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{
+		Name:      "web-5d8f-abcde",
+		Namespace: "default",
+		UID:       "pod-uid",
+		OwnerReferences: []metav1.OwnerReference{
+			{APIVersion: "v1", Kind: "Node", Name: "node-1", UID: "node-uid"},
+			{APIVersion: "apps/v1", Kind: "ReplicaSet", Name: "web-5d8f", UID: "rs-uid", Controller: ptr.To(true)},
+		},
+	}}
+	standalone := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "standalone", Namespace: "default", UID: "standalone-uid"}}
+	client := dynamicfake.NewSimpleDynamicClient(scheme.Scheme, deployment, replicaSet, pod, standalone)
+
+	mapper := meta.NewDefaultRESTMapper(nil)
+	mapper.Add(v1.SchemeGroupVersion.WithKind("Pod"), meta.RESTScopeNamespace)
+	mapper.Add(appsv1.SchemeGroupVersion.WithKind("ReplicaSet"), meta.RESTScopeNamespace)
+	mapper.Add(appsv1.SchemeGroupVersion.WithKind("Deployment"), meta.RESTScopeNamespace)
+	return client, mapper
+}
+
+func (s *OwnerResolverTestSuite) TestDynamicOwnerResolver() {
+	client, mapper := newOwnerChainClient()
+	resolver := NewDynamicOwnerResolver(client, mapper)
+
+	s.Run("follows controller references to the top-level owner", func() {
+		owner, err := resolver(context.Background(), v1.ObjectReference{APIVersion: "v1", Kind: "Pod", Name: "web-5d8f-abcde", Namespace: "default"})
+		s.Require().NoError(err)
+		s.Equal(&ObjectOwner{APIVersion: "apps/v1", Kind: "Deployment", Name: "web"}, owner)
+	})
+
+	s.Run("returns the direct owner if it has no owner itself", func() {
+		owner, err := resolver(context.Background(), v1.ObjectReference{APIVersion: "apps/v1", Kind: "ReplicaSet", Name: "web-5d8f", Namespace: "default"})
+		s.Require().NoError(err)
+		s.Equal(&ObjectOwner{APIVersion: "apps/v1", Kind: "Deployment", Name: "web"}, owner)
+	})
+
+	s.Run("returns nil for objects without owners", func() {
+		owner, err := resolver(context.Background(), v1.ObjectReference{APIVersion: "v1", Kind: "Pod", Name: "standalone", Namespace: "default"})
+		s.Require().NoError(err)
+		s.Nil(owner)
+	})
+
+	s.Run("returns error for missing objects", func() {
+		_, err := resolver(context.Background(), v1.ObjectReference{APIVersion: "v1", Kind: "Pod", Name: "gone", Namespace: "default"})
+		s.Error(err)
+	})
+}
+
+func (s *OwnerResolverTestSuite) TestObjectOwnerCache() {
+	s.Run("resolves each object once per TTL", func() {
+		cache := newObjectOwnerCache(s.resolver, time.Minute)
+
+		for i := 0; i < 3; i++ {
+			owner, err := cache.get(context.Background(), v1.ObjectReference{Name: "web", UID: "uid-web"})
+			s.Require().NoError(err)
+			s.Equal("web", owner.Name)
+		}
+
+		s.Equal(int32(1), s.calls.Load(), "owner should be resolved once and then served from cache")
+		s.Equal(1, cache.size())
+	})
+
+	s.Run("caches objects without owners", func() {
+		cache := newObjectOwnerCache(s.resolver, time.Minute)
+
+		for i := 0; i < 2; i++ {
+			owner, err := cache.get(context.Background(), v1.ObjectReference{Name: "standalone", UID: "uid-standalone"})
+			s.Require().NoError(err)
+			s.Nil(owner)
+		}
+
+		s.Equal(int32(1), s.calls.Load())
+	})
+
+	s.Run("does not cache references without UID", func() {
+		cache := newObjectOwnerCache(s.resolver, time.Minute)
+
+		_, _ = cache.get(context.Background(), v1.ObjectReference{Name: "web"})
+		_, _ = cache.get(context.Background(), v1.ObjectReference{Name: "web"})
+
+		s.Equal(int32(2), s.calls.Load())
+		s.Equal(0, cache.size())
+	})
+
+	s.Run("does not cache failures", func() {
+		cache := newObjectOwnerCache(s.resolver, time.Minute)
+
+		s.Nil(cache.ownerFor(context.Background(), &v1.Event{InvolvedObject: v1.ObjectReference{Name: "missing", UID: "uid-missing"}}))
+		s.Nil(cache.ownerFor(context.Background(), &v1.Event{InvolvedObject: v1.ObjectReference{Name: "missing", UID: "uid-missing"}}))
+
+		s.Equal(int32(2), s.calls.Load())
+		s.Equal(0, cache.size())
+	})
+
+	s.Run("refetches expired entries", func() {
+		cache := newObjectOwnerCache(s.resolver, time.Millisecond)
+
+		_, _ = cache.get(context.Background(), v1.ObjectReference{Name: "web", UID: "uid-web"})
+		time.Sleep(5 * time.Millisecond)
+		_, _ = cache.get(context.Background(), v1.ObjectReference{Name: "web", UID: "uid-web"})
+
+		s.Equal(int32(2), s.calls.Load())
+	})
+
+	s.Run("stays bounded when full", func() {
+		cache := newObjectOwnerCache(s.resolver, time.Minute)
+
+		for i := 0; i <= ownerCacheMaxEntries; i++ {
+			_, _ = cache.get(context.Background(), v1.ObjectReference{Name: "web", UID: types.UID(fmt.Sprintf("uid-%d", i))})
+		}
+
+		s.Equal(ownerCacheMaxEntries, cache.size())
+	})
+
+	s.Run("nil cache resolves nothing", func() {
+		var cache *objectOwnerCache
+		s.Nil(cache.ownerFor(context.Background(), &v1.Event{InvolvedObject: v1.ObjectReference{Name: "web", UID: "uid-web"}}))
+	})
+}
+
+func (s *OwnerResolverTestSuite) TestEventNotificationOwner() {
+	client, mapper := newOwnerChainClient()
+	server := NewMockMCPServer()
+	manager := NewEventSubscriptionManager(server, NewTestManagerConfig(), nil, nil)
+	session := NewMockServerSession("session1")
+	session.SetLogLevel(mcp.LoggingLevel("info"))
+	server.AddSession(session)
+	sub, err := manager.Create("session1", "cluster1", "events", SubscriptionFilters{}, "")
+	s.Require().NoError(err)
+	event := &v1.Event{
+		ObjectMeta:     metav1.ObjectMeta{Name: "web-5d8f-abcde.1", Namespace: "default"},
+		InvolvedObject: v1.ObjectReference{APIVersion: "v1", Kind: "Pod", Name: "web-5d8f-abcde", Namespace: "default", UID: "pod-uid"},
+		Type:           "Warning",
+		Reason:         "BackOff",
+	}
+
+	s.Run("includes the Deployment of the involved pod when resolving owners", func() {
+		owners := newObjectOwnerCache(NewDynamicOwnerResolver(client, mapper), DefaultOwnerCacheTTL)
+		manager.makeProcessEventFunc(context.Background(), sub, owners)(context.Background(), event)
+
+		calls := session.GetLogCalls()
+		s.Require().NotEmpty(calls)
+		notification, ok := calls[len(calls)-1].Data.(*EventNotification)
+		s.Require().True(ok)
+		s.Equal(&ObjectOwner{APIVersion: "apps/v1", Kind: "Deployment", Name: "web"}, notification.Event.Owner)
+	})
+
+	s.Run("omits the owner by default", func() {
+		manager.makeProcessEventFunc(context.Background(), sub, nil)(context.Background(), event)
+
+		calls := session.GetLogCalls()
+		s.Require().NotEmpty(calls)
+		notification, ok := calls[len(calls)-1].Data.(*EventNotification)
+		s.Require().True(ok)
+		s.Nil(notification.Event.Owner)
+	})
+
+	s.Run("owner cache is only created when enabled", func() {
+		s.Nil(manager.newOwnerCache(nil))
+	})
+}
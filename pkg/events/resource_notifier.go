@@ -0,0 +1,172 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+	"sync"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+const (
+	// DeliveryLog delivers a subscription's notifications as MCP log messages. It is the default.
+	DeliveryLog = "log"
+	// DeliveryResource publishes a subscription's notifications to its MCP resource
+	// (see SubscriptionResourceURI) and sends a resource-updated notification for each.
+	DeliveryResource = "resource"
+
+	// SubscriptionResourceScheme is the URI scheme of subscription resources.
+	SubscriptionResourceScheme = "k8s-events"
+	// SubscriptionResourceTemplate is the URI template matching every subscription resource.
+	SubscriptionResourceTemplate = SubscriptionResourceScheme + "://{cluster}/{subscriptionId}"
+
+	// DefaultResourceNotificationLimit is the default number of notifications a
+	// ResourceNotifier keeps per subscription resource.
+	DefaultResourceNotificationLimit = 100
+)
+
+// SubscriptionResourceURI returns the URI of the MCP resource a subscription with the
+// resource delivery mode publishes its notifications to, e.g. k8s-events://prod/sub-1234.
+func SubscriptionResourceURI(cluster, subscriptionID string) string {
+	return SubscriptionResourceScheme + "://" + url.PathEscape(cluster) + "/" + subscriptionID
+}
+
+// ValidateSubscriptionResourceURI returns an error unless uri is a subscription resource URI,
+// e.g. to reject resources/subscribe requests for other resources.
+func ValidateSubscriptionResourceURI(uri string) error {
+	if !strings.HasPrefix(uri, SubscriptionResourceScheme+"://") {
+		return fmt.Errorf("unknown resource %q: only %s resources can be subscribed to", uri, SubscriptionResourceTemplate)
+	}
+	return nil
+}
+
+// ResourceUpdater sends resource-updated notifications to the sessions subscribed to a
+// resource. It is satisfied by mcp.Server.
+type ResourceUpdater interface {
+	ResourceUpdated(ctx context.Context, params *mcp.ResourceUpdatedNotificationParams) error
+}
+
+// publishedNotification is a notification kept in a subscription resource.
+type publishedNotification struct {
+	Channel string `json:"channel"`
+	Data    any    `json:"data"`
+}
+
+// subscriptionResource holds the notifications published to a subscription resource.
+type subscriptionResource struct {
+	sessionID     string                  // session of the subscription, the only one allowed to read it
+	notifications []publishedNotification // most recent notifications, oldest first
+}
+
+// ResourceNotifier is a Notifier for subscriptions with the resource delivery mode, for
+// MCP clients that model streams better as resources than as log messages. Instead of
+// sending the payload, it appends it to the subscription's resource, at the URI given
+// by SubscriptionResourceURI for the payload's cluster and subscription, and sends a
+// resource-updated notification for the URI. Clients subscribe to the URI with
+// resources/subscribe and read the most recent notifications with resources/read.
+type ResourceNotifier struct {
+	updater ResourceUpdater
+	limit   int
+
+	mu        sync.Mutex
+	resources map[string]*subscriptionResource // URI -> published notifications
+}
+
+// NewResourceNotifier creates a ResourceNotifier sending resource-updated notifications
+// with updater and keeping up to limit notifications per subscription resource.
+// A non-positive limit uses DefaultResourceNotificationLimit.
+func NewResourceNotifier(updater ResourceUpdater, limit int) *ResourceNotifier {
+	if limit <= 0 {
+		limit = DefaultResourceNotificationLimit
+	}
+	return &ResourceNotifier{
+		updater:   updater,
+		limit:     limit,
+		resources: make(map[string]*subscriptionResource),
+	}
+}
+
+// Notify publishes payload to the resource of the subscription it belongs to and notifies
+// the sessions subscribed to the resource. Payloads that don't belong to a subscription,
+// like test notifications, have no resource and are reported as ErrNotificationDropped.
+func (n *ResourceNotifier) Notify(ctx context.Context, sessionID, channel string, payload any) error {
+	uri, ok := notificationResourceURI(payload)
+	if !ok {
+		return fmt.Errorf("%w: %T notifications are not published to subscription resources", ErrNotificationDropped, payload)
+	}
+
+	n.mu.Lock()
+	resource := n.resources[uri]
+	if resource == nil {
+		resource = &subscriptionResource{sessionID: sessionID}
+		n.resources[uri] = resource
+	}
+	resource.notifications = append(resource.notifications, publishedNotification{Channel: channel, Data: payload})
+	if excess := len(resource.notifications) - n.limit; excess > 0 {
+		resource.notifications = resource.notifications[excess:]
+	}
+	n.mu.Unlock()
+
+	return n.updater.ResourceUpdated(ctx, &mcp.ResourceUpdatedNotificationParams{URI: uri})
+}
+
+// ReadResource is the mcp.ResourceHandler of subscription resources. It returns the
+// most recent notifications published to the resource as a JSON array of objects with
+// the notification's channel and data, oldest first. Only the session of the
+// subscription can read its resource.
+func (n *ResourceNotifier) ReadResource(_ context.Context, req *mcp.ReadResourceRequest) (*mcp.ReadResourceResult, error) {
+	uri := req.Params.URI
+	if err := ValidateSubscriptionResourceURI(uri); err != nil {
+		return nil, mcp.ResourceNotFoundError(uri)
+	}
+
+	n.mu.Lock()
+	notifications := []publishedNotification{}
+	if resource := n.resources[uri]; resource != nil {
+		if req.Session != nil && req.Session.ID() != resource.sessionID {
+			n.mu.Unlock()
+			return nil, mcp.ResourceNotFoundError(uri)
+		}
+		notifications = append(notifications, resource.notifications...)
+	}
+	n.mu.Unlock()
+
+	data, err := json.Marshal(notifications)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal notifications of %s: %w", uri, err)
+	}
+	return &mcp.ReadResourceResult{Contents: []*mcp.ResourceContents{
+		{URI: uri, MIMEType: "application/json", Text: string(data)},
+	}}, nil
+}
+
+// Release drops the notifications published to a subscription resource, once the
+// subscription is cancelled.
+func (n *ResourceNotifier) Release(uri string) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	delete(n.resources, uri)
+}
+
+// resourceReleaser is implemented by notifiers keeping notifications per subscription
+// resource, like ResourceNotifier, so the manager can release them with the subscription.
+type resourceReleaser interface {
+	Release(uri string)
+}
+
+// notificationResourceURI returns the subscription resource URI of a notification payload.
+func notificationResourceURI(payload any) (string, bool) {
+	switch notification := payload.(type) {
+	case *EventNotification:
+		return SubscriptionResourceURI(notification.Cluster, notification.SubscriptionID), true
+	case *ResourceFaultNotification:
+		return SubscriptionResourceURI(notification.Cluster, notification.SubscriptionID), true
+	case *SubscriptionErrorNotification:
+		return SubscriptionResourceURI(notification.Cluster, notification.SubscriptionID), true
+	default:
+		return "", false
+	}
+}
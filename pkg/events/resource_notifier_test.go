@@ -0,0 +1,221 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/suite"
+)
+
+// recordingResourceUpdater records the URIs of resource-updated notifications.
+type recordingResourceUpdater struct {
+	mu   sync.Mutex
+	uris []string
+}
+
+// ResourceUpdated records the updated resource's URI.
+func (r *recordingResourceUpdater) ResourceUpdated(_ context.Context, params *mcp.ResourceUpdatedNotificationParams) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.uris = append(r.uris, params.URI)
+	return nil
+}
+
+// updated returns the URIs of the resource-updated notifications sent so far.
+func (r *recordingResourceUpdater) updated() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]string(nil), r.uris...)
+}
+
+type ResourceNotifierTestSuite struct {
+	suite.Suite
+	updater  *recordingResourceUpdater
+	notifier *ResourceNotifier
+}
+
+func (s *ResourceNotifierTestSuite) SetupTest() {
+	s.updater = &recordingResourceUpdater{}
+	s.notifier = NewResourceNotifier(s.updater, 2)
+}
+
+func (s *ResourceNotifierTestSuite) SetupSubTest() {
+	s.SetupTest()
+}
+
+func TestResourceNotifierSuite(t *testing.T) {
+	suite.Run(t, new(ResourceNotifierTestSuite))
+}
+
+// read reads a subscription resource as session and decodes its notifications
+func (s *ResourceNotifierTestSuite) read(uri string, session *mcp.ServerSession) []map[string]any {
+	result, err := s.notifier.ReadResource(context.Background(), &mcp.ReadResourceRequest{
+		Session: session,
+		Params:  &mcp.ReadResourceParams{URI: uri},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(result.Contents, 1)
+	s.Equal(uri, result.Contents[0].URI)
+	s.Equal("application/json", result.Contents[0].MIMEType)
+
+	var notifications []map[string]any
+	s.Require().NoError(json.Unmarshal([]byte(result.Contents[0].Text), &notifications))
+	return notifications
+}
+
+// TestSubscriptionResourceURI tests the URIs of subscription resources
+func (s *ResourceNotifierTestSuite) TestSubscriptionResourceURI() {
+	s.Run("combines the cluster and subscription ID", func() {
+		s.Equal("k8s-events://prod/sub-1234", SubscriptionResourceURI("prod", "sub-1234"))
+	})
+
+	s.Run("escapes the cluster name", func() {
+		s.Equal("k8s-events://team%2Fprod/sub-1234", SubscriptionResourceURI("team/prod", "sub-1234"))
+	})
+
+	s.Run("validates the scheme", func() {
+		s.NoError(ValidateSubscriptionResourceURI("k8s-events://prod/sub-1234"))
+		err := ValidateSubscriptionResourceURI("file:///etc/passwd")
+		s.Require().Error(err)
+		s.Contains(err.Error(), "only k8s-events://{cluster}/{subscriptionId} resources can be subscribed to")
+	})
+}
+
+// TestNotify tests that notifications are published to the subscription's resource
+func (s *ResourceNotifierTestSuite) TestNotify() {
+	s.Run("publishes to the subscription resource and sends a resource update", func() {
+		err := s.notifier.Notify(context.Background(), "", LoggerEvents, &EventNotification{
+			SubscriptionID: "sub-1",
+			Cluster:        "prod",
+			Event:          &EventDetails{Reason: "BackOff"},
+		})
+		s.Require().NoError(err)
+
+		s.Equal([]string{"k8s-events://prod/sub-1"}, s.updater.updated())
+		notifications := s.read("k8s-events://prod/sub-1", nil)
+		s.Require().Len(notifications, 1)
+		s.Equal(LoggerEvents, notifications[0]["channel"])
+		s.Equal("sub-1", notifications[0]["data"].(map[string]any)["subscriptionId"])
+		s.Equal("BackOff", notifications[0]["data"].(map[string]any)["event"].(map[string]any)["reason"])
+	})
+
+	s.Run("publishes faults and subscription errors on their channels", func() {
+		s.Require().NoError(s.notifier.Notify(context.Background(), "", LoggerFaults, &ResourceFaultNotification{SubscriptionID: "sub-1", Cluster: "prod", FaultType: FaultTypePodCrash}))
+		s.Require().NoError(s.notifier.Notify(context.Background(), "", LoggerSubscriptionError, &SubscriptionErrorNotification{SubscriptionID: "sub-1", Cluster: "prod", Degraded: true}))
+
+		notifications := s.read("k8s-events://prod/sub-1", nil)
+		s.Require().Len(notifications, 2)
+		s.Equal(LoggerFaults, notifications[0]["channel"])
+		s.Equal(LoggerSubscriptionError, notifications[1]["channel"])
+	})
+
+	s.Run("keeps only the most recent notifications", func() {
+		for _, reason := range []string{"Pulling", "Pulled", "BackOff"} {
+			s.Require().NoError(s.notifier.Notify(context.Background(), "", LoggerEvents, &EventNotification{
+				SubscriptionID: "sub-1",
+				Cluster:        "prod",
+				Event:          &EventDetails{Reason: reason},
+			}))
+		}
+
+		notifications := s.read("k8s-events://prod/sub-1", nil)
+		s.Require().Len(notifications, 2)
+		s.Equal("Pulled", notifications[0]["data"].(map[string]any)["event"].(map[string]any)["reason"])
+		s.Equal("BackOff", notifications[1]["data"].(map[string]any)["event"].(map[string]any)["reason"])
+		s.Len(s.updater.updated(), 3, "every notification sends a resource update")
+	})
+
+	s.Run("drops notifications that don't belong to a subscription", func() {
+		err := s.notifier.Notify(context.Background(), "", LoggerTestNotification, &TestNotification{})
+		s.ErrorIs(err, ErrNotificationDropped)
+		s.Empty(s.updater.updated())
+	})
+}
+
+// TestReadResource tests reading subscription resources
+func (s *ResourceNotifierTestSuite) TestReadResource() {
+	s.Run("returns no notifications before any is published", func() {
+		s.Empty(s.read("k8s-events://prod/sub-1", nil))
+	})
+
+	s.Run("hides the resource from other sessions", func() {
+		s.Require().NoError(s.notifier.Notify(context.Background(), "session1", LoggerEvents, &EventNotification{SubscriptionID: "sub-1", Cluster: "prod"}))
+
+		_, err := s.notifier.ReadResource(context.Background(), &mcp.ReadResourceRequest{
+			Session: &mcp.ServerSession{}, // a session without ID, not session1
+			Params:  &mcp.ReadResourceParams{URI: "k8s-events://prod/sub-1"},
+		})
+		s.Error(err)
+	})
+
+	s.Run("rejects other resources", func() {
+		_, err := s.notifier.ReadResource(context.Background(), &mcp.ReadResourceRequest{
+			Params: &mcp.ReadResourceParams{URI: "file:///etc/passwd"},
+		})
+		s.Error(err)
+	})
+
+	s.Run("released resources no longer hold notifications", func() {
+		s.Require().NoError(s.notifier.Notify(context.Background(), "", LoggerEvents, &EventNotification{SubscriptionID: "sub-1", Cluster: "prod"}))
+
+		s.notifier.Release("k8s-events://prod/sub-1")
+
+		s.Empty(s.read("k8s-events://prod/sub-1", nil))
+	})
+}
+
+// TestMCPResourceDelivery tests resource delivery end to end with an MCP client
+func (s *ResourceNotifierTestSuite) TestMCPResourceDelivery() {
+	s.Run("subscribed clients receive resource updates and read the notifications", func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		server := mcp.NewServer(&mcp.Implementation{Name: "test-server"}, &mcp.ServerOptions{
+			SubscribeHandler: func(_ context.Context, req *mcp.SubscribeRequest) error {
+				return ValidateSubscriptionResourceURI(req.Params.URI)
+			},
+			UnsubscribeHandler: func(context.Context, *mcp.UnsubscribeRequest) error { return nil },
+		})
+		notifier := NewResourceNotifier(server, 0)
+		server.AddResourceTemplate(&mcp.ResourceTemplate{
+			Name:        "event-subscription",
+			URITemplate: SubscriptionResourceTemplate,
+			MIMEType:    "application/json",
+		}, notifier.ReadResource)
+
+		updated := make(chan string, 1)
+		client := mcp.NewClient(&mcp.Implementation{Name: "test-client"}, &mcp.ClientOptions{
+			ResourceUpdatedHandler: func(_ context.Context, req *mcp.ResourceUpdatedNotificationRequest) {
+				updated <- req.Params.URI
+			},
+		})
+		serverTransport, clientTransport := mcp.NewInMemoryTransports()
+		_, err := server.Connect(ctx, serverTransport, nil)
+		s.Require().NoError(err)
+		clientSession, err := client.Connect(ctx, clientTransport, nil)
+		s.Require().NoError(err)
+		defer func() { _ = clientSession.Close() }()
+
+		uri := SubscriptionResourceURI("prod", "sub-1")
+		s.Require().NoError(clientSession.Subscribe(ctx, &mcp.SubscribeParams{URI: uri}))
+
+		err = notifier.Notify(ctx, "", LoggerEvents, &EventNotification{SubscriptionID: "sub-1", Cluster: "prod", Event: &EventDetails{Reason: "BackOff"}})
+		s.Require().NoError(err)
+
+		select {
+		case updatedURI := <-updated:
+			s.Equal(uri, updatedURI)
+		case <-ctx.Done():
+			s.FailNow("client did not receive the resource update")
+		}
+
+		result, err := clientSession.ReadResource(ctx, &mcp.ReadResourceParams{URI: uri})
+		s.Require().NoError(err)
+		s.Require().Len(result.Contents, 1)
+		s.Contains(result.Contents[0].Text, `"reason":"BackOff"`)
+	})
+}
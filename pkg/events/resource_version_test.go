@@ -215,7 +215,7 @@ func (s *ResourceVersionTestSuite) TestInitialResourceVersion_SkipsHistoricalEve
 			InitialResourceVersion: "1000", // Simulate starting from resource version 1000
 			MaxRetries:             5,
 			DedupCache:             dedupCache,
-			ProcessEvent: func(event *v1.Event) {
+			ProcessEvent: func(_ context.Context, event *v1.Event) {
 				processedEvents = append(processedEvents, event.Name)
 			},
 		}
@@ -2,26 +2,62 @@ package events
 
 import (
 	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"sort"
+	"sync"
 	"time"
+	"unicode/utf8"
 
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	appsv1 "k8s.io/api/apps/v1"
 	batchv1 "k8s.io/api/batch/v1"
 	v1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/dynamic/dynamicinformer"
 	"k8s.io/client-go/informers"
+	coreinformers "k8s.io/client-go/informers/core/v1"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/klog/v2"
 )
 
+const (
+	// DefaultResyncPeriod is the resync period used when ResourceWatcherConfig.ResyncPeriod is zero.
+	DefaultResyncPeriod = 10 * time.Minute
+
+	// MinResyncPeriod is the smallest resync period a ResourceWatcher will use.
+	// Shorter periods cause constant full resyncs of every watched resource, so
+	// any smaller non-zero value is raised to this minimum.
+	MinResyncPeriod = 30 * time.Second
+
+	// DefaultMaxContextBytes is the context size limit used when ResourceWatcherConfig.MaxContextBytes is zero.
+	DefaultMaxContextBytes = 4096
+
+	// contextTruncatedMarker ends context and details cut down to the size limit.
+	contextTruncatedMarker = "...[truncated]"
+)
+
 // FaultSignalCallback is a function that handles emitted fault signals.
 // It is called by ResourceWatcher when a fault is detected after deduplication
-// and enrichment.
-type FaultSignalCallback func(signal FaultSignal)
+// and enrichment. The context carries the resource update's processing span.
+type FaultSignalCallback func(ctx context.Context, signal FaultSignal)
 
 // ResourceWatcher manages watching Kubernetes resources using SharedInformers
 // for fault detection. It uses client-go's SharedInformerFactory to watch
-// resources (Pods, Nodes, Deployments, Jobs) and detect fault conditions
-// through edge-triggered detection (comparing old vs new object state).
+// resources (Pods, Nodes, Deployments, Jobs, EndpointSlices, Services, PersistentVolumes) and detect fault conditions
+// through edge-triggered detection (comparing old vs new object state). Warning Events are
+// watched too, for faults only surfaced as events (e.g. pods rejected by admission control).
+// Resources of DynamicDetectors (e.g. custom resources) are watched through a
+// DynamicSharedInformerFactory, and their updates only run the detectors for that resource.
 //
 // The ResourceWatcher runs a detection pipeline for each resource update:
 // 1. Run registered detectors to produce FaultSignals
@@ -29,25 +65,41 @@ type FaultSignalCallback func(signal FaultSignal)
 // 3. Enrich signals with additional context using FaultContextEnricher
 // 4. Emit signals via the FaultSignalCallback
 type ResourceWatcher struct {
-	clientset       kubernetes.Interface
-	informerFactory informers.SharedInformerFactory
-	stopChan        chan struct{}
-	cluster         string
-	detectors       []Detector
-	deduplicator    *FaultDeduplicator
-	enricher        *FaultContextEnricher
-	signalCallback  FaultSignalCallback
+	clientset              kubernetes.Interface
+	informerFactory        informers.SharedInformerFactory
+	stopChan               chan struct{}
+	stopOnce               sync.Once
+	cluster                string
+	detectors              []Detector
+	kinds                  map[string]bool // resource kinds with a typed informer; nil watches all
+	dynamicInformerFactory dynamicinformer.DynamicSharedInformerFactory
+	dynamicDetectors       map[schema.GroupVersionResource][]Detector
+	deduplicator           *FaultDeduplicator
+	enricher               *FaultContextEnricher
+	signalCallback         FaultSignalCallback
+	resyncPeriod           time.Duration
+	tracer                 trace.Tracer
+	spanAttributes         []attribute.KeyValue
+	sendInitialState       bool
+	namespaceScope         string
+	maxContextBytes        int
 }
 
 // ResourceWatcherConfig holds configuration for the resource watcher
 type ResourceWatcherConfig struct {
 	Clientset kubernetes.Interface
+	// DynamicClient is used to watch the resources of DynamicDetectors.
+	// Required only when Detectors contains a DynamicDetector.
+	DynamicClient dynamic.Interface
 	// Cluster is the name of the cluster being watched (for logging and fault ID generation)
 	Cluster string
 	// ResyncPeriod is the interval for full resync of cached resources.
-	// A resync period of 0 disables resyncing.
+	// Zero uses DefaultResyncPeriod, values below MinResyncPeriod are raised
+	// to the minimum, and negative values are rejected.
 	ResyncPeriod time.Duration
 	// Detectors is a list of fault detectors to run on resource updates.
+	// DynamicDetectors only run on updates of their own GVR. When every other detector
+	// is a TypedDetector, only the informers for their resource kinds are started.
 	// If empty, no fault detection will be performed.
 	Detectors []Detector
 	// Deduplicator is used to suppress duplicate fault signals.
@@ -60,13 +112,88 @@ type ResourceWatcherConfig struct {
 	// detection, deduplication, and enrichment. If nil, signals are
 	// logged but not emitted.
 	SignalCallback FaultSignalCallback
+	// Tracer is used to create spans for each processed resource update.
+	// If nil, the global OpenTelemetry tracer provider is used.
+	Tracer trace.Tracer
+	// SpanAttributes are added to the root span of every processed update
+	// (e.g. subscription ID).
+	SpanAttributes []attribute.KeyValue
+	// LabelSelector limits every informer to resources matching the selector
+	// (e.g. "app=nginx"), so faults are only detected for matching resources.
+	// It is applied server-side to the list and watch calls. If empty, all resources are watched.
+	// Events are not label-selected, as they don't carry the labels of their involved object.
+	LabelSelector string
+	// NamespaceScope limits the namespaced informers (Pods, Deployments, Jobs,
+	// EndpointSlices, Services, Events) to a single namespace. Cluster-scoped Nodes and PersistentVolumes are still watched.
+	// If empty, resources in all namespaces are watched.
+	NamespaceScope string
+	// MaxContextBytes limits the size of the Context and of each Details value of the
+	// detected fault signals, so huge termination messages and panic traces don't bloat
+	// notifications. Longer text keeps its leading bytes and ends with "...[truncated]".
+	// Zero uses DefaultMaxContextBytes and negative values are rejected.
+	MaxContextBytes int
+	// SendInitialState reports the faults resources are already in when the watch
+	// starts: once the informer caches have synced, every cached resource is run
+	// through the InitialStateDetectors in a goroutine, so Start doesn't wait for the
+	// scan, which ends early when the watcher is stopped. The signals are deduplicated like any other,
+	// so a later transition into the same fault isn't reported again within the TTL.
+	SendInitialState bool
 }
 
-// NewResourceWatcher creates a new resource watcher with the given configuration
-func NewResourceWatcher(config ResourceWatcherConfig) *ResourceWatcher {
-	if config.ResyncPeriod == 0 {
-		// Default to 10 minutes if not specified
-		config.ResyncPeriod = 10 * time.Minute
+// NewResourceWatcher creates a new resource watcher with the given configuration.
+// Returns an error if the configured resync period or context limit is negative, the label
+// selector is invalid, or a DynamicDetector is configured without a DynamicClient.
+func NewResourceWatcher(config ResourceWatcherConfig) (*ResourceWatcher, error) {
+	switch {
+	case config.ResyncPeriod < 0:
+		return nil, fmt.Errorf("resync period must not be negative, got %s", config.ResyncPeriod)
+	case config.ResyncPeriod == 0:
+		config.ResyncPeriod = DefaultResyncPeriod
+	case config.ResyncPeriod < MinResyncPeriod:
+		klog.Warningf("Resync period %s for cluster %s is below the minimum, using %s", config.ResyncPeriod, config.Cluster, MinResyncPeriod)
+		config.ResyncPeriod = MinResyncPeriod
+	}
+
+	switch {
+	case config.MaxContextBytes < 0:
+		return nil, fmt.Errorf("max context bytes must not be negative, got %d", config.MaxContextBytes)
+	case config.MaxContextBytes == 0:
+		config.MaxContextBytes = DefaultMaxContextBytes
+	}
+
+	if config.LabelSelector != "" {
+		if _, err := labels.Parse(config.LabelSelector); err != nil {
+			return nil, fmt.Errorf("invalid label selector %q: %w", config.LabelSelector, err)
+		}
+	}
+
+	// Separate detectors watching resources through the dynamic client
+	var detectors []Detector
+	dynamicDetectors := make(map[schema.GroupVersionResource][]Detector)
+	for _, detector := range config.Detectors {
+		if dynamicDetector, ok := detector.(DynamicDetector); ok {
+			gvr := dynamicDetector.GVR()
+			dynamicDetectors[gvr] = append(dynamicDetectors[gvr], detector)
+			continue
+		}
+		detectors = append(detectors, detector)
+	}
+	if len(dynamicDetectors) > 0 && config.DynamicClient == nil {
+		return nil, fmt.Errorf("dynamic client is required for detectors watching custom resources")
+	}
+
+	// Only watch the kinds the detectors inspect, unless some detector doesn't report its kind
+	var kinds map[string]bool
+	for _, detector := range detectors {
+		typedDetector, ok := detector.(TypedDetector)
+		if !ok {
+			kinds = nil
+			break
+		}
+		if kinds == nil {
+			kinds = make(map[string]bool)
+		}
+		kinds[typedDetector.ResourceKind()] = true
 	}
 
 	// Use provided deduplicator or create a default one
@@ -81,141 +208,349 @@ func NewResourceWatcher(config ResourceWatcherConfig) *ResourceWatcher {
 		enricher = NewFaultContextEnricher()
 	}
 
-	// Create SharedInformerFactory with resync period
-	informerFactory := informers.NewSharedInformerFactory(config.Clientset, config.ResyncPeriod)
+	// Create SharedInformerFactory with resync period, scoped by namespace and label selector
+	var factoryOptions []informers.SharedInformerOption
+	var tweakListOptions func(options *metav1.ListOptions)
+	if config.NamespaceScope != "" {
+		factoryOptions = append(factoryOptions, informers.WithNamespace(config.NamespaceScope))
+	}
+	if config.LabelSelector != "" {
+		labelSelector := config.LabelSelector
+		tweakListOptions = func(options *metav1.ListOptions) {
+			options.LabelSelector = labelSelector
+		}
+		factoryOptions = append(factoryOptions, informers.WithTweakListOptions(tweakListOptions))
+	}
+	informerFactory := informers.NewSharedInformerFactoryWithOptions(config.Clientset, config.ResyncPeriod, factoryOptions...)
 
-	return &ResourceWatcher{
-		clientset:       config.Clientset,
-		informerFactory: informerFactory,
-		stopChan:        make(chan struct{}),
-		cluster:         config.Cluster,
-		detectors:       config.Detectors,
-		deduplicator:    deduplicator,
-		enricher:        enricher,
-		signalCallback:  config.SignalCallback,
+	// Dynamic informers are only needed for DynamicDetectors, with the same scoping
+	var dynamicInformerFactory dynamicinformer.DynamicSharedInformerFactory
+	if len(dynamicDetectors) > 0 {
+		dynamicInformerFactory = dynamicinformer.NewFilteredDynamicSharedInformerFactory(
+			config.DynamicClient, config.ResyncPeriod, config.NamespaceScope, tweakListOptions)
 	}
+
+	return &ResourceWatcher{
+		clientset:              config.Clientset,
+		informerFactory:        informerFactory,
+		stopChan:               make(chan struct{}),
+		cluster:                config.Cluster,
+		detectors:              detectors,
+		kinds:                  kinds,
+		dynamicInformerFactory: dynamicInformerFactory,
+		dynamicDetectors:       dynamicDetectors,
+		deduplicator:           deduplicator,
+		enricher:               enricher,
+		signalCallback:         config.SignalCallback,
+		resyncPeriod:           config.ResyncPeriod,
+		tracer:                 config.Tracer,
+		spanAttributes:         config.SpanAttributes,
+		sendInitialState:       config.SendInitialState,
+		namespaceScope:         config.NamespaceScope,
+		maxContextBytes:        config.MaxContextBytes,
+	}, nil
+}
+
+// ResyncPeriod returns the effective resync period after defaults and clamping are applied.
+func (w *ResourceWatcher) ResyncPeriod() time.Duration {
+	return w.resyncPeriod
 }
 
 // Start begins watching for resource updates
 func (w *ResourceWatcher) Start(ctx context.Context) error {
-	// Register Pod informer with Update callback
-	podInformer := w.informerFactory.Core().V1().Pods().Informer()
-
-	// Add event handler for Pod updates
-	_, err := podInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
-		UpdateFunc: func(oldObj, newObj interface{}) {
-			oldPod, ok := oldObj.(*v1.Pod)
-			if !ok {
-				klog.Warningf("Expected *v1.Pod in UpdateFunc, got %T", oldObj)
-				return
-			}
-			newPod, ok := newObj.(*v1.Pod)
-			if !ok {
-				klog.Warningf("Expected *v1.Pod in UpdateFunc, got %T", newObj)
-				return
-			}
-
-			// Log Pod update for verification
-			klog.V(2).Infof("Pod update detected: %s/%s (ResourceVersion: %s -> %s)",
-				newPod.Namespace, newPod.Name,
-				oldPod.ResourceVersion, newPod.ResourceVersion)
-
-			// Run detection pipeline
-			w.processPodUpdate(ctx, oldPod, newPod)
-		},
-	})
-	if err != nil {
-		return err
+	if w.watchesKind("Pod") {
+		// Register Pod informer with Update callback
+		podInformer := w.informerFactory.Core().V1().Pods().Informer()
+
+		// Add event handler for Pod updates
+		_, err := podInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+			UpdateFunc: func(oldObj, newObj interface{}) {
+				oldPod, ok := oldObj.(*v1.Pod)
+				if !ok {
+					klog.Warningf("Expected *v1.Pod in UpdateFunc, got %T", oldObj)
+					return
+				}
+				newPod, ok := newObj.(*v1.Pod)
+				if !ok {
+					klog.Warningf("Expected *v1.Pod in UpdateFunc, got %T", newObj)
+					return
+				}
+
+				// Log Pod update for verification
+				klog.V(2).Infof("Pod update detected: %s/%s (ResourceVersion: %s -> %s)",
+					newPod.Namespace, newPod.Name,
+					oldPod.ResourceVersion, newPod.ResourceVersion)
+
+				// Run detection pipeline
+				w.processUpdate(ctx, "Pod", newPod.Namespace, newPod.Name, oldPod, newPod)
+			},
+		})
+		if err != nil {
+			return err
+		}
 	}
 
-	// Register Node informer with Update callback
-	nodeInformer := w.informerFactory.Core().V1().Nodes().Informer()
-
-	// Add event handler for Node updates
-	_, err = nodeInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
-		UpdateFunc: func(oldObj, newObj interface{}) {
-			oldNode, ok := oldObj.(*v1.Node)
-			if !ok {
-				klog.Warningf("Expected *v1.Node in UpdateFunc, got %T", oldObj)
-				return
-			}
-			newNode, ok := newObj.(*v1.Node)
-			if !ok {
-				klog.Warningf("Expected *v1.Node in UpdateFunc, got %T", newObj)
-				return
-			}
-
-			// Log Node update for verification
-			klog.V(2).Infof("Node update detected: %s (ResourceVersion: %s -> %s)",
-				newNode.Name,
-				oldNode.ResourceVersion, newNode.ResourceVersion)
-
-			// Run detection pipeline
-			w.processNodeUpdate(ctx, oldNode, newNode)
-		},
-	})
-	if err != nil {
-		return err
+	if w.watchesKind("Node") {
+		// Register Node informer with Update callback
+		nodeInformer := w.informerFactory.Core().V1().Nodes().Informer()
+
+		// Add event handler for Node updates
+		_, err := nodeInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+			UpdateFunc: func(oldObj, newObj interface{}) {
+				oldNode, ok := oldObj.(*v1.Node)
+				if !ok {
+					klog.Warningf("Expected *v1.Node in UpdateFunc, got %T", oldObj)
+					return
+				}
+				newNode, ok := newObj.(*v1.Node)
+				if !ok {
+					klog.Warningf("Expected *v1.Node in UpdateFunc, got %T", newObj)
+					return
+				}
+
+				// Log Node update for verification
+				klog.V(2).Infof("Node update detected: %s (ResourceVersion: %s -> %s)",
+					newNode.Name,
+					oldNode.ResourceVersion, newNode.ResourceVersion)
+
+				// Run detection pipeline
+				w.processUpdate(ctx, "Node", newNode.Namespace, newNode.Name, oldNode, newNode)
+			},
+		})
+		if err != nil {
+			return err
+		}
 	}
 
-	// Register Deployment informer with Update callback
-	deploymentInformer := w.informerFactory.Apps().V1().Deployments().Informer()
-
-	// Add event handler for Deployment updates
-	_, err = deploymentInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
-		UpdateFunc: func(oldObj, newObj interface{}) {
-			oldDeployment, ok := oldObj.(*appsv1.Deployment)
-			if !ok {
-				klog.Warningf("Expected *appsv1.Deployment in UpdateFunc, got %T", oldObj)
-				return
-			}
-			newDeployment, ok := newObj.(*appsv1.Deployment)
-			if !ok {
-				klog.Warningf("Expected *appsv1.Deployment in UpdateFunc, got %T", newObj)
-				return
-			}
+	if w.watchesKind("Deployment") {
+		// Register Deployment informer with Update callback
+		deploymentInformer := w.informerFactory.Apps().V1().Deployments().Informer()
+
+		// Add event handler for Deployment updates
+		_, err := deploymentInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+			UpdateFunc: func(oldObj, newObj interface{}) {
+				oldDeployment, ok := oldObj.(*appsv1.Deployment)
+				if !ok {
+					klog.Warningf("Expected *appsv1.Deployment in UpdateFunc, got %T", oldObj)
+					return
+				}
+				newDeployment, ok := newObj.(*appsv1.Deployment)
+				if !ok {
+					klog.Warningf("Expected *appsv1.Deployment in UpdateFunc, got %T", newObj)
+					return
+				}
+
+				// Log Deployment update for verification
+				klog.V(2).Infof("Deployment update detected: %s/%s (ResourceVersion: %s -> %s)",
+					newDeployment.Namespace, newDeployment.Name,
+					oldDeployment.ResourceVersion, newDeployment.ResourceVersion)
+
+				// Run detection pipeline
+				w.processUpdate(ctx, "Deployment", newDeployment.Namespace, newDeployment.Name, oldDeployment, newDeployment)
+			},
+		})
+		if err != nil {
+			return err
+		}
+	}
 
-			// Log Deployment update for verification
-			klog.V(2).Infof("Deployment update detected: %s/%s (ResourceVersion: %s -> %s)",
-				newDeployment.Namespace, newDeployment.Name,
-				oldDeployment.ResourceVersion, newDeployment.ResourceVersion)
+	if w.watchesKind("Job") {
+		// Register Job informer with Update callback
+		jobInformer := w.informerFactory.Batch().V1().Jobs().Informer()
+
+		// Add event handler for Job updates
+		_, err := jobInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+			UpdateFunc: func(oldObj, newObj interface{}) {
+				oldJob, ok := oldObj.(*batchv1.Job)
+				if !ok {
+					klog.Warningf("Expected *batchv1.Job in UpdateFunc, got %T", oldObj)
+					return
+				}
+				newJob, ok := newObj.(*batchv1.Job)
+				if !ok {
+					klog.Warningf("Expected *batchv1.Job in UpdateFunc, got %T", newObj)
+					return
+				}
+
+				// Log Job update for verification
+				klog.V(2).Infof("Job update detected: %s/%s (ResourceVersion: %s -> %s)",
+					newJob.Namespace, newJob.Name,
+					oldJob.ResourceVersion, newJob.ResourceVersion)
+
+				// Run detection pipeline
+				w.processUpdate(ctx, "Job", newJob.Namespace, newJob.Name, oldJob, newJob)
+			},
+		})
+		if err != nil {
+			return err
+		}
+	}
 
-			// Run detection pipeline
-			w.processDeploymentUpdate(ctx, oldDeployment, newDeployment)
-		},
-	})
-	if err != nil {
-		return err
+	if w.watchesKind("EndpointSlice") {
+		// Register EndpointSlice informer with Update callback
+		endpointSliceInformer := w.informerFactory.Discovery().V1().EndpointSlices().Informer()
+
+		// Add event handler for EndpointSlice updates
+		_, err := endpointSliceInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+			UpdateFunc: func(oldObj, newObj interface{}) {
+				oldSlice, ok := oldObj.(*discoveryv1.EndpointSlice)
+				if !ok {
+					klog.Warningf("Expected *discoveryv1.EndpointSlice in UpdateFunc, got %T", oldObj)
+					return
+				}
+				newSlice, ok := newObj.(*discoveryv1.EndpointSlice)
+				if !ok {
+					klog.Warningf("Expected *discoveryv1.EndpointSlice in UpdateFunc, got %T", newObj)
+					return
+				}
+
+				// Log EndpointSlice update for verification
+				klog.V(2).Infof("EndpointSlice update detected: %s/%s (ResourceVersion: %s -> %s)",
+					newSlice.Namespace, newSlice.Name,
+					oldSlice.ResourceVersion, newSlice.ResourceVersion)
+
+				// Run detection pipeline
+				w.processUpdate(ctx, "EndpointSlice", newSlice.Namespace, newSlice.Name, oldSlice, newSlice)
+			},
+		})
+		if err != nil {
+			return err
+		}
 	}
 
-	// Register Job informer with Update callback
-	jobInformer := w.informerFactory.Batch().V1().Jobs().Informer()
+	if w.watchesKind("Service") {
+		// Register Service informer with Update callback
+		serviceInformer := w.informerFactory.Core().V1().Services().Informer()
+
+		// Add event handler for Service updates
+		_, err := serviceInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+			UpdateFunc: func(oldObj, newObj interface{}) {
+				oldService, ok := oldObj.(*v1.Service)
+				if !ok {
+					klog.Warningf("Expected *v1.Service in UpdateFunc, got %T", oldObj)
+					return
+				}
+				newService, ok := newObj.(*v1.Service)
+				if !ok {
+					klog.Warningf("Expected *v1.Service in UpdateFunc, got %T", newObj)
+					return
+				}
+
+				// Log Service update for verification
+				klog.V(2).Infof("Service update detected: %s/%s (ResourceVersion: %s -> %s)",
+					newService.Namespace, newService.Name,
+					oldService.ResourceVersion, newService.ResourceVersion)
+
+				// Run detection pipeline
+				w.processUpdate(ctx, "Service", newService.Namespace, newService.Name, oldService, newService)
+			},
+		})
+		if err != nil {
+			return err
+		}
+	}
 
-	// Add event handler for Job updates
-	_, err = jobInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
-		UpdateFunc: func(oldObj, newObj interface{}) {
-			oldJob, ok := oldObj.(*batchv1.Job)
-			if !ok {
-				klog.Warningf("Expected *batchv1.Job in UpdateFunc, got %T", oldObj)
-				return
-			}
-			newJob, ok := newObj.(*batchv1.Job)
-			if !ok {
-				klog.Warningf("Expected *batchv1.Job in UpdateFunc, got %T", newObj)
-				return
-			}
+	if w.watchesKind("PersistentVolume") {
+		// Register PersistentVolume informer with Update callback
+		pvInformer := w.informerFactory.Core().V1().PersistentVolumes().Informer()
+
+		// Add event handler for PersistentVolume updates
+		_, err := pvInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+			UpdateFunc: func(oldObj, newObj interface{}) {
+				oldPV, ok := oldObj.(*v1.PersistentVolume)
+				if !ok {
+					klog.Warningf("Expected *v1.PersistentVolume in UpdateFunc, got %T", oldObj)
+					return
+				}
+				newPV, ok := newObj.(*v1.PersistentVolume)
+				if !ok {
+					klog.Warningf("Expected *v1.PersistentVolume in UpdateFunc, got %T", newObj)
+					return
+				}
+
+				// Log PersistentVolume update for verification
+				klog.V(2).Infof("PersistentVolume update detected: %s (ResourceVersion: %s -> %s)",
+					newPV.Name,
+					oldPV.ResourceVersion, newPV.ResourceVersion)
+
+				// Run detection pipeline
+				w.processUpdate(ctx, "PersistentVolume", newPV.Namespace, newPV.Name, oldPV, newPV)
+			},
+		})
+		if err != nil {
+			return err
+		}
+	}
 
-			// Log Job update for verification
-			klog.V(2).Infof("Job update detected: %s/%s (ResourceVersion: %s -> %s)",
-				newJob.Namespace, newJob.Name,
-				oldJob.ResourceVersion, newJob.ResourceVersion)
+	if w.watchesKind("Event") {
+		// Register Event informer with Add and Update callbacks
+		eventInformer := w.eventInformer()
+
+		// Add event handler for new and recurring Warning events. Events already listed when
+		// the watch starts describe the past, so only later ones are run through the detectors.
+		_, err := eventInformer.AddEventHandler(cache.ResourceEventHandlerDetailedFuncs{
+			AddFunc: func(obj interface{}, isInInitialList bool) {
+				if isInInitialList {
+					return
+				}
+				event, ok := obj.(*v1.Event)
+				if !ok {
+					klog.Warningf("Expected *v1.Event in AddFunc, got %T", obj)
+					return
+				}
+
+				// Run detection pipeline
+				w.processUpdate(ctx, "Event", event.Namespace, event.Name, nil, event)
+			},
+			UpdateFunc: func(oldObj, newObj interface{}) {
+				oldEvent, ok := oldObj.(*v1.Event)
+				if !ok {
+					klog.Warningf("Expected *v1.Event in UpdateFunc, got %T", oldObj)
+					return
+				}
+				newEvent, ok := newObj.(*v1.Event)
+				if !ok {
+					klog.Warningf("Expected *v1.Event in UpdateFunc, got %T", newObj)
+					return
+				}
+
+				// Log Event update for verification
+				klog.V(2).Infof("Event update detected: %s/%s (ResourceVersion: %s -> %s)",
+					newEvent.Namespace, newEvent.Name,
+					oldEvent.ResourceVersion, newEvent.ResourceVersion)
+
+				// Run detection pipeline
+				w.processUpdate(ctx, "Event", newEvent.Namespace, newEvent.Name, oldEvent, newEvent)
+			},
+		})
+		if err != nil {
+			return err
+		}
+	}
 
-			// Run detection pipeline
-			w.processJobUpdate(ctx, oldJob, newJob)
-		},
-	})
-	if err != nil {
-		return err
+	// Keep the state of TrackingDetectors in sync with the resources in the caches
+	for _, detector := range w.detectors {
+		trackingDetector, ok := detector.(TrackingDetector)
+		if !ok {
+			continue
+		}
+		kind := trackingDetector.ResourceKind()
+		if !w.watchesKind(kind) || !slices.Contains(typedInformerKinds, kind) {
+			continue
+		}
+		_, err := w.typedInformer(kind).AddEventHandler(cache.ResourceEventHandlerFuncs{
+			AddFunc: trackingDetector.Observe,
+			DeleteFunc: func(obj interface{}) {
+				// Deletions missed while disconnected come wrapped in a tombstone
+				if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+					obj = tombstone.Obj
+				}
+				trackingDetector.Forget(obj)
+			},
+		})
+		if err != nil {
+			return err
+		}
 	}
 
 	// Start the informer factory
@@ -233,224 +568,340 @@ func (w *ResourceWatcher) Start(ctx context.Context) error {
 	}
 	klog.V(1).Info("Informer caches synced successfully")
 
-	return nil
-}
-
-// processPodUpdate runs the detection pipeline on a Pod update event.
-// Pipeline stages:
-// 1. Run all registered detectors to produce fault signals
-// 2. Deduplicate signals using FaultDeduplicator
-// 3. Enrich signals with additional context using FaultContextEnricher
-// 4. Emit signals via the FaultSignalCallback
-func (w *ResourceWatcher) processPodUpdate(ctx context.Context, oldPod, newPod *v1.Pod) {
-	// Skip if no detectors are registered
-	if len(w.detectors) == 0 {
-		return
-	}
-
-	// Stage 1: Run all detectors
-	var allSignals []FaultSignal
-	for _, detector := range w.detectors {
-		signals := detector.Detect(oldPod, newPod)
-		allSignals = append(allSignals, signals...)
+	// Scan in the background so Start, which callers may run under their own locks,
+	// doesn't wait for every cached resource to be checked
+	if w.sendInitialState {
+		go w.detectInitialState(ctx)
 	}
 
-	// Stage 2: Deduplicate signals
-	var dedupedSignals []FaultSignal
-	for _, signal := range allSignals {
-		if w.deduplicator.ShouldEmit(signal) {
-			dedupedSignals = append(dedupedSignals, signal)
-		} else {
-			faultID := GenerateFaultID(w.cluster, signal.FaultType, signal.ResourceUID, signal.ContainerName)
-			klog.V(2).Infof("Suppressed duplicate fault signal: %s for %s/%s (container: %s, faultId: %s)",
-				signal.FaultType, signal.Namespace, signal.Name, signal.ContainerName, faultID)
-		}
+	if w.dynamicInformerFactory == nil {
+		return nil
 	}
 
-	// Stage 3: Enrich signals with additional context
-	for i := range dedupedSignals {
-		// Enrich modifies the signal in place
-		err := w.enricher.Enrich(ctx, &dedupedSignals[i], w.clientset)
+	// Register a dynamic informer for each resource watched by DynamicDetectors
+	for gvr, detectors := range w.dynamicDetectors {
+		dynamicInformer := w.dynamicInformerFactory.ForResource(gvr).Informer()
+
+		// Add event handler for resource updates, running only this resource's detectors
+		_, err := dynamicInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+			UpdateFunc: func(oldObj, newObj interface{}) {
+				oldResource, ok := oldObj.(*unstructured.Unstructured)
+				if !ok {
+					klog.Warningf("Expected *unstructured.Unstructured in UpdateFunc, got %T", oldObj)
+					return
+				}
+				newResource, ok := newObj.(*unstructured.Unstructured)
+				if !ok {
+					klog.Warningf("Expected *unstructured.Unstructured in UpdateFunc, got %T", newObj)
+					return
+				}
+
+				// Log resource update for verification
+				klog.V(2).Infof("%s update detected: %s (ResourceVersion: %s -> %s)",
+					gvr.String(), resourceRef(newResource.GetNamespace(), newResource.GetName()),
+					oldResource.GetResourceVersion(), newResource.GetResourceVersion())
+
+				// Run detection pipeline
+				w.processUpdateWith(ctx, detectors, newResource.GetKind(), newResource.GetNamespace(), newResource.GetName(), oldResource, newResource)
+			},
+		})
 		if err != nil {
-			// Log enrichment errors but don't block signal emission
-			klog.V(2).Infof("Failed to enrich fault signal: %v", err)
+			return err
 		}
 	}
 
-	// Stage 4: Emit signals
-	for _, signal := range dedupedSignals {
-		if w.signalCallback != nil {
-			w.signalCallback(signal)
+	// Start the dynamic informer factory
+	w.dynamicInformerFactory.Start(w.stopChan)
+
+	// Wait for cache sync
+	klog.V(1).Info("Waiting for dynamic informer caches to sync...")
+	dynamicSynced := w.dynamicInformerFactory.WaitForCacheSync(w.stopChan)
+	for gvr, isSynced := range dynamicSynced {
+		if !isSynced {
+			klog.Warningf("Failed to sync cache for dynamic informer: %v", gvr)
 		} else {
-			// If no callback is provided, log the signal
-			klog.Infof("Fault detected: %s in %s/%s (container: %s), severity: %s, context: %s",
-				signal.FaultType, signal.Namespace, signal.Name, signal.ContainerName,
-				signal.Severity, signal.Context)
+			klog.V(2).Infof("Cache synced for dynamic informer: %v", gvr)
 		}
 	}
+	klog.V(1).Info("Dynamic informer caches synced successfully")
+
+	return nil
+}
+
+// eventInformer returns the factory's informer for Warning events in the namespace scope.
+// Unlike the other informers it ignores the label selector: events don't carry the labels
+// of the object they are about, so a selector would filter them all out.
+func (w *ResourceWatcher) eventInformer() cache.SharedIndexInformer {
+	return w.informerFactory.InformerFor(&v1.Event{}, func(client kubernetes.Interface, resyncPeriod time.Duration) cache.SharedIndexInformer {
+		return coreinformers.NewFilteredEventInformer(client, w.namespaceScope, resyncPeriod,
+			cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc},
+			func(options *metav1.ListOptions) {
+				options.FieldSelector = "type=" + v1.EventTypeWarning
+			})
+	})
+}
+
+// watchesKind reports whether the typed informer for the given resource kind is started.
+func (w *ResourceWatcher) watchesKind(kind string) bool {
+	return w.kinds == nil || w.kinds[kind]
 }
 
-// processNodeUpdate runs the detection pipeline on a Node update event.
+// processUpdate runs the detection pipeline on a resource update event.
 // Pipeline stages:
 // 1. Run all registered detectors to produce fault signals
 // 2. Deduplicate signals using FaultDeduplicator
 // 3. Enrich signals with additional context using FaultContextEnricher
 // 4. Emit signals via the FaultSignalCallback
-func (w *ResourceWatcher) processNodeUpdate(ctx context.Context, oldNode, newNode *v1.Node) {
+//
+// The kind, namespace, and name identify the updated resource for logging and tracing.
+func (w *ResourceWatcher) processUpdate(ctx context.Context, kind, namespace, name string, oldObj, newObj interface{}) {
+	w.processUpdateWith(ctx, w.detectors, kind, namespace, name, oldObj, newObj)
+}
+
+// processUpdateWith runs the detection pipeline of processUpdate with the given detectors.
+func (w *ResourceWatcher) processUpdateWith(ctx context.Context, detectors []Detector, kind, namespace, name string, oldObj, newObj interface{}) {
 	// Skip if no detectors are registered
-	if len(w.detectors) == 0 {
+	if len(detectors) == 0 {
 		return
 	}
 
-	// Stage 1: Run all detectors
-	var allSignals []FaultSignal
+	w.runPipeline(ctx, kind, namespace, name, func() []FaultSignal {
+		var signals []FaultSignal
+		for _, detector := range detectors {
+			signals = append(signals, detector.Detect(oldObj, newObj)...)
+		}
+		return signals
+	})
+}
+
+// detectInitialState runs the detection pipeline with the InitialStateDetectors on
+// every resource in the synced typed informer caches, so faults that began before
+// the watch started are reported. The scan ends early once ctx is done or the watcher
+// is stopped.
+func (w *ResourceWatcher) detectInitialState(ctx context.Context) {
+	var detectors []InitialStateDetector
 	for _, detector := range w.detectors {
-		signals := detector.Detect(oldNode, newNode)
-		allSignals = append(allSignals, signals...)
+		if stateDetector, ok := detector.(InitialStateDetector); ok {
+			detectors = append(detectors, stateDetector)
+		}
 	}
 
-	// Stage 2: Deduplicate signals
-	var dedupedSignals []FaultSignal
-	for _, signal := range allSignals {
-		if w.deduplicator.ShouldEmit(signal) {
-			dedupedSignals = append(dedupedSignals, signal)
-		} else {
-			faultID := GenerateFaultID(w.cluster, signal.FaultType, signal.ResourceUID, signal.ContainerName)
-			klog.V(2).Infof("Suppressed duplicate fault signal: %s for Node %s (faultId: %s)",
-				signal.FaultType, signal.Name, faultID)
+	for _, kind := range typedInformerKinds {
+		if !w.watchesKind(kind) {
+			continue
 		}
-	}
 
-	// Stage 3: Enrich signals with additional context
-	for i := range dedupedSignals {
-		// Enrich modifies the signal in place
-		err := w.enricher.Enrich(ctx, &dedupedSignals[i], w.clientset)
-		if err != nil {
-			// Log enrichment errors but don't block signal emission
-			klog.V(2).Infof("Failed to enrich fault signal: %v", err)
+		// Detectors that don't report their kind get every resource
+		var kindDetectors []InitialStateDetector
+		for _, detector := range detectors {
+			if typedDetector, ok := detector.(TypedDetector); !ok || typedDetector.ResourceKind() == kind {
+				kindDetectors = append(kindDetectors, detector)
+			}
+		}
+		if len(kindDetectors) == 0 {
+			continue
 		}
-	}
 
-	// Stage 4: Emit signals
-	for _, signal := range dedupedSignals {
-		if w.signalCallback != nil {
-			w.signalCallback(signal)
-		} else {
-			// If no callback is provided, log the signal
-			klog.Infof("Fault detected: %s in Node %s, severity: %s, context: %s",
-				signal.FaultType, signal.Name, signal.Severity, signal.Context)
+		objects := w.typedInformer(kind).GetStore().List()
+		for _, obj := range objects {
+			if w.stopped(ctx) {
+				return
+			}
+			resource, err := meta.Accessor(obj)
+			if err != nil {
+				klog.Warningf("Unexpected object type in %s informer cache: %T", kind, obj)
+				continue
+			}
+			w.runPipeline(ctx, kind, resource.GetNamespace(), resource.GetName(), func() []FaultSignal {
+				var signals []FaultSignal
+				for _, detector := range kindDetectors {
+					signals = append(signals, detector.DetectState(obj)...)
+				}
+				return signals
+			})
 		}
+		klog.V(1).Infof("Checked initial state of %d %s resources on cluster %s", len(objects), kind, w.cluster)
 	}
 }
 
-// processDeploymentUpdate runs the detection pipeline on a Deployment update event.
-// Pipeline stages:
-// 1. Run all registered detectors to produce fault signals
-// 2. Deduplicate signals using FaultDeduplicator
-// 3. Enrich signals with additional context using FaultContextEnricher
-// 4. Emit signals via the FaultSignalCallback
-func (w *ResourceWatcher) processDeploymentUpdate(ctx context.Context, oldDeployment, newDeployment *appsv1.Deployment) {
-	// Skip if no detectors are registered
-	if len(w.detectors) == 0 {
-		return
+// stopped reports whether ctx is done or the watcher was stopped.
+func (w *ResourceWatcher) stopped(ctx context.Context) bool {
+	select {
+	case <-ctx.Done():
+		return true
+	case <-w.stopChan:
+		return true
+	default:
+		return false
 	}
+}
+
+// typedInformerKinds are the resource kinds with a typed informer, see typedInformer.
+var typedInformerKinds = []string{"Pod", "Node", "Deployment", "Job", "EndpointSlice", "Service", "PersistentVolume"}
+
+// typedInformer returns the shared informer for a resource kind with a typed informer.
+func (w *ResourceWatcher) typedInformer(kind string) cache.SharedIndexInformer {
+	switch kind {
+	case "Pod":
+		return w.informerFactory.Core().V1().Pods().Informer()
+	case "Node":
+		return w.informerFactory.Core().V1().Nodes().Informer()
+	case "Deployment":
+		return w.informerFactory.Apps().V1().Deployments().Informer()
+	case "Job":
+		return w.informerFactory.Batch().V1().Jobs().Informer()
+	case "EndpointSlice":
+		return w.informerFactory.Discovery().V1().EndpointSlices().Informer()
+	case "Service":
+		return w.informerFactory.Core().V1().Services().Informer()
+	case "PersistentVolume":
+		return w.informerFactory.Core().V1().PersistentVolumes().Informer()
+	default:
+		panic(fmt.Sprintf("no typed informer for kind %q", kind))
+	}
+}
+
+// runPipeline runs the detection pipeline for a resource, with detect producing
+// the signals of stage 1.
+func (w *ResourceWatcher) runPipeline(ctx context.Context, kind, namespace, name string, detect func() []FaultSignal) {
+	attrs := append([]attribute.KeyValue{
+		AttrCluster.String(w.cluster),
+		AttrResourceKind.String(kind),
+		AttrResourceName.String(name),
+		AttrNamespace.String(namespace),
+	}, w.spanAttributes...)
+	ctx, span := startSpan(ctx, w.tracer, SpanProcessFault, attrs...)
+	defer span.End()
 
 	// Stage 1: Run all detectors
-	var allSignals []FaultSignal
-	for _, detector := range w.detectors {
-		signals := detector.Detect(oldDeployment, newDeployment)
-		allSignals = append(allSignals, signals...)
+	_, detectSpan := startSpan(ctx, w.tracer, SpanDetect)
+	allSignals := detect()
+	for i := range allSignals {
+		w.truncateSignal(&allSignals[i])
 	}
+	// Detector order is unspecified, so sort for deterministic notifications
+	sortFaultSignals(allSignals)
+	detectSpan.SetAttributes(AttrSignalCount.Int(len(allSignals)))
+	detectSpan.End()
 
 	// Stage 2: Deduplicate signals
 	var dedupedSignals []FaultSignal
 	for _, signal := range allSignals {
-		if w.deduplicator.ShouldEmit(signal) {
+		_, dedupSpan := startSpan(ctx, w.tracer, SpanFaultDedupCheck, AttrFaultType.String(string(signal.FaultType)))
+		shouldEmit := w.deduplicator.ShouldEmit(signal)
+		dedupSpan.SetAttributes(AttrDuplicate.Bool(!shouldEmit))
+		dedupSpan.End()
+
+		if shouldEmit {
 			dedupedSignals = append(dedupedSignals, signal)
 		} else {
 			faultID := GenerateFaultID(w.cluster, signal.FaultType, signal.ResourceUID, signal.ContainerName)
-			klog.V(2).Infof("Suppressed duplicate fault signal: %s for Deployment %s/%s (faultId: %s)",
-				signal.FaultType, signal.Namespace, signal.Name, faultID)
+			klog.V(2).Infof("Suppressed duplicate fault signal: %s for %s %s (container: %s, faultId: %s)",
+				signal.FaultType, kind, resourceRef(signal.Namespace, signal.Name), signal.ContainerName, faultID)
 		}
 	}
 
 	// Stage 3: Enrich signals with additional context
 	for i := range dedupedSignals {
+		enrichCtx, enrichSpan := startSpan(ctx, w.tracer, SpanEnrich, AttrFaultType.String(string(dedupedSignals[i].FaultType)))
 		// Enrich modifies the signal in place
-		err := w.enricher.Enrich(ctx, &dedupedSignals[i], w.clientset)
+		err := w.enricher.Enrich(enrichCtx, &dedupedSignals[i], w.clientset)
 		if err != nil {
 			// Log enrichment errors but don't block signal emission
 			klog.V(2).Infof("Failed to enrich fault signal: %v", err)
+			recordSpanError(enrichSpan, err)
 		}
+		enrichSpan.End()
 	}
 
-	// Stage 4: Emit signals
+	// Stage 4: Emit signals, unless the watcher was cancelled while they were processed
+	if ctx.Err() != nil {
+		return
+	}
 	for _, signal := range dedupedSignals {
 		if w.signalCallback != nil {
-			w.signalCallback(signal)
+			w.signalCallback(ctx, signal)
 		} else {
 			// If no callback is provided, log the signal
-			klog.Infof("Fault detected: %s in Deployment %s/%s, severity: %s, context: %s",
-				signal.FaultType, signal.Namespace, signal.Name, signal.Severity, signal.Context)
+			klog.Infof("Fault detected: %s in %s %s (container: %s), severity: %s, context: %s",
+				signal.FaultType, kind, resourceRef(signal.Namespace, signal.Name), signal.ContainerName,
+				signal.Severity, signal.Context)
 		}
 	}
 }
 
-// processJobUpdate runs the detection pipeline on a Job update event.
-// Pipeline stages:
-// 1. Run all registered detectors to produce fault signals
-// 2. Deduplicate signals using FaultDeduplicator
-// 3. Enrich signals with additional context using FaultContextEnricher
-// 4. Emit signals via the FaultSignalCallback
-func (w *ResourceWatcher) processJobUpdate(ctx context.Context, oldJob, newJob *batchv1.Job) {
-	// Skip if no detectors are registered
-	if len(w.detectors) == 0 {
-		return
+// truncateSignal cuts the context and details of a detected signal down to the size limit.
+// Details are copied before truncation, as detectors may share them between signals.
+func (w *ResourceWatcher) truncateSignal(signal *FaultSignal) {
+	signal.Context = truncateContext(signal.Context, w.maxContextBytes)
+	var details map[string]string
+	for key, value := range signal.Details {
+		if len(value) <= w.maxContextBytes {
+			continue
+		}
+		if details == nil {
+			details = maps.Clone(signal.Details)
+		}
+		details[key] = truncateContext(value, w.maxContextBytes)
 	}
-
-	// Stage 1: Run all detectors
-	var allSignals []FaultSignal
-	for _, detector := range w.detectors {
-		signals := detector.Detect(oldJob, newJob)
-		allSignals = append(allSignals, signals...)
+	if details != nil {
+		signal.Details = details
 	}
+}
 
-	// Stage 2: Deduplicate signals
-	var dedupedSignals []FaultSignal
-	for _, signal := range allSignals {
-		if w.deduplicator.ShouldEmit(signal) {
-			dedupedSignals = append(dedupedSignals, signal)
-		} else {
-			faultID := GenerateFaultID(w.cluster, signal.FaultType, signal.ResourceUID, signal.ContainerName)
-			klog.V(2).Infof("Suppressed duplicate fault signal: %s for Job %s/%s (faultId: %s)",
-				signal.FaultType, signal.Namespace, signal.Name, faultID)
-		}
+// truncateContext returns text cut to at most maxBytes, ending with contextTruncatedMarker
+// if anything was cut. The cut is made on a UTF-8 character boundary.
+func truncateContext(text string, maxBytes int) string {
+	if len(text) <= maxBytes {
+		return text
 	}
-
-	// Stage 3: Enrich signals with additional context
-	for i := range dedupedSignals {
-		// Enrich modifies the signal in place
-		err := w.enricher.Enrich(ctx, &dedupedSignals[i], w.clientset)
-		if err != nil {
-			// Log enrichment errors but don't block signal emission
-			klog.V(2).Infof("Failed to enrich fault signal: %v", err)
-		}
+	if maxBytes <= len(contextTruncatedMarker) {
+		return contextTruncatedMarker[:maxBytes]
 	}
+	cut := maxBytes - len(contextTruncatedMarker)
+	for cut > 0 && !utf8.RuneStart(text[cut]) {
+		cut--
+	}
+	return text[:cut] + contextTruncatedMarker
+}
 
-	// Stage 4: Emit signals
-	for _, signal := range dedupedSignals {
-		if w.signalCallback != nil {
-			w.signalCallback(signal)
-		} else {
-			// If no callback is provided, log the signal
-			klog.Infof("Fault detected: %s in Job %s/%s, severity: %s, context: %s",
-				signal.FaultType, signal.Namespace, signal.Name, signal.Severity, signal.Context)
+// sortFaultSignals orders signals by namespace, name, container, and fault type.
+// The sort is stable, so signals with equal keys keep their detection order.
+func sortFaultSignals(signals []FaultSignal) {
+	sort.SliceStable(signals, func(i, j int) bool {
+		a, b := signals[i], signals[j]
+		if a.Namespace != b.Namespace {
+			return a.Namespace < b.Namespace
+		}
+		if a.Name != b.Name {
+			return a.Name < b.Name
 		}
+		if a.ContainerName != b.ContainerName {
+			return a.ContainerName < b.ContainerName
+		}
+		return a.FaultType < b.FaultType
+	})
+}
+
+// resourceRef formats a resource reference as namespace/name, or just name for
+// cluster-scoped resources.
+func resourceRef(namespace, name string) string {
+	if namespace == "" {
+		return name
 	}
+	return namespace + "/" + name
 }
 
-// Stop stops the resource watcher
+// Stop stops the resource watcher's informers and waits for them to shut down, so
+// their caches are released and no more updates are processed. Calling Stop more than
+// once has no further effect.
 func (w *ResourceWatcher) Stop() {
-	close(w.stopChan)
-	klog.V(1).Info("ResourceWatcher stopped")
+	w.stopOnce.Do(func() {
+		close(w.stopChan)
+		w.informerFactory.Shutdown()
+		if w.dynamicInformerFactory != nil {
+			w.dynamicInformerFactory.Shutdown()
+		}
+		klog.V(1).Info("ResourceWatcher stopped")
+	})
 }
@@ -5,6 +5,8 @@ import (
 	"os"
 	"path/filepath"
 	"runtime"
+	"strconv"
+	"strings"
 	"testing"
 	"time"
 
@@ -12,10 +14,17 @@ import (
 	"github.com/containers/kubernetes-mcp-server/pkg/events/detectors"
 	"github.com/spf13/afero"
 	"github.com/stretchr/testify/suite"
+	batchv1 "k8s.io/api/batch/v1"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	k8sruntime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
 	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
 	"k8s.io/client-go/rest"
+	k8stesting "k8s.io/client-go/testing"
 	"sigs.k8s.io/controller-runtime/pkg/envtest"
 	"sigs.k8s.io/controller-runtime/tools/setup-envtest/env"
 	"sigs.k8s.io/controller-runtime/tools/setup-envtest/remote"
@@ -137,7 +146,8 @@ func (s *ResourceWatcherTestSuite) TestResourceWatcher_ReceivesPodUpdates() {
 			ResyncPeriod: 10 * time.Minute,
 		}
 
-		watcher := events.NewResourceWatcher(config)
+		watcher, err := events.NewResourceWatcher(config)
+		s.Require().NoError(err, "failed to create resource watcher")
 		s.Require().NotNil(watcher)
 
 		// Start the watcher
@@ -185,13 +195,14 @@ func (s *ResourceWatcherTestSuite) TestResourceWatcher_StartStop() {
 			ResyncPeriod: 10 * time.Minute,
 		}
 
-		watcher := events.NewResourceWatcher(config)
+		watcher, err := events.NewResourceWatcher(config)
+		s.Require().NoError(err, "failed to create resource watcher")
 		s.Require().NotNil(watcher)
 
 		watcherCtx, cancelWatcher := context.WithCancel(ctx)
 		defer cancelWatcher()
 
-		err := watcher.Start(watcherCtx)
+		err = watcher.Start(watcherCtx)
 		s.Require().NoError(err, "start should not return error")
 
 		// Wait for cache sync
@@ -218,13 +229,14 @@ func (s *ResourceWatcherTestSuite) TestResourceWatcher_HandlesMultiplePodUpdates
 			ResyncPeriod: 10 * time.Minute,
 		}
 
-		watcher := events.NewResourceWatcher(config)
+		watcher, err := events.NewResourceWatcher(config)
+		s.Require().NoError(err, "failed to create resource watcher")
 		s.Require().NotNil(watcher)
 
 		watcherCtx, cancelWatcher := context.WithCancel(ctx)
 		defer cancelWatcher()
 
-		err := watcher.Start(watcherCtx)
+		err = watcher.Start(watcherCtx)
 		s.Require().NoError(err, "failed to start resource watcher")
 
 		// Wait for cache sync
@@ -282,11 +294,11 @@ func (s *ResourceWatcherTestSuite) TestResourceWatcher_DefaultResyncPeriod() {
 			ResyncPeriod: 0, // Not specified
 		}
 
-		watcher := events.NewResourceWatcher(config)
+		watcher, err := events.NewResourceWatcher(config)
+		s.Require().NoError(err)
 		s.Require().NotNil(watcher)
 
-		// The default is set in NewResourceWatcher
-		// Verify the watcher was created successfully (watcher is non-nil)
+		s.Equal(events.DefaultResyncPeriod, watcher.ResyncPeriod())
 	})
 }
 
@@ -308,19 +320,20 @@ func (s *ResourceWatcherTestSuite) TestResourceWatcher_FaultDetectionPipeline()
 				detectors.NewPodCrashDetector(),
 				detectors.NewCrashLoopDetector(),
 			},
-			SignalCallback: func(signal events.FaultSignal) {
+			SignalCallback: func(_ context.Context, signal events.FaultSignal) {
 				signalChan <- signal
 			},
 		}
 
-		watcher := events.NewResourceWatcher(config)
+		watcher, err := events.NewResourceWatcher(config)
+		s.Require().NoError(err, "failed to create resource watcher")
 		s.Require().NotNil(watcher)
 
 		// Start the watcher
 		watcherCtx, cancelWatcher := context.WithCancel(ctx)
 		defer cancelWatcher()
 
-		err := watcher.Start(watcherCtx)
+		err = watcher.Start(watcherCtx)
 		s.Require().NoError(err, "failed to start resource watcher")
 
 		// Wait for cache sync
@@ -418,19 +431,20 @@ func (s *ResourceWatcherTestSuite) TestResourceWatcher_FaultDetectionPipeline()
 				detectors.NewPodCrashDetector(),
 				detectors.NewCrashLoopDetector(),
 			},
-			SignalCallback: func(signal events.FaultSignal) {
+			SignalCallback: func(_ context.Context, signal events.FaultSignal) {
 				signalChan <- signal
 			},
 		}
 
-		watcher := events.NewResourceWatcher(config)
+		watcher, err := events.NewResourceWatcher(config)
+		s.Require().NoError(err, "failed to create resource watcher")
 		s.Require().NotNil(watcher)
 
 		// Start the watcher
 		watcherCtx, cancelWatcher := context.WithCancel(ctx)
 		defer cancelWatcher()
 
-		err := watcher.Start(watcherCtx)
+		err = watcher.Start(watcherCtx)
 		s.Require().NoError(err, "failed to start resource watcher")
 
 		// Wait for cache sync
@@ -536,19 +550,20 @@ func (s *ResourceWatcherTestSuite) TestResourceWatcher_FaultDetectionPipeline()
 				detectors.NewPodCrashDetector(),
 			},
 			Deduplicator: deduplicator,
-			SignalCallback: func(signal events.FaultSignal) {
+			SignalCallback: func(_ context.Context, signal events.FaultSignal) {
 				signalChan <- signal
 			},
 		}
 
-		watcher := events.NewResourceWatcher(config)
+		watcher, err := events.NewResourceWatcher(config)
+		s.Require().NoError(err, "failed to create resource watcher")
 		s.Require().NotNil(watcher)
 
 		// Start the watcher
 		watcherCtx, cancelWatcher := context.WithCancel(ctx)
 		defer cancelWatcher()
 
-		err := watcher.Start(watcherCtx)
+		err = watcher.Start(watcherCtx)
 		s.Require().NoError(err, "failed to start resource watcher")
 
 		// Wait for cache sync
@@ -643,3 +658,855 @@ func (s *ResourceWatcherTestSuite) TestResourceWatcher_FaultDetectionPipeline()
 		s.NoError(err, "failed to delete test pod")
 	})
 }
+
+// TestNewDefaultFaultPipeline verifies that the default fault pipeline detects faults without further wiring
+func (s *ResourceWatcherTestSuite) TestNewDefaultFaultPipeline() {
+	s.Run("detects a pod crash end-to-end", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		namespace := "default"
+
+		signalChan := make(chan events.FaultSignal, 10)
+		watcher, err := detectors.NewDefaultFaultPipeline(s.clientset, "test-cluster", func(_ context.Context, signal events.FaultSignal) {
+			signalChan <- signal
+		}, detectors.FaultPipelineOptions{})
+		s.Require().NoError(err, "failed to create default fault pipeline")
+		s.Require().NoError(watcher.Start(ctx), "failed to start default fault pipeline")
+		defer watcher.Stop()
+
+		pod := &v1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-default-pipeline-pod", Namespace: namespace},
+			Spec:       v1.PodSpec{Containers: []v1.Container{{Name: "app", Image: "nginx:latest"}}},
+		}
+		createdPod, err := s.clientset.CoreV1().Pods(namespace).Create(ctx, pod, metav1.CreateOptions{})
+		s.Require().NoError(err, "failed to create test pod")
+		defer func() {
+			_ = s.clientset.CoreV1().Pods(namespace).Delete(context.Background(), pod.Name, metav1.DeleteOptions{})
+		}()
+
+		createdPod.Status = v1.PodStatus{
+			Phase: v1.PodRunning,
+			ContainerStatuses: []v1.ContainerStatus{{
+				Name:  "app",
+				State: v1.ContainerState{Running: &v1.ContainerStateRunning{StartedAt: metav1.Now()}},
+			}},
+		}
+		createdPod, err = s.clientset.CoreV1().Pods(namespace).UpdateStatus(ctx, createdPod, metav1.UpdateOptions{})
+		s.Require().NoError(err, "failed to set initial pod status")
+		time.Sleep(500 * time.Millisecond)
+
+		crashedPod := createdPod.DeepCopy()
+		crashedPod.Status.ContainerStatuses[0].RestartCount = 1
+		crashedPod.Status.ContainerStatuses[0].State = v1.ContainerState{
+			Terminated: &v1.ContainerStateTerminated{ExitCode: 1, Reason: "Error", Message: "config file missing"},
+		}
+		_, err = s.clientset.CoreV1().Pods(namespace).UpdateStatus(ctx, crashedPod, metav1.UpdateOptions{})
+		s.Require().NoError(err, "failed to update pod status")
+
+		timeout := time.After(5 * time.Second)
+		for {
+			select {
+			case signal := <-signalChan:
+				if signal.FaultType != events.FaultTypePodCrash {
+					continue
+				}
+				s.Equal("Pod", signal.Kind)
+				s.Equal(pod.Name, signal.Name)
+				s.Equal("app", signal.ContainerName)
+				s.Contains(signal.Context, "config file missing")
+				return
+			case <-timeout:
+				s.Fail("timeout waiting for pod crash fault signal")
+				return
+			}
+		}
+	})
+}
+
+// TestResourceWatcher_Scoping verifies that label selector and namespace scope limit fault detection
+func (s *ResourceWatcherTestSuite) TestResourceWatcher_Scoping() {
+	s.Run("pods outside the label selector do not produce fault signals", func() {
+		ctx := context.Background()
+		namespace := "default"
+
+		signalChan := make(chan events.FaultSignal, 10)
+
+		watcher, err := events.NewResourceWatcher(events.ResourceWatcherConfig{
+			Clientset:      s.clientset,
+			Cluster:        "test-cluster",
+			ResyncPeriod:   10 * time.Minute,
+			LabelSelector:  "fault-detection=enabled",
+			NamespaceScope: namespace,
+			Detectors: []events.Detector{
+				detectors.NewPodCrashDetector(),
+			},
+			SignalCallback: func(_ context.Context, signal events.FaultSignal) {
+				signalChan <- signal
+			},
+		})
+		s.Require().NoError(err, "failed to create resource watcher")
+
+		watcherCtx, cancelWatcher := context.WithCancel(ctx)
+		defer cancelWatcher()
+
+		err = watcher.Start(watcherCtx)
+		s.Require().NoError(err, "failed to start resource watcher")
+		defer watcher.Stop()
+
+		// Wait for cache sync
+		time.Sleep(500 * time.Millisecond)
+
+		// crashPod creates a running pod and then simulates a container crash
+		crashPod := func(name string, podLabels map[string]string) {
+			pod, err := s.clientset.CoreV1().Pods(namespace).Create(ctx, &v1.Pod{
+				ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace, Labels: podLabels},
+				Spec: v1.PodSpec{
+					Containers: []v1.Container{{Name: "test-container", Image: "nginx:latest"}},
+				},
+			}, metav1.CreateOptions{})
+			s.Require().NoError(err, "failed to create pod %s", name)
+
+			pod.Status = v1.PodStatus{
+				Phase: v1.PodRunning,
+				ContainerStatuses: []v1.ContainerStatus{{
+					Name:  "test-container",
+					State: v1.ContainerState{Running: &v1.ContainerStateRunning{StartedAt: metav1.Now()}},
+				}},
+			}
+			pod, err = s.clientset.CoreV1().Pods(namespace).UpdateStatus(ctx, pod, metav1.UpdateOptions{})
+			s.Require().NoError(err, "failed to set initial status for pod %s", name)
+			time.Sleep(500 * time.Millisecond)
+
+			pod.Status.ContainerStatuses[0].RestartCount = 1
+			pod.Status.ContainerStatuses[0].State = v1.ContainerState{
+				Terminated: &v1.ContainerStateTerminated{ExitCode: 1, Reason: "Error"},
+			}
+			_, err = s.clientset.CoreV1().Pods(namespace).UpdateStatus(ctx, pod, metav1.UpdateOptions{})
+			s.Require().NoError(err, "failed to crash pod %s", name)
+		}
+
+		crashPod("unselected-crash-pod", nil)
+		crashPod("selected-crash-pod", map[string]string{"fault-detection": "enabled"})
+
+		select {
+		case signal := <-signalChan:
+			s.Equal("selected-crash-pod", signal.Name, "only the pod matching the selector should produce a signal")
+		case <-time.After(5 * time.Second):
+			s.Fail("timeout waiting for fault signal")
+		}
+
+		select {
+		case signal := <-signalChan:
+			s.Fail("unexpected fault signal", "pod %s is outside the label selector", signal.Name)
+		case <-time.After(time.Second):
+		}
+
+		for _, name := range []string{"unselected-crash-pod", "selected-crash-pod"} {
+			s.NoError(s.clientset.CoreV1().Pods(namespace).Delete(ctx, name, metav1.DeleteOptions{}), "failed to delete pod %s", name)
+		}
+	})
+}
+
+// TestResourceWatcher_InitialState verifies that faults resources are already in are reported on start
+func (s *ResourceWatcherTestSuite) TestResourceWatcher_InitialState() {
+	s.Run("reports a pod already crashlooping before the watch started", func() {
+		ctx := context.Background()
+		namespace := "default"
+
+		// Put the pod into CrashLoopBackOff before the watcher starts
+		pod, err := s.clientset.CoreV1().Pods(namespace).Create(ctx, &v1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "initial-crashloop-pod", Namespace: namespace},
+			Spec: v1.PodSpec{
+				Containers: []v1.Container{{Name: "test-container", Image: "nginx:latest"}},
+			},
+		}, metav1.CreateOptions{})
+		s.Require().NoError(err, "failed to create test pod")
+		defer func() {
+			s.NoError(s.clientset.CoreV1().Pods(namespace).Delete(ctx, pod.Name, metav1.DeleteOptions{}), "failed to delete test pod")
+		}()
+		pod.Status = v1.PodStatus{
+			Phase: v1.PodRunning,
+			ContainerStatuses: []v1.ContainerStatus{{
+				Name:         "test-container",
+				RestartCount: 5,
+				State: v1.ContainerState{Waiting: &v1.ContainerStateWaiting{
+					Reason:  "CrashLoopBackOff",
+					Message: "back-off 5m0s restarting failed container",
+				}},
+			}},
+		}
+		pod, err = s.clientset.CoreV1().Pods(namespace).UpdateStatus(ctx, pod, metav1.UpdateOptions{})
+		s.Require().NoError(err, "failed to put pod into CrashLoopBackOff")
+
+		signalChan := make(chan events.FaultSignal, 10)
+		watcher, err := events.NewResourceWatcher(events.ResourceWatcherConfig{
+			Clientset:        s.clientset,
+			Cluster:          "test-cluster",
+			ResyncPeriod:     10 * time.Minute,
+			NamespaceScope:   namespace,
+			Detectors:        []events.Detector{detectors.NewCrashLoopDetector()},
+			SendInitialState: true,
+			SignalCallback: func(_ context.Context, signal events.FaultSignal) {
+				signalChan <- signal
+			},
+		})
+		s.Require().NoError(err, "failed to create resource watcher")
+
+		watcherCtx, cancelWatcher := context.WithCancel(ctx)
+		defer cancelWatcher()
+		s.Require().NoError(watcher.Start(watcherCtx), "failed to start resource watcher")
+		defer watcher.Stop()
+
+		select {
+		case signal := <-signalChan:
+			s.Equal(events.FaultTypeCrashLoop, signal.FaultType)
+			s.Equal("initial-crashloop-pod", signal.Name)
+			s.Equal("test-container", signal.ContainerName)
+			s.Equal(events.SeverityCritical, signal.Severity)
+		case <-time.After(5 * time.Second):
+			s.Fail("timeout waiting for initial fault signal")
+		}
+
+		// The next crash loop transition is a duplicate of the initial fault
+		pod.Status.ContainerStatuses[0].State = v1.ContainerState{Running: &v1.ContainerStateRunning{StartedAt: metav1.Now()}}
+		pod, err = s.clientset.CoreV1().Pods(namespace).UpdateStatus(ctx, pod, metav1.UpdateOptions{})
+		s.Require().NoError(err, "failed to restart pod")
+		time.Sleep(500 * time.Millisecond)
+		pod.Status.ContainerStatuses[0].RestartCount = 6
+		pod.Status.ContainerStatuses[0].State = v1.ContainerState{Waiting: &v1.ContainerStateWaiting{Reason: "CrashLoopBackOff"}}
+		_, err = s.clientset.CoreV1().Pods(namespace).UpdateStatus(ctx, pod, metav1.UpdateOptions{})
+		s.Require().NoError(err, "failed to put pod back into CrashLoopBackOff")
+
+		select {
+		case signal := <-signalChan:
+			s.Fail("unexpected fault signal", "%s for %s should be deduplicated against the initial fault", signal.FaultType, signal.Name)
+		case <-time.After(time.Second):
+		}
+	})
+}
+
+// ResourceWatcherConfigSuite tests ResourceWatcher configuration handling without an API server
+type ResourceWatcherConfigSuite struct {
+	suite.Suite
+}
+
+func TestResourceWatcherConfigSuite(t *testing.T) {
+	suite.Run(t, new(ResourceWatcherConfigSuite))
+}
+
+// TestResourceWatcher_ResyncPeriodValidation verifies resync period defaulting, clamping, and rejection
+func (s *ResourceWatcherConfigSuite) TestResourceWatcher_ResyncPeriodValidation() {
+	newWatcher := func(resync time.Duration) (*events.ResourceWatcher, error) {
+		return events.NewResourceWatcher(events.ResourceWatcherConfig{
+			Clientset:    fake.NewClientset(),
+			Cluster:      "test-cluster",
+			ResyncPeriod: resync,
+		})
+	}
+
+	s.Run("zero uses the default resync period", func() {
+		watcher, err := newWatcher(0)
+		s.Require().NoError(err)
+		s.Equal(events.DefaultResyncPeriod, watcher.ResyncPeriod())
+	})
+
+	s.Run("values below the minimum are clamped to the minimum", func() {
+		for _, resync := range []time.Duration{time.Nanosecond, time.Second, events.MinResyncPeriod - time.Millisecond} {
+			watcher, err := newWatcher(resync)
+			s.Require().NoError(err)
+			s.Equal(events.MinResyncPeriod, watcher.ResyncPeriod(), "resync %s should be clamped", resync)
+		}
+	})
+
+	s.Run("values at or above the minimum are kept", func() {
+		for _, resync := range []time.Duration{events.MinResyncPeriod, 5 * time.Minute} {
+			watcher, err := newWatcher(resync)
+			s.Require().NoError(err)
+			s.Equal(resync, watcher.ResyncPeriod())
+		}
+	})
+
+	s.Run("negative values are rejected", func() {
+		watcher, err := newWatcher(-time.Second)
+		s.Require().Error(err)
+		s.Contains(err.Error(), "must not be negative")
+		s.Nil(watcher)
+	})
+}
+
+// TestResourceWatcher_ScopeOptions verifies label selector validation and informer scoping
+func (s *ResourceWatcherConfigSuite) TestResourceWatcher_ScopeOptions() {
+	s.Run("invalid label selector is rejected", func() {
+		watcher, err := events.NewResourceWatcher(events.ResourceWatcherConfig{
+			Clientset:     fake.NewClientset(),
+			Cluster:       "test-cluster",
+			LabelSelector: "app in (prod",
+		})
+		s.Require().Error(err)
+		s.Contains(err.Error(), "invalid label selector")
+		s.Nil(watcher)
+	})
+
+	s.Run("informers list with the label selector and namespace scope", func() {
+		clientset := fake.NewClientset()
+		watcher, err := events.NewResourceWatcher(events.ResourceWatcherConfig{
+			Clientset:      clientset,
+			Cluster:        "test-cluster",
+			LabelSelector:  "app=nginx",
+			NamespaceScope: "production",
+		})
+		s.Require().NoError(err)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		s.Require().NoError(watcher.Start(ctx))
+		defer watcher.Stop()
+
+		listed := map[string]k8stesting.ListAction{}
+		for _, action := range clientset.Actions() {
+			if listAction, ok := action.(k8stesting.ListAction); ok {
+				listed[action.GetResource().Resource] = listAction
+			}
+		}
+
+		for _, resource := range []string{"pods", "deployments", "jobs", "endpointslices"} {
+			s.Require().Contains(listed, resource)
+			s.Equal("production", listed[resource].GetNamespace(), "%s should be listed in the scoped namespace", resource)
+			s.Equal("app=nginx", listed[resource].GetListRestrictions().Labels.String(), "%s should be listed with the label selector", resource)
+		}
+		s.Require().Contains(listed, "nodes")
+		s.Empty(listed["nodes"].GetNamespace(), "nodes are cluster-scoped")
+		s.Require().Contains(listed, "events")
+		s.Equal("production", listed["events"].GetNamespace(), "events should be listed in the scoped namespace")
+		s.Empty(listed["events"].GetListRestrictions().Labels.String(), "events don't carry their object's labels")
+		s.Equal("type=Warning", listed["events"].GetListRestrictions().Fields.String(), "only Warning events should be listed")
+	})
+
+	s.Run("typed detectors only start informers for their resource kinds", func() {
+		clientset := fake.NewClientset()
+		watcher, err := events.NewResourceWatcher(events.ResourceWatcherConfig{
+			Clientset: clientset,
+			Cluster:   "test-cluster",
+			Detectors: []events.Detector{detectors.NewPodCrashDetector(), detectors.NewOOMKillDetector()},
+		})
+		s.Require().NoError(err)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		s.Require().NoError(watcher.Start(ctx))
+		defer watcher.Stop()
+
+		listed := map[string]bool{}
+		for _, action := range clientset.Actions() {
+			if _, ok := action.(k8stesting.ListAction); ok {
+				listed[action.GetResource().Resource] = true
+			}
+		}
+		s.Equal(map[string]bool{"pods": true}, listed, "only the pod informer should be started")
+	})
+}
+
+// TestResourceWatcher_DynamicDetectors verifies custom resources are watched through the dynamic client
+func (s *ResourceWatcherConfigSuite) TestResourceWatcher_DynamicDetectors() {
+	widgets := schema.GroupVersionResource{Group: "example.com", Version: "v1", Resource: "widgets"}
+	newWidget := func(readyStatus string) *unstructured.Unstructured {
+		return &unstructured.Unstructured{Object: map[string]interface{}{
+			"apiVersion": "example.com/v1",
+			"kind":       "Widget",
+			"metadata": map[string]interface{}{
+				"name":      "widget-1",
+				"namespace": "default",
+				"uid":       "widget-uid",
+			},
+			"status": map[string]interface{}{
+				"conditions": []interface{}{
+					map[string]interface{}{"type": "Ready", "status": readyStatus, "reason": "BackendUnavailable"},
+				},
+			},
+		}}
+	}
+
+	s.Run("dynamic detectors require a dynamic client", func() {
+		watcher, err := events.NewResourceWatcher(events.ResourceWatcherConfig{
+			Clientset: fake.NewClientset(),
+			Cluster:   "test-cluster",
+			Detectors: []events.Detector{detectors.NewGenericConditionDetector(widgets, "Ready", "False")},
+		})
+		s.Require().Error(err)
+		s.Contains(err.Error(), "dynamic client is required")
+		s.Nil(watcher)
+	})
+
+	s.Run("condition transition on a custom resource emits a custom fault", func() {
+		dynamicClient := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(k8sruntime.NewScheme(),
+			map[schema.GroupVersionResource]string{widgets: "WidgetList"}, newWidget("True"))
+		signals := make(chan events.FaultSignal, 10)
+		watcher, err := events.NewResourceWatcher(events.ResourceWatcherConfig{
+			Clientset:     fake.NewClientset(),
+			DynamicClient: dynamicClient,
+			Cluster:       "test-cluster",
+			Detectors: []events.Detector{
+				detectors.NewPodCrashDetector(),
+				detectors.NewGenericConditionDetector(widgets, "Ready", "False"),
+			},
+			SignalCallback: func(_ context.Context, signal events.FaultSignal) {
+				signals <- signal
+			},
+		})
+		s.Require().NoError(err)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		s.Require().NoError(watcher.Start(ctx))
+		defer watcher.Stop()
+
+		_, err = dynamicClient.Resource(widgets).Namespace("default").Update(ctx, newWidget("False"), metav1.UpdateOptions{})
+		s.Require().NoError(err)
+
+		select {
+		case signal := <-signals:
+			s.Equal(events.FaultTypeCustom, signal.FaultType)
+			s.Equal("example.com/v1", signal.APIVersion)
+			s.Equal("Widget", signal.Kind)
+			s.Equal("widget-1", signal.Name)
+			s.Equal("default", signal.Namespace)
+			s.Contains(signal.Context, "condition Ready changed to False")
+			s.Contains(signal.Context, "reason: BackendUnavailable")
+		case <-time.After(5 * time.Second):
+			s.Fail("timed out waiting for custom fault signal")
+		}
+	})
+}
+
+// blockingStateDetector is an InitialStateDetector whose DetectState blocks until release is closed
+type blockingStateDetector struct {
+	labelChangeDetector
+	scanning chan struct{}
+	release  chan struct{}
+}
+
+// DetectState signals the scan started and waits for release
+func (d *blockingStateDetector) DetectState(obj interface{}) []events.FaultSignal {
+	select {
+	case d.scanning <- struct{}{}:
+	default:
+	}
+	<-d.release
+	return []events.FaultSignal{}
+}
+
+// labelChangeDetector is a custom detector that reports every pod label change
+type labelChangeDetector struct{}
+
+// Describe describes the detector
+func (d *labelChangeDetector) Describe() events.DetectorInfo {
+	return events.DetectorInfo{FaultTypes: []events.FaultType{"LabelChange"}, ResourceKinds: []string{"Pod"}, Description: "pod label changes"}
+}
+
+// Detect emits a signal when the number of pod labels changes
+func (d *labelChangeDetector) Detect(oldObj, newObj interface{}) []events.FaultSignal {
+	oldPod, ok := oldObj.(*v1.Pod)
+	if !ok {
+		return []events.FaultSignal{}
+	}
+	newPod, ok := newObj.(*v1.Pod)
+	if !ok || len(oldPod.Labels) == len(newPod.Labels) {
+		return []events.FaultSignal{}
+	}
+	return []events.FaultSignal{{
+		FaultType:   "LabelChange",
+		ResourceUID: newPod.UID,
+		Kind:        "Pod",
+		Name:        newPod.Name,
+		Namespace:   newPod.Namespace,
+		Severity:    events.SeverityInfo,
+		Context:     "Pod labels changed",
+		Timestamp:   time.Now(),
+	}}
+}
+
+// TestResourceWatcher_RegisteredDetectors tests that detectors built from a registry run in the watcher
+func (s *ResourceWatcherConfigSuite) TestResourceWatcher_RegisteredDetectors() {
+	s.Run("custom detector from a registry participates in the pipeline", func() {
+		registry := events.NewDetectorRegistry()
+		registry.Register("PodCrash", func() events.Detector { return detectors.NewPodCrashDetector() })
+		registry.Register("LabelChange", func() events.Detector { return &labelChangeDetector{} })
+
+		built, err := registry.Build([]string{"LabelChange"})
+		s.Require().NoError(err)
+
+		clientset := fake.NewClientset()
+		signals := make(chan events.FaultSignal, 10)
+		watcher, err := events.NewResourceWatcher(events.ResourceWatcherConfig{
+			Clientset: clientset,
+			Cluster:   "test-cluster",
+			Detectors: built,
+			SignalCallback: func(_ context.Context, signal events.FaultSignal) {
+				signals <- signal
+			},
+		})
+		s.Require().NoError(err)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		s.Require().NoError(watcher.Start(ctx))
+		defer watcher.Stop()
+
+		pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "labelled-pod", Namespace: "default", UID: "labelled-uid"}}
+		_, err = clientset.CoreV1().Pods("default").Create(ctx, pod, metav1.CreateOptions{})
+		s.Require().NoError(err)
+		updated := pod.DeepCopy()
+		updated.Labels = map[string]string{"app": "web"}
+		_, err = clientset.CoreV1().Pods("default").Update(ctx, updated, metav1.UpdateOptions{})
+		s.Require().NoError(err)
+
+		select {
+		case signal := <-signals:
+			s.Equal(events.FaultType("LabelChange"), signal.FaultType)
+			s.Equal("labelled-pod", signal.Name)
+		case <-time.After(5 * time.Second):
+			s.Fail("timed out waiting for custom fault signal")
+		}
+	})
+}
+
+// TestResourceWatcher_InitialState verifies that initial state is only reported when requested
+func (s *ResourceWatcherConfigSuite) TestResourceWatcher_InitialState() {
+	unhealthyNode := &v1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: "node-1", UID: "node-uid"},
+		Status: v1.NodeStatus{Conditions: []v1.NodeCondition{
+			{Type: v1.NodeReady, Status: v1.ConditionFalse, Reason: "KubeletNotReady"},
+		}},
+	}
+	healthyNode := &v1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: "node-2", UID: "node-uid-2"},
+		Status: v1.NodeStatus{Conditions: []v1.NodeCondition{
+			{Type: v1.NodeReady, Status: v1.ConditionTrue},
+		}},
+	}
+	failedJob := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{Name: "job-1", Namespace: "default", UID: "job-uid"},
+		Status: batchv1.JobStatus{Conditions: []batchv1.JobCondition{
+			{Type: batchv1.JobFailed, Status: v1.ConditionTrue, Reason: "BackoffLimitExceeded"},
+		}},
+	}
+
+	// startWatcher starts a watcher on the existing resources and returns the signals it emits
+	startWatcher := func(sendInitialState bool) <-chan events.FaultSignal {
+		signals := make(chan events.FaultSignal, 10)
+		watcher, err := events.NewResourceWatcher(events.ResourceWatcherConfig{
+			Clientset:        fake.NewClientset(unhealthyNode, healthyNode, failedJob),
+			Cluster:          "test-cluster",
+			Detectors:        []events.Detector{detectors.NewNodeUnhealthyDetector(), detectors.NewJobFailureDetector()},
+			SendInitialState: sendInitialState,
+			SignalCallback: func(_ context.Context, signal events.FaultSignal) {
+				signals <- signal
+			},
+		})
+		s.Require().NoError(err)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		s.T().Cleanup(cancel)
+		s.Require().NoError(watcher.Start(ctx))
+		s.T().Cleanup(watcher.Stop)
+		return signals
+	}
+
+	s.Run("reports resources already in a fault", func() {
+		signals := startWatcher(true)
+
+		var reported []string
+		for range 2 {
+			select {
+			case signal := <-signals:
+				reported = append(reported, string(signal.FaultType)+" "+signal.Name)
+			case <-time.After(5 * time.Second):
+				s.FailNow("timed out waiting for initial fault signals")
+			}
+		}
+		s.ElementsMatch([]string{"NodeUnhealthy node-1", "JobFailure job-1"}, reported)
+		s.Empty(signals, "healthy resources should not be reported")
+	})
+
+	s.Run("is not reported by default", func() {
+		signals := startWatcher(false)
+
+		select {
+		case signal := <-signals:
+			s.Fail("unexpected fault signal", "%s for %s", signal.FaultType, signal.Name)
+		case <-time.After(100 * time.Millisecond):
+		}
+	})
+
+	s.Run("is scanned after Start returns", func() {
+		detector := &blockingStateDetector{scanning: make(chan struct{}, 1), release: make(chan struct{})}
+		defer close(detector.release)
+		watcher, err := events.NewResourceWatcher(events.ResourceWatcherConfig{
+			Clientset:        fake.NewClientset(&v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default"}}),
+			Cluster:          "test-cluster",
+			Detectors:        []events.Detector{detector},
+			SendInitialState: true,
+			SignalCallback:   func(context.Context, events.FaultSignal) {},
+		})
+		s.Require().NoError(err)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		started := make(chan error, 1)
+		go func() { started <- watcher.Start(ctx) }()
+		defer watcher.Stop()
+
+		select {
+		case err := <-started:
+			s.Require().NoError(err)
+		case <-time.After(5 * time.Second):
+			s.FailNow("Start waited for the initial state scan")
+		}
+		select {
+		case <-detector.scanning:
+		case <-time.After(5 * time.Second):
+			s.Fail("initial state was not scanned")
+		}
+	})
+}
+
+// TestResourceWatcher_AdmissionRejections verifies that Warning events are run through the event detectors
+func (s *ResourceWatcherConfigSuite) TestResourceWatcher_AdmissionRejections() {
+	newEvent := func(name string) *v1.Event {
+		return &v1.Event{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+			InvolvedObject: v1.ObjectReference{
+				Kind:      "ReplicaSet",
+				Name:      "web-7d4b9c",
+				Namespace: "default",
+				UID:       "rs-uid",
+			},
+			Type:   v1.EventTypeWarning,
+			Reason: "FailedCreate",
+			Message: `Error creating: pods "web-7d4b9c-x2k4p" is forbidden: violates PodSecurity "restricted:latest": ` +
+				`privileged (container "web" must not set securityContext.privileged=true)`,
+			Count: 1,
+		}
+	}
+
+	s.Run("rejection reported after the watch started emits a fault signal", func() {
+		clientset := fake.NewClientset(newEvent("web-7d4b9c.old"))
+		signals := make(chan events.FaultSignal, 10)
+		watcher, err := events.NewResourceWatcher(events.ResourceWatcherConfig{
+			Clientset: clientset,
+			Cluster:   "test-cluster",
+			Detectors: []events.Detector{detectors.NewAdmissionRejectedDetector()},
+			SignalCallback: func(_ context.Context, signal events.FaultSignal) {
+				signals <- signal
+			},
+		})
+		s.Require().NoError(err)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		s.Require().NoError(watcher.Start(ctx))
+		defer watcher.Stop()
+
+		select {
+		case signal := <-signals:
+			s.FailNow("unexpected fault signal", "events listed when the watch starts should not be reported, got %s", signal.FaultType)
+		case <-time.After(100 * time.Millisecond):
+		}
+
+		_, err = clientset.CoreV1().Events("default").Create(ctx, newEvent("web-7d4b9c.new"), metav1.CreateOptions{})
+		s.Require().NoError(err)
+
+		select {
+		case signal := <-signals:
+			s.Equal(events.FaultTypeAdmissionRejected, signal.FaultType)
+			s.Equal("ReplicaSet", signal.Kind)
+			s.Equal("web-7d4b9c", signal.Name)
+			s.Contains(signal.Context, `violates PodSecurity "restricted:latest"`)
+			s.Equal("restricted:latest", signal.Details["podSecurity"])
+		case <-time.After(5 * time.Second):
+			s.Fail("timed out waiting for admission rejection fault signal")
+		}
+	})
+}
+
+// TestResourceWatcher_ServiceUpdates verifies that Service updates are run through the Service detectors
+func (s *ResourceWatcherConfigSuite) TestResourceWatcher_ServiceUpdates() {
+	s.Run("load balancer stuck without ingress emits a fault signal", func() {
+		service := &v1.Service{
+			ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default", UID: "svc-uid"},
+			Spec: v1.ServiceSpec{
+				Type:  v1.ServiceTypeLoadBalancer,
+				Ports: []v1.ServicePort{{Name: "http", Port: 80, Protocol: v1.ProtocolTCP}},
+			},
+		}
+		clientset := fake.NewClientset(service)
+		signals := make(chan events.FaultSignal, 10)
+		watcher, err := events.NewResourceWatcher(events.ResourceWatcherConfig{
+			Clientset: clientset,
+			Cluster:   "test-cluster",
+			Detectors: []events.Detector{detectors.NewLoadBalancerPendingDetectorWithWindow(0)},
+			SignalCallback: func(_ context.Context, signal events.FaultSignal) {
+				signals <- signal
+			},
+		})
+		s.Require().NoError(err)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		s.Require().NoError(watcher.Start(ctx))
+		defer watcher.Stop()
+
+		// The first update starts tracking the pending Service, the second reports it
+		for _, revision := range []string{"1", "2"} {
+			service = service.DeepCopy()
+			service.Annotations = map[string]string{"revision": revision}
+			_, err = clientset.CoreV1().Services("default").Update(ctx, service, metav1.UpdateOptions{})
+			s.Require().NoError(err)
+			time.Sleep(10 * time.Millisecond)
+		}
+
+		select {
+		case signal := <-signals:
+			s.Equal(events.FaultTypeLoadBalancerPending, signal.FaultType)
+			s.Equal("Service", signal.Kind)
+			s.Equal("web", signal.Name)
+			s.Equal("http 80/TCP", signal.Details["ports"])
+		case <-time.After(5 * time.Second):
+			s.Fail("timed out waiting for load balancer pending fault signal")
+		}
+	})
+}
+
+// TestResourceWatcher_PersistentVolumeUpdates verifies that PersistentVolume updates are run through the PersistentVolume detectors
+func (s *ResourceWatcherConfigSuite) TestResourceWatcher_PersistentVolumeUpdates() {
+	s.Run("PV failing to be deleted emits a fault signal", func() {
+		pv := &v1.PersistentVolume{
+			ObjectMeta: metav1.ObjectMeta{Name: "pv-data", UID: "pv-uid"},
+			Spec:       v1.PersistentVolumeSpec{PersistentVolumeReclaimPolicy: v1.PersistentVolumeReclaimDelete},
+			Status:     v1.PersistentVolumeStatus{Phase: v1.VolumeReleased},
+		}
+		clientset := fake.NewClientset(pv)
+		signals := make(chan events.FaultSignal, 10)
+		watcher, err := events.NewResourceWatcher(events.ResourceWatcherConfig{
+			Clientset:      clientset,
+			Cluster:        "test-cluster",
+			NamespaceScope: "default",
+			Detectors:      []events.Detector{detectors.NewPVDetector()},
+			SignalCallback: func(_ context.Context, signal events.FaultSignal) {
+				signals <- signal
+			},
+		})
+		s.Require().NoError(err)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		s.Require().NoError(watcher.Start(ctx))
+		defer watcher.Stop()
+
+		pv = pv.DeepCopy()
+		pv.Status.Phase = v1.VolumeFailed
+		pv.Status.Message = "error deleting volume"
+		_, err = clientset.CoreV1().PersistentVolumes().UpdateStatus(ctx, pv, metav1.UpdateOptions{})
+		s.Require().NoError(err)
+
+		select {
+		case signal := <-signals:
+			s.Equal(events.FaultTypePVFailed, signal.FaultType)
+			s.Equal("PersistentVolume", signal.Kind)
+			s.Equal("pv-data", signal.Name)
+			s.Equal("error deleting volume", signal.Details["message"])
+		case <-time.After(5 * time.Second):
+			s.Fail("timed out waiting for PV failure fault signal")
+		}
+	})
+}
+
+// TestResourceWatcher_ContextLimit verifies that oversized fault context is truncated
+func (s *ResourceWatcherConfigSuite) TestResourceWatcher_ContextLimit() {
+	panicTrace := "panic: runtime error: invalid memory address or nil pointer dereference\n" +
+		"[signal SIGSEGV: segmentation violation code=0x1 addr=0x0 pc=0x48f2a1]\n\n" +
+		strings.Repeat("goroutine 1 [running]:\nmain.handler(0x0)\n\t/app/main.go:42 +0x21\n", 200)
+
+	// crashPod returns a pod whose container restarted restarts times, last with the panic trace
+	crashPod := func(restarts int32) *v1.Pod {
+		status := v1.ContainerStatus{Name: "app", RestartCount: restarts}
+		if restarts > 0 {
+			status.State.Terminated = &v1.ContainerStateTerminated{ExitCode: 2, Reason: "Error", Message: panicTrace}
+		}
+		return &v1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "crashing-pod", Namespace: "default", UID: "crashing-uid", ResourceVersion: strconv.Itoa(int(restarts) + 1)},
+			Status:     v1.PodStatus{ContainerStatuses: []v1.ContainerStatus{status}},
+		}
+	}
+
+	// detectCrash starts a watcher with the context limit, crashes the pod and returns the signal
+	detectCrash := func(maxContextBytes int) events.FaultSignal {
+		clientset := fake.NewClientset(crashPod(0))
+		signals := make(chan events.FaultSignal, 10)
+		watcher, err := events.NewResourceWatcher(events.ResourceWatcherConfig{
+			Clientset:       clientset,
+			Cluster:         "test-cluster",
+			Detectors:       []events.Detector{detectors.NewPodCrashDetector()},
+			MaxContextBytes: maxContextBytes,
+			SignalCallback: func(_ context.Context, signal events.FaultSignal) {
+				signals <- signal
+			},
+		})
+		s.Require().NoError(err)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		s.T().Cleanup(cancel)
+		s.Require().NoError(watcher.Start(ctx))
+		s.T().Cleanup(watcher.Stop)
+
+		_, err = clientset.CoreV1().Pods("default").UpdateStatus(ctx, crashPod(1), metav1.UpdateOptions{})
+		s.Require().NoError(err)
+
+		select {
+		case signal := <-signals:
+			return signal
+		case <-time.After(5 * time.Second):
+			s.FailNow("timed out waiting for pod crash fault signal")
+			return events.FaultSignal{}
+		}
+	}
+
+	s.Run("oversized termination message is truncated keeping its leading diagnostics", func() {
+		signal := detectCrash(256)
+
+		s.Equal(events.FaultTypePodCrash, signal.FaultType)
+		s.Len(signal.Context, 256)
+		s.True(strings.HasPrefix(signal.Context, "Container crashed with exit code 2, reason: Error, message: panic: runtime error: invalid memory address"),
+			"context should keep its leading portion, got %q", signal.Context)
+		s.True(strings.HasSuffix(signal.Context, "...[truncated]"))
+		s.Len(signal.Details["message"], 256)
+		s.True(strings.HasPrefix(signal.Details["message"], "panic: runtime error"))
+		s.True(strings.HasSuffix(signal.Details["message"], "...[truncated]"))
+		s.Equal("2", signal.Details["exitCode"], "short details are kept")
+	})
+
+	s.Run("default limit truncates to DefaultMaxContextBytes", func() {
+		signal := detectCrash(0)
+
+		s.Len(signal.Context, events.DefaultMaxContextBytes)
+		s.True(strings.HasSuffix(signal.Context, "...[truncated]"))
+	})
+
+	s.Run("context within the limit is kept", func() {
+		signal := detectCrash(len(panicTrace) * 2)
+
+		s.Equal("Container crashed with exit code 2, reason: Error, message: "+panicTrace, signal.Context)
+		s.Equal(panicTrace, signal.Details["message"])
+	})
+
+	s.Run("negative limits are rejected", func() {
+		watcher, err := events.NewResourceWatcher(events.ResourceWatcherConfig{
+			Clientset:       fake.NewClientset(),
+			Cluster:         "test-cluster",
+			MaxContextBytes: -1,
+		})
+		s.Require().Error(err)
+		s.Contains(err.Error(), "must not be negative")
+		s.Nil(watcher)
+	})
+}
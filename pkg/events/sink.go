@@ -0,0 +1,54 @@
+package events
+
+import (
+	"context"
+	"errors"
+	"net/url"
+
+	"k8s.io/klog/v2"
+)
+
+// NotificationSink delivers fault notifications to an external destination such as a
+// chat webhook, in addition to the MCP sessions subscribed to the cluster.
+//
+// Implementations must be safe for concurrent use.
+type NotificationSink interface {
+	// Name identifies the sink in logs.
+	Name() string
+
+	// Send delivers a single fault notification. It should return promptly once ctx is done.
+	Send(ctx context.Context, notification *ResourceFaultNotification) error
+}
+
+// forwardToSinks delivers a fault notification to every configured sink in the background,
+// so slow external destinations never delay session notifications.
+func (m *EventSubscriptionManager) forwardToSinks(notification *ResourceFaultNotification) {
+	for _, sink := range m.config.Sinks {
+		go func(sink NotificationSink) {
+			ctx, cancel := context.WithTimeout(context.Background(), m.config.NotificationTimeout)
+			defer cancel()
+
+			if err := sink.Send(ctx, notification); err != nil {
+				klog.Warningf("Failed to deliver fault %s to sink %s: %v", notification.FaultID, sink.Name(), err)
+				return
+			}
+			klog.V(2).Infof("Delivered fault %s to sink %s", notification.FaultID, sink.Name())
+		}(sink)
+	}
+}
+
+// redactURL returns only the scheme and host of a sink URL, so errors and logs don't
+// leak the secret tokens webhook URLs carry in their path or query.
+func redactURL(u *url.URL) string {
+	return u.Scheme + "://" + u.Host
+}
+
+// redactURLError replaces the URL in the *url.Error returned by http.Client.Do with
+// its scheme and host. Other errors are returned unchanged.
+func redactURLError(err error, u *url.URL) error {
+	var urlErr *url.Error
+	if !errors.As(err, &urlErr) {
+		return err
+	}
+	return &url.Error{Op: urlErr.Op, URL: redactURL(u), Err: urlErr.Err}
+}
@@ -0,0 +1,228 @@
+package events
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// Attachment colors used for each fault severity in Slack messages.
+const (
+	SlackColorCritical = "#E01E5A" // red
+	SlackColorWarning  = "#ECB22E" // yellow
+	SlackColorInfo     = "#36C5F0" // blue
+)
+
+// Maximum lengths of Slack Block Kit text fields.
+const (
+	slackMaxTextLength   = 3000 // section block text
+	slackMaxHeaderLength = 150  // header block text
+)
+
+// Default rate limit for Slack incoming webhooks, which allow roughly one message per second.
+const (
+	DefaultSlackRateLimit = rate.Limit(1)
+	DefaultSlackBurst     = 3
+)
+
+// SlackSinkConfig holds configuration for a SlackSink.
+type SlackSinkConfig struct {
+	// WebhookURL is the Slack incoming-webhook URL faults are posted to. Required.
+	WebhookURL string
+
+	// RateLimit is the sustained number of messages per second sent to the webhook.
+	// Default: 1
+	RateLimit rate.Limit
+
+	// Burst is the number of messages that may be sent at once before rate limiting applies.
+	// Default: 3
+	Burst int
+
+	// HTTPClient is used to post messages. Default: a client with a 10s timeout.
+	HTTPClient *http.Client
+}
+
+// SlackSink is a NotificationSink that posts faults to a Slack incoming webhook
+// formatted as Block Kit messages, colored by severity.
+// Sends wait for the rate limiter so bursts of faults respect Slack's limits; a send
+// whose context expires before it is allowed through fails without posting.
+type SlackSink struct {
+	webhookURL *url.URL
+	client     *http.Client
+	limiter    *rate.Limiter
+}
+
+// NewSlackSink creates a SlackSink from the given configuration.
+// Returns an error if the webhook URL is missing or invalid.
+func NewSlackSink(config SlackSinkConfig) (*SlackSink, error) {
+	if config.WebhookURL == "" {
+		return nil, fmt.Errorf("slack webhook URL is required")
+	}
+	// Webhook URLs embed their secret, so errors only mention the scheme and host
+	parsed, err := url.Parse(config.WebhookURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid slack webhook URL: not a valid URL")
+	}
+	if (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return nil, fmt.Errorf("invalid slack webhook URL %q: must be an http or https URL with a host", redactURL(parsed))
+	}
+
+	if config.RateLimit <= 0 {
+		config.RateLimit = DefaultSlackRateLimit
+	}
+	if config.Burst <= 0 {
+		config.Burst = DefaultSlackBurst
+	}
+	if config.HTTPClient == nil {
+		config.HTTPClient = &http.Client{Timeout: 10 * time.Second}
+	}
+
+	return &SlackSink{
+		webhookURL: parsed,
+		client:     config.HTTPClient,
+		limiter:    rate.NewLimiter(config.RateLimit, config.Burst),
+	}, nil
+}
+
+// Name identifies the sink in logs.
+func (s *SlackSink) Name() string {
+	return "slack"
+}
+
+// Send posts a fault notification to the Slack webhook.
+func (s *SlackSink) Send(ctx context.Context, notification *ResourceFaultNotification) error {
+	if err := s.limiter.Wait(ctx); err != nil {
+		return fmt.Errorf("slack rate limit: %w", err)
+	}
+
+	body, err := json.Marshal(formatSlackMessage(notification))
+	if err != nil {
+		return fmt.Errorf("failed to encode slack message: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.webhookURL.String(), bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create slack request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to post to slack: %w", redactURLError(err, s.webhookURL))
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		respBody, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("slack webhook returned status %d: %s", resp.StatusCode, bytes.TrimSpace(respBody))
+	}
+	return nil
+}
+
+// slackMessage is the payload posted to a Slack incoming webhook.
+// Blocks are nested in an attachment so the message carries a severity color bar.
+type slackMessage struct {
+	Text        string            `json:"text"`
+	Attachments []slackAttachment `json:"attachments"`
+}
+
+// slackAttachment is a colored container for Block Kit blocks.
+type slackAttachment struct {
+	Color  string       `json:"color"`
+	Blocks []slackBlock `json:"blocks"`
+}
+
+// slackBlock is a Block Kit layout block.
+type slackBlock struct {
+	Type     string       `json:"type"`
+	Text     *slackText   `json:"text,omitempty"`
+	Fields   []*slackText `json:"fields,omitempty"`
+	Elements []*slackText `json:"elements,omitempty"`
+}
+
+// slackText is a Block Kit text object.
+type slackText struct {
+	Type string `json:"type"`
+	Text string `json:"text"`
+}
+
+// formatSlackMessage converts a fault notification into a Slack Block Kit message.
+func formatSlackMessage(notification *ResourceFaultNotification) *slackMessage {
+	resource := notification.Resource
+	if resource == nil {
+		resource = &ResourceReference{}
+	}
+
+	resourceName := resource.Kind + "/" + resource.Name
+	summary := fmt.Sprintf("%s: %s", notification.FaultType, resourceName)
+	if resource.Namespace != "" {
+		summary = fmt.Sprintf("%s: %s in %s", notification.FaultType, resourceName, resource.Namespace)
+	}
+
+	namespace := resource.Namespace
+	if namespace == "" {
+		namespace = "-"
+	}
+
+	blocks := []slackBlock{
+		{
+			Type: "header",
+			Text: &slackText{Type: "plain_text", Text: truncateContext(summary, slackMaxHeaderLength)},
+		},
+		{
+			Type: "section",
+			Fields: []*slackText{
+				{Type: "mrkdwn", Text: fmt.Sprintf("*Severity:*\n%s", notification.Severity)},
+				{Type: "mrkdwn", Text: fmt.Sprintf("*Cluster:*\n%s", notification.Cluster)},
+				{Type: "mrkdwn", Text: fmt.Sprintf("*Resource:*\n%s", resourceName)},
+				{Type: "mrkdwn", Text: fmt.Sprintf("*Namespace:*\n%s", namespace)},
+			},
+		},
+	}
+
+	if notification.Context != "" {
+		blocks = append(blocks, slackBlock{
+			Type: "section",
+			Text: &slackText{Type: "mrkdwn", Text: formatSlackCodeBlock(notification.Context)},
+		})
+	}
+
+	blocks = append(blocks, slackBlock{
+		Type: "context",
+		Elements: []*slackText{
+			{Type: "mrkdwn", Text: fmt.Sprintf("Fault ID: `%s` | %s", notification.FaultID, notification.Timestamp)},
+		},
+	})
+
+	return &slackMessage{
+		Text: fmt.Sprintf("[%s] %s", notification.Severity, summary),
+		Attachments: []slackAttachment{
+			{Color: slackSeverityColor(notification.Severity), Blocks: blocks},
+		},
+	}
+}
+
+// formatSlackCodeBlock wraps text in a code block, truncating it to fit Slack's text limit.
+func formatSlackCodeBlock(text string) string {
+	const fence = "```"
+	return fence + truncateContext(text, slackMaxTextLength-2*len(fence)) + fence
+}
+
+// slackSeverityColor returns the attachment color for a fault severity.
+func slackSeverityColor(severity Severity) string {
+	switch severity {
+	case SeverityCritical:
+		return SlackColorCritical
+	case SeverityWarning:
+		return SlackColorWarning
+	default:
+		return SlackColorInfo
+	}
+}
@@ -0,0 +1,307 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	"github.com/stretchr/testify/suite"
+	"golang.org/x/time/rate"
+)
+
+type SlackSinkSuite struct {
+	suite.Suite
+	server   *httptest.Server
+	mu       sync.Mutex
+	requests []*http.Request
+	bodies   [][]byte
+	status   int
+}
+
+func TestSlackSinkSuite(t *testing.T) {
+	suite.Run(t, new(SlackSinkSuite))
+}
+
+func (s *SlackSinkSuite) SetupTest() {
+	s.resetRecorded()
+	s.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		s.mu.Lock()
+		s.requests = append(s.requests, r)
+		s.bodies = append(s.bodies, body)
+		status := s.status
+		s.mu.Unlock()
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte("ok"))
+	}))
+}
+
+func (s *SlackSinkSuite) SetupSubTest() {
+	s.resetRecorded()
+}
+
+func (s *SlackSinkSuite) TearDownTest() {
+	s.server.Close()
+}
+
+// resetRecorded clears the requests recorded by the test webhook.
+func (s *SlackSinkSuite) resetRecorded() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.requests = nil
+	s.bodies = nil
+	s.status = http.StatusOK
+}
+
+// setStatus sets the status code returned by the test webhook.
+func (s *SlackSinkSuite) setStatus(status int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.status = status
+}
+
+// recordedRequests returns the requests received by the test webhook.
+func (s *SlackSinkSuite) recordedRequests() []*http.Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]*http.Request(nil), s.requests...)
+}
+
+// lastMessage decodes the most recent message posted to the test webhook.
+func (s *SlackSinkSuite) lastMessage() map[string]any {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.Require().NotEmpty(s.bodies, "expected a message to be posted")
+
+	var message map[string]any
+	s.Require().NoError(json.Unmarshal(s.bodies[len(s.bodies)-1], &message))
+	return message
+}
+
+func makeSlackNotification(severity Severity) *ResourceFaultNotification {
+	return &ResourceFaultNotification{
+		SubscriptionID: "sub-1",
+		Cluster:        "prod",
+		FaultID:        "fault-123",
+		FaultType:      FaultTypeCrashLoop,
+		Severity:       severity,
+		Resource: &ResourceReference{
+			APIVersion: "v1",
+			Kind:       "Pod",
+			Name:       "api-7d9f",
+			Namespace:  "payments",
+			UID:        "pod-uid",
+		},
+		Context:   "Container entered CrashLoopBackOff state, restart count: 5",
+		Timestamp: "2025-01-01T12:00:00Z",
+	}
+}
+
+func (s *SlackSinkSuite) TestNewSlackSink() {
+	s.Run("requires a webhook URL", func() {
+		_, err := NewSlackSink(SlackSinkConfig{})
+		s.ErrorContains(err, "webhook URL is required")
+	})
+
+	s.Run("rejects invalid webhook URLs", func() {
+		for _, webhookURL := range []string{"not a url", "ftp://hooks.slack.com/services/x", "https://"} {
+			_, err := NewSlackSink(SlackSinkConfig{WebhookURL: webhookURL})
+			s.ErrorContains(err, "invalid slack webhook URL", "URL %q should be rejected", webhookURL)
+		}
+	})
+
+	s.Run("doesn't leak the webhook secret in errors", func() {
+		_, err := NewSlackSink(SlackSinkConfig{WebhookURL: "ftp://hooks.slack.com/services/T000/B000/secret-token"})
+		s.ErrorContains(err, `"ftp://hooks.slack.com"`)
+		s.NotContains(err.Error(), "secret-token")
+	})
+
+	s.Run("applies default rate limit", func() {
+		sink, err := NewSlackSink(SlackSinkConfig{WebhookURL: s.server.URL})
+		s.Require().NoError(err)
+		s.Equal(DefaultSlackRateLimit, sink.limiter.Limit())
+		s.Equal(DefaultSlackBurst, sink.limiter.Burst())
+	})
+}
+
+func (s *SlackSinkSuite) TestSend() {
+	s.Run("posts Block Kit JSON with fault details", func() {
+		sink, err := NewSlackSink(SlackSinkConfig{WebhookURL: s.server.URL})
+		s.Require().NoError(err)
+
+		s.Require().NoError(sink.Send(context.Background(), makeSlackNotification(SeverityWarning)))
+
+		requests := s.recordedRequests()
+		s.Require().Len(requests, 1)
+		s.Equal(http.MethodPost, requests[0].Method)
+		s.Equal("application/json", requests[0].Header.Get("Content-Type"))
+
+		message := s.lastMessage()
+		s.Equal("[warning] CrashLoop: Pod/api-7d9f in payments", message["text"])
+
+		attachments := message["attachments"].([]any)
+		s.Require().Len(attachments, 1)
+		attachment := attachments[0].(map[string]any)
+		s.Equal(SlackColorWarning, attachment["color"])
+
+		blocks := attachment["blocks"].([]any)
+		s.Require().Len(blocks, 4)
+
+		header := blocks[0].(map[string]any)
+		s.Equal("header", header["type"])
+		s.Equal("CrashLoop: Pod/api-7d9f in payments", header["text"].(map[string]any)["text"])
+
+		fields := blocks[1].(map[string]any)["fields"].([]any)
+		s.Require().Len(fields, 4)
+		s.Equal("*Severity:*\nwarning", fields[0].(map[string]any)["text"])
+		s.Equal("*Cluster:*\nprod", fields[1].(map[string]any)["text"])
+		s.Equal("*Resource:*\nPod/api-7d9f", fields[2].(map[string]any)["text"])
+		s.Equal("*Namespace:*\npayments", fields[3].(map[string]any)["text"])
+
+		contextBlock := blocks[2].(map[string]any)
+		s.Equal("section", contextBlock["type"])
+		s.Equal("```Container entered CrashLoopBackOff state, restart count: 5```", contextBlock["text"].(map[string]any)["text"])
+
+		footer := blocks[3].(map[string]any)
+		s.Equal("context", footer["type"])
+		s.Contains(footer["elements"].([]any)[0].(map[string]any)["text"], "fault-123")
+	})
+
+	s.Run("critical faults use the red attachment color", func() {
+		sink, err := NewSlackSink(SlackSinkConfig{WebhookURL: s.server.URL})
+		s.Require().NoError(err)
+
+		s.Require().NoError(sink.Send(context.Background(), makeSlackNotification(SeverityCritical)))
+
+		attachment := s.lastMessage()["attachments"].([]any)[0].(map[string]any)
+		s.Equal(SlackColorCritical, attachment["color"])
+	})
+
+	s.Run("info faults use the info attachment color", func() {
+		sink, err := NewSlackSink(SlackSinkConfig{WebhookURL: s.server.URL})
+		s.Require().NoError(err)
+
+		s.Require().NoError(sink.Send(context.Background(), makeSlackNotification(SeverityInfo)))
+
+		attachment := s.lastMessage()["attachments"].([]any)[0].(map[string]any)
+		s.Equal(SlackColorInfo, attachment["color"])
+	})
+
+	s.Run("omits context block when context is empty", func() {
+		sink, err := NewSlackSink(SlackSinkConfig{WebhookURL: s.server.URL})
+		s.Require().NoError(err)
+
+		notification := makeSlackNotification(SeverityWarning)
+		notification.Context = ""
+		notification.Resource.Kind = "Node"
+		notification.Resource.Name = "worker-1"
+		notification.Resource.Namespace = ""
+		s.Require().NoError(sink.Send(context.Background(), notification))
+
+		message := s.lastMessage()
+		s.Equal("[warning] CrashLoop: Node/worker-1", message["text"])
+		blocks := message["attachments"].([]any)[0].(map[string]any)["blocks"].([]any)
+		s.Len(blocks, 3)
+		fields := blocks[1].(map[string]any)["fields"].([]any)
+		s.Equal("*Namespace:*\n-", fields[3].(map[string]any)["text"])
+	})
+
+	s.Run("truncates long context to fit Slack limits", func() {
+		sink, err := NewSlackSink(SlackSinkConfig{WebhookURL: s.server.URL})
+		s.Require().NoError(err)
+
+		notification := makeSlackNotification(SeverityWarning)
+		notification.Context = strings.Repeat("x", 5000)
+		s.Require().NoError(sink.Send(context.Background(), notification))
+
+		blocks := s.lastMessage()["attachments"].([]any)[0].(map[string]any)["blocks"].([]any)
+		text := blocks[2].(map[string]any)["text"].(map[string]any)["text"].(string)
+		s.Len(text, slackMaxTextLength)
+		s.True(strings.HasSuffix(text, contextTruncatedMarker+"```"))
+	})
+
+	s.Run("truncates multi-byte context on a rune boundary", func() {
+		text := formatSlackCodeBlock(strings.Repeat("é", 3000))
+		s.True(utf8.ValidString(text), "truncated context should be valid UTF-8")
+		s.LessOrEqual(len(text), slackMaxTextLength)
+	})
+
+	s.Run("truncates long headers to fit Slack limits", func() {
+		sink, err := NewSlackSink(SlackSinkConfig{WebhookURL: s.server.URL})
+		s.Require().NoError(err)
+
+		notification := makeSlackNotification(SeverityWarning)
+		notification.Resource.Name = strings.Repeat("ü", 200)
+		s.Require().NoError(sink.Send(context.Background(), notification))
+
+		blocks := s.lastMessage()["attachments"].([]any)[0].(map[string]any)["blocks"].([]any)
+		header := blocks[0].(map[string]any)["text"].(map[string]any)["text"].(string)
+		s.LessOrEqual(len(header), slackMaxHeaderLength)
+		s.True(utf8.ValidString(header), "truncated header should be valid UTF-8")
+		s.True(strings.HasSuffix(header, contextTruncatedMarker))
+	})
+
+	s.Run("returns error for non-2xx responses", func() {
+		s.setStatus(http.StatusBadRequest)
+		sink, err := NewSlackSink(SlackSinkConfig{WebhookURL: s.server.URL})
+		s.Require().NoError(err)
+
+		err = sink.Send(context.Background(), makeSlackNotification(SeverityWarning))
+		s.ErrorContains(err, "status 400")
+	})
+
+	s.Run("doesn't leak the webhook secret when posting fails", func() {
+		server := httptest.NewServer(http.NotFoundHandler())
+		server.Close()
+		sink, err := NewSlackSink(SlackSinkConfig{WebhookURL: server.URL + "/services/T000/B000/secret-token"})
+		s.Require().NoError(err)
+
+		err = sink.Send(context.Background(), makeSlackNotification(SeverityWarning))
+		s.ErrorContains(err, "failed to post to slack")
+		s.ErrorContains(err, server.URL)
+		s.NotContains(err.Error(), "secret-token")
+	})
+}
+
+func (s *SlackSinkSuite) TestRateLimiting() {
+	s.Run("sends beyond the burst fail once the context expires", func() {
+		sink, err := NewSlackSink(SlackSinkConfig{
+			WebhookURL: s.server.URL,
+			RateLimit:  rate.Every(time.Hour),
+			Burst:      2,
+		})
+		s.Require().NoError(err)
+
+		s.Require().NoError(sink.Send(context.Background(), makeSlackNotification(SeverityWarning)))
+		s.Require().NoError(sink.Send(context.Background(), makeSlackNotification(SeverityWarning)))
+
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+		err = sink.Send(ctx, makeSlackNotification(SeverityWarning))
+		s.ErrorContains(err, "rate limit")
+		s.Len(s.recordedRequests(), 2, "rate-limited message should not be posted")
+	})
+
+	s.Run("sends wait for the limiter within the context deadline", func() {
+		sink, err := NewSlackSink(SlackSinkConfig{
+			WebhookURL: s.server.URL,
+			RateLimit:  rate.Every(100 * time.Millisecond),
+			Burst:      1,
+		})
+		s.Require().NoError(err)
+
+		start := time.Now()
+		s.Require().NoError(sink.Send(context.Background(), makeSlackNotification(SeverityWarning)))
+		s.Require().NoError(sink.Send(context.Background(), makeSlackNotification(SeverityWarning)))
+
+		s.GreaterOrEqual(time.Since(start), 80*time.Millisecond, "second send should be delayed by the limiter")
+		s.Len(s.recordedRequests(), 2)
+	})
+}
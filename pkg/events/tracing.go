@@ -0,0 +1,77 @@
+package events
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// tracerName is the instrumentation scope name used for all event pipeline spans.
+const tracerName = "github.com/containers/kubernetes-mcp-server/pkg/events"
+
+// Span names for the event and fault processing pipelines.
+const (
+	SpanProcessEvent     = "events.process_event"
+	SpanFilterMatch      = "events.filter_match"
+	SpanDedupCheck       = "events.dedup_check"
+	SpanDispatch         = "events.dispatch"
+	SpanProcessFault     = "faults.process_update"
+	SpanDetect           = "faults.detect"
+	SpanFaultDedupCheck  = "faults.dedup_check"
+	SpanEnrich           = "faults.enrich"
+	SpanSendNotification = "notification.send"
+)
+
+// Span attribute keys recorded on pipeline spans.
+const (
+	AttrSubscriptionID = attribute.Key("mcp.subscription.id")
+	AttrSessionID      = attribute.Key("mcp.session.id")
+	AttrCluster        = attribute.Key("k8s.cluster.name")
+	AttrFaultType      = attribute.Key("mcp.fault.type")
+	AttrFaultID        = attribute.Key("mcp.fault.id")
+	AttrResourceKind   = attribute.Key("k8s.resource.kind")
+	AttrResourceName   = attribute.Key("k8s.resource.name")
+	AttrNamespace      = attribute.Key("k8s.namespace.name")
+	AttrEventReason    = attribute.Key("k8s.event.reason")
+	AttrMatched        = attribute.Key("mcp.filter.matched")
+	AttrDuplicate      = attribute.Key("mcp.dedup.duplicate")
+	AttrSignalCount    = attribute.Key("mcp.fault.signal_count")
+	AttrLogger         = attribute.Key("mcp.notification.logger")
+)
+
+// newTracer returns the pipeline tracer from the given provider.
+// A nil provider falls back to the global provider, which is a no-op unless
+// the application registers one with otel.SetTracerProvider.
+func newTracer(provider trace.TracerProvider) trace.Tracer {
+	if provider == nil {
+		provider = otel.GetTracerProvider()
+	}
+	return provider.Tracer(tracerName)
+}
+
+// subscriptionAttributes returns the span attributes identifying a subscription.
+func subscriptionAttributes(sub *Subscription) []attribute.KeyValue {
+	return []attribute.KeyValue{
+		AttrSubscriptionID.String(sub.ID),
+		AttrSessionID.String(sub.SessionID),
+		AttrCluster.String(sub.Cluster),
+	}
+}
+
+// recordSpanError marks a span as failed with the given error.
+func recordSpanError(span trace.Span, err error) {
+	span.RecordError(err)
+	span.SetStatus(codes.Error, err.Error())
+}
+
+// startSpan starts a child span of ctx using tracer, falling back to the
+// global provider if tracer is nil so callers never need a nil check.
+func startSpan(ctx context.Context, tracer trace.Tracer, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	if tracer == nil {
+		tracer = newTracer(nil)
+	}
+	return tracer.Start(ctx, name, trace.WithAttributes(attrs...))
+}
@@ -0,0 +1,191 @@
+package events
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/suite"
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+
+	pkgkubernetes "github.com/containers/kubernetes-mcp-server/pkg/kubernetes"
+)
+
+type TracingTestSuite struct {
+	suite.Suite
+	recorder *tracetest.SpanRecorder
+	provider *sdktrace.TracerProvider
+}
+
+func TestTracingSuite(t *testing.T) {
+	suite.Run(t, new(TracingTestSuite))
+}
+
+func (s *TracingTestSuite) SetupTest() {
+	s.recorder = tracetest.NewSpanRecorder()
+	s.provider = sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(s.recorder))
+}
+
+func (s *TracingTestSuite) TearDownTest() {
+	_ = s.provider.Shutdown(context.Background())
+}
+
+// spanAttribute returns the value of the given attribute key on a span, or an empty value.
+func spanAttribute(span sdktrace.ReadOnlySpan, key attribute.Key) attribute.Value {
+	for _, kv := range span.Attributes() {
+		if kv.Key == key {
+			return kv.Value
+		}
+	}
+	return attribute.Value{}
+}
+
+// TestEventProcessingSpans verifies the span hierarchy recorded for one processed event
+func (s *TracingTestSuite) TestEventProcessingSpans() {
+	s.Run("records process, dedup, dispatch, filter, and send spans for one event", func() {
+		clientset := fake.NewClientset()
+		fakeWatcher := watch.NewFake()
+		clientset.PrependWatchReactor("events", func(action k8stesting.Action) (bool, watch.Interface, error) {
+			return true, fakeWatcher, nil
+		})
+
+		server := NewMockMCPServer()
+		session := NewMockServerSession("session1")
+		session.SetLogLevel(mcp.LoggingLevel("info"))
+		server.AddSession(session)
+
+		config := NewTestManagerConfig()
+		config.TracerProvider = s.provider
+		getK8sClient := func(cluster string) (*pkgkubernetes.Kubernetes, error) {
+			return &pkgkubernetes.Kubernetes{Interface: clientset}, nil
+		}
+		manager := NewEventSubscriptionManager(server, config, getK8sClient, nil)
+		defer manager.CancelAll()
+
+		sub, err := manager.Create("session1", "cluster1", "events", SubscriptionFilters{}, "")
+		s.Require().NoError(err)
+
+		fakeWatcher.Add(&v1.Event{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            "test-event",
+				Namespace:       "default",
+				UID:             "event-uid",
+				ResourceVersion: "1",
+			},
+			InvolvedObject: v1.ObjectReference{Kind: "Pod", Name: "test-pod", Namespace: "default"},
+			Reason:         "BackOff",
+			Type:           "Warning",
+		})
+
+		// Notifications are sent from the subscription's delivery goroutine, so the send
+		// span may end after the root span
+		s.Require().Eventually(func() bool {
+			ended := map[string]bool{}
+			for _, span := range s.recorder.Ended() {
+				ended[span.Name()] = true
+			}
+			return ended[SpanProcessEvent] && ended[SpanSendNotification]
+		}, 2*time.Second, 10*time.Millisecond, "event processing and send spans should be recorded")
+
+		spans := map[string]sdktrace.ReadOnlySpan{}
+		for _, span := range s.recorder.Ended() {
+			spans[span.Name()] = span
+		}
+		s.Require().Len(spans, 5, "expected exactly one span per pipeline stage")
+
+		root := spans[SpanProcessEvent]
+		s.False(root.Parent().IsValid(), "process span should be the root")
+		s.Equal("cluster1", spanAttribute(root, AttrCluster).AsString())
+		s.Equal("BackOff", spanAttribute(root, AttrEventReason).AsString())
+
+		dispatch := spans[SpanDispatch]
+		s.Equal(sub.ID, spanAttribute(dispatch, AttrSubscriptionID).AsString())
+		s.Equal("session1", spanAttribute(dispatch, AttrSessionID).AsString())
+
+		// The shared watch dedups once, then dispatches to each subscriber
+		parents := map[string]sdktrace.ReadOnlySpan{
+			SpanDedupCheck:       root,
+			SpanDispatch:         root,
+			SpanFilterMatch:      dispatch,
+			SpanSendNotification: dispatch,
+		}
+		for name, parent := range parents {
+			child, ok := spans[name]
+			s.Require().True(ok, "span %s should be recorded", name)
+			s.Equal(root.SpanContext().TraceID(), child.SpanContext().TraceID(), "span %s should share the trace", name)
+			s.Equal(parent.SpanContext().SpanID(), child.Parent().SpanID(), "span %s should be a child of the %s span", name, parent.Name())
+		}
+
+		s.True(spanAttribute(spans[SpanFilterMatch], AttrMatched).AsBool())
+		s.False(spanAttribute(spans[SpanDedupCheck], AttrDuplicate).AsBool())
+		s.Equal(LoggerEvents, spanAttribute(spans[SpanSendNotification], AttrLogger).AsString())
+		s.Len(session.GetLogCalls(), 1)
+	})
+}
+
+// TestFaultProcessingSpans verifies the span hierarchy recorded for one resource update
+func (s *TracingTestSuite) TestFaultProcessingSpans() {
+	s.Run("records detect, dedup, and enrich spans under the update span", func() {
+		var callbackCtx context.Context
+		watcher, err := NewResourceWatcher(ResourceWatcherConfig{
+			Clientset: fake.NewClientset(),
+			Cluster:   "cluster1",
+			Detectors: []Detector{&mockTracingDetector{}},
+			Tracer:    newTracer(s.provider),
+			SignalCallback: func(ctx context.Context, signal FaultSignal) {
+				callbackCtx = ctx
+			},
+		})
+		s.Require().NoError(err)
+
+		pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "test-pod", Namespace: "default", UID: "pod-uid"}}
+		watcher.processUpdate(context.Background(), "Pod", pod.Namespace, pod.Name, pod, pod)
+
+		spans := map[string]sdktrace.ReadOnlySpan{}
+		for _, span := range s.recorder.Ended() {
+			spans[span.Name()] = span
+		}
+		s.Require().Len(spans, 4)
+
+		root := spans[SpanProcessFault]
+		s.False(root.Parent().IsValid(), "update span should be the root")
+		s.Equal("Pod", spanAttribute(root, AttrResourceKind).AsString())
+		for _, name := range []string{SpanDetect, SpanFaultDedupCheck, SpanEnrich} {
+			s.Equal(root.SpanContext().SpanID(), spans[name].Parent().SpanID(), "span %s should be a child of the update span", name)
+		}
+		s.Equal(int64(1), spanAttribute(spans[SpanDetect], AttrSignalCount).AsInt64())
+		s.Equal(string(FaultTypePodCrash), spanAttribute(spans[SpanEnrich], AttrFaultType).AsString())
+
+		s.Require().NotNil(callbackCtx)
+		s.Equal(root.SpanContext().SpanID(), trace.SpanContextFromContext(callbackCtx).SpanID(), "callback should receive the update span context")
+	})
+}
+
+// mockTracingDetector emits one warning signal for every update.
+type mockTracingDetector struct{}
+
+func (d *mockTracingDetector) Describe() DetectorInfo {
+	return DetectorInfo{FaultTypes: []FaultType{FaultTypePodCrash}, ResourceKinds: []string{"Pod"}}
+}
+
+func (d *mockTracingDetector) Detect(oldObj, newObj interface{}) []FaultSignal {
+	pod := newObj.(*v1.Pod)
+	return []FaultSignal{{
+		FaultType:   FaultTypePodCrash,
+		ResourceUID: pod.UID,
+		Kind:        "Pod",
+		Name:        pod.Name,
+		Namespace:   pod.Namespace,
+		Severity:    SeverityWarning,
+		Timestamp:   time.Now(),
+	}}
+}
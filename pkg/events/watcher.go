@@ -2,17 +2,41 @@ package events
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
+	"runtime/debug"
+	"sync"
+	"sync/atomic"
 	"time"
 
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
 )
 
+// DefaultStableConnectionThreshold is how long a watch must stay connected before
+// its retry count is reset.
+const DefaultStableConnectionThreshold = 10 * time.Second
+
+// DefaultWatchTimeout is how long the API server keeps an event watch open before
+// closing it, after which the watch is re-established from the last resource version.
+const DefaultWatchTimeout = 30 * time.Minute
+
+// DefaultWatchListPageSize is how many events are requested per page when a watch
+// whose resource version expired relists the existing events.
+const DefaultWatchListPageSize int64 = 500
+
+// watchTimeoutSlack is subtracted from the watch timeout when deciding whether a
+// closed watch expired, as the server-side timer starts before connectedAt is recorded.
+const watchTimeoutSlack = time.Second
+
 // exponentialBackoff calculates backoff duration for retry attempts
 // Returns: 1s, 2s, 4s, 8s, 16s, 30s (capped at 30s)
 func exponentialBackoff(retryCount int) time.Duration {
@@ -31,6 +55,45 @@ func exponentialBackoff(retryCount int) time.Duration {
 	return backoff
 }
 
+// errWatchForbidden is returned for watches the API server forbids, typically after
+// an RBAC change. Retrying can't help, so the watch goes degraded right away.
+var errWatchForbidden = errors.New("watch forbidden")
+
+// errProcessEventPanics is returned once ProcessEvent panicked MaxProcessEventPanics
+// times. Reconnecting would feed the same broken callback, so the watch goes degraded.
+var errProcessEventPanics = errors.New("too many ProcessEvent panics")
+
+// watchErrorAction is how a watch responds to an error status from the API server.
+type watchErrorAction int
+
+const (
+	// watchErrorIgnore logs the status and keeps the watch running
+	watchErrorIgnore watchErrorAction = iota
+	// watchErrorRetry ends the watch and reconnects with backoff
+	watchErrorRetry
+	// watchErrorResync ends the watch and reconnects without a resource version
+	watchErrorResync
+	// watchErrorDegrade ends the watch and gives up without retrying
+	watchErrorDegrade
+)
+
+// classifyWatchError returns how a watch responds to an error status: 410 Gone
+// resyncs from the current state, 403 Forbidden degrades immediately as retrying
+// won't help until permissions change, and 5xx and 429 Too Many Requests retry with
+// backoff. Other statuses are ignored.
+func classifyWatchError(status *metav1.Status) watchErrorAction {
+	switch {
+	case status.Code == http.StatusGone:
+		return watchErrorResync
+	case status.Code == http.StatusForbidden:
+		return watchErrorDegrade
+	case status.Code >= http.StatusInternalServerError, status.Code == http.StatusTooManyRequests:
+		return watchErrorRetry
+	default:
+		return watchErrorIgnore
+	}
+}
+
 // EventWatcher manages watching Kubernetes events with automatic reconnection
 type EventWatcher struct {
 	clientset kubernetes.Interface
@@ -44,27 +107,97 @@ type EventWatcher struct {
 	// to skip historical events on initial connection while still resuming from
 	// the correct position on reconnections.
 	initialResourceVersion string
+	// currentResourceVersion returns the resource version to restart from once the
+	// watch's resource version expired, if set.
+	currentResourceVersion func() (string, error)
+	// resourceVersionExpired is set when the API server reports the watch's resource
+	// version as expired (410 Gone), until the next watch restarts without it.
+	resourceVersionExpired bool
 	filters                *SubscriptionFilters
 	resultChan             chan watch.Event
 	stopChan               chan struct{}
+	stopOnce               sync.Once
 	retryCount             int
 	maxRetries             int
+	stableThreshold        time.Duration
+	watchTimeout           time.Duration
+	listPageSize           int64
 	onError                func(error)
-	onDegraded             func()
+	onReconnecting         func()
+	onReconnected          func()
+	onDegraded             func(err error)
+	reconnecting           bool
+	backoff                func(retryCount int) time.Duration
+	clock                  Clock
 	dedupCache             *DeduplicationCache
-	processEvent           func(event *v1.Event)
+	processEvent           func(ctx context.Context, event *v1.Event)
+	processPanics          atomic.Int64
+	maxProcessPanics       int64
+	labels                 *objectLabelCache
+	includeModifications   bool
+	breaker                *CircuitBreaker
+	tracer                 trace.Tracer
+	spanAttributes         []attribute.KeyValue
 }
 
 // EventWatcherConfig holds configuration for the event watcher
 type EventWatcherConfig struct {
-	Clientset    kubernetes.Interface
-	Namespace    string
-	Filters      *SubscriptionFilters
-	MaxRetries   int
-	OnError      func(error)
-	OnDegraded   func()
-	DedupCache   *DeduplicationCache
-	ProcessEvent func(event *v1.Event)
+	Clientset  kubernetes.Interface
+	Namespace  string
+	Filters    *SubscriptionFilters
+	MaxRetries int
+	OnError    func(error)
+	// StableConnectionThreshold is how long a watch must stay connected before the retry
+	// count is reset, so a watch that keeps failing right after connecting still goes
+	// degraded. If zero, DefaultStableConnectionThreshold is used.
+	StableConnectionThreshold time.Duration
+	// WatchTimeout is sent as the watch's TimeoutSeconds, so the API server closes it
+	// periodically and long-lived watches don't get stuck on a stale connection. A watch
+	// closed at its timeout is re-established without counting as a failure.
+	// If zero, DefaultWatchTimeout is used; a negative value disables the timeout.
+	WatchTimeout time.Duration
+	// WatchListPageSize is the Limit of the list requests relisting the existing events
+	// after the watch's resource version expired (410 Gone) without CurrentResourceVersion,
+	// so large clusters are listed in chunks instead of in a single response.
+	// If zero, DefaultWatchListPageSize is used; a negative value lists every event at once.
+	WatchListPageSize int64
+	// OnReconnecting is called when the watch fails and a reconnection will be attempted.
+	OnReconnecting func()
+	// OnReconnected is called when the watch is re-established after a failure.
+	OnReconnected func()
+	// OnDegraded is called with the last watch error when the watch gives up, after
+	// MaxRetries failed attempts or right away when retrying can't help (see classifyWatchError).
+	OnDegraded func(err error)
+	DedupCache *DeduplicationCache
+	// Clock times backoffs and connection stability.
+	// If nil, the real clock is used.
+	Clock Clock
+	// ProcessEvent is called for each event that passes filtering and deduplication.
+	// The context carries the event's processing span.
+	// A panicking ProcessEvent is recovered and logged, and the watch carries on with
+	// the next event.
+	ProcessEvent func(ctx context.Context, event *v1.Event)
+	// MaxProcessEventPanics is how many times ProcessEvent may panic before the watch
+	// gives up and goes degraded, as a callback that keeps panicking would otherwise
+	// fail every event silently. If zero, panics never degrade the watch.
+	MaxProcessEventPanics int
+	// IncludeModifications controls whether watch.Modified events (e.g. count bumps on
+	// recurring events) are delivered. When false, only watch.Added events are delivered.
+	// If nil, defaults to true.
+	IncludeModifications *bool
+	// LabelResolver fetches involved object labels for LabelSelector filtering.
+	// Resolved labels are cached by object UID. If nil, the label selector is not applied.
+	LabelResolver LabelResolver
+	// Breaker is shared by the watches on the same cluster. While it is open, watch
+	// attempts are paused without counting towards MaxRetries.
+	// If nil, watch attempts are never paused.
+	Breaker *CircuitBreaker
+	// Tracer is used to create spans for each received event.
+	// If nil, the global OpenTelemetry tracer provider is used.
+	Tracer trace.Tracer
+	// SpanAttributes are added to the root span of every processed event
+	// (e.g. subscription ID and cluster).
+	SpanAttributes []attribute.KeyValue
 	// InitialResourceVersion is the resource version to start watching from.
 	// When set, the watcher will skip all historical events and only process
 	// events with resource versions greater than this value. This prevents
@@ -72,6 +205,12 @@ type EventWatcherConfig struct {
 	// the subscription was created.
 	// If empty, the watch will start from the beginning (receiving all historical events).
 	InitialResourceVersion string
+	// CurrentResourceVersion returns the current resource version of the watched events.
+	// When set, a watch whose resource version expired (410 Gone) restarts from the
+	// version it returns, skipping the events it can no longer resume from instead of
+	// replaying every existing event. If nil, the existing events are relisted in pages of
+	// WatchListPageSize and the watch restarts from the list's resource version.
+	CurrentResourceVersion func() (string, error)
 }
 
 // NewEventWatcher creates a new event watcher with the given configuration
@@ -79,17 +218,54 @@ func NewEventWatcher(config EventWatcherConfig) *EventWatcher {
 	if config.MaxRetries == 0 {
 		config.MaxRetries = 5
 	}
+	if config.StableConnectionThreshold == 0 {
+		config.StableConnectionThreshold = DefaultStableConnectionThreshold
+	}
+	if config.WatchTimeout == 0 {
+		config.WatchTimeout = DefaultWatchTimeout
+	} else if config.WatchTimeout > 0 && config.WatchTimeout < time.Second {
+		// TimeoutSeconds has whole-second granularity
+		config.WatchTimeout = time.Second
+	}
+	if config.WatchListPageSize == 0 {
+		config.WatchListPageSize = DefaultWatchListPageSize
+	}
+
+	includeModifications := true
+	if config.IncludeModifications != nil {
+		includeModifications = *config.IncludeModifications
+	}
+
+	// Only resolve object labels when a label selector needs them
+	var objectLabels *objectLabelCache
+	if config.LabelResolver != nil && config.Filters != nil && config.Filters.LabelSelector != "" {
+		objectLabels = newObjectLabelCache(config.LabelResolver, DefaultLabelCacheTTL)
+	}
 
 	return &EventWatcher{
 		clientset:              config.Clientset,
 		namespace:              config.Namespace,
 		filters:                config.Filters,
 		maxRetries:             config.MaxRetries,
+		stableThreshold:        config.StableConnectionThreshold,
+		watchTimeout:           config.WatchTimeout,
+		listPageSize:           config.WatchListPageSize,
 		onError:                config.OnError,
+		onReconnecting:         config.OnReconnecting,
+		onReconnected:          config.OnReconnected,
 		onDegraded:             config.OnDegraded,
+		backoff:                exponentialBackoff,
+		clock:                  clockOrDefault(config.Clock),
 		dedupCache:             config.DedupCache,
 		processEvent:           config.ProcessEvent,
+		maxProcessPanics:       int64(config.MaxProcessEventPanics),
+		labels:                 objectLabels,
+		includeModifications:   includeModifications,
+		breaker:                config.Breaker,
+		tracer:                 config.Tracer,
+		spanAttributes:         config.SpanAttributes,
 		initialResourceVersion: config.InitialResourceVersion,
+		currentResourceVersion: config.CurrentResourceVersion,
 		resultChan:             make(chan watch.Event, 100),
 		stopChan:               make(chan struct{}),
 	}
@@ -100,9 +276,9 @@ func (w *EventWatcher) Start(ctx context.Context) {
 	go w.watchLoop(ctx)
 }
 
-// Stop stops the event watcher
+// Stop stops the event watcher. It is safe to call more than once.
 func (w *EventWatcher) Stop() {
-	close(w.stopChan)
+	w.stopOnce.Do(func() { close(w.stopChan) })
 }
 
 // ResultChan returns the channel for receiving watch events
@@ -123,7 +299,35 @@ func (w *EventWatcher) watchLoop(ctx context.Context) {
 			klog.V(2).Info("Stop signal received, stopping event watcher")
 			return
 		default:
+			// Wait out an open circuit breaker without spending a retry, so watches on a
+			// persistently failing cluster back off together
+			if w.breaker != nil {
+				if allowed, retryIn := w.breaker.Allow(); !allowed {
+					klog.V(2).Infof("Circuit breaker open, waiting %v before the next watch attempt", retryIn)
+					if !w.wait(ctx, retryIn) {
+						return
+					}
+					continue
+				}
+			}
+
 			if err := w.startWatch(ctx); err != nil {
+				// Retrying a forbidden watch fails the same way until its permissions change,
+				// and a panicking callback keeps panicking on the reconnected watch
+				if errors.Is(err, errWatchForbidden) || errors.Is(err, errProcessEventPanics) {
+					klog.Warningf("Watch can't recover, not retrying: %v", err)
+					if w.onError != nil {
+						w.onError(err)
+					}
+					if w.onDegraded != nil {
+						w.onDegraded(err)
+					}
+					return
+				}
+
+				if w.breaker != nil {
+					w.breaker.RecordFailure()
+				}
 				w.retryCount++
 				klog.Warningf("Watch failed (attempt %d/%d): %v", w.retryCount, w.maxRetries, err)
 
@@ -134,21 +338,22 @@ func (w *EventWatcher) watchLoop(ctx context.Context) {
 				if w.retryCount >= w.maxRetries {
 					klog.Warningf("Watch connection failed after %d reconnection attempts", w.maxRetries)
 					if w.onDegraded != nil {
-						w.onDegraded()
+						w.onDegraded(err)
 					}
 					return
 				}
 
+				if !w.reconnecting {
+					w.reconnecting = true
+					if w.onReconnecting != nil {
+						w.onReconnecting()
+					}
+				}
+
 				// Exponential backoff before retry
-				backoff := exponentialBackoff(w.retryCount)
+				backoff := w.backoff(w.retryCount)
 				klog.V(2).Infof("Backing off for %v before retry", backoff)
-
-				select {
-				case <-time.After(backoff):
-					continue
-				case <-ctx.Done():
-					return
-				case <-w.stopChan:
+				if !w.wait(ctx, backoff) {
 					return
 				}
 			}
@@ -156,13 +361,35 @@ func (w *EventWatcher) watchLoop(ctx context.Context) {
 	}
 }
 
-// startWatch creates a new watch and processes events
-func (w *EventWatcher) startWatch(ctx context.Context) error {
-	// Build watch options
+// wait blocks for d and reports whether the watcher should keep running, returning
+// false as soon as ctx is done or the watcher is stopped. The timer is released on
+// return, so cancelling many backing-off watchers doesn't leave their timers pending.
+func (w *EventWatcher) wait(ctx context.Context, d time.Duration) bool {
+	timer := w.clock.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C():
+		return true
+	case <-ctx.Done():
+		return false
+	case <-w.stopChan:
+		return false
+	}
+}
+
+// watchOptions builds the options for the next watch request, pushing the filters
+// that the API server can apply down as field and label selectors.
+func (w *EventWatcher) watchOptions() metav1.ListOptions {
 	opts := metav1.ListOptions{
 		Watch: true,
 	}
 
+	// Have the server close the watch periodically so it is re-established
+	if w.watchTimeout > 0 {
+		opts.TimeoutSeconds = ptr.To(int64(w.watchTimeout / time.Second))
+	}
+
 	// Use resource version if available for resuming
 	if w.resourceVersion != "" {
 		opts.ResourceVersion = w.resourceVersion
@@ -173,34 +400,46 @@ func (w *EventWatcher) startWatch(ctx context.Context) error {
 		klog.V(1).Infof("Starting watch from initial resource version %s (skipping historical events)", w.initialResourceVersion)
 	}
 
-	// Add field selectors for involved object if specified
+	// Add field and label selectors for filters the server can apply
 	if w.filters != nil {
-		if w.filters.InvolvedKind != "" {
-			if opts.FieldSelector != "" {
-				opts.FieldSelector += ","
-			}
-			opts.FieldSelector += fmt.Sprintf("involvedObject.kind=%s", w.filters.InvolvedKind)
-		}
-		if w.filters.InvolvedName != "" {
-			if opts.FieldSelector != "" {
-				opts.FieldSelector += ","
-			}
-			opts.FieldSelector += fmt.Sprintf("involvedObject.name=%s", w.filters.InvolvedName)
-		}
-		if w.filters.InvolvedNamespace != "" {
-			if opts.FieldSelector != "" {
-				opts.FieldSelector += ","
-			}
-			opts.FieldSelector += fmt.Sprintf("involvedObject.namespace=%s", w.filters.InvolvedNamespace)
-		}
+		opts.FieldSelector = w.filters.GetInvolvedObjectFieldSelector()
 		if w.filters.Type != "" {
 			if opts.FieldSelector != "" {
 				opts.FieldSelector += ","
 			}
 			opts.FieldSelector += fmt.Sprintf("type=%s", w.filters.Type)
 		}
+		// Events carry their own labels, so the server can select on them
+		if w.filters.EventLabelSelector != "" {
+			opts.LabelSelector = w.filters.EventLabelSelector
+		}
 	}
 
+	return opts
+}
+
+// relistOptions builds the options for the next page of a relist, with the selectors
+// of watchOptions and up to listPageSize events per page.
+func (w *EventWatcher) relistOptions(continueToken string) metav1.ListOptions {
+	opts := w.watchOptions()
+	opts.Watch = false
+	opts.TimeoutSeconds = nil
+	opts.Continue = continueToken
+	if w.listPageSize > 0 {
+		opts.Limit = w.listPageSize
+	}
+	return opts
+}
+
+// startWatch creates a new watch and processes events
+func (w *EventWatcher) startWatch(ctx context.Context) error {
+	if w.resourceVersionExpired {
+		if err := w.restartFromCurrentResourceVersion(ctx); err != nil {
+			return err
+		}
+	}
+	opts := w.watchOptions()
+
 	// Create the watcher
 	var watcher watch.Interface
 	var err error
@@ -214,11 +453,32 @@ func (w *EventWatcher) startWatch(ctx context.Context) error {
 	}
 
 	if err != nil {
+		if apierrors.IsForbidden(err) {
+			return fmt.Errorf("%w: %v", errWatchForbidden, err)
+		}
+		if apierrors.IsResourceExpired(err) || apierrors.IsGone(err) {
+			w.expireResourceVersion()
+			return fmt.Errorf("watch resource version expired: %w", err)
+		}
 		return fmt.Errorf("failed to create event watcher: %w", err)
 	}
+	// Every return path ends this watch: context done, Stop, and reconnection, which
+	// creates a new watch only after this one is stopped
 	defer watcher.Stop()
 
 	klog.V(2).Info("Event watch successfully established")
+	connectedAt := w.clock.Now()
+	if w.breaker != nil {
+		w.breaker.RecordSuccess()
+	}
+
+	if w.reconnecting {
+		w.reconnecting = false
+		klog.V(1).Info("Event watch reconnected")
+		if w.onReconnected != nil {
+			w.onReconnected()
+		}
+	}
 
 	// Process events from the watcher
 	for {
@@ -229,26 +489,27 @@ func (w *EventWatcher) startWatch(ctx context.Context) error {
 			return nil
 		case event, ok := <-watcher.ResultChan():
 			if !ok {
+				w.resetRetriesIfStable(connectedAt)
+				if w.watchExpired(connectedAt) {
+					// Closed by the server at its timeout, re-watch from the last resource version
+					klog.V(2).Info("Watch timed out, re-establishing")
+					return nil
+				}
 				// Watch closed, need to reconnect
 				klog.V(2).Info("Watch channel closed, will reconnect")
 				return fmt.Errorf("watch channel closed")
 			}
 
-			// Reset retry count on successful event
-			w.retryCount = 0
+			// Reset retry count once the connection has proven stable, not on the
+			// first event, so a flapping watch still accumulates retries
+			w.resetRetriesIfStable(connectedAt)
 
 			// Handle watch errors
 			if event.Type == watch.Error {
-				// Check if this is a 410 Gone error (resourceVersion too old)
 				if status, ok := event.Object.(*metav1.Status); ok {
 					klog.Warningf("Watch error event: %v", status)
-
-					// If resourceVersion is too old (410 Gone), clear it so the next
-					// watch starts fresh instead of retrying with the same stale version
-					if status.Code == http.StatusGone {
-						klog.V(2).Infof("ResourceVersion %s is too old (410 Gone), clearing for fresh watch", w.resourceVersion)
-						w.resourceVersion = ""
-						return fmt.Errorf("watch resource version expired: %s", status.Message)
+					if err := w.statusError(status); err != nil {
+						return err
 					}
 				}
 				continue
@@ -265,77 +526,226 @@ func (w *EventWatcher) startWatch(ctx context.Context) error {
 				w.resourceVersion = k8sEvent.ResourceVersion
 			}
 
-			// Apply client-side filters
-			if !w.matchesFilters(k8sEvent) {
+			// Only deliver newly created events when modifications are excluded
+			if !w.includeModifications && event.Type != watch.Added {
+				klog.V(3).Infof("Skipping %s event %s/%s: modifications excluded", event.Type, k8sEvent.Namespace, k8sEvent.Name)
 				continue
 			}
 
-			// Check deduplication
-			if w.dedupCache != nil {
-				key := w.makeDeduplicationKey(k8sEvent)
-				if w.dedupCache.IsDuplicate(key) {
-					klog.V(2).Infof("Skipping duplicate event: %s", key)
-					continue
-				}
+			w.deliver(ctx, event, k8sEvent)
+			if err := w.processPanicsError(); err != nil {
+				return err
 			}
+		}
+	}
+}
 
-			// Process the event
-			if w.processEvent != nil {
-				w.processEvent(k8sEvent)
-			}
+// deliver processes an event that passed the watch's checks and sends it to the result
+// channel, unless it was filtered out or deduplicated.
+func (w *EventWatcher) deliver(ctx context.Context, event watch.Event, k8sEvent *v1.Event) {
+	if !w.handleEvent(ctx, k8sEvent) {
+		return
+	}
 
-			// Send to result channel (non-blocking)
-			select {
-			case w.resultChan <- event:
-			default:
-				klog.Warning("Event result channel full, dropping event")
-			}
-		}
+	// Send to result channel (non-blocking)
+	select {
+	case w.resultChan <- event:
+	default:
+		klog.Warning("Event result channel full, dropping event")
 	}
 }
 
-// matchesFilters checks if an event matches the subscription filters
-func (w *EventWatcher) matchesFilters(event *v1.Event) bool {
-	if w.filters == nil {
-		return true
+// statusError returns the error ending a watch that received an error status, or
+// nil if the watch can keep running, as classified by classifyWatchError.
+func (w *EventWatcher) statusError(status *metav1.Status) error {
+	switch classifyWatchError(status) {
+	case watchErrorResync:
+		w.expireResourceVersion()
+		return fmt.Errorf("watch resource version expired: %s", status.Message)
+	case watchErrorDegrade:
+		return fmt.Errorf("%w: %s", errWatchForbidden, status.Message)
+	case watchErrorRetry:
+		return fmt.Errorf("watch failed with status %d: %s", status.Code, status.Message)
+	default:
+		return nil
+	}
+}
+
+// expireResourceVersion clears the resource version the API server reported as too old
+// (410 Gone), so the next watch starts fresh instead of retrying with the same stale
+// version. The initial resource version is cleared too, as it is older still.
+func (w *EventWatcher) expireResourceVersion() {
+	expired := w.resourceVersion
+	if expired == "" {
+		expired = w.initialResourceVersion
+	}
+	klog.V(2).Infof("ResourceVersion %s is too old (410 Gone), clearing for fresh watch", expired)
+	w.resourceVersion = ""
+	w.initialResourceVersion = ""
+	w.resourceVersionExpired = true
+}
+
+// restartFromCurrentResourceVersion moves a watch whose resource version expired to the
+// current resource version, if the watcher was configured with CurrentResourceVersion.
+// Events between the expired and current versions are skipped. Otherwise the existing
+// events are relisted.
+func (w *EventWatcher) restartFromCurrentResourceVersion(ctx context.Context) error {
+	if w.currentResourceVersion == nil {
+		return w.relist(ctx)
+	}
+
+	resourceVersion, err := w.currentResourceVersion()
+	if err != nil {
+		return fmt.Errorf("failed to get current resource version: %w", err)
 	}
+	klog.Warningf("Resuming expired watch from the current resource version %s, events since the expired version are skipped", resourceVersion)
+	w.resourceVersion = resourceVersion
+	w.resourceVersionExpired = false
+	return nil
+}
 
-	// Check namespace filter (if namespaces list is specified)
-	if len(w.filters.Namespaces) > 0 {
-		matched := false
-		for _, ns := range w.filters.Namespaces {
-			if event.Namespace == ns {
-				matched = true
-				break
+// relist delivers the existing events as added, like a watch without a resource version
+// would, listing them in pages of listPageSize, and moves the watch to the resource
+// version of the list. A failed page fails the whole relist, which is retried from the
+// first page as the watch's resource version stays expired.
+func (w *EventWatcher) relist(ctx context.Context) error {
+	events := w.clientset.CoreV1().Events(w.namespace)
+	continueToken := ""
+	for {
+		list, err := events.List(ctx, w.relistOptions(continueToken))
+		if err != nil {
+			if apierrors.IsForbidden(err) {
+				return fmt.Errorf("%w: %v", errWatchForbidden, err)
 			}
+			return fmt.Errorf("failed to relist events: %w", err)
 		}
-		if !matched {
-			return false
+		for i := range list.Items {
+			k8sEvent := &list.Items[i]
+			w.deliver(ctx, watch.Event{Type: watch.Added, Object: k8sEvent}, k8sEvent)
+			if err := w.processPanicsError(); err != nil {
+				return err
+			}
+		}
+		if list.Continue == "" {
+			klog.V(1).Infof("Relisted events after the expired watch, resuming from resource version %s", list.ResourceVersion)
+			w.resourceVersion = list.ResourceVersion
+			w.resourceVersionExpired = false
+			return nil
 		}
+		continueToken = list.Continue
 	}
+}
 
-	// Check type filter
-	if w.filters.Type != "" && event.Type != w.filters.Type {
-		return false
+// watchExpired reports whether a watch established at connectedAt was open for
+// its full timeout, so its closing is expected rather than a failure.
+func (w *EventWatcher) watchExpired(connectedAt time.Time) bool {
+	return w.watchTimeout > 0 && w.clock.Now().Sub(connectedAt) >= w.watchTimeout-watchTimeoutSlack
+}
+
+// resetRetriesIfStable resets the retry count if the watch established at connectedAt
+// has stayed connected for at least the stable connection threshold.
+func (w *EventWatcher) resetRetriesIfStable(connectedAt time.Time) {
+	if w.retryCount > 0 && w.clock.Now().Sub(connectedAt) >= w.stableThreshold {
+		klog.V(2).Infof("Watch stable for %v, resetting retry count", w.stableThreshold)
+		w.retryCount = 0
 	}
+}
 
-	// Check reason filter (prefix match)
-	if w.filters.Reason != "" {
-		if len(event.Reason) < len(w.filters.Reason) {
+// handleEvent runs filtering, deduplication, and processing for a single event
+// inside an event processing span. Returns false if the event was filtered out
+// or suppressed as a duplicate.
+func (w *EventWatcher) handleEvent(ctx context.Context, event *v1.Event) bool {
+	attrs := append([]attribute.KeyValue{
+		AttrEventReason.String(event.Reason),
+		AttrResourceKind.String(event.InvolvedObject.Kind),
+		AttrResourceName.String(event.InvolvedObject.Name),
+		AttrNamespace.String(event.Namespace),
+	}, w.spanAttributes...)
+	ctx, span := startSpan(ctx, w.tracer, SpanProcessEvent, attrs...)
+	defer span.End()
+
+	// Apply client-side filters (shared watches filter per subscriber instead)
+	if w.filters != nil {
+		_, filterSpan := startSpan(ctx, w.tracer, SpanFilterMatch)
+		matched := w.matchesFilters(ctx, event)
+		filterSpan.SetAttributes(AttrMatched.Bool(matched))
+		filterSpan.End()
+		if !matched {
 			return false
 		}
-		if event.Reason[:len(w.filters.Reason)] != w.filters.Reason {
+	}
+
+	// Check deduplication
+	if w.dedupCache != nil {
+		_, dedupSpan := startSpan(ctx, w.tracer, SpanDedupCheck)
+		key := w.makeDeduplicationKey(event)
+		duplicate := w.dedupCache.IsDuplicate(key)
+		dedupSpan.SetAttributes(AttrDuplicate.Bool(duplicate))
+		dedupSpan.End()
+		if duplicate {
+			klog.V(2).Infof("Skipping duplicate event: %s", key)
 			return false
 		}
 	}
 
-	// Note: Label selector filtering would require additional logic
-	// to fetch the involved object and check its labels
-	// For now, we skip label selector filtering in the watcher
-
+	// Process the event
+	if w.processEvent != nil {
+		if err := w.runProcessEvent(ctx, event); err != nil {
+			recordSpanError(span, err)
+			return false
+		}
+	}
 	return true
 }
 
+// runProcessEvent calls processEvent, recovering from a panic so a buggy callback (e.g.
+// a failing sink) can't crash the watch goroutine. A recovered panic is logged, counted
+// and returned as an error.
+func (w *EventWatcher) runProcessEvent(ctx context.Context, event *v1.Event) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			panics := w.processPanics.Add(1)
+			err = fmt.Errorf("ProcessEvent panicked: %v", r)
+			klog.Errorf("Recovered from panic processing event %s/%s (%d panics so far): %v\n%s",
+				event.Namespace, event.Name, panics, r, debug.Stack())
+		}
+	}()
+	w.processEvent(ctx, event)
+	return nil
+}
+
+// ProcessEventPanics returns how many times ProcessEvent panicked.
+func (w *EventWatcher) ProcessEventPanics() int64 {
+	return w.processPanics.Load()
+}
+
+// processPanicsError returns errProcessEventPanics once ProcessEvent panicked
+// maxProcessPanics times, or nil if the watch can keep running.
+func (w *EventWatcher) processPanicsError() error {
+	if w.maxProcessPanics <= 0 {
+		return nil
+	}
+	if panics := w.processPanics.Load(); panics >= w.maxProcessPanics {
+		return fmt.Errorf("%w: %d panics", errProcessEventPanics, panics)
+	}
+	return nil
+}
+
+// matchesFilters checks if an event matches the subscription filters. The label
+// selector is matched against the involved object's labels, and only when a
+// LabelResolver is configured.
+func (w *EventWatcher) matchesFilters(ctx context.Context, event *v1.Event) bool {
+	if w.filters == nil {
+		return true
+	}
+	if w.labels == nil && w.filters.LabelSelector != "" {
+		withoutSelector := *w.filters
+		withoutSelector.LabelSelector = ""
+		return withoutSelector.Matches(event)
+	}
+	return w.labels.matches(ctx, w.filters, event)
+}
+
 // makeDeduplicationKey creates a unique key for event deduplication
 // Key format: <cluster>/<ns>/<name>/<uid>/<resourceVersion>
 func (w *EventWatcher) makeDeduplicationKey(event *v1.Event) string {
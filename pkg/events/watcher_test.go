@@ -2,17 +2,25 @@ package events
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"runtime"
 	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/suite"
 	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/runtime"
+	k8sruntime "k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/client-go/kubernetes/fake"
 	k8stesting "k8s.io/client-go/testing"
+	clocktesting "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
 )
 
 type WatcherTestSuite struct {
@@ -80,7 +88,7 @@ func (s *WatcherTestSuite) TestWatchReconnection() {
 			Namespace:  "",
 			MaxRetries: 5,
 			DedupCache: dedupCache,
-			ProcessEvent: func(event *v1.Event) {
+			ProcessEvent: func(_ context.Context, event *v1.Event) {
 				processedEvents = append(processedEvents, event.Name)
 			},
 		}
@@ -139,7 +147,7 @@ func (s *WatcherTestSuite) TestWatchDegradedState() {
 			OnError: func(err error) {
 				errorCount++
 			},
-			OnDegraded: func() {
+			OnDegraded: func(error) {
 				degradedCalled = true
 			},
 		}
@@ -159,9 +167,80 @@ func (s *WatcherTestSuite) TestWatchDegradedState() {
 	})
 }
 
+// TestWatchHealthTransitions validates health callbacks through connection loss, retry, and give-up
+func (s *WatcherTestSuite) TestWatchHealthTransitions() {
+	s.Run("reports reconnecting, reconnected, and degraded", func() {
+		clientset := fake.NewClientset()
+
+		var mu sync.Mutex
+		var watchers []*watch.FakeWatcher
+		transitions := []string{}
+		record := func(transition string) {
+			mu.Lock()
+			defer mu.Unlock()
+			transitions = append(transitions, transition)
+		}
+		getTransitions := func() []string {
+			mu.Lock()
+			defer mu.Unlock()
+			return append([]string(nil), transitions...)
+		}
+		getWatcher := func(i int) *watch.FakeWatcher {
+			mu.Lock()
+			defer mu.Unlock()
+			if i >= len(watchers) {
+				return nil
+			}
+			return watchers[i]
+		}
+
+		// The first two watches connect; later attempts fail to connect
+		clientset.PrependWatchReactor("events", func(action k8stesting.Action) (handled bool, ret watch.Interface, err error) {
+			mu.Lock()
+			defer mu.Unlock()
+			if len(watchers) >= 2 {
+				return true, nil, errors.New("connection refused")
+			}
+			fakeWatcher := watch.NewFake()
+			watchers = append(watchers, fakeWatcher)
+			return true, fakeWatcher, nil
+		})
+
+		eventWatcher := NewEventWatcher(EventWatcherConfig{
+			Clientset:      clientset,
+			MaxRetries:     3,
+			OnReconnecting: func() { record("reconnecting") },
+			OnReconnected:  func() { record("reconnected") },
+			OnDegraded:     func(error) { record("degraded") },
+		})
+		eventWatcher.backoff = func(int) time.Duration { return time.Millisecond }
+
+		ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+		defer cancel()
+		eventWatcher.Start(ctx)
+
+		// Connection loss on the first watch
+		s.Require().Eventually(func() bool { return getWatcher(0) != nil }, time.Second, 5*time.Millisecond)
+		s.Empty(getTransitions(), "initial connection should not report a transition")
+		getWatcher(0).Stop()
+
+		// Retry establishes the second watch
+		s.Require().Eventually(func() bool { return len(getTransitions()) == 2 }, time.Second, 5*time.Millisecond)
+		s.Equal([]string{"reconnecting", "reconnected"}, getTransitions())
+
+		// Losing the second watch exhausts the remaining retries
+		getWatcher(1).Stop()
+		s.Require().Eventually(func() bool { return len(getTransitions()) == 4 }, time.Second, 5*time.Millisecond)
+		s.Equal([]string{"reconnecting", "reconnected", "reconnecting", "degraded"}, getTransitions())
+	})
+}
+
 // TestWatchRetryCountReset validates retry count resets on success
 func (s *WatcherTestSuite) TestWatchRetryCountReset() {
-	s.Run("resets retry count on successful event", func() {
+	// runWatch starts a watch with retryCount 2, delivers one event once connected and
+	// another after the clock moved by elapsed, and returns the retry count after the
+	// second event was processed
+	runWatch := func(threshold, elapsed time.Duration) int {
 		clientset := fake.NewClientset()
 
 		watcher := watch.NewFake()
@@ -172,13 +251,16 @@ func (s *WatcherTestSuite) TestWatchRetryCountReset() {
 
 		dedupCache := NewDeduplicationCache(5 * time.Second)
 		processed := make(chan struct{}, 1)
+		fakeClock := clocktesting.NewFakeClock(time.Now())
 
 		config := EventWatcherConfig{
-			Clientset:  clientset,
-			Namespace:  "",
-			MaxRetries: 5,
-			DedupCache: dedupCache,
-			ProcessEvent: func(event *v1.Event) {
+			Clientset:                 clientset,
+			Namespace:                 "",
+			MaxRetries:                5,
+			StableConnectionThreshold: threshold,
+			DedupCache:                dedupCache,
+			Clock:                     fakeClock,
+			ProcessEvent: func(_ context.Context, event *v1.Event) {
 				processed <- struct{}{}
 			},
 		}
@@ -195,26 +277,27 @@ func (s *WatcherTestSuite) TestWatchRetryCountReset() {
 			close(done)
 		}()
 
-		// Send an event to reset retry count
-		event := &v1.Event{
-			ObjectMeta: metav1.ObjectMeta{
-				Name:            "test-event",
-				Namespace:       "default",
-				ResourceVersion: "1",
-			},
-		}
-		watcher.Add(event)
-
-		select {
-		case <-processed:
-		case <-time.After(200 * time.Millisecond):
-			s.Fail("event was not processed")
+		deliver := func(resourceVersion string) {
+			watcher.Add(&v1.Event{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:            "test-event",
+					Namespace:       "default",
+					ResourceVersion: resourceVersion,
+				},
+			})
+			select {
+			case <-processed:
+			case <-time.After(200 * time.Millisecond):
+				s.Fail("event was not processed")
+			}
 		}
 
-		// Retry count should be reset after successful event
-		s.Equal(0, eventWatcher.retryCount, "retry count should be reset after successful event")
+		// The first event is received once the watch is connected
+		deliver("1")
+		fakeClock.Step(elapsed)
+		deliver("2")
+		retryCount := eventWatcher.retryCount
 
-		watcher.Stop()
 		cancel()
 
 		select {
@@ -222,6 +305,60 @@ func (s *WatcherTestSuite) TestWatchRetryCountReset() {
 		case <-time.After(200 * time.Millisecond):
 			s.Fail("watcher did not stop")
 		}
+		return retryCount
+	}
+
+	s.Run("resets retry count on events once the connection is stable", func() {
+		s.Equal(0, runWatch(20*time.Millisecond, 50*time.Millisecond), "retry count should be reset after a stable connection")
+	})
+
+	s.Run("keeps retry count on events before the connection is stable", func() {
+		s.Equal(2, runWatch(time.Hour, 0), "retry count should not be reset by the first event")
+	})
+
+	s.Run("defaults the stable connection threshold", func() {
+		eventWatcher := NewEventWatcher(EventWatcherConfig{Clientset: fake.NewClientset()})
+		s.Equal(DefaultStableConnectionThreshold, eventWatcher.stableThreshold)
+	})
+
+	s.Run("flapping watch delivering one event per connection goes degraded", func() {
+		clientset := fake.NewClientset()
+
+		var connections atomic.Int32
+		clientset.PrependWatchReactor("events", func(action k8stesting.Action) (handled bool, ret watch.Interface, err error) {
+			n := connections.Add(1)
+			fakeWatcher := watch.NewFakeWithChanSize(1, false)
+			fakeWatcher.Add(&v1.Event{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:            fmt.Sprintf("stale-event-%d", n),
+					Namespace:       "default",
+					ResourceVersion: fmt.Sprintf("%d", n),
+				},
+			})
+			fakeWatcher.Stop()
+			return true, fakeWatcher, nil
+		})
+
+		degraded := make(chan struct{})
+		eventWatcher := NewEventWatcher(EventWatcherConfig{
+			Clientset:                 clientset,
+			MaxRetries:                3,
+			StableConnectionThreshold: time.Hour,
+			DedupCache:                NewDeduplicationCache(5 * time.Second),
+			OnDegraded:                func(error) { close(degraded) },
+		})
+		eventWatcher.backoff = func(int) time.Duration { return time.Millisecond }
+
+		ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+		defer cancel()
+		eventWatcher.Start(ctx)
+
+		select {
+		case <-degraded:
+		case <-ctx.Done():
+			s.Fail("flapping watch should go degraded")
+		}
+		s.Equal(int32(3), connections.Load(), "each connection should count as a retry")
 	})
 }
 
@@ -293,7 +430,7 @@ func (s *WatcherTestSuite) TestWatchFiltering() {
 			Filters:    filters,
 			MaxRetries: 5,
 			DedupCache: dedupCache,
-			ProcessEvent: func(event *v1.Event) {
+			ProcessEvent: func(_ context.Context, event *v1.Event) {
 				processedEvents = append(processedEvents, event.Namespace+"/"+event.Name)
 			},
 		}
@@ -362,7 +499,7 @@ func (s *WatcherTestSuite) TestWatchFiltering() {
 			Filters:    filters,
 			MaxRetries: 5,
 			DedupCache: dedupCache,
-			ProcessEvent: func(event *v1.Event) {
+			ProcessEvent: func(_ context.Context, event *v1.Event) {
 				processedEvents = append(processedEvents, event.Type+"/"+event.Name)
 			},
 		}
@@ -425,7 +562,7 @@ func (s *WatcherTestSuite) TestWatchFiltering() {
 			Filters:    filters,
 			MaxRetries: 5,
 			DedupCache: dedupCache,
-			ProcessEvent: func(event *v1.Event) {
+			ProcessEvent: func(_ context.Context, event *v1.Event) {
 				processedEvents = append(processedEvents, event.Reason)
 			},
 		}
@@ -475,6 +612,97 @@ func (s *WatcherTestSuite) TestWatchFiltering() {
 		s.Contains(processedEvents, "BackoffLimitExceeded", "should process event with reason starting with 'Back'")
 		s.NotContains(processedEvents, "Started", "should not process event with reason not starting with 'Back'")
 	})
+
+	s.Run("filters by involved object UID", func() {
+		eventWatcher := NewEventWatcher(EventWatcherConfig{
+			Clientset: fake.NewClientset(),
+			Filters:   &SubscriptionFilters{InvolvedUID: "pod-uid-1"},
+		})
+
+		matching := &v1.Event{InvolvedObject: v1.ObjectReference{Name: "test-pod", UID: "pod-uid-1"}}
+		recreated := &v1.Event{InvolvedObject: v1.ObjectReference{Name: "test-pod", UID: "pod-uid-2"}}
+
+		s.True(eventWatcher.matchesFilters(context.Background(), matching), "should process event for the filtered UID")
+		s.False(eventWatcher.matchesFilters(context.Background(), recreated), "should not process event for a recreated object")
+	})
+
+	s.Run("filters by source component", func() {
+		eventWatcher := NewEventWatcher(EventWatcherConfig{
+			Clientset: fake.NewClientset(),
+			Filters:   &SubscriptionFilters{SourceComponent: "default-scheduler"},
+		})
+
+		legacy := &v1.Event{Source: v1.EventSource{Component: "default-scheduler"}}
+		reporting := &v1.Event{ReportingController: "default-scheduler"}
+		kubelet := &v1.Event{Source: v1.EventSource{Component: "kubelet"}, ReportingController: "kubelet"}
+
+		s.True(eventWatcher.matchesFilters(context.Background(), legacy), "should process event with matching Source.Component")
+		s.True(eventWatcher.matchesFilters(context.Background(), reporting), "should process event with matching ReportingController")
+		s.False(eventWatcher.matchesFilters(context.Background(), kubelet), "should not process event from another component")
+	})
+
+	s.Run("filters by involved object labels with a label resolver", func() {
+		resolved := 0
+		eventWatcher := NewEventWatcher(EventWatcherConfig{
+			Clientset: fake.NewClientset(),
+			Filters:   &SubscriptionFilters{LabelSelector: "app=web"},
+			LabelResolver: func(_ context.Context, ref v1.ObjectReference) (map[string]string, error) {
+				resolved++
+				return map[string]string{"app": ref.Name}, nil
+			},
+		})
+
+		web := &v1.Event{InvolvedObject: v1.ObjectReference{Kind: "Pod", Name: "web", UID: "pod-uid-web"}}
+		db := &v1.Event{InvolvedObject: v1.ObjectReference{Kind: "Pod", Name: "db", UID: "pod-uid-db"}}
+
+		s.True(eventWatcher.matchesFilters(context.Background(), web), "should process event for an object matching the selector")
+		s.True(eventWatcher.matchesFilters(context.Background(), web), "should process repeated event for the same object")
+		s.False(eventWatcher.matchesFilters(context.Background(), db), "should not process event for an object outside the selector")
+		s.Equal(2, resolved, "object labels should be cached by UID")
+	})
+
+	s.Run("applies the same filters as the subscription", func() {
+		eventWatcher := NewEventWatcher(EventWatcherConfig{
+			Clientset: fake.NewClientset(),
+			Filters: &SubscriptionFilters{
+				ExcludeReasons:       []string{"Pulled"},
+				ExcludeInvolvedNames: []string{"noisy-pod"},
+				AnnotationSelector:   "team=payments",
+				CELExpression:        `event.type == "Warning"`,
+			},
+		})
+
+		matching := &v1.Event{
+			ObjectMeta:     metav1.ObjectMeta{Annotations: map[string]string{"team": "payments"}},
+			Type:           "Warning",
+			Reason:         "BackOff",
+			InvolvedObject: v1.ObjectReference{Name: "web"},
+		}
+		excludedReason := matching.DeepCopy()
+		excludedReason.Reason = "Pulled"
+		excludedName := matching.DeepCopy()
+		excludedName.InvolvedObject.Name = "noisy-pod"
+		otherTeam := matching.DeepCopy()
+		otherTeam.Annotations = map[string]string{"team": "search"}
+		normal := matching.DeepCopy()
+		normal.Type = "Normal"
+
+		s.True(eventWatcher.matchesFilters(context.Background(), matching))
+		s.False(eventWatcher.matchesFilters(context.Background(), excludedReason), "should apply excludeReasons")
+		s.False(eventWatcher.matchesFilters(context.Background(), excludedName), "should apply excludeInvolvedNames")
+		s.False(eventWatcher.matchesFilters(context.Background(), otherTeam), "should apply annotationSelector")
+		s.False(eventWatcher.matchesFilters(context.Background(), normal), "should apply celExpression")
+	})
+
+	s.Run("skips the label selector without a label resolver", func() {
+		eventWatcher := NewEventWatcher(EventWatcherConfig{
+			Clientset: fake.NewClientset(),
+			Filters:   &SubscriptionFilters{LabelSelector: "app=web", Type: "Warning"},
+		})
+
+		s.True(eventWatcher.matchesFilters(context.Background(), &v1.Event{Type: "Warning"}))
+		s.False(eventWatcher.matchesFilters(context.Background(), &v1.Event{Type: "Normal"}))
+	})
 }
 
 // TestWatchDeduplication validates deduplication integration
@@ -495,7 +723,7 @@ func (s *WatcherTestSuite) TestWatchDeduplication() {
 			Namespace:  "",
 			MaxRetries: 5,
 			DedupCache: dedupCache,
-			ProcessEvent: func(event *v1.Event) {
+			ProcessEvent: func(_ context.Context, event *v1.Event) {
 				processedEvents = append(processedEvents, event.Name)
 			},
 		}
@@ -542,7 +770,7 @@ func (s *WatcherTestSuite) TestWatchDeduplication() {
 			Namespace:  "",
 			MaxRetries: 5,
 			DedupCache: dedupCache,
-			ProcessEvent: func(event *v1.Event) {
+			ProcessEvent: func(_ context.Context, event *v1.Event) {
 				processedEvents = append(processedEvents, event.ResourceVersion)
 			},
 		}
@@ -583,6 +811,180 @@ func (s *WatcherTestSuite) TestWatchDeduplication() {
 	})
 }
 
+// TestWatchModifications validates delivery of Added vs Modified watch events
+func (s *WatcherTestSuite) TestWatchModifications() {
+	// runWatcher feeds one added, one modified and one more added event through a
+	// watcher and returns the names of the delivered events.
+	runWatcher := func(includeModifications *bool) []string {
+		clientset := fake.NewClientset()
+		fakeWatcher := watch.NewFake()
+		clientset.PrependWatchReactor("events", func(action k8stesting.Action) (handled bool, ret watch.Interface, err error) {
+			return true, fakeWatcher, nil
+		})
+
+		var mu sync.Mutex
+		processedEvents := []string{}
+		eventWatcher := NewEventWatcher(EventWatcherConfig{
+			Clientset:            clientset,
+			IncludeModifications: includeModifications,
+			ProcessEvent: func(_ context.Context, event *v1.Event) {
+				mu.Lock()
+				defer mu.Unlock()
+				processedEvents = append(processedEvents, event.Name)
+			},
+		})
+		ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+		defer cancel()
+		eventWatcher.Start(ctx)
+
+		fakeWatcher.Add(&v1.Event{ObjectMeta: metav1.ObjectMeta{Name: "created", Namespace: "default", ResourceVersion: "1"}, Count: 1})
+		fakeWatcher.Modify(&v1.Event{ObjectMeta: metav1.ObjectMeta{Name: "created", Namespace: "default", ResourceVersion: "2"}, Count: 2})
+		fakeWatcher.Add(&v1.Event{ObjectMeta: metav1.ObjectMeta{Name: "another", Namespace: "default", ResourceVersion: "3"}, Count: 1})
+		time.Sleep(50 * time.Millisecond)
+
+		mu.Lock()
+		defer mu.Unlock()
+		return append([]string(nil), processedEvents...)
+	}
+
+	s.Run("delivers modifications by default", func() {
+		s.Equal([]string{"created", "created", "another"}, runWatcher(nil))
+	})
+
+	s.Run("delivers modifications when explicitly included", func() {
+		s.Equal([]string{"created", "created", "another"}, runWatcher(ptr.To(true)))
+	})
+
+	s.Run("delivers only added events when modifications are excluded", func() {
+		s.Equal([]string{"created", "another"}, runWatcher(ptr.To(false)))
+	})
+}
+
+// TestWatchProcessEventPanics validates that a panicking ProcessEvent doesn't end the watch
+func (s *WatcherTestSuite) TestWatchProcessEventPanics() {
+	// newEvent returns the n-th event fed to the watcher
+	newEvent := func(n int) *v1.Event {
+		return &v1.Event{ObjectMeta: metav1.ObjectMeta{Name: fmt.Sprintf("event-%d", n), Namespace: "default", ResourceVersion: fmt.Sprint(n)}}
+	}
+
+	s.Run("events after a panic are still delivered", func() {
+		clientset := fake.NewClientset()
+		fakeWatcher := watch.NewFake()
+		clientset.PrependWatchReactor("events", func(action k8stesting.Action) (handled bool, ret watch.Interface, err error) {
+			return true, fakeWatcher, nil
+		})
+
+		var mu sync.Mutex
+		processedEvents := []string{}
+		eventWatcher := NewEventWatcher(EventWatcherConfig{
+			Clientset: clientset,
+			ProcessEvent: func(_ context.Context, event *v1.Event) {
+				if event.Name == "event-1" {
+					panic("sink exploded")
+				}
+				mu.Lock()
+				defer mu.Unlock()
+				processedEvents = append(processedEvents, event.Name)
+			},
+		})
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		eventWatcher.Start(ctx)
+
+		for n := 1; n <= 3; n++ {
+			fakeWatcher.Add(newEvent(n))
+		}
+
+		s.Eventually(func() bool {
+			mu.Lock()
+			defer mu.Unlock()
+			return len(processedEvents) == 2
+		}, time.Second, 10*time.Millisecond, "events after the panic should be processed")
+		s.Equal([]string{"event-2", "event-3"}, processedEvents)
+		s.Equal(int64(1), eventWatcher.ProcessEventPanics())
+
+		delivered := []string{}
+		for range 2 {
+			event := <-eventWatcher.ResultChan()
+			delivered = append(delivered, event.Object.(*v1.Event).Name)
+		}
+		s.Equal([]string{"event-2", "event-3"}, delivered, "the event that panicked is not delivered")
+	})
+
+	s.Run("goes degraded after MaxProcessEventPanics panics", func() {
+		clientset := fake.NewClientset()
+		fakeWatcher := watch.NewFake()
+		var watchCount atomic.Int32
+		clientset.PrependWatchReactor("events", func(action k8stesting.Action) (handled bool, ret watch.Interface, err error) {
+			watchCount.Add(1)
+			return true, fakeWatcher, nil
+		})
+
+		degraded := make(chan error, 1)
+		eventWatcher := NewEventWatcher(EventWatcherConfig{
+			Clientset:             clientset,
+			MaxProcessEventPanics: 2,
+			ProcessEvent: func(_ context.Context, _ *v1.Event) {
+				panic("sink exploded")
+			},
+			OnDegraded: func(err error) {
+				degraded <- err
+			},
+		})
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		eventWatcher.Start(ctx)
+
+		fakeWatcher.Add(newEvent(1))
+		select {
+		case err := <-degraded:
+			s.Failf("degraded too early", "after one panic: %v", err)
+		case <-time.After(50 * time.Millisecond):
+		}
+
+		fakeWatcher.Add(newEvent(2))
+		select {
+		case err := <-degraded:
+			s.ErrorIs(err, errProcessEventPanics)
+		case <-time.After(time.Second):
+			s.Fail("watch should go degraded after two panics")
+		}
+		s.Equal(int64(2), eventWatcher.ProcessEventPanics())
+		s.Equal(int32(1), watchCount.Load(), "the watch should not be retried")
+	})
+
+	s.Run("panics never degrade the watch by default", func() {
+		clientset := fake.NewClientset()
+		fakeWatcher := watch.NewFake()
+		clientset.PrependWatchReactor("events", func(action k8stesting.Action) (handled bool, ret watch.Interface, err error) {
+			return true, fakeWatcher, nil
+		})
+
+		var degraded atomic.Bool
+		eventWatcher := NewEventWatcher(EventWatcherConfig{
+			Clientset: clientset,
+			ProcessEvent: func(_ context.Context, _ *v1.Event) {
+				panic("sink exploded")
+			},
+			OnDegraded: func(error) {
+				degraded.Store(true)
+			},
+		})
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		eventWatcher.Start(ctx)
+
+		for n := 1; n <= 10; n++ {
+			fakeWatcher.Add(newEvent(n))
+		}
+
+		s.Eventually(func() bool {
+			return eventWatcher.ProcessEventPanics() == 10
+		}, time.Second, 10*time.Millisecond)
+		s.False(degraded.Load())
+	})
+}
+
 // TestInitialResourceVersion validates that the watcher uses initial resource version to skip historical events
 func (s *WatcherTestSuite) TestInitialResourceVersion() {
 	s.Run("uses initial resource version on first watch", func() {
@@ -844,7 +1246,7 @@ func (s *WatcherTestSuite) TestWatch410ErrorHandling() {
 			Namespace:  "",
 			MaxRetries: 5,
 			DedupCache: dedupCache,
-			ProcessEvent: func(event *v1.Event) {
+			ProcessEvent: func(_ context.Context, event *v1.Event) {
 				mu.Lock()
 				processedEvents = append(processedEvents, event.Name)
 				mu.Unlock()
@@ -871,7 +1273,568 @@ func (s *WatcherTestSuite) TestWatch410ErrorHandling() {
 			s.Contains(processedEvents, "recovery-event", "should process the recovery event")
 		}
 	})
+
+	// expiringClientset returns a clientset whose first event watch fails with expire,
+	// and the resource versions its event watches were started from
+	expiringClientset := func(expire func(*watch.FakeWatcher) error) (*fake.Clientset, func() []string) {
+		clientset := fake.NewClientset()
+		var mu sync.Mutex
+		var versions []string
+		clientset.PrependWatchReactor("events", func(action k8stesting.Action) (handled bool, ret watch.Interface, err error) {
+			mu.Lock()
+			defer mu.Unlock()
+			versions = append(versions, action.(k8stesting.WatchActionImpl).WatchRestrictions.ResourceVersion)
+			fakeWatch := watch.NewFake()
+			if len(versions) == 1 {
+				if err := expire(fakeWatch); err != nil {
+					return true, nil, err
+				}
+			}
+			return true, fakeWatch, nil
+		})
+		return clientset, func() []string {
+			mu.Lock()
+			defer mu.Unlock()
+			return append([]string(nil), versions...)
+		}
+	}
+	expiredStatus := func(fakeWatch *watch.FakeWatcher) error {
+		go fakeWatch.Error(&metav1.Status{Code: http.StatusGone, Reason: metav1.StatusReasonExpired, Message: "too old resource version"})
+		return nil
+	}
+	expiredWatch := func(*watch.FakeWatcher) error {
+		return apierrors.NewResourceExpired("too old resource version")
+	}
+
+	for name, expire := range map[string]func(*watch.FakeWatcher) error{
+		"status":              expiredStatus,
+		"watch request error": expiredWatch,
+	} {
+		s.Run("expired initial resource version restarts from the current one on a "+name, func() {
+			clientset, versions := expiringClientset(expire)
+			eventWatcher := NewEventWatcher(EventWatcherConfig{
+				Clientset:              clientset,
+				MaxRetries:             5,
+				InitialResourceVersion: "42",
+				CurrentResourceVersion: func() (string, error) { return "500", nil },
+			})
+			eventWatcher.backoff = func(int) time.Duration { return time.Millisecond }
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			eventWatcher.Start(ctx)
+
+			s.Require().Eventually(func() bool {
+				return len(versions()) == 2
+			}, time.Second, 5*time.Millisecond, "watcher should reconnect")
+			s.Equal([]string{"42", "500"}, versions())
+		})
+	}
+
+	s.Run("expired initial resource version isn't retried without CurrentResourceVersion", func() {
+		clientset, versions := expiringClientset(expiredStatus)
+		eventWatcher := NewEventWatcher(EventWatcherConfig{
+			Clientset:              clientset,
+			MaxRetries:             5,
+			InitialResourceVersion: "42",
+		})
+		eventWatcher.backoff = func(int) time.Duration { return time.Millisecond }
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		eventWatcher.Start(ctx)
+
+		s.Require().Eventually(func() bool {
+			return len(versions()) == 2
+		}, time.Second, 5*time.Millisecond, "watcher should reconnect")
+		s.Equal([]string{"42", ""}, versions())
+	})
+
+	s.Run("expired resource version relists the existing events in pages without CurrentResourceVersion", func() {
+		clientset, versions := expiringClientset(expiredStatus)
+		var mu sync.Mutex
+		var listOptions []metav1.ListOptions
+		clientset.PrependReactor("list", "events", func(action k8stesting.Action) (handled bool, ret k8sruntime.Object, err error) {
+			mu.Lock()
+			defer mu.Unlock()
+			opts := action.(k8stesting.ListActionImpl).ListOptions
+			listOptions = append(listOptions, opts)
+			if opts.Continue == "" {
+				return true, &v1.EventList{
+					ListMeta: metav1.ListMeta{Continue: "page-2"},
+					Items:    []v1.Event{{ObjectMeta: metav1.ObjectMeta{Name: "event-1", Namespace: "default", UID: "uid-1"}, Type: "Warning"}},
+				}, nil
+			}
+			return true, &v1.EventList{
+				ListMeta: metav1.ListMeta{ResourceVersion: "700"},
+				Items:    []v1.Event{{ObjectMeta: metav1.ObjectMeta{Name: "event-2", Namespace: "default", UID: "uid-2"}, Type: "Warning"}},
+			}, nil
+		})
+		var processed []string
+		eventWatcher := NewEventWatcher(EventWatcherConfig{
+			Clientset:              clientset,
+			MaxRetries:             5,
+			InitialResourceVersion: "42",
+			WatchListPageSize:      1,
+			Filters:                &SubscriptionFilters{Type: "Warning"},
+			ProcessEvent: func(_ context.Context, event *v1.Event) {
+				mu.Lock()
+				defer mu.Unlock()
+				processed = append(processed, event.Name)
+			},
+		})
+		eventWatcher.backoff = func(int) time.Duration { return time.Millisecond }
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		eventWatcher.Start(ctx)
+
+		s.Require().Eventually(func() bool {
+			return len(versions()) == 2
+		}, time.Second, 5*time.Millisecond, "watcher should reconnect")
+		s.Equal([]string{"42", "700"}, versions(), "should resume from the list's resource version")
+
+		mu.Lock()
+		defer mu.Unlock()
+		s.Require().Len(listOptions, 2)
+		for _, opts := range listOptions {
+			s.Equal(int64(1), opts.Limit)
+			s.Equal("type=Warning", opts.FieldSelector)
+			s.False(opts.Watch)
+			s.Empty(opts.ResourceVersion)
+		}
+		s.Equal("page-2", listOptions[1].Continue)
+		s.Equal([]string{"event-1", "event-2"}, processed)
+	})
+
+	s.Run("relist page size defaults to DefaultWatchListPageSize", func() {
+		eventWatcher := NewEventWatcher(EventWatcherConfig{Clientset: fake.NewClientset()})
+		s.Equal(DefaultWatchListPageSize, eventWatcher.relistOptions("").Limit)
+	})
+
+	s.Run("negative relist page size lists every event at once", func() {
+		eventWatcher := NewEventWatcher(EventWatcherConfig{Clientset: fake.NewClientset(), WatchListPageSize: -1})
+		s.Zero(eventWatcher.relistOptions("").Limit)
+	})
+
+	s.Run("failing to get the current resource version is retried", func() {
+		clientset, versions := expiringClientset(expiredStatus)
+		var attempts atomic.Int32
+		eventWatcher := NewEventWatcher(EventWatcherConfig{
+			Clientset:              clientset,
+			MaxRetries:             5,
+			InitialResourceVersion: "42",
+			CurrentResourceVersion: func() (string, error) {
+				if attempts.Add(1) == 1 {
+					return "", errors.New("connection refused")
+				}
+				return "500", nil
+			},
+		})
+		eventWatcher.backoff = func(int) time.Duration { return time.Millisecond }
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		eventWatcher.Start(ctx)
+
+		s.Require().Eventually(func() bool {
+			return len(versions()) == 2
+		}, time.Second, 5*time.Millisecond, "watcher should reconnect")
+		s.Equal([]string{"42", "500"}, versions())
+		s.Equal(int32(2), attempts.Load())
+	})
+}
+
+// TestWatchErrorClassification validates that watch error statuses other than 410 are routed by classifyWatchError
+func (s *WatcherTestSuite) TestWatchErrorClassification() {
+	s.Run("classifies statuses by code", func() {
+		for code, expected := range map[int32]watchErrorAction{
+			http.StatusGone:                watchErrorResync,
+			http.StatusForbidden:           watchErrorDegrade,
+			http.StatusInternalServerError: watchErrorRetry,
+			http.StatusServiceUnavailable:  watchErrorRetry,
+			http.StatusTooManyRequests:     watchErrorRetry,
+			http.StatusBadRequest:          watchErrorIgnore,
+		} {
+			s.Equal(expected, classifyWatchError(&metav1.Status{Code: code}), "code %d", code)
+		}
+	})
+
+	// newStatusWatcher starts a watcher whose first watch receives status, recording
+	// the resource version of every watch call and the error the watcher degraded with
+	newStatusWatcher := func(status *metav1.Status, watchErr error) (calls func() []string, degraded chan error, cancel context.CancelFunc) {
+		clientset := fake.NewClientset()
+		var mu sync.Mutex
+		var resourceVersions []string
+		clientset.PrependWatchReactor("events", func(action k8stesting.Action) (handled bool, ret watch.Interface, err error) {
+			mu.Lock()
+			defer mu.Unlock()
+			resourceVersions = append(resourceVersions, action.(k8stesting.WatchAction).GetWatchRestrictions().ResourceVersion)
+			if watchErr != nil {
+				return true, nil, watchErr
+			}
+			watcher := watch.NewFakeWithChanSize(2, false)
+			if len(resourceVersions) == 1 {
+				watcher.Add(&v1.Event{ObjectMeta: metav1.ObjectMeta{Name: "test-event", Namespace: "default", ResourceVersion: "2256"}})
+				watcher.Error(status)
+			}
+			return true, watcher, nil
+		})
+
+		degraded = make(chan error, 1)
+		eventWatcher := NewEventWatcher(EventWatcherConfig{
+			Clientset:  clientset,
+			MaxRetries: 5,
+			OnDegraded: func(err error) { degraded <- err },
+		})
+		eventWatcher.backoff = func(int) time.Duration { return time.Millisecond }
+
+		ctx, cancel := context.WithCancel(context.Background())
+		eventWatcher.Start(ctx)
+		calls = func() []string {
+			mu.Lock()
+			defer mu.Unlock()
+			return append([]string(nil), resourceVersions...)
+		}
+		return calls, degraded, cancel
+	}
+
+	s.Run("403 Forbidden degrades immediately without retrying", func() {
+		calls, degraded, cancel := newStatusWatcher(&metav1.Status{
+			Status:  metav1.StatusFailure,
+			Message: `events is forbidden: User "system:serviceaccount:default:mcp" cannot watch resource "events"`,
+			Reason:  metav1.StatusReasonForbidden,
+			Code:    http.StatusForbidden,
+		}, nil)
+		defer cancel()
+
+		select {
+		case err := <-degraded:
+			s.ErrorIs(err, errWatchForbidden)
+			s.Contains(err.Error(), "cannot watch resource")
+		case <-time.After(time.Second):
+			s.Fail("watcher should degrade on 403")
+		}
+		s.Len(calls(), 1, "a forbidden watch should not be retried")
+	})
+
+	s.Run("403 Forbidden on watch creation degrades immediately", func() {
+		calls, degraded, cancel := newStatusWatcher(nil, apierrors.NewForbidden(v1.Resource("events"), "", errors.New("RBAC: access denied")))
+		defer cancel()
+
+		select {
+		case err := <-degraded:
+			s.ErrorIs(err, errWatchForbidden)
+		case <-time.After(time.Second):
+			s.Fail("watcher should degrade on 403")
+		}
+		s.Len(calls(), 1, "a forbidden watch should not be retried")
+	})
+
+	s.Run("500 Internal Server Error retries from the last resource version", func() {
+		calls, degraded, cancel := newStatusWatcher(&metav1.Status{
+			Status:  metav1.StatusFailure,
+			Message: "etcdserver: leader changed",
+			Reason:  metav1.StatusReasonInternalError,
+			Code:    http.StatusInternalServerError,
+		}, nil)
+		defer cancel()
+
+		s.Eventually(func() bool {
+			return len(calls()) == 2
+		}, time.Second, 5*time.Millisecond, "watcher should reconnect after a 500")
+		s.Equal([]string{"", "2256"}, calls(), "the retry should resume from the last resource version")
+		s.Empty(degraded, "a 500 should not degrade the watch")
+	})
+}
+
+// TestWatchTimeout validates that watches are opened with a server-side timeout
+func (s *WatcherTestSuite) TestWatchTimeout() {
+	captureTimeout := func(watchTimeout time.Duration) *int64 {
+		clientset := fake.NewClientset()
+
+		var captured *int64
+		clientset.PrependWatchReactor("events", func(action k8stesting.Action) (handled bool, ret watch.Interface, err error) {
+			captured = action.(k8stesting.WatchActionImpl).ListOptions.TimeoutSeconds
+			watcher := watch.NewFake()
+			go watcher.Stop()
+			return true, watcher, nil
+		})
+
+		eventWatcher := NewEventWatcher(EventWatcherConfig{
+			Clientset:    clientset,
+			WatchTimeout: watchTimeout,
+		})
+		_ = eventWatcher.startWatch(context.Background())
+		return captured
+	}
+
+	s.Run("uses default timeout when unset", func() {
+		timeout := captureTimeout(0)
+		s.Require().NotNil(timeout, "watch should set TimeoutSeconds")
+		s.Equal(int64(DefaultWatchTimeout/time.Second), *timeout)
+	})
+
+	s.Run("uses configured timeout", func() {
+		timeout := captureTimeout(5 * time.Minute)
+		s.Require().NotNil(timeout, "watch should set TimeoutSeconds")
+		s.Equal(int64(300), *timeout)
+	})
+
+	s.Run("rounds sub-second timeout up to one second", func() {
+		timeout := captureTimeout(100 * time.Millisecond)
+		s.Require().NotNil(timeout, "watch should set TimeoutSeconds")
+		s.Equal(int64(1), *timeout)
+	})
+
+	s.Run("negative timeout disables it", func() {
+		s.Nil(captureTimeout(-1), "watch should not set TimeoutSeconds")
+	})
+
+	s.Run("watch closed at its timeout is not a failure", func() {
+		eventWatcher := NewEventWatcher(EventWatcherConfig{WatchTimeout: time.Minute})
+		s.True(eventWatcher.watchExpired(time.Now().Add(-time.Minute)))
+		s.False(eventWatcher.watchExpired(time.Now().Add(-10*time.Second)), "early close should be treated as a failure")
+
+		disabled := NewEventWatcher(EventWatcherConfig{WatchTimeout: -1})
+		s.False(disabled.watchExpired(time.Now().Add(-time.Hour)))
+	})
+}
+
+// TestWatchOptions tests that filters the API server can apply are pushed down as selectors
+func (s *WatcherTestSuite) TestWatchOptions() {
+	s.Run("pushes event label selector down as label selector", func() {
+		eventWatcher := NewEventWatcher(EventWatcherConfig{
+			Namespace: "default",
+			Filters:   &SubscriptionFilters{Namespaces: []string{"default"}, EventLabelSelector: "team=payments"},
+		})
+
+		opts := eventWatcher.watchOptions()
+		s.True(opts.Watch)
+		s.Equal("team=payments", opts.LabelSelector)
+	})
+
+	s.Run("does not push down involved object label selector", func() {
+		eventWatcher := NewEventWatcher(EventWatcherConfig{
+			Filters: &SubscriptionFilters{LabelSelector: "app=nginx"},
+		})
+
+		s.Empty(eventWatcher.watchOptions().LabelSelector, "events don't carry their involved object's labels")
+	})
+
+	s.Run("combines field selectors", func() {
+		eventWatcher := NewEventWatcher(EventWatcherConfig{
+			Filters: &SubscriptionFilters{InvolvedKind: "Pod", Type: "Warning", EventLabelSelector: "team=payments"},
+		})
+
+		opts := eventWatcher.watchOptions()
+		s.Equal("involvedObject.kind=Pod,type=Warning", opts.FieldSelector)
+		s.Equal("team=payments", opts.LabelSelector)
+	})
+
+	s.Run("pushes involved API version down together with the kind", func() {
+		eventWatcher := NewEventWatcher(EventWatcherConfig{
+			Filters: &SubscriptionFilters{InvolvedKind: "Deployment", InvolvedAPIVersion: "apps/v1"},
+		})
+
+		s.Equal("involvedObject.kind=Deployment,involvedObject.apiVersion=apps/v1", eventWatcher.watchOptions().FieldSelector)
+	})
+
+	s.Run("filters involved API version without kind client-side", func() {
+		eventWatcher := NewEventWatcher(EventWatcherConfig{
+			Filters: &SubscriptionFilters{InvolvedAPIVersion: "apps/v1"},
+		})
+
+		s.Empty(eventWatcher.watchOptions().FieldSelector)
+		matching := &v1.Event{InvolvedObject: v1.ObjectReference{Kind: "Deployment", APIVersion: "apps/v1"}}
+		other := &v1.Event{InvolvedObject: v1.ObjectReference{Kind: "Deployment", APIVersion: "example.com/v1"}}
+		s.True(eventWatcher.matchesFilters(context.Background(), matching))
+		s.False(eventWatcher.matchesFilters(context.Background(), other))
+	})
+
+	s.Run("still filters client-side", func() {
+		eventWatcher := NewEventWatcher(EventWatcherConfig{
+			Filters: &SubscriptionFilters{EventLabelSelector: "team=payments"},
+		})
+
+		matching := &v1.Event{ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"team": "payments"}}}
+		other := &v1.Event{ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"team": "search"}}}
+		s.True(eventWatcher.matchesFilters(context.Background(), matching))
+		s.False(eventWatcher.matchesFilters(context.Background(), other))
+	})
+}
+
+// TestWatchCircuitBreaker tests that watches sharing a circuit breaker back off together
+func (s *WatcherTestSuite) TestWatchCircuitBreaker() {
+	s.Run("persistent failures pause every watch on the cluster and resume after the cooldown", func() {
+		clientset := fake.NewClientset()
+
+		var attempts atomic.Int32
+		var clusterUp atomic.Bool
+		clientset.PrependWatchReactor("events", func(action k8stesting.Action) (handled bool, ret watch.Interface, err error) {
+			attempts.Add(1)
+			if !clusterUp.Load() {
+				return true, nil, errors.New("connection refused")
+			}
+			return true, watch.NewFake(), nil
+		})
+
+		breaker := NewCircuitBreaker(3, 300*time.Millisecond)
+		var connected atomic.Int32
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		for i := 0; i < 3; i++ {
+			eventWatcher := NewEventWatcher(EventWatcherConfig{
+				Clientset:     clientset,
+				MaxRetries:    100,
+				Breaker:       breaker,
+				OnReconnected: func() { connected.Add(1) },
+			})
+			eventWatcher.backoff = func(int) time.Duration { return time.Millisecond }
+			eventWatcher.Start(ctx)
+		}
+
+		s.Require().Eventually(func() bool {
+			return breaker.State() == BreakerOpen
+		}, time.Second, 5*time.Millisecond, "breaker should open after repeated failures")
+
+		// While open, no watch on the cluster makes another attempt
+		paused := attempts.Load()
+		time.Sleep(150 * time.Millisecond)
+		s.Equal(paused, attempts.Load(), "watch attempts should be paused while the breaker is open")
+
+		// After the cooldown a trial attempt fails and the breaker reopens
+		s.Require().Eventually(func() bool {
+			return attempts.Load() > paused
+		}, time.Second, 5*time.Millisecond, "a trial attempt should be made after the cooldown")
+		s.Eventually(func() bool {
+			return breaker.State() == BreakerOpen
+		}, time.Second, 5*time.Millisecond, "failed trial should reopen the breaker")
+		s.LessOrEqual(attempts.Load()-paused, int32(3), "only the trial attempt should be let through, not every watch")
+
+		// Once the cluster is back, the next trial closes the breaker and every watch reconnects
+		clusterUp.Store(true)
+		s.Eventually(func() bool {
+			return connected.Load() == 3
+		}, 3*time.Second, 10*time.Millisecond, "all watches should resume after the cooldown")
+		s.Equal(BreakerClosed, breaker.State())
+	})
+}
+
+// TestWatchCancellation validates that stopping a watcher stops its watches and goroutine
+func (s *WatcherTestSuite) TestWatchCancellation() {
+	// newRecordingClientset returns a clientset whose event watches are recorded
+	newRecordingClientset := func() (*fake.Clientset, func() []*watch.FakeWatcher) {
+		clientset := fake.NewClientset()
+		var mu sync.Mutex
+		var watches []*watch.FakeWatcher
+		clientset.PrependWatchReactor("events", func(action k8stesting.Action) (handled bool, ret watch.Interface, err error) {
+			mu.Lock()
+			defer mu.Unlock()
+			fakeWatch := watch.NewFake()
+			watches = append(watches, fakeWatch)
+			return true, fakeWatch, nil
+		})
+		return clientset, func() []*watch.FakeWatcher {
+			mu.Lock()
+			defer mu.Unlock()
+			return append([]*watch.FakeWatcher(nil), watches...)
+		}
+	}
+
+	s.Run("cancelling the context stops the watch and closes the result channel", func() {
+		clientset, watches := newRecordingClientset()
+		eventWatcher := NewEventWatcher(EventWatcherConfig{Clientset: clientset, MaxRetries: 5})
+		ctx, cancel := context.WithCancel(context.Background())
+		eventWatcher.Start(ctx)
+		s.Require().Eventually(func() bool {
+			return len(watches()) == 1
+		}, time.Second, 5*time.Millisecond, "watch should be established")
+
+		cancel()
+
+		s.Eventually(func() bool {
+			return watches()[0].IsStopped()
+		}, time.Second, 5*time.Millisecond, "watch should be stopped when the context is cancelled")
+		s.Eventually(func() bool {
+			_, open := <-eventWatcher.ResultChan()
+			return !open
+		}, time.Second, 5*time.Millisecond, "result channel should be closed")
+	})
+
+	s.Run("Stop stops the watch and may be called more than once", func() {
+		clientset, watches := newRecordingClientset()
+		eventWatcher := NewEventWatcher(EventWatcherConfig{Clientset: clientset, MaxRetries: 5})
+		eventWatcher.Start(context.Background())
+		s.Require().Eventually(func() bool {
+			return len(watches()) == 1
+		}, time.Second, 5*time.Millisecond, "watch should be established")
+
+		eventWatcher.Stop()
+		s.NotPanics(eventWatcher.Stop)
+
+		s.Eventually(func() bool {
+			return watches()[0].IsStopped()
+		}, time.Second, 5*time.Millisecond, "watch should be stopped by Stop")
+	})
+
+	s.Run("reconnecting stops the previous watch", func() {
+		clientset, watches := newRecordingClientset()
+		eventWatcher := NewEventWatcher(EventWatcherConfig{Clientset: clientset, MaxRetries: 5})
+		eventWatcher.backoff = func(int) time.Duration { return time.Millisecond }
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		eventWatcher.Start(ctx)
+		s.Require().Eventually(func() bool {
+			return len(watches()) == 1
+		}, time.Second, 5*time.Millisecond, "watch should be established")
+
+		// An expired resource version makes the watcher reconnect without the server
+		// closing the watch, so only the watcher can stop it
+		watches()[0].Error(&metav1.Status{Code: 410, Message: "too old resource version"})
+
+		s.Require().Eventually(func() bool {
+			return len(watches()) == 2
+		}, time.Second, 5*time.Millisecond, "watcher should reconnect")
+		s.True(watches()[0].IsStopped(), "previous watch should be stopped before reconnecting")
+		s.False(watches()[1].IsStopped())
+	})
+
+	s.Run("starting and cancelling many watchers leaks no goroutines", func() {
+		// Half the watchers connect, the other half fail and back off before retrying
+		clientset := fake.NewClientset()
+		var attempts atomic.Int32
+		clientset.PrependWatchReactor("events", func(action k8stesting.Action) (handled bool, ret watch.Interface, err error) {
+			if attempts.Add(1)%2 == 0 {
+				return true, nil, errors.New("connection refused")
+			}
+			return true, watch.NewFake(), nil
+		})
+		baseline := runtime.NumGoroutine()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		watchers := make([]*EventWatcher, 50)
+		for i := range watchers {
+			watchers[i] = NewEventWatcher(EventWatcherConfig{Clientset: clientset, MaxRetries: 100})
+			watchers[i].backoff = func(int) time.Duration { return time.Minute }
+			watchers[i].Start(ctx)
+		}
+		s.Require().Eventually(func() bool {
+			return attempts.Load() >= int32(len(watchers))
+		}, time.Second, 5*time.Millisecond, "every watcher should attempt a watch")
+
+		cancel()
+
+		for _, eventWatcher := range watchers {
+			s.Eventually(func() bool {
+				_, open := <-eventWatcher.ResultChan()
+				return !open
+			}, time.Second, 5*time.Millisecond, "every watcher should stop")
+		}
+		// Poll from the test goroutine: Eventually runs its condition in a goroutine of its own
+		deadline := time.Now().Add(2 * time.Second)
+		for runtime.NumGoroutine() > baseline && time.Now().Before(deadline) {
+			time.Sleep(10 * time.Millisecond)
+		}
+		s.LessOrEqual(runtime.NumGoroutine(), baseline, "goroutines should return to the baseline")
+	})
 }
 
 // Mock objects to compile tests
-var _ runtime.Object = &v1.Event{}
+var _ k8sruntime.Object = &v1.Event{}
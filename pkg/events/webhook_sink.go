@@ -0,0 +1,122 @@
+package events
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// WebhookSinkConfig holds configuration for a WebhookSink.
+type WebhookSinkConfig struct {
+	// URL is the endpoint fault notifications are posted to. Required.
+	URL string
+
+	// CompressionThreshold is the payload size in bytes from which notifications are
+	// gzip-compressed and posted with a "Content-Encoding: gzip" header, so faults
+	// enriched with logs don't bloat requests. Smaller payloads are posted uncompressed.
+	// Zero disables compression; negative values are rejected.
+	// Default: 0
+	CompressionThreshold int
+
+	// HTTPClient is used to post notifications. Default: a client with a 10s timeout.
+	HTTPClient *http.Client
+}
+
+// WebhookSink is a NotificationSink that posts each fault notification as JSON to an
+// HTTP endpoint, e.g. an alerting pipeline or a log collector. Unlike SlackSink, the
+// notification is posted as-is, with its full context and enrichment.
+type WebhookSink struct {
+	url                  *url.URL
+	client               *http.Client
+	compressionThreshold int
+}
+
+// NewWebhookSink creates a WebhookSink from the given configuration.
+// Returns an error if the URL is missing or invalid, or the compression threshold is negative.
+func NewWebhookSink(config WebhookSinkConfig) (*WebhookSink, error) {
+	if config.URL == "" {
+		return nil, fmt.Errorf("webhook URL is required")
+	}
+	// Webhook URLs may embed a secret, so errors only mention the scheme and host
+	parsed, err := url.Parse(config.URL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid webhook URL: not a valid URL")
+	}
+	if (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return nil, fmt.Errorf("invalid webhook URL %q: must be an http or https URL with a host", redactURL(parsed))
+	}
+	if config.CompressionThreshold < 0 {
+		return nil, fmt.Errorf("webhook compression threshold must not be negative, got %d", config.CompressionThreshold)
+	}
+
+	if config.HTTPClient == nil {
+		config.HTTPClient = &http.Client{Timeout: 10 * time.Second}
+	}
+
+	return &WebhookSink{
+		url:                  parsed,
+		client:               config.HTTPClient,
+		compressionThreshold: config.CompressionThreshold,
+	}, nil
+}
+
+// Name identifies the sink in logs.
+func (s *WebhookSink) Name() string {
+	return "webhook"
+}
+
+// Send posts a fault notification to the webhook, gzip-compressed if it is at least
+// the compression threshold in size.
+func (s *WebhookSink) Send(ctx context.Context, notification *ResourceFaultNotification) error {
+	body, err := json.Marshal(notification)
+	if err != nil {
+		return fmt.Errorf("failed to encode webhook payload: %w", err)
+	}
+
+	compressed := s.compressionThreshold > 0 && len(body) >= s.compressionThreshold
+	if compressed {
+		if body, err = gzipPayload(body); err != nil {
+			return fmt.Errorf("failed to compress webhook payload: %w", err)
+		}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url.String(), bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create webhook request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if compressed {
+		req.Header.Set("Content-Encoding", "gzip")
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to post to webhook: %w", redactURLError(err, s.url))
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		respBody, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("webhook returned status %d: %s", resp.StatusCode, bytes.TrimSpace(respBody))
+	}
+	return nil
+}
+
+// gzipPayload returns the gzip-compressed payload.
+func gzipPayload(payload []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	writer := gzip.NewWriter(&buf)
+	if _, err := writer.Write(payload); err != nil {
+		return nil, err
+	}
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
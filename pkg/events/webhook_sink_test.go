@@ -0,0 +1,167 @@
+package events
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type WebhookSinkSuite struct {
+	suite.Suite
+	server   *httptest.Server
+	mu       sync.Mutex
+	requests []*http.Request
+	bodies   [][]byte
+	status   int
+}
+
+func TestWebhookSinkSuite(t *testing.T) {
+	suite.Run(t, new(WebhookSinkSuite))
+}
+
+func (s *WebhookSinkSuite) SetupTest() {
+	s.resetRecorded()
+	s.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		s.mu.Lock()
+		s.requests = append(s.requests, r)
+		s.bodies = append(s.bodies, body)
+		status := s.status
+		s.mu.Unlock()
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte("ok"))
+	}))
+}
+
+func (s *WebhookSinkSuite) SetupSubTest() {
+	s.resetRecorded()
+}
+
+func (s *WebhookSinkSuite) TearDownTest() {
+	s.server.Close()
+}
+
+// resetRecorded clears the requests recorded by the test webhook.
+func (s *WebhookSinkSuite) resetRecorded() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.requests = nil
+	s.bodies = nil
+	s.status = http.StatusOK
+}
+
+// lastRequest returns the most recent request received by the test webhook and its raw body.
+func (s *WebhookSinkSuite) lastRequest() (*http.Request, []byte) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.Require().NotEmpty(s.requests, "expected a notification to be posted")
+	return s.requests[len(s.requests)-1], s.bodies[len(s.bodies)-1]
+}
+
+func (s *WebhookSinkSuite) TestNewWebhookSink() {
+	s.Run("requires a URL", func() {
+		_, err := NewWebhookSink(WebhookSinkConfig{})
+		s.ErrorContains(err, "webhook URL is required")
+	})
+
+	s.Run("rejects invalid URLs", func() {
+		for _, webhookURL := range []string{"not a url", "ftp://alerts.example.com/faults", "https://"} {
+			_, err := NewWebhookSink(WebhookSinkConfig{URL: webhookURL})
+			s.ErrorContains(err, "invalid webhook URL", "URL %q should be rejected", webhookURL)
+		}
+	})
+
+	s.Run("doesn't leak a secret in the URL in errors", func() {
+		_, err := NewWebhookSink(WebhookSinkConfig{URL: "ftp://alerts.example.com/faults?token=secret-token"})
+		s.ErrorContains(err, `"ftp://alerts.example.com"`)
+		s.NotContains(err.Error(), "secret-token")
+	})
+
+	s.Run("rejects negative compression thresholds", func() {
+		_, err := NewWebhookSink(WebhookSinkConfig{URL: s.server.URL, CompressionThreshold: -1})
+		s.ErrorContains(err, "compression threshold must not be negative")
+	})
+}
+
+func (s *WebhookSinkSuite) TestSend() {
+	s.Run("posts the notification as JSON", func() {
+		sink, err := NewWebhookSink(WebhookSinkConfig{URL: s.server.URL})
+		s.Require().NoError(err)
+
+		notification := makeSlackNotification(SeverityWarning)
+		s.Require().NoError(sink.Send(context.Background(), notification))
+
+		req, body := s.lastRequest()
+		s.Equal(http.MethodPost, req.Method)
+		s.Equal("application/json", req.Header.Get("Content-Type"))
+		s.Empty(req.Header.Get("Content-Encoding"))
+		expected, err := json.Marshal(notification)
+		s.Require().NoError(err)
+		s.JSONEq(string(expected), string(body))
+	})
+
+	s.Run("posts payloads below the compression threshold uncompressed", func() {
+		sink, err := NewWebhookSink(WebhookSinkConfig{URL: s.server.URL, CompressionThreshold: 4096})
+		s.Require().NoError(err)
+
+		s.Require().NoError(sink.Send(context.Background(), makeSlackNotification(SeverityWarning)))
+
+		req, body := s.lastRequest()
+		s.Empty(req.Header.Get("Content-Encoding"))
+		s.True(json.Valid(body), "small payload should be plain JSON")
+	})
+
+	s.Run("gzips payloads at or above the compression threshold", func() {
+		sink, err := NewWebhookSink(WebhookSinkConfig{URL: s.server.URL, CompressionThreshold: 4096})
+		s.Require().NoError(err)
+
+		notification := makeSlackNotification(SeverityCritical)
+		notification.Context = "panic: runtime error\n" + strings.Repeat("goroutine 1 [running]:\nmain.handler()\n", 500)
+		s.Require().NoError(sink.Send(context.Background(), notification))
+
+		req, body := s.lastRequest()
+		s.Equal("gzip", req.Header.Get("Content-Encoding"))
+		s.Equal("application/json", req.Header.Get("Content-Type"))
+
+		reader, err := gzip.NewReader(bytes.NewReader(body))
+		s.Require().NoError(err)
+		decoded, err := io.ReadAll(reader)
+		s.Require().NoError(err)
+		expected, err := json.Marshal(notification)
+		s.Require().NoError(err)
+		s.JSONEq(string(expected), string(decoded), "compressed payload should decode back to the notification")
+		s.Less(len(body), len(expected))
+	})
+
+	s.Run("returns error for non-2xx responses", func() {
+		s.mu.Lock()
+		s.status = http.StatusServiceUnavailable
+		s.mu.Unlock()
+		sink, err := NewWebhookSink(WebhookSinkConfig{URL: s.server.URL})
+		s.Require().NoError(err)
+
+		err = sink.Send(context.Background(), makeSlackNotification(SeverityWarning))
+		s.ErrorContains(err, "status 503")
+	})
+
+	s.Run("doesn't leak a secret in the URL when posting fails", func() {
+		server := httptest.NewServer(http.NotFoundHandler())
+		server.Close()
+		sink, err := NewWebhookSink(WebhookSinkConfig{URL: server.URL + "/faults?token=secret-token"})
+		s.Require().NoError(err)
+
+		err = sink.Send(context.Background(), makeSlackNotification(SeverityWarning))
+		s.ErrorContains(err, "failed to post to webhook")
+		s.ErrorContains(err, server.URL)
+		s.NotContains(err.Error(), "secret-token")
+	})
+}
@@ -97,23 +97,35 @@ func NewServer(configuration Configuration, oidcProvider *oidc.Provider, httpCli
 		configuration: &configuration,
 		oidcProvider:  oidcProvider,
 		httpClient:    httpClient,
-		server: mcp.NewServer(
-			&mcp.Implementation{
-				Name:       version.BinaryName,
-				Title:      version.BinaryName,
-				Version:    version.Version,
-				WebsiteURL: version.WebsiteURL,
-			},
-			&mcp.ServerOptions{
-				Capabilities: &mcp.ServerCapabilities{
-					Resources: nil,
-					Prompts:   &mcp.PromptCapabilities{ListChanged: !configuration.Stateless},
-					Tools:     &mcp.ToolCapabilities{ListChanged: !configuration.Stateless},
-					Logging:   &mcp.LoggingCapabilities{},
-				},
-				Instructions: configuration.ServerInstructions,
-			}),
 	}
+	serverOptions := &mcp.ServerOptions{
+		Capabilities: &mcp.ServerCapabilities{
+			Resources: nil,
+			Prompts:   &mcp.PromptCapabilities{ListChanged: !configuration.Stateless},
+			Tools:     &mcp.ToolCapabilities{ListChanged: !configuration.Stateless},
+			Logging:   &mcp.LoggingCapabilities{},
+		},
+		Instructions:       configuration.ServerInstructions,
+		InitializedHandler: s.onSessionInitialized,
+	}
+	if !configuration.Stateless {
+		// Event subscriptions can be delivered as resource updates, which need sessions
+		// that outlive a request to subscribe to the resources
+		serverOptions.SubscribeHandler = func(_ context.Context, req *mcp.SubscribeRequest) error {
+			return events.ValidateSubscriptionResourceURI(req.Params.URI)
+		}
+		serverOptions.UnsubscribeHandler = func(context.Context, *mcp.UnsubscribeRequest) error {
+			return nil
+		}
+	}
+	s.server = mcp.NewServer(
+		&mcp.Implementation{
+			Name:       version.BinaryName,
+			Title:      version.BinaryName,
+			Version:    version.Version,
+			WebsiteURL: version.WebsiteURL,
+		},
+		serverOptions)
 
 	s.server.AddReceivingMiddleware(sessionInjectionMiddleware)
 	s.server.AddReceivingMiddleware(authHeaderPropagationMiddleware)
@@ -136,20 +148,40 @@ func NewServer(configuration Configuration, oidcProvider *oidc.Provider, httpCli
 	getK8sClient := func(cluster string) (*internalk8s.Kubernetes, error) {
 		return s.p.GetDerivedKubernetes(context.Background(), cluster)
 	}
-	// Create default set of fault detectors for resource-based fault detection
-	faultDetectors := []events.Detector{
-		detectors.NewPodCrashDetector(),
-		detectors.NewCrashLoopDetector(),
-		detectors.NewNodeUnhealthyDetector(),
-		detectors.NewDeploymentFailureDetector(),
-		detectors.NewJobFailureDetector(),
+	eventConfig := events.DefaultManagerConfig()
+	if !configuration.Stateless {
+		resourceNotifier := events.NewResourceNotifier(s.server, events.DefaultResourceNotificationLimit)
+		s.server.AddResourceTemplate(&mcp.ResourceTemplate{
+			Name:        "event-subscription",
+			Title:       "Event subscription notifications",
+			Description: "Most recent notifications of an event subscription created with delivery 'resource'",
+			MIMEType:    "application/json",
+			URITemplate: events.SubscriptionResourceTemplate,
+		}, resourceNotifier.ReadResource)
+		eventConfig.ResourceNotifier = resourceNotifier
 	}
-	s.eventManager = events.NewEventSubscriptionManager(mcpAdapter, events.DefaultManagerConfig(), getK8sClient, faultDetectors)
+	s.eventManager = events.NewEventSubscriptionManager(mcpAdapter, eventConfig, getK8sClient, detectors.DefaultRegistry)
 	s.eventAdapter = &events.ManagerAdapter{EventSubscriptionManager: s.eventManager}
 
 	return s, nil
 }
 
+// onSessionInitialized waits for the initialized session to close and then cancels
+// its event subscriptions, so they don't linger until the next session monitor check.
+// Stateless sessions only live for a single request and are left to the monitor.
+func (s *Server) onSessionInitialized(_ context.Context, req *mcp.InitializedRequest) {
+	if s.configuration.Stateless || req == nil || req.Session == nil {
+		return
+	}
+	session := req.Session
+	go func() {
+		_ = session.Wait()
+		if s.eventManager != nil {
+			s.eventManager.OnSessionClosed(session.ID())
+		}
+	}()
+}
+
 func (s *Server) reloadToolsets() error {
 	ctx := context.Background()
 
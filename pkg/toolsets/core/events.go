@@ -3,6 +3,7 @@ package core
 import (
 	"encoding/json"
 	"fmt"
+	"maps"
 
 	"github.com/google/jsonschema-go/jsonschema"
 	"k8s.io/klog/v2"
@@ -40,47 +41,8 @@ func initEvents() []api.ServerTool {
 			Name:        "events_subscribe",
 			Description: "Subscribe to Kubernetes event notifications in real-time. Requires HTTP/SSE transport (start server with --port). Client must call logging/setLevel before receiving notifications.",
 			InputSchema: &jsonschema.Schema{
-				Type: "object",
-				Properties: map[string]*jsonschema.Schema{
-					"mode": {
-						Type:        "string",
-						Description: "Subscription mode: 'events' for all events (default), 'faults' for resource-based fault detection with edge-triggered state change notifications",
-						Enum:        []any{"events", "faults"},
-						Default:     json.RawMessage(`"events"`),
-					},
-					"namespaces": {
-						Type:        "array",
-						Description: "Optional list of namespaces to watch. If not provided, watches all namespaces (cluster-wide)",
-						Items: &jsonschema.Schema{
-							Type: "string",
-						},
-					},
-					"labelSelector": {
-						Type:        "string",
-						Description: "Optional label selector for filtering events by involved object labels (e.g., 'app=nginx,tier=frontend')",
-					},
-					"involvedKind": {
-						Type:        "string",
-						Description: "Optional involved object kind filter (e.g., 'Pod', 'Deployment')",
-					},
-					"involvedName": {
-						Type:        "string",
-						Description: "Optional involved object name filter",
-					},
-					"involvedNamespace": {
-						Type:        "string",
-						Description: "Optional involved object namespace filter",
-					},
-					"type": {
-						Type:        "string",
-						Description: "Optional event type filter: 'Normal' or 'Warning'",
-						Enum:        []any{"Normal", "Warning"},
-					},
-					"reason": {
-						Type:        "string",
-						Description: "Optional event reason prefix filter (e.g., 'BackOff', 'Failed')",
-					},
-				},
+				Type:       "object",
+				Properties: eventsSubscribeProperties(),
 			},
 			Annotations: api.ToolAnnotations{
 				Title:           "Events: Subscribe",
@@ -122,6 +84,26 @@ func initEvents() []api.ServerTool {
 				OpenWorldHint:   ptr.To(false),
 			},
 		}, Handler: eventsListSubscriptions},
+		{Tool: api.Tool{
+			Name:        "events_recent_faults",
+			Description: "List faults recently detected in the current cluster, newest first. Use this after subscribing to catch up on faults that occurred before the subscription was created. Faults are only recorded while a 'faults' mode subscription is active for the cluster.",
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"limit": {
+						Type:        "integer",
+						Description: "Optional maximum number of faults to return. If not provided, returns all buffered faults",
+						Minimum:     ptr.To(float64(0)),
+					},
+				},
+			},
+			Annotations: api.ToolAnnotations{
+				Title:           "Events: Recent Faults",
+				ReadOnlyHint:    ptr.To(true),
+				DestructiveHint: ptr.To(false),
+				OpenWorldHint:   ptr.To(false),
+			},
+		}, Handler: eventsRecentFaults},
 	}
 }
 
@@ -145,6 +127,23 @@ func eventsList(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
 	return api.NewToolCallResult(fmt.Sprintf("# The following events (YAML format) were found:\n%s", yamlEvents), err), nil
 }
 
+// eventsSubscribeProperties returns the events_subscribe input properties: the
+// subscription mode and resource version to resume from, plus every subscription filter.
+func eventsSubscribeProperties() map[string]*jsonschema.Schema {
+	properties := events.FilterSchemaProperties()
+	properties["mode"] = &jsonschema.Schema{
+		Type:        "string",
+		Description: "Subscription mode: 'events' for all events (default), 'faults' for resource-based fault detection with edge-triggered state change notifications",
+		Enum:        []any{"events", "faults"},
+		Default:     json.RawMessage(`"events"`),
+	}
+	properties["resumeFrom"] = &jsonschema.Schema{
+		Type:        "string",
+		Description: "Resource version to resume an 'events' mode subscription from, e.g. the resourceVersion of the last event received before reconnecting. Events after it are delivered first; if it is too old, the subscription starts from now. Defaults to now",
+	}
+	return properties
+}
+
 func eventsSubscribe(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
 	// Transport check: verify sessionID is not empty
 	if params.SessionID == "" {
@@ -163,8 +162,17 @@ func eventsSubscribe(params api.ToolHandlerParams) (*api.ToolCallResult, error)
 		mode = modeArg
 	}
 
-	// Parse filters from arguments
-	filters := events.ParseFiltersFromMap(params.GetArguments())
+	// Parse filters from the remaining arguments, rejecting unknown keys so typos are
+	// reported instead of silently widening the subscription
+	filterArgs := maps.Clone(params.GetArguments())
+	delete(filterArgs, "mode")
+	delete(filterArgs, "resumeFrom")
+	delete(filterArgs, kubernetes.KubeConfigTargetParameterName)
+	filters, err := events.ParseFiltersFromMapStrict(filterArgs)
+	if err != nil {
+		klog.V(1).Infof("Event subscription filters rejected for session %s: %v", params.SessionID, err)
+		return api.NewToolCallResult("", fmt.Errorf("invalid subscription filters: %v", err)), nil
+	}
 
 	// Validate filters for the specified mode
 	if err := filters.ValidateForMode(mode); err != nil {
@@ -172,8 +180,10 @@ func eventsSubscribe(params api.ToolHandlerParams) (*api.ToolCallResult, error)
 		return api.NewToolCallResult("", fmt.Errorf("invalid subscription filters: %v", err)), nil
 	}
 
+	resumeFrom, _ := params.GetArguments()["resumeFrom"].(string)
+
 	// Create the subscription (using the cluster from params)
-	subInterface, err := params.EventManager.Create(params.SessionID, params.Cluster, mode, filters)
+	subInterface, err := params.EventManager.Create(params.SessionID, params.Cluster, mode, filters, resumeFrom)
 	if err != nil {
 		klog.V(1).Infof("Failed to create event subscription for session %s: %v", params.SessionID, err)
 		return api.NewToolCallResult("", fmt.Errorf("failed to create subscription: %v", err)), nil
@@ -197,6 +207,23 @@ func eventsSubscribe(params api.ToolHandlerParams) (*api.ToolCallResult, error)
 		"filters":        sub.Filters.ToMap(),
 		"createdAt":      sub.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
 		"status":         "active",
+		"deliveryViable": sub.DeliveryViable,
+	}
+	if sub.ResumeFrom != "" {
+		response["resumeFrom"] = sub.ResumeFrom
+	}
+	delivery := "You will receive event notifications via the logging/message protocol."
+	if sub.Filters.Delivery == events.DeliveryResource {
+		resourceURI := events.SubscriptionResourceURI(sub.Cluster, sub.ID)
+		response["resourceUri"] = resourceURI
+		delivery = fmt.Sprintf("Subscribe to %s with resources/subscribe to receive notifications/resources/updated, and read it with resources/read for the most recent notifications.", resourceURI)
+	}
+	if !sub.DeliveryViable {
+		level := "info"
+		if sub.Mode == "faults" {
+			level = "warning"
+		}
+		delivery += fmt.Sprintf("\n\nWarning: your log level drops these notifications. Send logging/setLevel with level %q or lower to receive them.", level)
 	}
 
 	responseJSON, err := json.MarshalIndent(response, "", "  ")
@@ -204,7 +231,7 @@ func eventsSubscribe(params api.ToolHandlerParams) (*api.ToolCallResult, error)
 		return api.NewToolCallResult("", fmt.Errorf("failed to marshal response: %v", err)), nil
 	}
 
-	return api.NewToolCallResult(fmt.Sprintf("# Event Subscription Created\n\n%s\n\nYou will receive event notifications via the logging/message protocol.", string(responseJSON)), nil), nil
+	return api.NewToolCallResult(fmt.Sprintf("# Event Subscription Created\n\n%s\n\n%s", string(responseJSON), delivery), nil), nil
 }
 
 func eventsUnsubscribe(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
@@ -270,12 +297,15 @@ func eventsListSubscriptions(params api.ToolHandlerParams) (*api.ToolCallResult,
 	subscriptionsList := make([]map[string]interface{}, 0, len(subs))
 	for _, sub := range subs {
 		subscriptionsList = append(subscriptionsList, map[string]interface{}{
-			"subscriptionId": sub.ID,
-			"cluster":        sub.Cluster,
-			"mode":           sub.Mode,
-			"filters":        sub.Filters.ToMap(),
-			"createdAt":      sub.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
-			"degraded":       sub.Degraded,
+			"subscriptionId":       sub.ID,
+			"cluster":              sub.Cluster,
+			"mode":                 sub.Mode,
+			"filters":              sub.Filters.ToMap(),
+			"createdAt":            sub.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+			"degraded":             sub.Degraded,
+			"health":               sub.Health,
+			"droppedNotifications": sub.DroppedNotifications(),
+			"lastEventAt":          sub.LastEventAt().Format("2006-01-02T15:04:05Z07:00"),
 		})
 	}
 
@@ -291,3 +321,60 @@ func eventsListSubscriptions(params api.ToolHandlerParams) (*api.ToolCallResult,
 
 	return api.NewToolCallResult(fmt.Sprintf("# Active Subscriptions\n\n%s", string(responseJSON)), nil), nil
 }
+
+func eventsRecentFaults(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	// Check if EventManager is available
+	if params.EventManager == nil {
+		return api.NewToolCallResult("", fmt.Errorf("event subscription manager not available")), nil
+	}
+
+	limit := 0
+	if limitArg := params.GetArguments()["limit"]; limitArg != nil {
+		parsed, err := api.ParseInt64(limitArg)
+		if err != nil {
+			return api.NewToolCallResult("", fmt.Errorf("failed to parse limit parameter: %w", err)), nil
+		}
+		limit = int(parsed)
+	}
+
+	// Get recent faults for this cluster
+	faultsInterface := params.EventManager.GetRecentFaults(params.Cluster, limit)
+
+	// Cast to concrete type
+	faults, ok := faultsInterface.([]*events.FaultSignal)
+	if !ok {
+		return api.NewToolCallResult("", fmt.Errorf("unexpected faults type returned")), nil
+	}
+
+	// Build response with fault details
+	faultsList := make([]map[string]interface{}, 0, len(faults))
+	for _, fault := range faults {
+		entry := map[string]interface{}{
+			"faultId":   events.GenerateFaultID(params.Cluster, fault.FaultType, fault.ResourceUID, fault.ContainerName),
+			"faultType": fault.FaultType,
+			"severity":  fault.Severity,
+			"kind":      fault.Kind,
+			"name":      fault.Name,
+			"namespace": fault.Namespace,
+			"context":   fault.Context,
+			"timestamp": fault.Timestamp.Format("2006-01-02T15:04:05Z07:00"),
+		}
+		if fault.ContainerName != "" {
+			entry["containerName"] = fault.ContainerName
+		}
+		faultsList = append(faultsList, entry)
+	}
+
+	response := map[string]interface{}{
+		"cluster": params.Cluster,
+		"faults":  faultsList,
+		"total":   len(faults),
+	}
+
+	responseJSON, err := json.MarshalIndent(response, "", "  ")
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to marshal response: %v", err)), nil
+	}
+
+	return api.NewToolCallResult(fmt.Sprintf("# Recent Faults\n\n%s", string(responseJSON)), nil), nil
+}
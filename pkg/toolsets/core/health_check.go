@@ -576,8 +576,11 @@ func gatherEventDiagnostics(params api.PromptHandlerParams, namespace string) (s
 				continue
 			}
 
-			// Check timestamp
+			// Check timestamp, using the series of newer events that don't set the legacy fields
 			lastSeenTime := event.LastTimestamp.Time
+			if lastSeenTime.IsZero() && event.Series != nil {
+				lastSeenTime = event.Series.LastObservedTime.Time
+			}
 			if lastSeenTime.IsZero() {
 				lastSeenTime = event.EventTime.Time
 			}
@@ -597,8 +600,13 @@ func gatherEventDiagnostics(params api.PromptHandlerParams, namespace string) (s
 				message = message[:150] + "..."
 			}
 
+			count := event.Count
+			if count == 0 && event.Series != nil {
+				count = event.Series.Count
+			}
+
 			recentEvents = append(recentEvents, fmt.Sprintf("- **%s/%s** in `%s` (%s, Count: %d)\n  - %s",
-				event.InvolvedObject.Kind, event.InvolvedObject.Name, ns, event.Reason, event.Count, message))
+				event.InvolvedObject.Kind, event.InvolvedObject.Name, ns, event.Reason, count, message))
 		}
 	}
 